@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.calendars (
+  id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+  organization_id uuid NOT NULL,
+  name character varying(128) NOT NULL,
+  description text,
+  exclusions jsonb DEFAULT '[]'::jsonb NOT NULL,
+  created_by uuid,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+  CONSTRAINT calendars_pkey PRIMARY KEY (id),
+  CONSTRAINT calendars_organization_id_name_key UNIQUE (organization_id, name),
+  CONSTRAINT calendars_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE,
+  CONSTRAINT calendars_created_by_fkey FOREIGN KEY (created_by) REFERENCES public.users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendars_organization_id ON public.calendars (organization_id);
+
+COMMIT;
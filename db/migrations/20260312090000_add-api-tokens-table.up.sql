@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.api_tokens (
+  id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+  organization_id uuid NOT NULL,
+  user_id uuid NOT NULL,
+  name character varying(128) NOT NULL,
+  token_hash character varying(250) NOT NULL,
+  scopes jsonb DEFAULT '[]'::jsonb NOT NULL,
+  canvas_id uuid,
+  expires_at timestamp without time zone,
+  last_used_at timestamp without time zone,
+  created_by uuid,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+  CONSTRAINT api_tokens_pkey PRIMARY KEY (id),
+  CONSTRAINT api_tokens_token_hash_key UNIQUE (token_hash),
+  CONSTRAINT api_tokens_user_id_name_key UNIQUE (user_id, name),
+  CONSTRAINT api_tokens_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE,
+  CONSTRAINT api_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE,
+  CONSTRAINT api_tokens_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.workflows(id) ON DELETE CASCADE,
+  CONSTRAINT api_tokens_created_by_fkey FOREIGN KEY (created_by) REFERENCES public.users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_tokens_organization_id ON public.api_tokens (organization_id);
+
+COMMIT;
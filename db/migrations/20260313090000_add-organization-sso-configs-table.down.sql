@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.organization_sso_configs;
+
+COMMIT;
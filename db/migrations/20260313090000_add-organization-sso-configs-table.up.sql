@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.organization_sso_configs (
+  id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+  organization_id uuid NOT NULL,
+  protocol character varying(16) NOT NULL,
+  enabled boolean DEFAULT false NOT NULL,
+  enforced boolean DEFAULT false NOT NULL,
+  oidc_issuer_url text,
+  oidc_client_id character varying(255),
+  oidc_client_secret_ciphertext bytea,
+  saml_idp_metadata text,
+  groups_claim character varying(128) DEFAULT 'groups'::character varying NOT NULL,
+  group_mappings jsonb DEFAULT '[]'::jsonb NOT NULL,
+  updated_by uuid,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+  CONSTRAINT organization_sso_configs_pkey PRIMARY KEY (id),
+  CONSTRAINT organization_sso_configs_organization_id_key UNIQUE (organization_id),
+  CONSTRAINT organization_sso_configs_protocol_check CHECK (protocol IN ('oidc', 'saml')),
+  CONSTRAINT organization_sso_configs_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE,
+  CONSTRAINT organization_sso_configs_updated_by_fkey FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL
+);
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.organization_scim_tokens (
+  id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+  organization_id uuid NOT NULL,
+  token_hash character varying(250) NOT NULL,
+  last_used_at timestamp without time zone,
+  created_by uuid,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+  CONSTRAINT organization_scim_tokens_pkey PRIMARY KEY (id),
+  CONSTRAINT organization_scim_tokens_organization_id_key UNIQUE (organization_id),
+  CONSTRAINT organization_scim_tokens_token_hash_key UNIQUE (token_hash),
+  CONSTRAINT organization_scim_tokens_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE,
+  CONSTRAINT organization_scim_tokens_created_by_fkey FOREIGN KEY (created_by) REFERENCES public.users(id) ON DELETE SET NULL
+);
+
+COMMIT;
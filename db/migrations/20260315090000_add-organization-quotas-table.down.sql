@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.idx_workflow_node_executions_workflow_created_at;
+DROP INDEX IF EXISTS public.idx_workflow_events_workflow_created_at;
+DROP TABLE IF EXISTS public.organization_quotas;
+
+COMMIT;
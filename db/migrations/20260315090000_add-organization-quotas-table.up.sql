@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.organization_quotas (
+  organization_id uuid NOT NULL,
+  max_canvases integer,
+  max_nodes_per_canvas integer,
+  max_events_per_minute integer,
+  max_executions_per_day integer,
+  max_memory_rows integer,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+  CONSTRAINT organization_quotas_pkey PRIMARY KEY (organization_id),
+  CONSTRAINT organization_quotas_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_events_workflow_created_at ON public.workflow_events USING btree (workflow_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_workflow_node_executions_workflow_created_at ON public.workflow_node_executions USING btree (workflow_id, created_at);
+
+COMMIT;
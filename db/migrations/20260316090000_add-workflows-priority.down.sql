@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE public.workflows DROP COLUMN IF EXISTS priority;
+
+COMMIT;
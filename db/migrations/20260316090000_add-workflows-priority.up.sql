@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE public.workflows ADD COLUMN IF NOT EXISTS priority character varying(16) DEFAULT 'normal' NOT NULL;
+
+COMMIT;
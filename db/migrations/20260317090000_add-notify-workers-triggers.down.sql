@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS workflow_nodes_notify_workers ON public.workflow_nodes;
+DROP TRIGGER IF EXISTS workflow_node_requests_notify_workers ON public.workflow_node_requests;
+DROP TRIGGER IF EXISTS workflow_node_queue_items_notify_workers ON public.workflow_node_queue_items;
+DROP TRIGGER IF EXISTS workflow_node_executions_notify_workers ON public.workflow_node_executions;
+DROP TRIGGER IF EXISTS workflow_events_notify_workers ON public.workflow_events;
+DROP FUNCTION IF EXISTS public.notify_workers();
+
+COMMIT;
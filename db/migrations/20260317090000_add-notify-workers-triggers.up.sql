@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE OR REPLACE FUNCTION public.notify_workers() RETURNS trigger
+  LANGUAGE plpgsql
+  AS $$
+BEGIN
+  PERFORM pg_notify('superplane_workers', TG_TABLE_NAME);
+  RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS workflow_events_notify_workers ON public.workflow_events;
+CREATE TRIGGER workflow_events_notify_workers AFTER INSERT ON public.workflow_events FOR EACH STATEMENT EXECUTE FUNCTION public.notify_workers();
+
+DROP TRIGGER IF EXISTS workflow_node_executions_notify_workers ON public.workflow_node_executions;
+CREATE TRIGGER workflow_node_executions_notify_workers AFTER INSERT ON public.workflow_node_executions FOR EACH STATEMENT EXECUTE FUNCTION public.notify_workers();
+
+DROP TRIGGER IF EXISTS workflow_node_queue_items_notify_workers ON public.workflow_node_queue_items;
+CREATE TRIGGER workflow_node_queue_items_notify_workers AFTER INSERT ON public.workflow_node_queue_items FOR EACH STATEMENT EXECUTE FUNCTION public.notify_workers();
+
+DROP TRIGGER IF EXISTS workflow_node_requests_notify_workers ON public.workflow_node_requests;
+CREATE TRIGGER workflow_node_requests_notify_workers AFTER INSERT ON public.workflow_node_requests FOR EACH STATEMENT EXECUTE FUNCTION public.notify_workers();
+
+DROP TRIGGER IF EXISTS workflow_nodes_notify_workers ON public.workflow_nodes;
+CREATE TRIGGER workflow_nodes_notify_workers AFTER UPDATE OF state ON public.workflow_nodes FOR EACH ROW WHEN (NEW.state = 'ready' AND OLD.state IS DISTINCT FROM NEW.state) EXECUTE FUNCTION public.notify_workers();
+
+COMMIT;
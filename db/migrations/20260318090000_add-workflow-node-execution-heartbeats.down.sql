@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.workflow_node_execution_heartbeats;
+
+COMMIT;
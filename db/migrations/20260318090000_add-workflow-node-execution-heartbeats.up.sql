@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.workflow_node_execution_heartbeats (
+  execution_id uuid NOT NULL,
+  worker character varying(255) NOT NULL,
+  attempts integer DEFAULT 1 NOT NULL,
+  heartbeat_at timestamp with time zone DEFAULT now() NOT NULL,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  CONSTRAINT workflow_node_execution_heartbeats_pkey PRIMARY KEY (execution_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_node_execution_heartbeats_heartbeat_at ON public.workflow_node_execution_heartbeats USING btree (heartbeat_at);
+
+COMMIT;
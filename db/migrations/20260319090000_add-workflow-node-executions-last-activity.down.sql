@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.idx_workflow_node_executions_waiting;
+ALTER TABLE public.workflow_node_executions DROP COLUMN IF EXISTS max_waiting_seconds;
+ALTER TABLE public.workflow_node_executions DROP COLUMN IF EXISTS last_activity_at;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE public.workflow_node_executions ADD COLUMN IF NOT EXISTS last_activity_at timestamp without time zone;
+ALTER TABLE public.workflow_node_executions ADD COLUMN IF NOT EXISTS max_waiting_seconds integer;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_node_executions_waiting ON public.workflow_node_executions USING btree (last_activity_at) WHERE (((state)::text = 'started'::text) AND (max_waiting_seconds IS NOT NULL));
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.idx_workflow_node_queue_items_node_created_at;
+DROP INDEX IF EXISTS public.idx_workflow_node_executions_pending;
+DROP INDEX IF EXISTS public.idx_workflow_events_pending;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_events_pending ON public.workflow_events USING btree (workflow_id, created_at) WHERE ((state)::text = 'pending'::text);
+CREATE INDEX IF NOT EXISTS idx_workflow_node_executions_pending ON public.workflow_node_executions USING btree (workflow_id, created_at) WHERE ((state)::text = 'pending'::text);
+CREATE INDEX IF NOT EXISTS idx_workflow_node_queue_items_node_created_at ON public.workflow_node_queue_items USING btree (workflow_id, node_id, created_at);
+
+COMMIT;
@@ -33,6 +33,20 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
 COMMENT ON EXTENSION "uuid-ossp" IS 'generate universally unique identifiers (UUIDs)';
 
 
+--
+-- Name: notify_workers(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.notify_workers() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+  PERFORM pg_notify('superplane_workers', TG_TABLE_NAME);
+  RETURN NULL;
+END;
+$$;
+
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
@@ -84,6 +98,26 @@ CREATE TABLE public.accounts (
 );
 
 
+--
+-- Name: api_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.api_tokens (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    name character varying(128) NOT NULL,
+    token_hash character varying(250) NOT NULL,
+    scopes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    canvas_id uuid,
+    expires_at timestamp without time zone,
+    last_used_at timestamp without time zone,
+    created_by uuid,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: app_installation_requests; Type: TABLE; Schema: public; Owner: -
 --
@@ -171,6 +205,22 @@ CREATE TABLE public.blueprints (
 );
 
 
+--
+-- Name: calendars; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.calendars (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    name character varying(128) NOT NULL,
+    description text,
+    exclusions jsonb DEFAULT '[]'::jsonb NOT NULL,
+    created_by uuid,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: canvas_memories; Type: TABLE; Schema: public; Owner: -
 --
@@ -330,6 +380,60 @@ CREATE TABLE public.organization_invite_links (
 );
 
 
+--
+-- Name: organization_quotas; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_quotas (
+    organization_id uuid NOT NULL,
+    max_canvases integer,
+    max_nodes_per_canvas integer,
+    max_events_per_minute integer,
+    max_executions_per_day integer,
+    max_memory_rows integer,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: organization_scim_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_scim_tokens (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    token_hash character varying(250) NOT NULL,
+    last_used_at timestamp without time zone,
+    created_by uuid,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: organization_sso_configs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_sso_configs (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    protocol character varying(16) NOT NULL,
+    enabled boolean DEFAULT false NOT NULL,
+    enforced boolean DEFAULT false NOT NULL,
+    oidc_issuer_url text,
+    oidc_client_id character varying(255),
+    oidc_client_secret_ciphertext bytea,
+    saml_idp_metadata text,
+    groups_claim character varying(128) DEFAULT 'groups'::character varying NOT NULL,
+    group_mappings jsonb DEFAULT '[]'::jsonb NOT NULL,
+    updated_by uuid,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL,
+    CONSTRAINT organization_sso_configs_protocol_check CHECK (((protocol)::text = ANY ((ARRAY['oidc'::character varying, 'saml'::character varying])::text[])))
+);
+
+
 --
 -- Name: organizations; Type: TABLE; Schema: public; Owner: -
 --
@@ -464,6 +568,19 @@ CREATE TABLE public.workflow_events (
 );
 
 
+--
+-- Name: workflow_node_execution_heartbeats; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_heartbeats (
+    execution_id uuid NOT NULL,
+    worker character varying(255) NOT NULL,
+    attempts integer DEFAULT 1 NOT NULL,
+    heartbeat_at timestamp with time zone DEFAULT now() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: workflow_node_execution_kvs; Type: TABLE; Schema: public; Owner: -
 --
@@ -499,7 +616,9 @@ CREATE TABLE public.workflow_node_executions (
     configuration jsonb DEFAULT '{}'::jsonb NOT NULL,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    cancelled_by uuid
+    cancelled_by uuid,
+    last_activity_at timestamp without time zone,
+    max_waiting_seconds integer
 );
 
 
@@ -604,7 +723,8 @@ CREATE TABLE public.workflows (
     created_by uuid,
     deleted_at timestamp without time zone,
     is_template boolean DEFAULT false NOT NULL,
-    live_version_id uuid NOT NULL
+    live_version_id uuid NOT NULL,
+    priority character varying(16) DEFAULT 'normal'::character varying NOT NULL
 );
 
 
@@ -671,6 +791,30 @@ ALTER TABLE ONLY public.accounts
     ADD CONSTRAINT accounts_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: api_tokens api_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: api_tokens api_tokens_token_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_token_hash_key UNIQUE (token_hash);
+
+
+--
+-- Name: api_tokens api_tokens_user_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_user_id_name_key UNIQUE (user_id, name);
+
+
 --
 -- Name: app_installation_requests app_installation_requests_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -727,6 +871,22 @@ ALTER TABLE ONLY public.blueprints
     ADD CONSTRAINT blueprints_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: calendars calendars_organization_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.calendars
+    ADD CONSTRAINT calendars_organization_id_name_key UNIQUE (organization_id, name);
+
+
+--
+-- Name: calendars calendars_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.calendars
+    ADD CONSTRAINT calendars_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: canvas_memories canvas_memories_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -831,6 +991,54 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_token_key UNIQUE (token);
 
 
+--
+-- Name: organization_quotas organization_quotas_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_quotas
+    ADD CONSTRAINT organization_quotas_pkey PRIMARY KEY (organization_id);
+
+
+--
+-- Name: organization_scim_tokens organization_scim_tokens_organization_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_scim_tokens
+    ADD CONSTRAINT organization_scim_tokens_organization_id_key UNIQUE (organization_id);
+
+
+--
+-- Name: organization_scim_tokens organization_scim_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_scim_tokens
+    ADD CONSTRAINT organization_scim_tokens_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organization_scim_tokens organization_scim_tokens_token_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_scim_tokens
+    ADD CONSTRAINT organization_scim_tokens_token_hash_key UNIQUE (token_hash);
+
+
+--
+-- Name: organization_sso_configs organization_sso_configs_organization_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_configs
+    ADD CONSTRAINT organization_sso_configs_organization_id_key UNIQUE (organization_id);
+
+
+--
+-- Name: organization_sso_configs organization_sso_configs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_configs
+    ADD CONSTRAINT organization_sso_configs_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: organizations organizations_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -935,6 +1143,14 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_node_execution_heartbeats workflow_node_execution_heartbeats_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_heartbeats
+    ADD CONSTRAINT workflow_node_execution_heartbeats_pkey PRIMARY KEY (execution_id);
+
+
 --
 -- Name: workflow_node_execution_kvs workflow_node_execution_kvs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -1099,6 +1315,13 @@ CREATE INDEX idx_app_installations_deleted_at ON public.app_installations USING
 CREATE UNIQUE INDEX idx_app_installations_org_name_unique ON public.app_installations USING btree (organization_id, installation_name);
 
 
+--
+-- Name: idx_api_tokens_organization_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_api_tokens_organization_id ON public.api_tokens USING btree (organization_id);
+
+
 --
 -- Name: idx_app_installations_organization_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1113,6 +1336,13 @@ CREATE INDEX idx_app_installations_organization_id ON public.app_installations U
 CREATE INDEX idx_blueprints_organization_id ON public.blueprints USING btree (organization_id);
 
 
+--
+-- Name: idx_calendars_organization_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_calendars_organization_id ON public.calendars USING btree (organization_id);
+
+
 --
 -- Name: idx_canvas_memories_canvas_namespace; Type: INDEX; Schema: public; Owner: -
 --
@@ -1225,6 +1455,13 @@ CREATE INDEX idx_workflow_change_requests_workflow_id ON public.workflow_change_
 CREATE INDEX idx_workflow_events_execution_id ON public.workflow_events USING btree (execution_id);
 
 
+--
+-- Name: idx_workflow_events_pending; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_events_pending ON public.workflow_events USING btree (workflow_id, created_at) WHERE ((state)::text = 'pending'::text);
+
+
 --
 -- Name: idx_workflow_events_state; Type: INDEX; Schema: public; Owner: -
 --
@@ -1232,6 +1469,13 @@ CREATE INDEX idx_workflow_events_execution_id ON public.workflow_events USING bt
 CREATE INDEX idx_workflow_events_state ON public.workflow_events USING btree (state);
 
 
+--
+-- Name: idx_workflow_events_workflow_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_events_workflow_created_at ON public.workflow_events USING btree (workflow_id, created_at);
+
+
 --
 -- Name: idx_workflow_events_workflow_node_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1239,6 +1483,13 @@ CREATE INDEX idx_workflow_events_state ON public.workflow_events USING btree (st
 CREATE INDEX idx_workflow_events_workflow_node_id ON public.workflow_events USING btree (workflow_id, node_id);
 
 
+--
+-- Name: idx_workflow_node_execution_heartbeats_heartbeat_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_heartbeats_heartbeat_at ON public.workflow_node_execution_heartbeats USING btree (heartbeat_at);
+
+
 --
 -- Name: idx_workflow_node_execution_kvs_ekv; Type: INDEX; Schema: public; Owner: -
 --
@@ -1274,6 +1525,13 @@ CREATE INDEX idx_workflow_node_executions_parent_execution_id ON public.workflow
 CREATE INDEX idx_workflow_node_executions_parent_state ON public.workflow_node_executions USING btree (parent_execution_id, state);
 
 
+--
+-- Name: idx_workflow_node_executions_pending; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_pending ON public.workflow_node_executions USING btree (workflow_id, created_at) WHERE ((state)::text = 'pending'::text);
+
+
 --
 -- Name: idx_workflow_node_executions_previous_execution_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1295,6 +1553,20 @@ CREATE INDEX idx_workflow_node_executions_root_event_id ON public.workflow_node_
 CREATE INDEX idx_workflow_node_executions_state_created_at ON public.workflow_node_executions USING btree (state, created_at DESC);
 
 
+--
+-- Name: idx_workflow_node_executions_waiting; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_waiting ON public.workflow_node_executions USING btree (last_activity_at) WHERE (((state)::text = 'started'::text) AND (max_waiting_seconds IS NOT NULL));
+
+
+--
+-- Name: idx_workflow_node_executions_workflow_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_workflow_created_at ON public.workflow_node_executions USING btree (workflow_id, created_at);
+
+
 --
 -- Name: idx_workflow_node_executions_workflow_node_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1309,6 +1581,13 @@ CREATE INDEX idx_workflow_node_executions_workflow_node_id ON public.workflow_no
 CREATE INDEX idx_workflow_node_installation_id ON public.workflow_nodes USING btree (app_installation_id);
 
 
+--
+-- Name: idx_workflow_node_queue_items_node_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_queue_items_node_created_at ON public.workflow_node_queue_items USING btree (workflow_id, node_id, created_at);
+
+
 --
 -- Name: idx_workflow_node_queue_items_root_event_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1414,6 +1693,41 @@ CREATE UNIQUE INDEX unique_human_user_in_organization ON public.users USING btre
 CREATE UNIQUE INDEX unique_service_account_in_organization ON public.users USING btree (organization_id, name) WHERE ((type)::text = 'service_account'::text);
 
 
+--
+-- Name: workflow_events workflow_events_notify_workers; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_events_notify_workers AFTER INSERT ON public.workflow_events FOR EACH STATEMENT EXECUTE FUNCTION public.notify_workers();
+
+
+--
+-- Name: workflow_node_executions workflow_node_executions_notify_workers; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_node_executions_notify_workers AFTER INSERT ON public.workflow_node_executions FOR EACH STATEMENT EXECUTE FUNCTION public.notify_workers();
+
+
+--
+-- Name: workflow_node_queue_items workflow_node_queue_items_notify_workers; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_node_queue_items_notify_workers AFTER INSERT ON public.workflow_node_queue_items FOR EACH STATEMENT EXECUTE FUNCTION public.notify_workers();
+
+
+--
+-- Name: workflow_node_requests workflow_node_requests_notify_workers; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_node_requests_notify_workers AFTER INSERT ON public.workflow_node_requests FOR EACH STATEMENT EXECUTE FUNCTION public.notify_workers();
+
+
+--
+-- Name: workflow_nodes workflow_nodes_notify_workers; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_nodes_notify_workers AFTER UPDATE OF state ON public.workflow_nodes FOR EACH ROW WHEN ((((new.state)::text = 'ready'::text) AND ((old.state)::text IS DISTINCT FROM (new.state)::text))) EXECUTE FUNCTION public.notify_workers();
+
+
 --
 -- Name: account_password_auth account_password_auth_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1430,6 +1744,38 @@ ALTER TABLE ONLY public.account_providers
     ADD CONSTRAINT account_providers_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.accounts(id);
 
 
+--
+-- Name: api_tokens api_tokens_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
+--
+-- Name: api_tokens api_tokens_created_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_created_by_fkey FOREIGN KEY (created_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: api_tokens api_tokens_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: api_tokens api_tokens_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: app_installation_requests app_installation_requests_app_installation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1486,6 +1832,22 @@ ALTER TABLE ONLY public.app_installations
     ADD CONSTRAINT app_installations_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: calendars calendars_created_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.calendars
+    ADD CONSTRAINT calendars_created_by_fkey FOREIGN KEY (created_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: calendars calendars_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.calendars
+    ADD CONSTRAINT calendars_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
 --
 -- Name: canvas_memories canvas_memories_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1582,6 +1944,46 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: organization_quotas organization_quotas_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_quotas
+    ADD CONSTRAINT organization_quotas_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_scim_tokens organization_scim_tokens_created_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_scim_tokens
+    ADD CONSTRAINT organization_scim_tokens_created_by_fkey FOREIGN KEY (created_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: organization_scim_tokens organization_scim_tokens_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_scim_tokens
+    ADD CONSTRAINT organization_scim_tokens_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_sso_configs organization_sso_configs_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_configs
+    ADD CONSTRAINT organization_sso_configs_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_sso_configs organization_sso_configs_updated_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_configs
+    ADD CONSTRAINT organization_sso_configs_updated_by_fkey FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
 --
 -- Name: users users_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1846,7 +2248,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20260304110100	f
+20260320090000	f
 \.
 
 
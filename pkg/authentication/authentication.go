@@ -37,6 +37,7 @@ type Handler struct {
 	templateDir          string
 	blockSignup          bool
 	passwordLoginEnabled bool
+	baseURL              string
 }
 
 type ProviderConfig struct {
@@ -88,6 +89,7 @@ func (a *Handler) InitializeProviders(providers map[string]ProviderConfig) {
 func (a *Handler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/logout", a.handleLogout).Methods("GET")
 	router.HandleFunc("/auth/config", a.handleAuthConfig).Methods("GET")
+	a.registerSSORoutes(router)
 	if a.passwordLoginEnabled {
 		router.HandleFunc("/login", a.handlePasswordLogin).Methods("POST")
 		router.HandleFunc("/signup", a.handlePasswordSignup).Methods("POST")
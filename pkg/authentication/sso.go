@@ -0,0 +1,519 @@
+package authentication
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/markbates/goth"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	// Claim of the account token for sessions created
+	// through the single sign-on of an organization.
+	// These sessions can only be used for that organization.
+	SSOOrganizationClaim = "sso_org"
+
+	SSOProviderPrefix = "sso:"
+
+	ssoStateCookieName = "sso_state"
+	ssoStateDuration   = 10 * time.Minute
+)
+
+var ErrSSOAccountLinkRequired = errors.New("an account with this email already exists, and must be linked before using single sign-on")
+
+/*
+ * The identity of a user, as reported by the identity provider.
+ */
+type ssoIdentity struct {
+	Subject string
+	Email   string
+	Name    string
+	Groups  []string
+}
+
+/*
+ * State of an ongoing login, kept in a short-lived signed cookie,
+ * so the callback can verify the response is for a login we started.
+ * LinkAccountID is the account logged in when the login started, if any,
+ * which is the only account the identity can be linked to by email.
+ */
+type ssoState struct {
+	OrganizationID string
+	State          string
+	Nonce          string
+	RequestID      string
+	Redirect       string
+	LinkAccountID  string
+}
+
+func SSOProviderName(organizationID string) string {
+	return SSOProviderPrefix + organizationID
+}
+
+func SSOLoginURL(baseURL, organizationID string) string {
+	return fmt.Sprintf("%s/auth/sso/%s", strings.TrimRight(baseURL, "/"), organizationID)
+}
+
+func SSOCallbackURL(baseURL, organizationID string) string {
+	return SSOLoginURL(baseURL, organizationID) + "/callback"
+}
+
+func SSOMetadataURL(baseURL, organizationID string) string {
+	return SSOLoginURL(baseURL, organizationID) + "/metadata"
+}
+
+// SSOClientSecretCredentialName is used as associated data
+// when encrypting the OIDC client secret of an organization.
+func SSOClientSecretCredentialName(organizationID string) string {
+	return "sso_oidc_client_secret:" + organizationID
+}
+
+func (a *Handler) InitializeSSO(baseURL string) {
+	a.baseURL = baseURL
+}
+
+func (a *Handler) registerSSORoutes(router *mux.Router) {
+	router.HandleFunc("/auth/sso/{organization_id}/metadata", a.handleSSOMetadata).Methods("GET")
+	router.HandleFunc("/auth/sso/{organization_id}/callback", a.handleSSOCallback).Methods("GET", "POST")
+	router.HandleFunc("/auth/sso/{organization_id}", a.handleSSOLogin).Methods("GET")
+}
+
+func (a *Handler) handleSSOLogin(w http.ResponseWriter, r *http.Request) {
+	config, err := findEnabledSSOConfig(mux.Vars(r)["organization_id"])
+	if err != nil {
+		http.Error(w, "Single sign-on is not configured", http.StatusNotFound)
+		return
+	}
+
+	stateValue, err := crypto.Base64String(32)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	state := ssoState{
+		OrganizationID: config.OrganizationID.String(),
+		State:          stateValue,
+		Redirect:       getRedirectURL(r),
+		LinkAccountID:  a.loggedInAccountID(r),
+	}
+
+	var loginURL string
+	switch config.Protocol {
+	case models.SSOProtocolOIDC:
+		loginURL, err = a.beginOIDCLogin(r, config, &state)
+	case models.SSOProtocolSAML:
+		loginURL, err = a.beginSAMLLogin(config, &state)
+	default:
+		err = fmt.Errorf("unknown protocol %s", config.Protocol)
+	}
+
+	if err != nil {
+		log.Errorf("Error starting single sign-on for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Single sign-on is not available", http.StatusBadGateway)
+		return
+	}
+
+	err = a.setSSOStateCookie(w, r, config, state)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, loginURL, http.StatusFound)
+}
+
+func (a *Handler) handleSSOCallback(w http.ResponseWriter, r *http.Request) {
+	config, err := findEnabledSSOConfig(mux.Vars(r)["organization_id"])
+	if err != nil {
+		http.Error(w, "Single sign-on is not configured", http.StatusNotFound)
+		return
+	}
+
+	state, err := a.getSSOStateCookie(r, config.OrganizationID.String())
+	clearSSOStateCookie(w, r, config)
+	if err != nil {
+		log.Warnf("Invalid single sign-on state for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	var identity *ssoIdentity
+	switch config.Protocol {
+	case models.SSOProtocolOIDC:
+		identity, err = a.completeOIDCLogin(r, config, state)
+	case models.SSOProtocolSAML:
+		identity, err = a.completeSAMLLogin(r, config, state)
+	default:
+		err = fmt.Errorf("unknown protocol %s", config.Protocol)
+	}
+
+	if err != nil {
+		log.Warnf("Single sign-on failed for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	if identity.Subject == "" || identity.Email == "" {
+		log.Warnf("Single sign-on for organization %s returned no subject or email", config.OrganizationID)
+		http.Error(w, "Authentication failed: the identity provider did not return an email", http.StatusUnauthorized)
+		return
+	}
+
+	account, err := a.loginWithSSO(config, identity, state.LinkAccountID)
+	if errors.Is(err, ErrSSOAccountLinkRequired) {
+		log.Warnf("Single sign-on for %s in organization %s requires linking an existing account", identity.Email, config.OrganizationID)
+		http.Error(w, "An account with this email already exists. Log in to SuperPlane with it, then sign in with single sign-on again to link them.", http.StatusConflict)
+		return
+	}
+
+	if err != nil {
+		log.Errorf("Error completing single sign-on for %s in organization %s: %v", identity.Email, config.OrganizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	token, err := a.jwtSigner.GenerateWithClaims(account.ID.String(), 24*time.Hour, map[string]any{
+		SSOOrganizationClaim: config.OrganizationID.String(),
+	})
+
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "account_token",
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(24 * time.Hour.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	redirectURL := state.Redirect
+	if redirectURL == "" || redirectURL == "/" {
+		redirectURL = "/" + config.OrganizationID.String()
+	}
+
+	// SAML responses are posted by the browser, so we use 303 to redirect with GET.
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+func (a *Handler) handleSSOMetadata(w http.ResponseWriter, r *http.Request) {
+	config, err := findEnabledSSOConfig(mux.Vars(r)["organization_id"])
+	if err != nil || config.Protocol != models.SSOProtocolSAML {
+		http.Error(w, "SAML single sign-on is not configured", http.StatusNotFound)
+		return
+	}
+
+	sp, err := a.samlServiceProvider(config)
+	if err != nil {
+		log.Errorf("Error building SAML metadata for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	metadata, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, _ = w.Write(metadata)
+}
+
+/*
+ * Users logging in through the identity provider of an organization
+ * are trusted by that organization, so accounts and memberships
+ * are created for them if they don't exist yet.
+ *
+ * The identity provider is only trusted for that organization, though:
+ * it never changes the email of an account, and an identity is only linked
+ * to an existing account with the same email if that account was logged in
+ * when the login started, since any organization can configure an identity
+ * provider that reports any email.
+ */
+func (a *Handler) loginWithSSO(config *models.OrganizationSSOConfig, identity *ssoIdentity, linkAccountID string) (*models.Account, error) {
+	gothUser := goth.User{
+		UserID:   identity.Subject,
+		Email:    identity.Email,
+		Name:     identity.Name,
+		NickName: identity.Email,
+		Provider: SSOProviderName(config.OrganizationID.String()),
+	}
+
+	if gothUser.Name == "" {
+		gothUser.Name = identity.Email
+	}
+
+	account, err := findOrCreateAccountForSSO(gothUser, linkAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = updateAccountProviders(a.encryptor, account, gothUser)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.ensureSSOMembership(config.OrganizationID, account)
+	if err != nil {
+		return nil, err
+	}
+
+	a.syncSSOGroups(config, user, identity.Groups)
+	return account, nil
+}
+
+func findOrCreateAccountForSSO(gothUser goth.User, linkAccountID string) (*models.Account, error) {
+	account, err := models.FindAccountByProvider(gothUser.Provider, gothUser.UserID)
+	if err == nil {
+		return account, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	account, err = models.FindAccountByEmail(gothUser.Email)
+	if err == nil {
+		if account.ID.String() != linkAccountID {
+			return nil, ErrSSOAccountLinkRequired
+		}
+
+		return account, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return models.CreateAccount(gothUser.Name, gothUser.Email)
+}
+
+/*
+ * Only sessions not created through single sign-on can link accounts,
+ * so an identity provider can't be used to link accounts to another one.
+ */
+func (a *Handler) loggedInAccountID(r *http.Request) string {
+	cookie, err := r.Cookie("account_token")
+	if err != nil {
+		return ""
+	}
+
+	claims, err := a.jwtSigner.ValidateAndGetClaims(cookie.Value)
+	if err != nil {
+		return ""
+	}
+
+	if _, ok := claims[SSOOrganizationClaim]; ok {
+		return ""
+	}
+
+	accountID, _ := claims["sub"].(string)
+	return accountID
+}
+
+func (a *Handler) ensureSSOMembership(organizationID uuid.UUID, account *models.Account) (*models.User, error) {
+	var user *models.User
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		user, err = models.FindMaybeDeletedUserByEmailInTransaction(tx, organizationID.String(), account.Email)
+		if err == nil && !user.DeletedAt.Valid {
+			return nil
+		}
+
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err == nil {
+			err = user.RestoreInTransaction(tx)
+		} else {
+			user, err = models.CreateUserInTransaction(tx, organizationID, account.ID, account.Email, account.Name)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return a.authService.AssignRole(user.ID.String(), models.RoleOrgViewer, organizationID.String(), models.DomainTypeOrganization)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+/*
+ * Membership of the groups used in the mappings follows the identity provider:
+ * users are added to the groups mapped from their identity provider groups,
+ * and removed from the other mapped groups. Other groups are not touched.
+ * Failures are logged, but don't prevent the login.
+ */
+func (a *Handler) syncSSOGroups(config *models.OrganizationSSOConfig, user *models.User, idpGroups []string) {
+	organizationID := config.OrganizationID.String()
+	userID := user.ID.String()
+	mapped := config.MappedGroups(idpGroups)
+
+	for _, group := range config.ManagedGroups() {
+		members, err := a.authService.GetGroupUsers(organizationID, models.DomainTypeOrganization, group)
+		if err != nil {
+			log.Warnf("Error finding members of group %s in organization %s: %v", group, organizationID, err)
+			continue
+		}
+
+		isMember := slices.Contains(members, userID)
+		shouldBeMember := slices.Contains(mapped, group)
+
+		if shouldBeMember && !isMember {
+			err = a.authService.AddUserToGroup(organizationID, models.DomainTypeOrganization, userID, group)
+		}
+
+		if !shouldBeMember && isMember {
+			err = a.authService.RemoveUserFromGroup(organizationID, models.DomainTypeOrganization, userID, group)
+		}
+
+		if err != nil {
+			log.Warnf("Error syncing group %s for user %s in organization %s: %v", group, userID, organizationID, err)
+		}
+	}
+}
+
+func findEnabledSSOConfig(organizationID string) (*models.OrganizationSSOConfig, error) {
+	config, err := models.FindOrganizationSSOConfig(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.Enabled {
+		return nil, errors.New("single sign-on is disabled")
+	}
+
+	return config, nil
+}
+
+/*
+ * SAML responses are posted by the identity provider page,
+ * which is a cross-site request, so the cookie must allow it.
+ */
+func ssoStateCookieSameSite(config *models.OrganizationSSOConfig) (http.SameSite, bool) {
+	if config.Protocol == models.SSOProtocolSAML {
+		return http.SameSiteNoneMode, true
+	}
+
+	return http.SameSiteLaxMode, false
+}
+
+func (a *Handler) setSSOStateCookie(w http.ResponseWriter, r *http.Request, config *models.OrganizationSSOConfig, state ssoState) error {
+	token, err := a.jwtSigner.GenerateWithClaims(state.OrganizationID, ssoStateDuration, map[string]any{
+		"state":      state.State,
+		"nonce":      state.Nonce,
+		"request_id": state.RequestID,
+		"redirect":   state.Redirect,
+		"link":       state.LinkAccountID,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	sameSite, secure := ssoStateCookieSameSite(config)
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookieName,
+		Value:    token,
+		Path:     "/auth/sso/" + state.OrganizationID,
+		MaxAge:   int(ssoStateDuration.Seconds()),
+		HttpOnly: true,
+		Secure:   secure || r.TLS != nil,
+		SameSite: sameSite,
+	})
+
+	return nil
+}
+
+func (a *Handler) getSSOStateCookie(r *http.Request, organizationID string) (*ssoState, error) {
+	cookie, err := r.Cookie(ssoStateCookieName)
+	if err != nil {
+		return nil, fmt.Errorf("state cookie not found")
+	}
+
+	claims, err := a.jwtSigner.ValidateAndGetClaims(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims["sub"] != organizationID {
+		return nil, fmt.Errorf("state is for another organization")
+	}
+
+	state := &ssoState{OrganizationID: organizationID}
+	state.State, _ = claims["state"].(string)
+	state.Nonce, _ = claims["nonce"].(string)
+	state.RequestID, _ = claims["request_id"].(string)
+	state.Redirect, _ = claims["redirect"].(string)
+	state.LinkAccountID, _ = claims["link"].(string)
+
+	if state.State == "" {
+		return nil, fmt.Errorf("state is empty")
+	}
+
+	if !isValidRedirectURL(state.Redirect) {
+		state.Redirect = "/"
+	}
+
+	return state, nil
+}
+
+func clearSSOStateCookie(w http.ResponseWriter, r *http.Request, config *models.OrganizationSSOConfig) {
+	sameSite, secure := ssoStateCookieSameSite(config)
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookieName,
+		Value:    "",
+		Path:     "/auth/sso/" + config.OrganizationID.String(),
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   secure || r.TLS != nil,
+		SameSite: sameSite,
+	})
+}
+
+func normalizeSSOIdentity(identity *ssoIdentity) *ssoIdentity {
+	identity.Subject = strings.TrimSpace(identity.Subject)
+	identity.Email = utils.NormalizeEmail(strings.TrimSpace(identity.Email))
+	identity.Name = strings.TrimSpace(identity.Name)
+	return identity
+}
+
+func parseSSOURL(value string) (*url.URL, error) {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("invalid URL %s", value)
+	}
+
+	return parsed, nil
+}
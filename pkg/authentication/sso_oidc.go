@@ -0,0 +1,154 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"golang.org/x/oauth2"
+)
+
+func (a *Handler) beginOIDCLogin(r *http.Request, config *models.OrganizationSSOConfig, state *ssoState) (string, error) {
+	oauthConfig, _, err := a.oidcClient(r.Context(), config)
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := crypto.Base64String(32)
+	if err != nil {
+		return "", err
+	}
+
+	state.Nonce = nonce
+	return oauthConfig.AuthCodeURL(state.State, gooidc.Nonce(nonce)), nil
+}
+
+func (a *Handler) completeOIDCLogin(r *http.Request, config *models.OrganizationSSOConfig, state *ssoState) (*ssoIdentity, error) {
+	query := r.URL.Query()
+	if query.Get("error") != "" {
+		return nil, fmt.Errorf("identity provider error: %s %s", query.Get("error"), query.Get("error_description"))
+	}
+
+	if query.Get("state") != state.State {
+		return nil, fmt.Errorf("state mismatch")
+	}
+
+	oauthConfig, verifier, err := a.oidcClient(r.Context(), config)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauthConfig.Exchange(r.Context(), query.Get("code"))
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging code: %v", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("no id_token in token response")
+	}
+
+	idToken, err := verifier.Verify(r.Context(), rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id_token: %v", err)
+	}
+
+	if idToken.Nonce != state.Nonce {
+		return nil, fmt.Errorf("nonce mismatch")
+	}
+
+	claims := map[string]any{}
+	err = idToken.Claims(&claims)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id_token claims: %v", err)
+	}
+
+	return oidcIdentity(idToken.Subject, claims, config.GroupsClaim), nil
+}
+
+/*
+ * The provider configuration is discovered from the issuer
+ * on every login, so changes in the identity provider,
+ * like rotated signing keys, are picked up without restarts.
+ */
+func (a *Handler) oidcClient(ctx context.Context, config *models.OrganizationSSOConfig) (*oauth2.Config, *gooidc.IDTokenVerifier, error) {
+	if config.OIDCIssuerURL == nil || config.OIDCClientID == nil {
+		return nil, nil, fmt.Errorf("OIDC is not configured")
+	}
+
+	provider, err := gooidc.NewProvider(ctx, *config.OIDCIssuerURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error discovering OIDC provider: %v", err)
+	}
+
+	organizationID := config.OrganizationID.String()
+	clientSecret, err := a.encryptor.Decrypt(ctx, config.OIDCClientSecretCiphertext, []byte(SSOClientSecretCredentialName(organizationID)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decrypting client secret: %v", err)
+	}
+
+	oauthConfig := &oauth2.Config{
+		ClientID:     *config.OIDCClientID,
+		ClientSecret: string(clientSecret),
+		RedirectURL:  SSOCallbackURL(a.baseURL, organizationID),
+		Endpoint:     provider.Endpoint(),
+		Scopes:       []string{gooidc.ScopeOpenID, "email", "profile"},
+	}
+
+	verifier := provider.Verifier(&gooidc.Config{ClientID: *config.OIDCClientID})
+	return oauthConfig, verifier, nil
+}
+
+/*
+ * Entra ID does not always include the email claim,
+ * so the preferred username is used if it is an email.
+ */
+func oidcIdentity(subject string, claims map[string]any, groupsClaim string) *ssoIdentity {
+	identity := &ssoIdentity{
+		Subject: subject,
+		Email:   claimString(claims, "email"),
+		Name:    claimString(claims, "name"),
+		Groups:  claimStrings(claims, groupsClaim),
+	}
+
+	preferredUsername := claimString(claims, "preferred_username")
+	if identity.Email == "" && isEmail(preferredUsername) {
+		identity.Email = preferredUsername
+	}
+
+	if identity.Name == "" {
+		identity.Name = preferredUsername
+	}
+
+	return normalizeSSOIdentity(identity)
+}
+
+func claimString(claims map[string]any, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
+/*
+ * Group claims are usually a list of strings,
+ * but some identity providers send a single string.
+ */
+func claimStrings(claims map[string]any, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return []string{value}
+	case []any:
+		values := []string{}
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	default:
+		return []string{}
+	}
+}
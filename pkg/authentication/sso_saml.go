@@ -0,0 +1,217 @@
+package authentication
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/crewjam/saml"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+/*
+ * Common attribute names for the email and name of the user.
+ * Okta and Keycloak use short names, Entra ID and ADFS use claim URIs.
+ */
+var samlEmailAttributes = []string{
+	"email",
+	"mail",
+	"emailaddress",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+	"urn:oid:0.9.2342.19200300.100.1.3",
+}
+
+var samlNameAttributes = []string{
+	"name",
+	"displayName",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+	"http://schemas.microsoft.com/identity/claims/displayname",
+	"urn:oid:2.16.840.1.113730.3.1.241",
+}
+
+// ParseSAMLIdPMetadata parses the metadata of a SAML identity provider,
+// either a single entity, or a list of entities with a single identity provider.
+func ParseSAMLIdPMetadata(metadata string) (*saml.EntityDescriptor, error) {
+	descriptor := &saml.EntityDescriptor{}
+	err := xml.Unmarshal([]byte(metadata), descriptor)
+	if err == nil {
+		return validateSAMLIdPMetadata(descriptor)
+	}
+
+	entities := &saml.EntitiesDescriptor{}
+	if xml.Unmarshal([]byte(metadata), entities) != nil {
+		return nil, fmt.Errorf("invalid SAML metadata: %v", err)
+	}
+
+	for i := range entities.EntityDescriptors {
+		if len(entities.EntityDescriptors[i].IDPSSODescriptors) > 0 {
+			return validateSAMLIdPMetadata(&entities.EntityDescriptors[i])
+		}
+	}
+
+	return nil, fmt.Errorf("invalid SAML metadata: no identity provider found")
+}
+
+func validateSAMLIdPMetadata(descriptor *saml.EntityDescriptor) (*saml.EntityDescriptor, error) {
+	if descriptor.EntityID == "" {
+		return nil, fmt.Errorf("invalid SAML metadata: missing entity ID")
+	}
+
+	if len(descriptor.IDPSSODescriptors) == 0 {
+		return nil, fmt.Errorf("invalid SAML metadata: no identity provider found")
+	}
+
+	for _, idp := range descriptor.IDPSSODescriptors {
+		for _, service := range idp.SingleSignOnServices {
+			if service.Binding == saml.HTTPRedirectBinding {
+				return descriptor, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("invalid SAML metadata: identity provider does not support the HTTP-Redirect binding")
+}
+
+func (a *Handler) beginSAMLLogin(config *models.OrganizationSSOConfig, state *ssoState) (string, error) {
+	sp, err := a.samlServiceProvider(config)
+	if err != nil {
+		return "", err
+	}
+
+	request, err := sp.MakeAuthenticationRequest(sp.GetSSOBindingLocation(saml.HTTPRedirectBinding), saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", err
+	}
+
+	loginURL, err := request.Redirect(state.State, sp)
+	if err != nil {
+		return "", err
+	}
+
+	state.RequestID = request.ID
+	return loginURL.String(), nil
+}
+
+/*
+ * Only the HTTP-POST binding is supported for responses,
+ * and only responses to requests we started are accepted.
+ */
+func (a *Handler) completeSAMLLogin(r *http.Request, config *models.OrganizationSSOConfig, state *ssoState) (*ssoIdentity, error) {
+	if r.Method != http.MethodPost {
+		return nil, fmt.Errorf("SAML responses must be posted")
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		return nil, err
+	}
+
+	if r.PostForm.Get("RelayState") != state.State {
+		return nil, fmt.Errorf("state mismatch")
+	}
+
+	sp, err := a.samlServiceProvider(config)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := base64.StdEncoding.DecodeString(r.PostForm.Get("SAMLResponse"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SAML response encoding: %v", err)
+	}
+
+	assertion, err := sp.ParseXMLResponse(response, []string{state.RequestID}, sp.AcsURL)
+	if err != nil {
+		if invalid, ok := err.(*saml.InvalidResponseError); ok {
+			return nil, fmt.Errorf("invalid SAML response: %v", invalid.PrivateErr)
+		}
+
+		return nil, err
+	}
+
+	return samlIdentity(assertion, config.GroupsClaim), nil
+}
+
+func (a *Handler) samlServiceProvider(config *models.OrganizationSSOConfig) (*saml.ServiceProvider, error) {
+	if config.SAMLIdPMetadata == nil {
+		return nil, fmt.Errorf("SAML is not configured")
+	}
+
+	metadata, err := ParseSAMLIdPMetadata(*config.SAMLIdPMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID := config.OrganizationID.String()
+	metadataURL, err := parseSSOURL(SSOMetadataURL(a.baseURL, organizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	acsURL, err := parseSSOURL(SSOCallbackURL(a.baseURL, organizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &saml.ServiceProvider{
+		EntityID:          metadataURL.String(),
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		IDPMetadata:       metadata,
+		AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+	}, nil
+}
+
+func samlIdentity(assertion *saml.Assertion, groupsClaim string) *ssoIdentity {
+	identity := &ssoIdentity{
+		Email:  samlAttribute(assertion, samlEmailAttributes...),
+		Name:   samlAttribute(assertion, samlNameAttributes...),
+		Groups: samlAttributeValues(assertion, groupsClaim),
+	}
+
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		identity.Subject = assertion.Subject.NameID.Value
+	}
+
+	if identity.Email == "" && isEmail(identity.Subject) {
+		identity.Email = identity.Subject
+	}
+
+	return normalizeSSOIdentity(identity)
+}
+
+func samlAttribute(assertion *saml.Assertion, names ...string) string {
+	for _, name := range names {
+		values := samlAttributeValues(assertion, name)
+		if len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	return ""
+}
+
+func samlAttributeValues(assertion *saml.Assertion, name string) []string {
+	values := []string{}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			if !strings.EqualFold(attribute.Name, name) && !strings.EqualFold(attribute.FriendlyName, name) {
+				continue
+			}
+
+			for _, value := range attribute.Values {
+				if value.Value != "" {
+					values = append(values, value.Value)
+				}
+			}
+		}
+	}
+
+	return values
+}
+
+func isEmail(value string) bool {
+	return strings.Contains(value, "@")
+}
@@ -0,0 +1,237 @@
+package authentication
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+const testIdPMetadata = `<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com/metadata">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+
+func TestParseSAMLIdPMetadata(t *testing.T) {
+	t.Run("single entity", func(t *testing.T) {
+		descriptor, err := ParseSAMLIdPMetadata(testIdPMetadata)
+		require.NoError(t, err)
+		assert.Equal(t, "https://idp.example.com/metadata", descriptor.EntityID)
+	})
+
+	t.Run("invalid XML", func(t *testing.T) {
+		_, err := ParseSAMLIdPMetadata("not xml")
+		require.Error(t, err)
+	})
+
+	t.Run("entity without identity provider", func(t *testing.T) {
+		_, err := ParseSAMLIdPMetadata(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="sp"/>`)
+		require.Error(t, err)
+	})
+}
+
+func TestOIDCIdentity(t *testing.T) {
+	t.Run("uses email, name and groups claims", func(t *testing.T) {
+		identity := oidcIdentity("sub-1", map[string]any{
+			"email":  "Jane@Example.com",
+			"name":   "Jane",
+			"groups": []any{"engineering", "ops", 42},
+		}, "groups")
+
+		assert.Equal(t, "sub-1", identity.Subject)
+		assert.Equal(t, "jane@example.com", identity.Email)
+		assert.Equal(t, "Jane", identity.Name)
+		assert.Equal(t, []string{"engineering", "ops"}, identity.Groups)
+	})
+
+	t.Run("falls back to preferred username", func(t *testing.T) {
+		identity := oidcIdentity("sub-1", map[string]any{
+			"preferred_username": "jane@example.com",
+			"roles":              "admins",
+		}, "roles")
+
+		assert.Equal(t, "jane@example.com", identity.Email)
+		assert.Equal(t, "jane@example.com", identity.Name)
+		assert.Equal(t, []string{"admins"}, identity.Groups)
+	})
+}
+
+func TestSAMLIdentity(t *testing.T) {
+	assertion := &saml.Assertion{
+		Subject: &saml.Subject{NameID: &saml.NameID{Value: "jane@example.com"}},
+		AttributeStatements: []saml.AttributeStatement{
+			{
+				Attributes: []saml.Attribute{
+					{Name: "http://schemas.microsoft.com/identity/claims/displayname", Values: []saml.AttributeValue{{Value: "Jane"}}},
+					{Name: "memberOf", Values: []saml.AttributeValue{{Value: "engineering"}, {Value: "ops"}}},
+				},
+			},
+		},
+	}
+
+	identity := samlIdentity(assertion, "memberOf")
+	assert.Equal(t, "jane@example.com", identity.Subject)
+	assert.Equal(t, "jane@example.com", identity.Email)
+	assert.Equal(t, "Jane", identity.Name)
+	assert.Equal(t, []string{"engineering", "ops"}, identity.Groups)
+}
+
+func TestHandler_loginWithSSO(t *testing.T) {
+	handler, r := setupAuthHandler(t, true)
+
+	require.NoError(t, r.AuthService.CreateGroup(r.Organization.ID.String(), models.DomainTypeOrganization, "developers", models.RoleOrgViewer, "Developers", ""))
+	require.NoError(t, r.AuthService.CreateGroup(r.Organization.ID.String(), models.DomainTypeOrganization, "operators", models.RoleOrgViewer, "Operators", ""))
+
+	config := &models.OrganizationSSOConfig{
+		OrganizationID: r.Organization.ID,
+		Protocol:       models.SSOProtocolOIDC,
+		Enabled:        true,
+		GroupsClaim:    models.DefaultSSOGroupsClaim,
+		GroupMappings: []models.SSOGroupMapping{
+			{IdPGroup: "engineering", Group: "developers"},
+			{IdPGroup: "ops", Group: "operators"},
+		},
+	}
+
+	identity := &ssoIdentity{
+		Subject: "idp-user-1",
+		Email:   "sso-user@example.com",
+		Name:    "SSO User",
+		Groups:  []string{"engineering"},
+	}
+
+	t.Run("creates account and membership, even if signup is blocked", func(t *testing.T) {
+		account, err := handler.loginWithSSO(config, identity, "")
+		require.NoError(t, err)
+		assert.Equal(t, "sso-user@example.com", account.Email)
+
+		user, err := models.FindActiveUserByEmail(r.Organization.ID.String(), account.Email)
+		require.NoError(t, err)
+
+		developers, err := r.AuthService.GetGroupUsers(r.Organization.ID.String(), models.DomainTypeOrganization, "developers")
+		require.NoError(t, err)
+		assert.Contains(t, developers, user.ID.String())
+
+		provider, err := account.FindAccountProviderByID(SSOProviderName(r.Organization.ID.String()), "idp-user-1")
+		require.NoError(t, err)
+		assert.Equal(t, "sso-user@example.com", provider.Email)
+	})
+
+	t.Run("group membership follows the identity provider on every login", func(t *testing.T) {
+		identity.Groups = []string{"ops"}
+		account, err := handler.loginWithSSO(config, identity, "")
+		require.NoError(t, err)
+
+		user, err := models.FindActiveUserByEmail(r.Organization.ID.String(), account.Email)
+		require.NoError(t, err)
+
+		developers, err := r.AuthService.GetGroupUsers(r.Organization.ID.String(), models.DomainTypeOrganization, "developers")
+		require.NoError(t, err)
+		assert.NotContains(t, developers, user.ID.String())
+
+		operators, err := r.AuthService.GetGroupUsers(r.Organization.ID.String(), models.DomainTypeOrganization, "operators")
+		require.NoError(t, err)
+		assert.Contains(t, operators, user.ID.String())
+	})
+
+	t.Run("existing account with the same email is not linked", func(t *testing.T) {
+		//
+		// An account using the email in another organization,
+		// which the identity provider of this organization claims too.
+		//
+		existing, err := models.CreateAccount("Other User", "other-org-user@example.com")
+		require.NoError(t, err)
+		otherOrganization := support.CreateOrganization(t, r, r.User)
+		_, err = models.CreateUser(otherOrganization.ID, existing.ID, existing.Email, existing.Name)
+		require.NoError(t, err)
+
+		collision := &ssoIdentity{Subject: "idp-user-2", Email: "other-org-user@example.com", Name: "Impostor"}
+		_, err = handler.loginWithSSO(config, collision, "")
+		require.ErrorIs(t, err, ErrSSOAccountLinkRequired)
+
+		_, err = existing.FindAccountProviderByID(SSOProviderName(r.Organization.ID.String()), "idp-user-2")
+		require.Error(t, err)
+
+		_, err = models.FindActiveUserByEmail(r.Organization.ID.String(), existing.Email)
+		require.Error(t, err)
+
+		//
+		// Another logged in account can't be used to link it either.
+		//
+		_, err = handler.loginWithSSO(config, collision, r.Account.ID.String())
+		require.ErrorIs(t, err, ErrSSOAccountLinkRequired)
+	})
+
+	t.Run("existing account is linked when it started the login", func(t *testing.T) {
+		existing, err := models.CreateAccount("Linked User", "linked-user@example.com")
+		require.NoError(t, err)
+
+		linked := &ssoIdentity{Subject: "idp-user-3", Email: "linked-user@example.com", Name: "Linked User"}
+		account, err := handler.loginWithSSO(config, linked, existing.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, existing.ID, account.ID)
+
+		//
+		// Once linked, the identity logs in to the account,
+		// without the account being logged in.
+		//
+		account, err = handler.loginWithSSO(config, linked, "")
+		require.NoError(t, err)
+		assert.Equal(t, existing.ID, account.ID)
+	})
+
+	t.Run("identity provider does not change the account email", func(t *testing.T) {
+		renamed := &ssoIdentity{Subject: "idp-user-1", Email: "renamed@example.com", Name: "SSO User"}
+		account, err := handler.loginWithSSO(config, renamed, "")
+		require.NoError(t, err)
+		assert.Equal(t, "sso-user@example.com", account.Email)
+
+		account, err = models.FindAccountByID(account.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, "sso-user@example.com", account.Email)
+
+		provider, err := account.FindAccountProviderByID(SSOProviderName(r.Organization.ID.String()), "idp-user-1")
+		require.NoError(t, err)
+		assert.Equal(t, "renamed@example.com", provider.Email)
+	})
+}
+
+func TestHandler_loggedInAccountID(t *testing.T) {
+	handler, r := setupAuthHandler(t, true)
+
+	request := func(token string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/auth/sso/"+r.Organization.ID.String(), nil)
+		if token != "" {
+			req.AddCookie(&http.Cookie{Name: "account_token", Value: token})
+		}
+
+		return req
+	}
+
+	t.Run("no session", func(t *testing.T) {
+		assert.Empty(t, handler.loggedInAccountID(request("")))
+	})
+
+	t.Run("full session", func(t *testing.T) {
+		token, err := handler.jwtSigner.Generate(r.Account.ID.String(), time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, r.Account.ID.String(), handler.loggedInAccountID(request(token)))
+	})
+
+	t.Run("single sign-on sessions can't link accounts", func(t *testing.T) {
+		token, err := handler.jwtSigner.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+			SSOOrganizationClaim: r.Organization.ID.String(),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, handler.loggedInAccountID(request(token)))
+	})
+}
@@ -1,9 +1,15 @@
 package authorization
 
-import "gorm.io/gorm"
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
 
 type PermissionChecker interface {
 	CheckOrganizationPermission(userID, orgID, resource, action string) (bool, error)
+	CheckCanvasPermission(userID, orgID, canvasID, resource, action string) (bool, error)
+	CheckCanvasAccess(userID, orgID, canvasID, resource, orgAction, canvasAction string) (bool, error)
 	IsValidPermission(domainType string, permission *Permission) bool
 }
 
@@ -26,6 +32,15 @@ type RoleManager interface {
 	GetOrgUsersForRole(role string, orgID string) ([]string, error)
 }
 
+// Canvas role bindings interface
+type CanvasRoleManager interface {
+	AssignCanvasRole(canvasID string, binding CanvasRoleBinding) error
+	RemoveCanvasRole(canvasID string, binding CanvasRoleBinding) error
+	GetCanvasRoleBindings(canvasID string) ([]*CanvasRoleBinding, error)
+	RemoveUserCanvasRoles(userID string, canvasIDs []string) error
+	GetUserCanvasRoles(userID, orgID, canvasID string) ([]string, error)
+}
+
 // Setup and initialization interface
 type AuthorizationSetup interface {
 	SetupOrganization(tx *gorm.DB, orgID, ownerID string) error
@@ -62,6 +77,7 @@ type Authorization interface {
 	UserAccessQuery
 	RoleDefinitionQuery
 	CustomRoleManager
+	CanvasRoleManager
 }
 
 type RoleDefinition struct {
@@ -79,3 +95,22 @@ type Permission struct {
 	Action     string
 	DomainType string
 }
+
+// A canvas role bound to either a user or a group of the organization.
+type CanvasRoleBinding struct {
+	UserID string
+	Group  string
+	Role   string
+}
+
+func (b CanvasRoleBinding) subject() (string, error) {
+	if (b.UserID == "") == (b.Group == "") {
+		return "", fmt.Errorf("canvas role binding needs either a user or a group")
+	}
+
+	if b.UserID != "" {
+		return prefixUserID(b.UserID), nil
+	}
+
+	return prefixGroupName(b.Group), nil
+}
@@ -25,13 +25,15 @@ const (
 var _ Authorization = (*AuthService)(nil)
 
 type AuthService struct {
-	enforcer           *casbin.SyncedEnforcer
-	orgPolicyTemplates [][5]string
+	enforcer              *casbin.SyncedEnforcer
+	orgPolicyTemplates    [][5]string
+	canvasPolicyTemplates [][5]string
 }
 
 func NewAuthService() (*AuthService, error) {
 	modelPath := os.Getenv("RBAC_MODEL_PATH")
 	orgPolicyPath := os.Getenv("RBAC_ORG_POLICY_PATH")
+	canvasPolicyPath := os.Getenv("RBAC_CANVAS_POLICY_PATH")
 
 	adapter, err := gormadapter.NewTransactionalAdapterByDB(database.Conn())
 	if err != nil {
@@ -60,9 +62,20 @@ func NewAuthService() (*AuthService, error) {
 		return nil, fmt.Errorf("failed to parse org policies: %w", err)
 	}
 
+	canvasPoliciesCsv, err := os.ReadFile(canvasPolicyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read canvas policies: %w", err)
+	}
+
+	canvasPolicyTemplates, err := parsePoliciesFromCsv(canvasPoliciesCsv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse canvas policies: %w", err)
+	}
+
 	service := &AuthService{
-		enforcer:           enforcer,
-		orgPolicyTemplates: orgPolicyTemplates,
+		enforcer:              enforcer,
+		orgPolicyTemplates:    orgPolicyTemplates,
+		canvasPolicyTemplates: canvasPolicyTemplates,
 	}
 
 	if err := service.loadDefaultPolicies(); err != nil {
@@ -76,16 +89,79 @@ func (a *AuthService) CheckOrganizationPermission(userID, orgID, resource, actio
 	return a.checkPermission(userID, orgID, models.DomainTypeOrganization, resource, action)
 }
 
+/*
+ * Canvas roles are bound to users directly, or to groups of the organization.
+ * Group members get the permissions of the canvas roles bound to their groups.
+ */
+func (a *AuthService) CheckCanvasPermission(userID, orgID, canvasID, resource, action string) (bool, error) {
+	orgDomain := prefixDomain(models.DomainTypeOrganization, orgID)
+	canvasDomain := prefixDomain(models.DomainTypeCanvas, canvasID)
+	if err := a.loadPoliciesForDomains(orgDomain, canvasDomain); err != nil {
+		return false, err
+	}
+
+	subjects, err := a.getCanvasSubjects(userID, orgDomain)
+	if err != nil {
+		return false, err
+	}
+
+	for _, subject := range subjects {
+		allowed, err := a.enforcer.Enforce(subject, canvasDomain, resource, action)
+		if err != nil {
+			return false, err
+		}
+
+		if allowed {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+/*
+ * Canvases without role bindings are open to the organization roles.
+ * Once a canvas has role bindings, only they decide who can access it,
+ * and the owners and admins of the organization keep full access to it.
+ * orgAction is checked in the organization roles, canvasAction in the canvas roles.
+ */
+func (a *AuthService) CheckCanvasAccess(userID, orgID, canvasID, resource, orgAction, canvasAction string) (bool, error) {
+	bindings, err := a.GetCanvasRoleBindings(canvasID)
+	if err != nil {
+		return false, err
+	}
+
+	if len(bindings) == 0 {
+		return a.CheckOrganizationPermission(userID, orgID, resource, orgAction)
+	}
+
+	orgDomain := prefixDomain(models.DomainTypeOrganization, orgID)
+	if err := a.loadPoliciesForDomains(orgDomain); err != nil {
+		return false, err
+	}
+
+	orgRoles, err := a.enforcer.GetImplicitRolesForUser(prefixUserID(userID), orgDomain)
+	if err != nil {
+		return false, err
+	}
+
+	if contains(orgRoles, prefixRoleName(models.RoleOrgAdmin)) {
+		return true, nil
+	}
+
+	return a.CheckCanvasPermission(userID, orgID, canvasID, resource, canvasAction)
+}
+
 func (a *AuthService) IsValidPermission(domainType string, permission *Permission) bool {
 	if permission == nil {
 		return false
 	}
 
-	if domainType != models.DomainTypeOrganization {
+	if err := models.ValidateDomainType(domainType); err != nil {
 		return false
 	}
 
-	for _, policy := range a.orgPolicyTemplates {
+	for _, policy := range a.policyTemplates(domainType) {
 		if policy[0] != "p" {
 			continue
 		}
@@ -99,14 +175,36 @@ func (a *AuthService) IsValidPermission(domainType string, permission *Permissio
 
 func (a *AuthService) checkPermission(userID, domainID, domainType, resource, action string) (bool, error) {
 	domain := prefixDomain(domainType, domainID)
-	defaultDomain := ""
-	if domainType == models.DomainTypeOrganization {
-		defaultDomain = "/org/*"
+
+	//
+	// We always reload policies before checking for permissions.
+	// Due to the lack of proper support for distributed enforcers in casbin,
+	// we have no way to reliably know if policies on this instance are up to date.
+	//
+	if err := a.loadPoliciesForDomains(domain); err != nil {
+		return false, err
 	}
 
-	policyDomains := []string{domain}
-	if defaultDomain != "" {
-		policyDomains = append(policyDomains, defaultDomain)
+	prefixedUserID := prefixUserID(userID)
+	allowed, err := a.enforcer.Enforce(prefixedUserID, domain, resource, action)
+	if err != nil {
+		return false, err
+	}
+
+	if allowed {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (a *AuthService) loadPoliciesForDomains(domains ...string) error {
+	policyDomains := []string{}
+	for _, domain := range domains {
+		policyDomains = append(policyDomains, domain)
+		if defaultDomain := defaultDomainFor(a.getDomainTypeFromDomain(domain)); defaultDomain != "" && !contains(policyDomains, defaultDomain) {
+			policyDomains = append(policyDomains, defaultDomain)
+		}
 	}
 
 	filters := []gormadapter.Filter{
@@ -120,31 +218,187 @@ func (a *AuthService) checkPermission(userID, domainID, domainType, resource, ac
 		},
 	}
 
+	err := a.enforcer.LoadFilteredPolicy(filters)
+	if err != nil {
+		return err
+	}
+
+	return a.loadDefaultPolicies()
+}
+
+/*
+ * The user and the groups it belongs to in the organization.
+ */
+func (a *AuthService) getCanvasSubjects(userID, orgDomain string) ([]string, error) {
+	prefixedUserID := prefixUserID(userID)
+	subjects := []string{prefixedUserID}
+
+	memberships, err := a.enforcer.GetFilteredGroupingPolicy(0, prefixedUserID, "", orgDomain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups for user: %w", err)
+	}
+
+	for _, membership := range memberships {
+		if strings.HasPrefix(membership[1], "/groups/") {
+			subjects = append(subjects, membership[1])
+		}
+	}
+
+	return subjects, nil
+}
+
+func (a *AuthService) AssignCanvasRole(canvasID string, binding CanvasRoleBinding) error {
+	if !a.IsDefaultRole(binding.Role, models.DomainTypeCanvas) {
+		return fmt.Errorf("invalid role %s for domain type %s", binding.Role, models.DomainTypeCanvas)
+	}
+
+	subject, err := binding.subject()
+	if err != nil {
+		return err
+	}
+
+	domain := prefixDomain(models.DomainTypeCanvas, canvasID)
+	if err := a.loadPoliciesForDomains(domain); err != nil {
+		return err
+	}
+
 	//
-	// We always reload policies before checking for permissions.
-	// Due to the lack of proper support for distributed enforcers in casbin,
-	// we have no way to reliably know if policies on this instance are up to date.
+	// A user or group has a single role in a canvas,
+	// so the role it had before is replaced.
 	//
-	err := a.enforcer.LoadFilteredPolicy(filters)
+	db := a.enforcer.GetAdapter().(*gormadapter.Adapter)
+	return db.Transaction(a.enforcer, func(enforcerTx casbin.IEnforcer) error {
+		_, err := enforcerTx.RemoveFilteredGroupingPolicy(0, subject, "", domain)
+		if err != nil {
+			return fmt.Errorf("failed to remove existing canvas role: %w", err)
+		}
+
+		_, err = enforcerTx.AddGroupingPolicy(subject, prefixRoleName(binding.Role), domain)
+		if err != nil {
+			return fmt.Errorf("failed to add canvas role: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (a *AuthService) RemoveCanvasRole(canvasID string, binding CanvasRoleBinding) error {
+	subject, err := binding.subject()
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	if err := a.loadDefaultPolicies(); err != nil {
-		return false, err
+	domain := prefixDomain(models.DomainTypeCanvas, canvasID)
+	if err := a.loadPoliciesForDomains(domain); err != nil {
+		return err
 	}
 
-	prefixedUserID := prefixUserID(userID)
-	allowed, err := a.enforcer.Enforce(prefixedUserID, domain, resource, action)
+	ruleRemoved, err := a.enforcer.RemoveFilteredGroupingPolicy(0, subject, "", domain)
 	if err != nil {
-		return false, err
+		return fmt.Errorf("failed to remove canvas role: %w", err)
 	}
 
-	if allowed {
-		return true, nil
+	if !ruleRemoved {
+		return fmt.Errorf("%s has no role in canvas %s", subject, canvasID)
 	}
 
-	return false, nil
+	return nil
+}
+
+/*
+ * Removes the roles of the user in the given canvases,
+ * e.g. when the user leaves the organization of the canvases.
+ */
+func (a *AuthService) RemoveUserCanvasRoles(userID string, canvasIDs []string) error {
+	if len(canvasIDs) == 0 {
+		return nil
+	}
+
+	domains := make([]string, 0, len(canvasIDs))
+	for _, canvasID := range canvasIDs {
+		domains = append(domains, prefixDomain(models.DomainTypeCanvas, canvasID))
+	}
+
+	if err := a.loadPoliciesForDomains(domains...); err != nil {
+		return err
+	}
+
+	subject := prefixUserID(userID)
+	for _, domain := range domains {
+		_, err := a.enforcer.RemoveFilteredGroupingPolicy(0, subject, "", domain)
+		if err != nil {
+			return fmt.Errorf("failed to remove canvas role: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (a *AuthService) GetCanvasRoleBindings(canvasID string) ([]*CanvasRoleBinding, error) {
+	domain := prefixDomain(models.DomainTypeCanvas, canvasID)
+	if err := a.loadPoliciesForDomains(domain); err != nil {
+		return nil, err
+	}
+
+	policies, err := a.enforcer.GetFilteredGroupingPolicy(2, domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get canvas role bindings: %w", err)
+	}
+
+	bindings := []*CanvasRoleBinding{}
+	for _, policy := range policies {
+		if !strings.HasPrefix(policy[1], "/roles/") {
+			continue
+		}
+
+		binding := &CanvasRoleBinding{Role: strings.TrimPrefix(policy[1], "/roles/")}
+		switch {
+		case strings.HasPrefix(policy[0], "/users/"):
+			binding.UserID = strings.TrimPrefix(policy[0], "/users/")
+		case strings.HasPrefix(policy[0], "/groups/"):
+			binding.Group = strings.TrimPrefix(policy[0], "/groups/")
+		default:
+			continue
+		}
+
+		bindings = append(bindings, binding)
+	}
+
+	return bindings, nil
+}
+
+/*
+ * Returns the canvas roles of the user, including the ones
+ * given through its groups and the ones inherited from them.
+ */
+func (a *AuthService) GetUserCanvasRoles(userID, orgID, canvasID string) ([]string, error) {
+	orgDomain := prefixDomain(models.DomainTypeOrganization, orgID)
+	canvasDomain := prefixDomain(models.DomainTypeCanvas, canvasID)
+	if err := a.loadPoliciesForDomains(orgDomain, canvasDomain); err != nil {
+		return nil, err
+	}
+
+	subjects, err := a.getCanvasSubjects(userID, orgDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := []string{}
+	for _, subject := range subjects {
+		roleNames, err := a.enforcer.GetImplicitRolesForUser(subject, canvasDomain)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, roleName := range roleNames {
+			role := strings.TrimPrefix(roleName, "/roles/")
+			if strings.HasPrefix(roleName, "/roles/") && !contains(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	return roles, nil
 }
 
 func (a *AuthService) CreateGroup(domainID string, domainType string, groupName string, role string, displayName string, description string) error {
@@ -405,6 +659,7 @@ func (a *AuthService) assignRoleWithEnforcer(enforcer casbin.IEnforcer, userID,
 	// Check if it's a default role
 	validRoles := map[string][]string{
 		models.DomainTypeOrganization: {models.RoleOrgViewer, models.RoleOrgAdmin, models.RoleOrgOwner},
+		models.DomainTypeCanvas:       {models.RoleCanvasViewer, models.RoleCanvasOperator, models.RoleCanvasEditor, models.RoleCanvasOwner},
 	}
 
 	isValidDefaultRole := false
@@ -868,6 +1123,7 @@ func (a *AuthService) DeleteCustomRole(domainID string, domainType string, roleN
 func (a *AuthService) IsDefaultRole(roleName string, domainType string) bool {
 	defaultRoles := map[string][]string{
 		models.DomainTypeOrganization: {models.RoleOrgOwner, models.RoleOrgAdmin, models.RoleOrgViewer},
+		models.DomainTypeCanvas:       {models.RoleCanvasOwner, models.RoleCanvasEditor, models.RoleCanvasOperator, models.RoleCanvasViewer},
 	}
 
 	roles, exists := defaultRoles[domainType]
@@ -900,7 +1156,8 @@ func parsePoliciesFromCsv(content []byte) ([][5]string, error) {
 }
 
 func (a *AuthService) loadDefaultPolicies() error {
-	for _, policy := range a.orgPolicyTemplates {
+	policies := append(append([][5]string{}, a.orgPolicyTemplates...), a.canvasPolicyTemplates...)
+	for _, policy := range policies {
 		switch policy[0] {
 		case "g":
 			_, err := a.enforcer.AddGroupingPolicy(policy[1], policy[2], policy[3])
@@ -1005,11 +1262,12 @@ func (a *AuthService) getDefaultRolePermissions(roleName, domainType string) []*
 		prefixRoleName(roleName): true,
 	}
 
+	templates := a.policyTemplates(domainType)
 	queue := []string{prefixRoleName(roleName)}
 	for len(queue) > 0 {
 		current := queue[0]
 		queue = queue[1:]
-		for _, policy := range a.orgPolicyTemplates {
+		for _, policy := range templates {
 			if policy[0] != "g" {
 				continue
 			}
@@ -1025,7 +1283,7 @@ func (a *AuthService) getDefaultRolePermissions(roleName, domainType string) []*
 	}
 
 	permissionSet := make(map[string]*Permission)
-	for _, policy := range a.orgPolicyTemplates {
+	for _, policy := range templates {
 		if policy[0] != "p" {
 			continue
 		}
@@ -1137,6 +1395,13 @@ func (a *AuthService) getRolesFromPolicies(domain string) ([]string, error) {
 		roles[models.RoleOrgViewer] = true
 	}
 
+	if a.getDomainTypeFromDomain(domain) == models.DomainTypeCanvas {
+		roles[models.RoleCanvasOwner] = true
+		roles[models.RoleCanvasEditor] = true
+		roles[models.RoleCanvasOperator] = true
+		roles[models.RoleCanvasViewer] = true
+	}
+
 	roleList := make([]string, 0, len(roles))
 	for role := range roles {
 		roleList = append(roleList, role)
@@ -1150,6 +1415,11 @@ func (a *AuthService) getRoleDescription(roleName string) string {
 		models.RoleOrgViewer: models.DescOrgViewer,
 		models.RoleOrgAdmin:  models.DescOrgAdmin,
 		models.RoleOrgOwner:  models.DescOrgOwner,
+
+		models.RoleCanvasViewer:   models.DescCanvasViewer,
+		models.RoleCanvasOperator: models.DescCanvasOperator,
+		models.RoleCanvasEditor:   models.DescCanvasEditor,
+		models.RoleCanvasOwner:    models.DescCanvasOwner,
 	}
 
 	if description, exists := descriptions[roleName]; exists {
@@ -1172,9 +1442,32 @@ func (a *AuthService) getDomainTypeFromDomain(domain string) string {
 		return models.DomainTypeOrganization
 	}
 
+	if strings.HasPrefix(domain, "/canvas/") {
+		return models.DomainTypeCanvas
+	}
+
 	return ""
 }
 
+func (a *AuthService) policyTemplates(domainType string) [][5]string {
+	if domainType == models.DomainTypeCanvas {
+		return a.canvasPolicyTemplates
+	}
+
+	return a.orgPolicyTemplates
+}
+
+func defaultDomainFor(domainType string) string {
+	switch domainType {
+	case models.DomainTypeOrganization:
+		return "/org/*"
+	case models.DomainTypeCanvas:
+		return "/canvas/*"
+	default:
+		return ""
+	}
+}
+
 func (a *AuthService) setupDefaultOrganizationRoleMetadataInTransaction(tx *gorm.DB, orgID string) error {
 	defaultRoles := []struct {
 		name        string
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
 )
@@ -595,3 +596,156 @@ func Test__AuthService_GetRoleHierarchy(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func Test__AuthService_CanvasRoles(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	canvasID := uuid.New().String()
+	otherCanvasID := uuid.New().String()
+
+	t.Run("user without canvas role has no canvas permissions", func(t *testing.T) {
+		userID := uuid.New().String()
+
+		allowed, err := r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, "canvases", "read")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("canvas role is scoped to the canvas and includes lower roles", func(t *testing.T) {
+		userID := uuid.New().String()
+		err := r.AuthService.AssignCanvasRole(canvasID, authorization.CanvasRoleBinding{UserID: userID, Role: models.RoleCanvasOperator})
+		require.NoError(t, err)
+
+		allowed, err := r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, "canvases", "run")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, "canvases", "read")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, "canvases", "update")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		allowed, err = r.AuthService.CheckCanvasPermission(userID, orgID, otherCanvasID, "canvases", "read")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		roles, err := r.AuthService.GetUserCanvasRoles(userID, orgID, canvasID)
+		require.NoError(t, err)
+		assert.Contains(t, roles, models.RoleCanvasOperator)
+		assert.Contains(t, roles, models.RoleCanvasViewer)
+		assert.NotContains(t, roles, models.RoleCanvasEditor)
+	})
+
+	t.Run("assigning a new role replaces the previous one", func(t *testing.T) {
+		userID := uuid.New().String()
+		require.NoError(t, r.AuthService.AssignCanvasRole(canvasID, authorization.CanvasRoleBinding{UserID: userID, Role: models.RoleCanvasOwner}))
+		require.NoError(t, r.AuthService.AssignCanvasRole(canvasID, authorization.CanvasRoleBinding{UserID: userID, Role: models.RoleCanvasViewer}))
+
+		allowed, err := r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, "members", "update")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		bindings, err := r.AuthService.GetCanvasRoleBindings(canvasID)
+		require.NoError(t, err)
+
+		roles := []string{}
+		for _, binding := range bindings {
+			if binding.UserID == userID {
+				roles = append(roles, binding.Role)
+			}
+		}
+		assert.Equal(t, []string{models.RoleCanvasViewer}, roles)
+	})
+
+	t.Run("group members get the canvas role of the group", func(t *testing.T) {
+		groupName := "canvas-editors"
+		userID := uuid.New().String()
+		require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, groupName, models.RoleOrgViewer, "Canvas Editors", "Canvas Editors"))
+		require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, userID, groupName))
+		require.NoError(t, r.AuthService.AssignCanvasRole(canvasID, authorization.CanvasRoleBinding{Group: groupName, Role: models.RoleCanvasEditor}))
+
+		allowed, err := r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, "canvases", "update")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		require.NoError(t, r.AuthService.RemoveCanvasRole(canvasID, authorization.CanvasRoleBinding{Group: groupName, Role: models.RoleCanvasEditor}))
+
+		allowed, err = r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, "canvases", "update")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("binding needs exactly one subject", func(t *testing.T) {
+		err := r.AuthService.AssignCanvasRole(canvasID, authorization.CanvasRoleBinding{Role: models.RoleCanvasViewer})
+		assert.Error(t, err)
+
+		err = r.AuthService.AssignCanvasRole(canvasID, authorization.CanvasRoleBinding{UserID: uuid.New().String(), Group: "g", Role: models.RoleCanvasViewer})
+		assert.Error(t, err)
+	})
+}
+
+func Test__AuthService_CanvasAccess(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	canvasID := uuid.New().String()
+
+	owner := uuid.New().String()
+	admin := uuid.New().String()
+	viewer := uuid.New().String()
+	operator := uuid.New().String()
+	require.NoError(t, r.AuthService.AssignRole(owner, models.RoleOrgOwner, orgID, models.DomainTypeOrganization))
+	require.NoError(t, r.AuthService.AssignRole(admin, models.RoleOrgAdmin, orgID, models.DomainTypeOrganization))
+	require.NoError(t, r.AuthService.AssignRole(viewer, models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+	require.NoError(t, r.AuthService.AssignRole(operator, models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+
+	check := func(userID, action, canvasAction string) bool {
+		allowed, err := r.AuthService.CheckCanvasAccess(userID, orgID, canvasID, "canvases", action, canvasAction)
+		require.NoError(t, err)
+		return allowed
+	}
+
+	t.Run("canvas without role bindings -> organization roles decide", func(t *testing.T) {
+		assert.True(t, check(viewer, "read", "read"))
+		assert.False(t, check(viewer, "update", "run"))
+		assert.True(t, check(admin, "update", "run"))
+		assert.False(t, check(uuid.New().String(), "read", "read"))
+	})
+
+	require.NoError(t, r.AuthService.AssignCanvasRole(canvasID, authorization.CanvasRoleBinding{UserID: operator, Role: models.RoleCanvasOperator}))
+
+	t.Run("canvas with role bindings -> organization members without a canvas role have no access", func(t *testing.T) {
+		assert.False(t, check(viewer, "read", "read"))
+	})
+
+	t.Run("canvas with role bindings -> canvas roles decide", func(t *testing.T) {
+		assert.True(t, check(operator, "read", "read"))
+		assert.True(t, check(operator, "update", "run"))
+		assert.False(t, check(operator, "update", "update"))
+	})
+
+	t.Run("canvas with role bindings -> organization owners and admins keep full access", func(t *testing.T) {
+		assert.True(t, check(owner, "delete", "delete"))
+		assert.True(t, check(admin, "update", "update"))
+	})
+
+	t.Run("group members get access through the canvas role of the group", func(t *testing.T) {
+		groupName := "canvas-viewers"
+		require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, groupName, models.RoleOrgViewer, "Canvas Viewers", "Canvas Viewers"))
+		require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, viewer, groupName))
+		require.NoError(t, r.AuthService.AssignCanvasRole(canvasID, authorization.CanvasRoleBinding{Group: groupName, Role: models.RoleCanvasViewer}))
+
+		assert.True(t, check(viewer, "read", "read"))
+		assert.False(t, check(viewer, "update", "run"))
+	})
+
+	t.Run("removing the last role binding opens the canvas to the organization again", func(t *testing.T) {
+		require.NoError(t, r.AuthService.RemoveCanvasRole(canvasID, authorization.CanvasRoleBinding{UserID: operator, Role: models.RoleCanvasOperator}))
+		require.NoError(t, r.AuthService.RemoveCanvasRole(canvasID, authorization.CanvasRoleBinding{Group: "canvas-viewers", Role: models.RoleCanvasViewer}))
+
+		assert.True(t, check(operator, "read", "read"))
+		assert.False(t, check(operator, "update", "run"))
+	})
+}
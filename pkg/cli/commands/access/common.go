@@ -0,0 +1,106 @@
+package access
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func resolveOrganizationID(ctx core.CommandContext) (string, error) {
+	me, _, err := ctx.API.MeAPI.MeMe(ctx.Context).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	if !me.HasOrganizationId() || strings.TrimSpace(me.GetOrganizationId()) == "" {
+		return "", fmt.Errorf("organization id not found for authenticated user")
+	}
+
+	return me.GetOrganizationId(), nil
+}
+
+func organizationDomainType() openapi_client.AuthorizationDomainType {
+	return openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION
+}
+
+/*
+ * Users can be referenced by id or email.
+ * The API looks them up by whichever one is given.
+ */
+type userRef struct {
+	ID    string
+	Email string
+}
+
+func parseUserRef(value string) (userRef, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return userRef{}, fmt.Errorf("--user is required")
+	}
+
+	if strings.Contains(value, "@") {
+		return userRef{Email: value}, nil
+	}
+
+	return userRef{ID: value}, nil
+}
+
+/*
+ * Permissions are given as resource:action, e.g. canvases:read.
+ */
+func parsePermissions(values []string) ([]openapi_client.AuthorizationPermission, error) {
+	permissions := []openapi_client.AuthorizationPermission{}
+	seen := map[string]struct{}{}
+	for _, value := range values {
+		resource, action, ok := strings.Cut(strings.TrimSpace(value), ":")
+		resource = strings.TrimSpace(resource)
+		action = strings.TrimSpace(action)
+		if !ok || resource == "" || action == "" {
+			return nil, fmt.Errorf("invalid permission %q: expected resource:action", value)
+		}
+
+		key := resource + ":" + action
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		permission := openapi_client.AuthorizationPermission{}
+		permission.SetResource(resource)
+		permission.SetAction(action)
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, nil
+}
+
+func formatPermission(permission openapi_client.AuthorizationPermission) string {
+	return fmt.Sprintf("%s:%s", permission.GetResource(), permission.GetAction())
+}
+
+/*
+ * Finds a user of the organization by id or email.
+ * Service accounts are included, since they can be assigned roles too.
+ */
+func findUser(ctx core.CommandContext, organizationID string, ref string) (*openapi_client.SuperplaneUsersUser, error) {
+	response, _, err := ctx.API.UsersAPI.
+		UsersListUsers(ctx.Context).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		IncludeServiceAccounts(true).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range response.GetUsers() {
+		metadata := user.GetMetadata()
+		if metadata.GetId() == ref || strings.EqualFold(metadata.GetEmail(), ref) {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user %q not found", ref)
+}
@@ -0,0 +1,52 @@
+package access
+
+import (
+	"testing"
+)
+
+func TestParsePermissions(t *testing.T) {
+	permissions, err := parsePermissions([]string{"canvases:read", " secrets : update ", "canvases:read"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(permissions) != 2 {
+		t.Fatalf("expected 2 permissions, got %d", len(permissions))
+	}
+	if formatPermission(permissions[0]) != "canvases:read" {
+		t.Fatalf("expected canvases:read, got %s", formatPermission(permissions[0]))
+	}
+	if formatPermission(permissions[1]) != "secrets:update" {
+		t.Fatalf("expected secrets:update, got %s", formatPermission(permissions[1]))
+	}
+}
+
+func TestParsePermissionsRejectsInvalidValues(t *testing.T) {
+	for _, value := range []string{"canvases", "canvases:", ":read", ""} {
+		if _, err := parsePermissions([]string{value}); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
+
+func TestParseUserRef(t *testing.T) {
+	ref, err := parseUserRef("jane@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ref.Email != "jane@example.com" || ref.ID != "" {
+		t.Fatalf("expected email reference, got %+v", ref)
+	}
+
+	ref, err = parseUserRef(" 0b6c1a4e-7f0d-4a53-9d3a-2f1f5f6f0a11 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ref.ID != "0b6c1a4e-7f0d-4a53-9d3a-2f1f5f6f0a11" || ref.Email != "" {
+		t.Fatalf("expected id reference, got %+v", ref)
+	}
+
+	if _, err := parseUserRef(" "); err == nil {
+		t.Fatalf("expected error for empty user")
+	}
+}
@@ -0,0 +1,327 @@
+package access
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type listGroupsCommand struct{}
+
+func (c *listGroupsCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.GroupsAPI.
+		GroupsListGroups(ctx.Context).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	groups := response.GetGroups()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(groups)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderGroupListText(stdout, groups)
+	})
+}
+
+type getGroupCommand struct{}
+
+func (c *getGroupCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.GroupsAPI.
+		GroupsListGroupUsers(ctx.Context, ctx.Args[0]).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderGroupText(stdout, response.GetGroup(), response.GetUsers())
+	})
+}
+
+type groupFlags struct {
+	displayName *string
+	description *string
+	role        *string
+}
+
+type createGroupCommand struct {
+	flags groupFlags
+}
+
+func (c *createGroupCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	group := openapi_client.GroupsGroup{}
+	metadata := openapi_client.GroupsGroupMetadata{}
+	metadata.SetName(ctx.Args[0])
+	group.SetMetadata(metadata)
+
+	spec := openapi_client.GroupsGroupSpec{}
+	c.flags.apply(ctx, &spec)
+	group.SetSpec(spec)
+
+	body := openapi_client.GroupsCreateGroupRequest{}
+	body.SetDomainType(organizationDomainType())
+	body.SetDomainId(organizationID)
+	body.SetGroup(group)
+
+	response, _, err := ctx.API.GroupsAPI.GroupsCreateGroup(ctx.Context).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	created := response.GetGroup()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(created)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Group created: %s\n", ctx.Args[0])
+		return err
+	})
+}
+
+type updateGroupCommand struct {
+	flags groupFlags
+}
+
+/*
+ * Only the fields given as flags are changed.
+ */
+func (c *updateGroupCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	current, _, err := ctx.API.GroupsAPI.
+		GroupsDescribeGroup(ctx.Context, ctx.Args[0]).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	group := current.GetGroup()
+	spec := group.GetSpec()
+	c.flags.apply(ctx, &spec)
+	group.SetSpec(spec)
+
+	body := openapi_client.GroupsUpdateGroupBody{}
+	body.SetDomainType(organizationDomainType())
+	body.SetDomainId(organizationID)
+	body.SetGroup(group)
+
+	response, _, err := ctx.API.GroupsAPI.GroupsUpdateGroup(ctx.Context, ctx.Args[0]).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	updated := response.GetGroup()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(updated)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Group updated: %s\n", ctx.Args[0])
+		return err
+	})
+}
+
+func (f groupFlags) apply(ctx core.CommandContext, spec *openapi_client.GroupsGroupSpec) {
+	flags := ctx.Cmd.Flags()
+	if flags.Changed("display-name") {
+		spec.SetDisplayName(*f.displayName)
+	}
+
+	if flags.Changed("description") {
+		spec.SetDescription(*f.description)
+	}
+
+	if flags.Changed("role") {
+		spec.SetRole(*f.role)
+	}
+}
+
+type deleteGroupCommand struct{}
+
+func (c *deleteGroupCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.GroupsAPI.
+		GroupsDeleteGroup(ctx.Context, ctx.Args[0]).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := fmt.Fprintf(stdout, "Group deleted: %s\n", ctx.Args[0])
+			return err
+		})
+	}
+
+	return ctx.Renderer.Render(response)
+}
+
+type addGroupUserCommand struct {
+	user *string
+}
+
+func (c *addGroupUserCommand) Execute(ctx core.CommandContext) error {
+	ref, err := parseUserRef(*c.user)
+	if err != nil {
+		return err
+	}
+
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.GroupsAddUserToGroupBody{}
+	body.SetDomainType(organizationDomainType())
+	body.SetDomainId(organizationID)
+	if ref.Email != "" {
+		body.SetUserEmail(ref.Email)
+	} else {
+		body.SetUserId(ref.ID)
+	}
+
+	response, _, err := ctx.API.GroupsAPI.GroupsAddUserToGroup(ctx.Context, ctx.Args[0]).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := fmt.Fprintf(stdout, "User %s added to group %s\n", *c.user, ctx.Args[0])
+			return err
+		})
+	}
+
+	return ctx.Renderer.Render(response)
+}
+
+type removeGroupUserCommand struct {
+	user *string
+}
+
+func (c *removeGroupUserCommand) Execute(ctx core.CommandContext) error {
+	ref, err := parseUserRef(*c.user)
+	if err != nil {
+		return err
+	}
+
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.GroupsRemoveUserFromGroupBody{}
+	body.SetDomainType(organizationDomainType())
+	body.SetDomainId(organizationID)
+	if ref.Email != "" {
+		body.SetUserEmail(ref.Email)
+	} else {
+		body.SetUserId(ref.ID)
+	}
+
+	response, _, err := ctx.API.GroupsAPI.GroupsRemoveUserFromGroup(ctx.Context, ctx.Args[0]).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := fmt.Fprintf(stdout, "User %s removed from group %s\n", *c.user, ctx.Args[0])
+			return err
+		})
+	}
+
+	return ctx.Renderer.Render(response)
+}
+
+func renderGroupListText(stdout io.Writer, groups []openapi_client.GroupsGroup) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "NAME\tDISPLAY_NAME\tROLE\tMEMBERS\tCREATED_AT")
+
+	for _, group := range groups {
+		metadata := group.GetMetadata()
+		spec := group.GetSpec()
+		status := group.GetStatus()
+
+		createdAt := ""
+		if metadata.HasCreatedAt() {
+			createdAt = metadata.GetCreatedAt().Format(time.RFC3339)
+		}
+
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%d\t%s\n",
+			metadata.GetName(),
+			spec.GetDisplayName(),
+			spec.GetRole(),
+			status.GetMembersCount(),
+			createdAt,
+		)
+	}
+
+	return writer.Flush()
+}
+
+func renderGroupText(stdout io.Writer, group openapi_client.GroupsGroup, users []openapi_client.SuperplaneUsersUser) error {
+	metadata := group.GetMetadata()
+	spec := group.GetSpec()
+
+	_, _ = fmt.Fprintf(stdout, "Name: %s\n", metadata.GetName())
+	_, _ = fmt.Fprintf(stdout, "DisplayName: %s\n", spec.GetDisplayName())
+	_, _ = fmt.Fprintf(stdout, "Description: %s\n", spec.GetDescription())
+	_, _ = fmt.Fprintf(stdout, "Role: %s\n", spec.GetRole())
+	if metadata.HasCreatedAt() {
+		_, _ = fmt.Fprintf(stdout, "CreatedAt: %s\n", metadata.GetCreatedAt().Format(time.RFC3339))
+	}
+
+	_, _ = fmt.Fprintln(stdout, "Members:")
+	for _, user := range users {
+		userMetadata := user.GetMetadata()
+		_, _ = fmt.Fprintf(stdout, "- %s (%s)\n", userMetadata.GetEmail(), userMetadata.GetId())
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+package access
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type listRolesCommand struct{}
+
+func (c *listRolesCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.RolesAPI.
+		RolesListRoles(ctx.Context).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	roles := response.GetRoles()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(roles)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderRoleListText(stdout, roles)
+	})
+}
+
+type getRoleCommand struct{}
+
+func (c *getRoleCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	role, err := describeRole(ctx, organizationID, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(role)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderRoleText(stdout, role)
+	})
+}
+
+type roleFlags struct {
+	displayName *string
+	description *string
+	permissions *[]string
+	inherits    *string
+}
+
+type createRoleCommand struct {
+	flags roleFlags
+}
+
+func (c *createRoleCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	role := openapi_client.RolesRole{}
+	metadata := openapi_client.RolesRoleMetadata{}
+	metadata.SetName(ctx.Args[0])
+	role.SetMetadata(metadata)
+
+	spec := openapi_client.RolesRoleSpec{}
+	if err := c.flags.apply(ctx, &spec); err != nil {
+		return err
+	}
+	role.SetSpec(spec)
+
+	body := openapi_client.RolesCreateRoleRequest{}
+	body.SetDomainType(organizationDomainType())
+	body.SetDomainId(organizationID)
+	body.SetRole(role)
+
+	response, _, err := ctx.API.RolesAPI.RolesCreateRole(ctx.Context).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	created := response.GetRole()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(created)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Role created: %s\n", ctx.Args[0])
+		return err
+	})
+}
+
+type updateRoleCommand struct {
+	flags roleFlags
+}
+
+/*
+ * Only the fields given as flags are changed.
+ * Permissions, when given, replace the existing ones.
+ */
+func (c *updateRoleCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	role, err := describeRole(ctx, organizationID, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	spec := role.GetSpec()
+	if err := c.flags.apply(ctx, &spec); err != nil {
+		return err
+	}
+	role.SetSpec(spec)
+
+	body := openapi_client.RolesUpdateRoleBody{}
+	body.SetDomainType(organizationDomainType())
+	body.SetDomainId(organizationID)
+	body.SetRole(role)
+
+	response, _, err := ctx.API.RolesAPI.RolesUpdateRole(ctx.Context, ctx.Args[0]).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	updated := response.GetRole()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(updated)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Role updated: %s\n", ctx.Args[0])
+		return err
+	})
+}
+
+func (f roleFlags) apply(ctx core.CommandContext, spec *openapi_client.RolesRoleSpec) error {
+	flags := ctx.Cmd.Flags()
+	if flags.Changed("display-name") {
+		spec.SetDisplayName(*f.displayName)
+	}
+
+	if flags.Changed("description") {
+		spec.SetDescription(*f.description)
+	}
+
+	if flags.Changed("permission") {
+		permissions, err := parsePermissions(*f.permissions)
+		if err != nil {
+			return err
+		}
+		spec.SetPermissions(permissions)
+	}
+
+	if flags.Changed("inherits") {
+		inherits := strings.TrimSpace(*f.inherits)
+		if inherits == "" {
+			spec.InheritedRole = nil
+			return nil
+		}
+
+		metadata := openapi_client.RolesRoleMetadata{}
+		metadata.SetName(inherits)
+		inherited := openapi_client.RolesRole{}
+		inherited.SetMetadata(metadata)
+		spec.SetInheritedRole(inherited)
+	}
+
+	return nil
+}
+
+type deleteRoleCommand struct{}
+
+func (c *deleteRoleCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.RolesAPI.
+		RolesDeleteRole(ctx.Context, ctx.Args[0]).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := fmt.Fprintf(stdout, "Role deleted: %s\n", ctx.Args[0])
+			return err
+		})
+	}
+
+	return ctx.Renderer.Render(response)
+}
+
+type assignRoleCommand struct {
+	user *string
+}
+
+/*
+ * Users have a single role in the organization,
+ * so assigning a role replaces the current one.
+ */
+func (c *assignRoleCommand) Execute(ctx core.CommandContext) error {
+	ref, err := parseUserRef(*c.user)
+	if err != nil {
+		return err
+	}
+
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.RolesAssignRoleBody{}
+	body.SetDomainType(organizationDomainType())
+	body.SetDomainId(organizationID)
+	if ref.Email != "" {
+		body.SetUserEmail(ref.Email)
+	} else {
+		body.SetUserId(ref.ID)
+	}
+
+	response, _, err := ctx.API.RolesAPI.RolesAssignRole(ctx.Context, ctx.Args[0]).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := fmt.Fprintf(stdout, "Role %s assigned to %s\n", ctx.Args[0], *c.user)
+			return err
+		})
+	}
+
+	return ctx.Renderer.Render(response)
+}
+
+func describeRole(ctx core.CommandContext, organizationID string, name string) (openapi_client.RolesRole, error) {
+	response, _, err := ctx.API.RolesAPI.
+		RolesDescribeRole(ctx.Context, name).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		Execute()
+	if err != nil {
+		return openapi_client.RolesRole{}, err
+	}
+
+	return response.GetRole(), nil
+}
+
+func renderRoleListText(stdout io.Writer, roles []openapi_client.RolesRole) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "NAME\tDISPLAY_NAME\tPERMISSIONS\tINHERITS")
+
+	for _, role := range roles {
+		metadata := role.GetMetadata()
+		spec := role.GetSpec()
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%d\t%s\n",
+			metadata.GetName(),
+			spec.GetDisplayName(),
+			len(spec.GetPermissions()),
+			inheritedRoleName(spec),
+		)
+	}
+
+	return writer.Flush()
+}
+
+func renderRoleText(stdout io.Writer, role openapi_client.RolesRole) error {
+	metadata := role.GetMetadata()
+	spec := role.GetSpec()
+
+	_, _ = fmt.Fprintf(stdout, "Name: %s\n", metadata.GetName())
+	_, _ = fmt.Fprintf(stdout, "DisplayName: %s\n", spec.GetDisplayName())
+	_, _ = fmt.Fprintf(stdout, "Description: %s\n", spec.GetDescription())
+	if inherits := inheritedRoleName(spec); inherits != "" {
+		_, _ = fmt.Fprintf(stdout, "Inherits: %s\n", inherits)
+	}
+
+	_, _ = fmt.Fprintln(stdout, "Permissions:")
+	for _, permission := range spec.GetPermissions() {
+		_, _ = fmt.Fprintf(stdout, "- %s\n", formatPermission(permission))
+	}
+
+	return nil
+}
+
+func inheritedRoleName(spec openapi_client.RolesRoleSpec) string {
+	inherited, ok := spec.GetInheritedRoleOk()
+	if !ok {
+		return ""
+	}
+
+	metadata := inherited.GetMetadata()
+	return metadata.GetName()
+}
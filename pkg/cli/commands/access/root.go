@@ -0,0 +1,456 @@
+package access
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewRolesCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:     "roles",
+		Short:   "Manage organization roles",
+		Aliases: []string{"role"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List roles",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(listCmd, &listRolesCommand{}, options)
+
+	getCmd := &cobra.Command{
+		Use:   "get <name>",
+		Short: "Get a role and its permissions",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(getCmd, &getRoleCommand{}, options)
+
+	createCmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a custom role",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(createCmd, &createRoleCommand{flags: bindRoleFlags(createCmd)}, options)
+
+	updateCmd := &cobra.Command{
+		Use:   "update <name>",
+		Short: "Update a custom role",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(updateCmd, &updateRoleCommand{flags: bindRoleFlags(updateCmd)}, options)
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a custom role",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(deleteCmd, &deleteRoleCommand{}, options)
+
+	assignCmd := &cobra.Command{
+		Use:   "assign <name> --user <id-or-email>",
+		Short: "Assign a role to a user or service account",
+		Args:  cobra.ExactArgs(1),
+	}
+	var assignUser string
+	assignCmd.Flags().StringVar(&assignUser, "user", "", "id or email of the user")
+	_ = assignCmd.MarkFlagRequired("user")
+	core.Bind(assignCmd, &assignRoleCommand{user: &assignUser}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(getCmd)
+	root.AddCommand(createCmd)
+	root.AddCommand(updateCmd)
+	root.AddCommand(deleteCmd)
+	root.AddCommand(assignCmd)
+
+	return root
+}
+
+func bindRoleFlags(cmd *cobra.Command) roleFlags {
+	flags := roleFlags{
+		displayName: new(string),
+		description: new(string),
+		permissions: new([]string),
+		inherits:    new(string),
+	}
+
+	cmd.Flags().StringVar(flags.displayName, "display-name", "", "display name of the role")
+	cmd.Flags().StringVar(flags.description, "description", "", "description of the role")
+	cmd.Flags().StringSliceVar(flags.permissions, "permission", nil, "permission as resource:action, e.g. canvases:read (repeatable)")
+	cmd.Flags().StringVar(flags.inherits, "inherits", "", "name of a role to inherit permissions from")
+
+	return flags
+}
+
+func NewGroupsCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:     "groups",
+		Short:   "Manage organization groups",
+		Aliases: []string{"group"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List groups",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(listCmd, &listGroupsCommand{}, options)
+
+	getCmd := &cobra.Command{
+		Use:   "get <name>",
+		Short: "Get a group and its members",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(getCmd, &getGroupCommand{}, options)
+
+	createCmd := &cobra.Command{
+		Use:   "create <name> --role <role>",
+		Short: "Create a group",
+		Args:  cobra.ExactArgs(1),
+	}
+	createFlags := bindGroupFlags(createCmd)
+	_ = createCmd.MarkFlagRequired("role")
+	core.Bind(createCmd, &createGroupCommand{flags: createFlags}, options)
+
+	updateCmd := &cobra.Command{
+		Use:   "update <name>",
+		Short: "Update a group",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(updateCmd, &updateGroupCommand{flags: bindGroupFlags(updateCmd)}, options)
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a group",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(deleteCmd, &deleteGroupCommand{}, options)
+
+	addUserCmd := &cobra.Command{
+		Use:   "add-user <name> --user <id-or-email>",
+		Short: "Add a user to a group",
+		Args:  cobra.ExactArgs(1),
+	}
+	var addUser string
+	addUserCmd.Flags().StringVar(&addUser, "user", "", "id or email of the user")
+	_ = addUserCmd.MarkFlagRequired("user")
+	core.Bind(addUserCmd, &addGroupUserCommand{user: &addUser}, options)
+
+	removeUserCmd := &cobra.Command{
+		Use:   "remove-user <name> --user <id-or-email>",
+		Short: "Remove a user from a group",
+		Args:  cobra.ExactArgs(1),
+	}
+	var removeUser string
+	removeUserCmd.Flags().StringVar(&removeUser, "user", "", "id or email of the user")
+	_ = removeUserCmd.MarkFlagRequired("user")
+	core.Bind(removeUserCmd, &removeGroupUserCommand{user: &removeUser}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(getCmd)
+	root.AddCommand(createCmd)
+	root.AddCommand(updateCmd)
+	root.AddCommand(deleteCmd)
+	root.AddCommand(addUserCmd)
+	root.AddCommand(removeUserCmd)
+
+	return root
+}
+
+func bindGroupFlags(cmd *cobra.Command) groupFlags {
+	flags := groupFlags{
+		displayName: new(string),
+		description: new(string),
+		role:        new(string),
+	}
+
+	cmd.Flags().StringVar(flags.displayName, "display-name", "", "display name of the group")
+	cmd.Flags().StringVar(flags.description, "description", "", "description of the group")
+	cmd.Flags().StringVar(flags.role, "role", "", "role given to the members of the group")
+
+	return flags
+}
+
+func NewUsersCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:     "users",
+		Short:   "Manage organization users",
+		Aliases: []string{"user"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List users",
+		Args:  cobra.NoArgs,
+	}
+	var includeServiceAccounts bool
+	listCmd.Flags().BoolVar(&includeServiceAccounts, "include-service-accounts", false, "include service accounts in the list")
+	core.Bind(listCmd, &listUsersCommand{includeServiceAccounts: &includeServiceAccounts}, options)
+
+	getCmd := &cobra.Command{
+		Use:   "get <id-or-email>",
+		Short: "Get a user, its roles and permissions",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(getCmd, &getUserCommand{}, options)
+
+	removeCmd := &cobra.Command{
+		Use:   "remove <id-or-email>",
+		Short: "Remove a user from the organization",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(removeCmd, &removeUserCommand{}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(getCmd)
+	root.AddCommand(removeCmd)
+
+	return root
+}
+
+func NewServiceAccountsCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:     "service-accounts",
+		Short:   "Manage service accounts",
+		Aliases: []string{"service-account", "sa"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List service accounts",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(listCmd, &listServiceAccountsCommand{}, options)
+
+	getCmd := &cobra.Command{
+		Use:   "get <id-or-name>",
+		Short: "Get a service account",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(getCmd, &getServiceAccountCommand{}, options)
+
+	createCmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a service account and print its token",
+		Args:  cobra.ExactArgs(1),
+	}
+	var createDescription string
+	var createRole string
+	createCmd.Flags().StringVar(&createDescription, "description", "", "description of the service account")
+	createCmd.Flags().StringVar(&createRole, "role", "org_viewer", "organization role: org_admin or org_viewer")
+	core.Bind(createCmd, &createServiceAccountCommand{description: &createDescription, role: &createRole}, options)
+
+	updateCmd := &cobra.Command{
+		Use:   "update <id-or-name>",
+		Short: "Update a service account",
+		Args:  cobra.ExactArgs(1),
+	}
+	var updateName string
+	var updateDescription string
+	updateCmd.Flags().StringVar(&updateName, "name", "", "new name of the service account")
+	updateCmd.Flags().StringVar(&updateDescription, "description", "", "new description of the service account")
+	core.Bind(updateCmd, &updateServiceAccountCommand{name: &updateName, description: &updateDescription}, options)
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <id-or-name>",
+		Short: "Delete a service account",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(deleteCmd, &deleteServiceAccountCommand{}, options)
+
+	rotateCmd := &cobra.Command{
+		Use:   "rotate-token <id-or-name>",
+		Short: "Issue a new token for a service account, revoking the current one",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(rotateCmd, &rotateServiceAccountTokenCommand{}, options)
+
+	listTokensCmd := &cobra.Command{
+		Use:   "list-tokens <id-or-name>",
+		Short: "List the named API tokens of a service account",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(listTokensCmd, &listServiceAccountTokensCommand{}, options)
+
+	createTokenCmd := &cobra.Command{
+		Use:   "create-token <id-or-name> <token-name>",
+		Short: "Create a named API token for a service account and print it",
+		Args:  cobra.ExactArgs(2),
+	}
+	core.Bind(createTokenCmd, &createServiceAccountTokenCommand{flags: bindTokenFlags(createTokenCmd)}, options)
+
+	revokeTokenCmd := &cobra.Command{
+		Use:   "revoke-token <id-or-name> <token-id-or-name>",
+		Short: "Revoke a named API token of a service account",
+		Args:  cobra.ExactArgs(2),
+	}
+	core.Bind(revokeTokenCmd, &revokeServiceAccountTokenCommand{}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(getCmd)
+	root.AddCommand(createCmd)
+	root.AddCommand(updateCmd)
+	root.AddCommand(deleteCmd)
+	root.AddCommand(rotateCmd)
+	root.AddCommand(listTokensCmd)
+	root.AddCommand(createTokenCmd)
+	root.AddCommand(revokeTokenCmd)
+
+	return root
+}
+
+func NewTokensCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:     "tokens",
+		Short:   "Manage your named API tokens",
+		Aliases: []string{"token"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List your named API tokens",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(listCmd, &listTokensCommand{}, options)
+
+	createCmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a named API token and print it",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(createCmd, &createTokenCommand{flags: bindTokenFlags(createCmd)}, options)
+
+	revokeCmd := &cobra.Command{
+		Use:   "revoke <id-or-name>",
+		Short: "Revoke a named API token",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(revokeCmd, &revokeTokenCommand{}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(createCmd)
+	root.AddCommand(revokeCmd)
+
+	return root
+}
+
+func bindTokenFlags(cmd *cobra.Command) tokenFlags {
+	flags := tokenFlags{
+		scopes:   new([]string),
+		canvasID: new(string),
+		expires:  new(string),
+	}
+
+	cmd.Flags().StringSliceVar(flags.scopes, "scope", nil, "permission as resource:action, e.g. canvases:read (repeatable); all permissions if omitted")
+	cmd.Flags().StringVar(flags.canvasID, "canvas-id", "", "restrict the token to one canvas")
+	cmd.Flags().StringVar(flags.expires, "expires", "", "expiry as RFC3339 timestamp or duration from now, e.g. 720h")
+
+	return flags
+}
+
+func NewSSOCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:   "sso",
+		Short: "Manage organization single sign-on",
+	}
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get the single sign-on configuration",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(getCmd, &getSSOCommand{}, options)
+
+	setOIDCCmd := &cobra.Command{
+		Use:   "set-oidc --issuer-url <url> --client-id <id> --client-secret <secret>",
+		Short: "Configure single sign-on with an OIDC identity provider",
+		Args:  cobra.NoArgs,
+	}
+	var issuerURL, clientID, clientSecret string
+	setOIDCCmd.Flags().StringVar(&issuerURL, "issuer-url", "", "issuer URL of the identity provider")
+	setOIDCCmd.Flags().StringVar(&clientID, "client-id", "", "client ID of the application")
+	setOIDCCmd.Flags().StringVar(&clientSecret, "client-secret", "", "client secret of the application; kept if omitted")
+	_ = setOIDCCmd.MarkFlagRequired("issuer-url")
+	_ = setOIDCCmd.MarkFlagRequired("client-id")
+	core.Bind(setOIDCCmd, &setOIDCCommand{
+		flags:        bindSSOFlags(setOIDCCmd),
+		issuerURL:    &issuerURL,
+		clientID:     &clientID,
+		clientSecret: &clientSecret,
+	}, options)
+
+	setSAMLCmd := &cobra.Command{
+		Use:   "set-saml --metadata-file <path>",
+		Short: "Configure single sign-on with a SAML 2.0 identity provider",
+		Args:  cobra.NoArgs,
+	}
+	var metadataFile string
+	setSAMLCmd.Flags().StringVar(&metadataFile, "metadata-file", "", "path to the metadata XML of the identity provider")
+	_ = setSAMLCmd.MarkFlagRequired("metadata-file")
+	core.Bind(setSAMLCmd, &setSAMLCommand{flags: bindSSOFlags(setSAMLCmd), metadataFile: &metadataFile}, options)
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete the single sign-on configuration",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(deleteCmd, &deleteSSOCommand{}, options)
+
+	root.AddCommand(getCmd)
+	root.AddCommand(setOIDCCmd)
+	root.AddCommand(setSAMLCmd)
+	root.AddCommand(deleteCmd)
+
+	return root
+}
+
+func NewSCIMCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:   "scim",
+		Short: "Manage SCIM provisioning of users and groups",
+	}
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get the SCIM provisioning configuration",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(getCmd, &getSCIMCommand{}, options)
+
+	regenerateCmd := &cobra.Command{
+		Use:   "regenerate-token",
+		Short: "Enable SCIM provisioning with a new token, revoking the previous one",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(regenerateCmd, &regenerateSCIMTokenCommand{}, options)
+
+	disableCmd := &cobra.Command{
+		Use:   "disable",
+		Short: "Disable SCIM provisioning, revoking the token",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(disableCmd, &disableSCIMCommand{}, options)
+
+	root.AddCommand(getCmd)
+	root.AddCommand(regenerateCmd)
+	root.AddCommand(disableCmd)
+
+	return root
+}
+
+func bindSSOFlags(cmd *cobra.Command) ssoFlags {
+	flags := ssoFlags{
+		disabled:      new(bool),
+		enforced:      new(bool),
+		groupsClaim:   new(string),
+		groupMappings: new([]string),
+	}
+
+	cmd.Flags().BoolVar(flags.disabled, "disabled", false, "save the configuration without enabling single sign-on")
+	cmd.Flags().BoolVar(flags.enforced, "enforced", false, "require members to log in through the identity provider")
+	cmd.Flags().StringVar(flags.groupsClaim, "groups-claim", "", "claim or attribute with the groups of the user (default groups)")
+	cmd.Flags().StringSliceVar(flags.groupMappings, "group-mapping", nil, "map an identity provider group to a group, as idp-group=group (repeatable)")
+
+	return flags
+}
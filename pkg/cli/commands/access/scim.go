@@ -0,0 +1,100 @@
+package access
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type getSCIMCommand struct{}
+
+func (c *getSCIMCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.OrganizationAPI.OrganizationsGetSCIMConfig(ctx.Context, organizationID).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response.GetScimConfig())
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderSCIMConfigText(stdout, response.GetScimConfig())
+	})
+}
+
+type regenerateSCIMTokenCommand struct{}
+
+/*
+ * The previous token stops working as soon as the new one is issued,
+ * so the identity provider must be updated with the new one.
+ */
+func (c *regenerateSCIMTokenCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.OrganizationAPI.
+		OrganizationsRegenerateSCIMToken(ctx.Context, organizationID).
+		Body(map[string]any{}).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		config := response.GetScimConfig()
+		_, _ = fmt.Fprintf(stdout, "SCIM base URL: %s\n", config.GetBaseUrl())
+		_, err := fmt.Fprintf(stdout, "Token: %s\n", response.GetToken())
+		return err
+	})
+}
+
+type disableSCIMCommand struct{}
+
+func (c *disableSCIMCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = ctx.API.OrganizationAPI.OrganizationsDeleteSCIMToken(ctx.Context, organizationID).Execute()
+	if err != nil {
+		return err
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintln(stdout, "SCIM provisioning disabled")
+		return err
+	})
+}
+
+func renderSCIMConfigText(stdout io.Writer, config openapi_client.OrganizationsSCIMConfig) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintf(writer, "Enabled:\t%t\n", config.GetEnabled())
+	_, _ = fmt.Fprintf(writer, "Base URL:\t%s\n", config.GetBaseUrl())
+
+	if config.HasTokenCreatedAt() {
+		_, _ = fmt.Fprintf(writer, "Token created:\t%s\n", config.GetTokenCreatedAt().Format(time.RFC3339))
+	}
+
+	if config.HasTokenLastUsedAt() {
+		_, _ = fmt.Fprintf(writer, "Token last used:\t%s\n", config.GetTokenLastUsedAt().Format(time.RFC3339))
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,224 @@
+package access
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type listServiceAccountsCommand struct{}
+
+func (c *listServiceAccountsCommand) Execute(ctx core.CommandContext) error {
+	response, _, err := ctx.API.ServiceAccountsAPI.ServiceAccountsListServiceAccounts(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	accounts := response.GetServiceAccounts()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(accounts)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderServiceAccountListText(stdout, accounts)
+	})
+}
+
+type getServiceAccountCommand struct{}
+
+func (c *getServiceAccountCommand) Execute(ctx core.CommandContext) error {
+	account, err := findServiceAccount(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(account)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderServiceAccountText(stdout, *account)
+	})
+}
+
+type createServiceAccountCommand struct {
+	description *string
+	role        *string
+}
+
+/*
+ * The token is only returned on creation,
+ * so it is always printed, even in text output.
+ */
+func (c *createServiceAccountCommand) Execute(ctx core.CommandContext) error {
+	body := openapi_client.ServiceAccountsCreateServiceAccountRequest{}
+	body.SetName(ctx.Args[0])
+	body.SetDescription(*c.description)
+	body.SetRole(*c.role)
+
+	response, _, err := ctx.API.ServiceAccountsAPI.ServiceAccountsCreateServiceAccount(ctx.Context).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	account := response.GetServiceAccount()
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Service account created: %s (%s)\n", account.GetName(), account.GetId())
+		_, err := fmt.Fprintf(stdout, "Token: %s\n", response.GetToken())
+		return err
+	})
+}
+
+type updateServiceAccountCommand struct {
+	name        *string
+	description *string
+}
+
+func (c *updateServiceAccountCommand) Execute(ctx core.CommandContext) error {
+	account, err := findServiceAccount(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	flags := ctx.Cmd.Flags()
+	if !flags.Changed("name") && !flags.Changed("description") {
+		return fmt.Errorf("--name or --description is required")
+	}
+
+	body := openapi_client.ServiceAccountsUpdateServiceAccountBody{}
+	if flags.Changed("name") {
+		body.SetName(*c.name)
+	}
+	if flags.Changed("description") {
+		body.SetDescription(*c.description)
+	}
+
+	response, _, err := ctx.API.ServiceAccountsAPI.ServiceAccountsUpdateServiceAccount(ctx.Context, account.GetId()).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	updated := response.GetServiceAccount()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(updated)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Service account updated: %s\n", updated.GetName())
+		return err
+	})
+}
+
+type deleteServiceAccountCommand struct{}
+
+func (c *deleteServiceAccountCommand) Execute(ctx core.CommandContext) error {
+	account, err := findServiceAccount(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.ServiceAccountsAPI.ServiceAccountsDeleteServiceAccount(ctx.Context, account.GetId()).Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := fmt.Fprintf(stdout, "Service account deleted: %s\n", ctx.Args[0])
+			return err
+		})
+	}
+
+	return ctx.Renderer.Render(response)
+}
+
+type rotateServiceAccountTokenCommand struct{}
+
+/*
+ * The previous token stops working as soon as the new one is issued.
+ */
+func (c *rotateServiceAccountTokenCommand) Execute(ctx core.CommandContext) error {
+	account, err := findServiceAccount(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.ServiceAccountsAPI.
+		ServiceAccountsRegenerateServiceAccountToken(ctx.Context, account.GetId()).
+		Body(map[string]any{}).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Token: %s\n", response.GetToken())
+		return err
+	})
+}
+
+/*
+ * Service accounts can be referenced by id or name.
+ */
+func findServiceAccount(ctx core.CommandContext, ref string) (*openapi_client.ServiceAccountsServiceAccount, error) {
+	response, _, err := ctx.API.ServiceAccountsAPI.ServiceAccountsListServiceAccounts(ctx.Context).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range response.GetServiceAccounts() {
+		if account.GetId() == ref || account.GetName() == ref {
+			return &account, nil
+		}
+	}
+
+	return nil, fmt.Errorf("service account %q not found", ref)
+}
+
+func renderServiceAccountListText(stdout io.Writer, accounts []openapi_client.ServiceAccountsServiceAccount) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "ID\tNAME\tHAS_TOKEN\tCREATED_AT")
+
+	for _, account := range accounts {
+		createdAt := ""
+		if account.HasCreatedAt() {
+			createdAt = account.GetCreatedAt().Format(time.RFC3339)
+		}
+
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%t\t%s\n",
+			account.GetId(),
+			account.GetName(),
+			account.GetHasToken(),
+			createdAt,
+		)
+	}
+
+	return writer.Flush()
+}
+
+func renderServiceAccountText(stdout io.Writer, account openapi_client.ServiceAccountsServiceAccount) error {
+	_, _ = fmt.Fprintf(stdout, "ID: %s\n", account.GetId())
+	_, _ = fmt.Fprintf(stdout, "Name: %s\n", account.GetName())
+	_, _ = fmt.Fprintf(stdout, "Description: %s\n", account.GetDescription())
+	_, _ = fmt.Fprintf(stdout, "HasToken: %t\n", account.GetHasToken())
+	_, _ = fmt.Fprintf(stdout, "CreatedBy: %s\n", account.GetCreatedBy())
+	if account.HasCreatedAt() {
+		_, _ = fmt.Fprintf(stdout, "CreatedAt: %s\n", account.GetCreatedAt().Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package access
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type ssoFlags struct {
+	disabled      *bool
+	enforced      *bool
+	groupsClaim   *string
+	groupMappings *[]string
+}
+
+type getSSOCommand struct{}
+
+func (c *getSSOCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.OrganizationAPI.OrganizationsGetSSOConfig(ctx.Context, organizationID).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response.GetSsoConfig())
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderSSOConfigText(stdout, response.GetSsoConfig())
+	})
+}
+
+type setOIDCCommand struct {
+	flags        ssoFlags
+	issuerURL    *string
+	clientID     *string
+	clientSecret *string
+}
+
+func (c *setOIDCCommand) Execute(ctx core.CommandContext) error {
+	config, err := buildSSOConfig(c.flags, "oidc")
+	if err != nil {
+		return err
+	}
+
+	oidc := openapi_client.SSOConfigOIDC{}
+	oidc.SetIssuerUrl(strings.TrimSpace(*c.issuerURL))
+	oidc.SetClientId(strings.TrimSpace(*c.clientID))
+	if secret := strings.TrimSpace(*c.clientSecret); secret != "" {
+		oidc.SetClientSecret(secret)
+	}
+
+	config.SetOidc(oidc)
+	return updateSSOConfig(ctx, config)
+}
+
+type setSAMLCommand struct {
+	flags        ssoFlags
+	metadataFile *string
+}
+
+func (c *setSAMLCommand) Execute(ctx core.CommandContext) error {
+	config, err := buildSSOConfig(c.flags, "saml")
+	if err != nil {
+		return err
+	}
+
+	metadata, err := os.ReadFile(*c.metadataFile)
+	if err != nil {
+		return fmt.Errorf("failed to read metadata file: %w", err)
+	}
+
+	saml := openapi_client.SSOConfigSAML{}
+	saml.SetIdpMetadata(string(metadata))
+	config.SetSaml(saml)
+	return updateSSOConfig(ctx, config)
+}
+
+type deleteSSOCommand struct{}
+
+func (c *deleteSSOCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = ctx.API.OrganizationAPI.OrganizationsDeleteSSOConfig(ctx.Context, organizationID).Execute()
+	if err != nil {
+		return err
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintln(stdout, "Single sign-on configuration deleted")
+		return err
+	})
+}
+
+func buildSSOConfig(flags ssoFlags, protocol string) (openapi_client.OrganizationsSSOConfig, error) {
+	config := openapi_client.OrganizationsSSOConfig{}
+	mappings, err := parseSSOGroupMappings(*flags.groupMappings)
+	if err != nil {
+		return config, err
+	}
+
+	config.SetProtocol(protocol)
+	config.SetEnabled(!*flags.disabled)
+	config.SetEnforced(*flags.enforced)
+	config.SetGroupMappings(mappings)
+	if claim := strings.TrimSpace(*flags.groupsClaim); claim != "" {
+		config.SetGroupsClaim(claim)
+	}
+
+	return config, nil
+}
+
+func updateSSOConfig(ctx core.CommandContext, config openapi_client.OrganizationsSSOConfig) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.OrganizationsUpdateSSOConfigBody{}
+	body.SetSsoConfig(config)
+
+	response, _, err := ctx.API.OrganizationAPI.
+		OrganizationsUpdateSSOConfig(ctx.Context, organizationID).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response.GetSsoConfig())
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderSSOConfigText(stdout, response.GetSsoConfig())
+	})
+}
+
+/*
+ * Group mappings are given as idp-group=group,
+ * where group is the name of a SuperPlane group.
+ */
+func parseSSOGroupMappings(values []string) ([]openapi_client.OrganizationsSSOGroupMapping, error) {
+	mappings := []openapi_client.OrganizationsSSOGroupMapping{}
+	for _, value := range values {
+		idpGroup, group, ok := strings.Cut(value, "=")
+		idpGroup = strings.TrimSpace(idpGroup)
+		group = strings.TrimSpace(group)
+		if !ok || idpGroup == "" || group == "" {
+			return nil, fmt.Errorf("invalid group mapping %q: expected idp-group=group", value)
+		}
+
+		mapping := openapi_client.OrganizationsSSOGroupMapping{}
+		mapping.SetIdpGroup(idpGroup)
+		mapping.SetGroup(group)
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings, nil
+}
+
+func renderSSOConfigText(stdout io.Writer, config openapi_client.OrganizationsSSOConfig) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintf(writer, "Protocol:\t%s\n", config.GetProtocol())
+	_, _ = fmt.Fprintf(writer, "Enabled:\t%t\n", config.GetEnabled())
+	_, _ = fmt.Fprintf(writer, "Enforced:\t%t\n", config.GetEnforced())
+	_, _ = fmt.Fprintf(writer, "Login URL:\t%s\n", config.GetLoginUrl())
+	_, _ = fmt.Fprintf(writer, "Callback URL:\t%s\n", config.GetCallbackUrl())
+
+	if config.HasOidc() {
+		oidc := config.GetOidc()
+		_, _ = fmt.Fprintf(writer, "Issuer URL:\t%s\n", oidc.GetIssuerUrl())
+		_, _ = fmt.Fprintf(writer, "Client ID:\t%s\n", oidc.GetClientId())
+		_, _ = fmt.Fprintf(writer, "Client secret configured:\t%t\n", oidc.GetClientSecretConfigured())
+	}
+
+	if config.HasSaml() {
+		saml := config.GetSaml()
+		_, _ = fmt.Fprintf(writer, "IdP entity ID:\t%s\n", saml.GetIdpEntityId())
+		_, _ = fmt.Fprintf(writer, "SP entity ID:\t%s\n", saml.GetSpEntityId())
+		_, _ = fmt.Fprintf(writer, "SP metadata URL:\t%s\n", saml.GetSpMetadataUrl())
+	}
+
+	_, _ = fmt.Fprintf(writer, "Groups claim:\t%s\n", config.GetGroupsClaim())
+	for _, mapping := range config.GetGroupMappings() {
+		_, _ = fmt.Fprintf(writer, "Group mapping:\t%s -> %s\n", mapping.GetIdpGroup(), mapping.GetGroup())
+	}
+
+	return writer.Flush()
+}
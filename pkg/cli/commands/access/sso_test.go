@@ -0,0 +1,24 @@
+package access
+
+import "testing"
+
+func TestParseSSOGroupMappings(t *testing.T) {
+	mappings, err := parseSSOGroupMappings([]string{"engineering=developers", " ops = operators "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(mappings) != 2 {
+		t.Fatalf("expected 2 mappings, got %d", len(mappings))
+	}
+
+	if mappings[1].GetIdpGroup() != "ops" || mappings[1].GetGroup() != "operators" {
+		t.Fatalf("expected trimmed mapping, got %s=%s", mappings[1].GetIdpGroup(), mappings[1].GetGroup())
+	}
+
+	for _, value := range []string{"engineering", "=developers", "engineering="} {
+		if _, err := parseSSOGroupMappings([]string{value}); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package access
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type tokenFlags struct {
+	scopes   *[]string
+	canvasID *string
+	expires  *string
+}
+
+/*
+ * Personal and service account tokens have the same fields,
+ * but different types in the API client.
+ */
+type tokenRow struct {
+	ID         string
+	Name       string
+	Scopes     []string
+	CanvasID   string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+type listTokensCommand struct{}
+
+func (c *listTokensCommand) Execute(ctx core.CommandContext) error {
+	response, _, err := ctx.API.MeAPI.MeListTokens(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	tokens := response.GetTokens()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(tokens)
+	}
+
+	rows := []tokenRow{}
+	for _, token := range tokens {
+		rows = append(rows, tokenRow{
+			ID:         token.GetId(),
+			Name:       token.GetName(),
+			Scopes:     token.GetScopes(),
+			CanvasID:   token.GetCanvasId(),
+			ExpiresAt:  token.ExpiresAt,
+			LastUsedAt: token.LastUsedAt,
+		})
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderTokenListText(stdout, rows)
+	})
+}
+
+type createTokenCommand struct {
+	flags tokenFlags
+}
+
+func (c *createTokenCommand) Execute(ctx core.CommandContext) error {
+	scopes, expiresAt, err := parseTokenFlags(c.flags)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.MeCreateTokenRequest{}
+	body.SetName(ctx.Args[0])
+	body.SetScopes(scopes)
+	if canvasID := strings.TrimSpace(*c.flags.canvasID); canvasID != "" {
+		body.SetCanvasId(canvasID)
+	}
+	if expiresAt != nil {
+		body.SetExpiresAt(*expiresAt)
+	}
+
+	response, _, err := ctx.API.MeAPI.MeCreateToken(ctx.Context).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	token := response.GetApiToken()
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Token created: %s (%s)\n", token.GetName(), token.GetId())
+		_, err := fmt.Fprintf(stdout, "Token: %s\n", response.GetToken())
+		return err
+	})
+}
+
+type revokeTokenCommand struct{}
+
+func (c *revokeTokenCommand) Execute(ctx core.CommandContext) error {
+	response, _, err := ctx.API.MeAPI.MeListTokens(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	tokenID := ""
+	for _, token := range response.GetTokens() {
+		if token.GetId() == ctx.Args[0] || token.GetName() == ctx.Args[0] {
+			tokenID = token.GetId()
+			break
+		}
+	}
+
+	if tokenID == "" {
+		return fmt.Errorf("token %q not found", ctx.Args[0])
+	}
+
+	_, _, err = ctx.API.MeAPI.MeRevokeToken(ctx.Context, tokenID).Execute()
+	if err != nil {
+		return err
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Token revoked: %s\n", ctx.Args[0])
+		return err
+	})
+}
+
+type listServiceAccountTokensCommand struct{}
+
+func (c *listServiceAccountTokensCommand) Execute(ctx core.CommandContext) error {
+	account, err := findServiceAccount(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.ServiceAccountsAPI.ServiceAccountsListServiceAccountTokens(ctx.Context, account.GetId()).Execute()
+	if err != nil {
+		return err
+	}
+
+	tokens := response.GetTokens()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(tokens)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderTokenListText(stdout, serviceAccountTokenRows(tokens))
+	})
+}
+
+type createServiceAccountTokenCommand struct {
+	flags tokenFlags
+}
+
+func (c *createServiceAccountTokenCommand) Execute(ctx core.CommandContext) error {
+	account, err := findServiceAccount(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	scopes, expiresAt, err := parseTokenFlags(c.flags)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.ServiceAccountsCreateServiceAccountTokenBody{}
+	body.SetName(ctx.Args[1])
+	body.SetScopes(scopes)
+	if canvasID := strings.TrimSpace(*c.flags.canvasID); canvasID != "" {
+		body.SetCanvasId(canvasID)
+	}
+	if expiresAt != nil {
+		body.SetExpiresAt(*expiresAt)
+	}
+
+	response, _, err := ctx.API.ServiceAccountsAPI.
+		ServiceAccountsCreateServiceAccountToken(ctx.Context, account.GetId()).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	token := response.GetApiToken()
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Token created for %s: %s (%s)\n", account.GetName(), token.GetName(), token.GetId())
+		_, err := fmt.Fprintf(stdout, "Token: %s\n", response.GetToken())
+		return err
+	})
+}
+
+type revokeServiceAccountTokenCommand struct{}
+
+func (c *revokeServiceAccountTokenCommand) Execute(ctx core.CommandContext) error {
+	account, err := findServiceAccount(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.ServiceAccountsAPI.ServiceAccountsListServiceAccountTokens(ctx.Context, account.GetId()).Execute()
+	if err != nil {
+		return err
+	}
+
+	tokenID := ""
+	for _, token := range response.GetTokens() {
+		if token.GetId() == ctx.Args[1] || token.GetName() == ctx.Args[1] {
+			tokenID = token.GetId()
+			break
+		}
+	}
+
+	if tokenID == "" {
+		return fmt.Errorf("token %q not found for service account %s", ctx.Args[1], account.GetName())
+	}
+
+	_, _, err = ctx.API.ServiceAccountsAPI.ServiceAccountsRevokeServiceAccountToken(ctx.Context, account.GetId(), tokenID).Execute()
+	if err != nil {
+		return err
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Token revoked: %s\n", ctx.Args[1])
+		return err
+	})
+}
+
+func serviceAccountTokenRows(tokens []openapi_client.ServiceAccountsServiceAccountToken) []tokenRow {
+	rows := []tokenRow{}
+	for _, token := range tokens {
+		rows = append(rows, tokenRow{
+			ID:         token.GetId(),
+			Name:       token.GetName(),
+			Scopes:     token.GetScopes(),
+			CanvasID:   token.GetCanvasId(),
+			ExpiresAt:  token.ExpiresAt,
+			LastUsedAt: token.LastUsedAt,
+		})
+	}
+
+	return rows
+}
+
+func parseTokenFlags(flags tokenFlags) ([]string, *time.Time, error) {
+	scopes := []string{}
+	for _, scope := range *flags.scopes {
+		resource, action, ok := strings.Cut(strings.TrimSpace(scope), ":")
+		resource = strings.TrimSpace(resource)
+		action = strings.TrimSpace(action)
+		if !ok || resource == "" || action == "" {
+			return nil, nil, fmt.Errorf("invalid scope %q: expected resource:action", scope)
+		}
+
+		scopes = append(scopes, resource+":"+action)
+	}
+
+	expires := strings.TrimSpace(*flags.expires)
+	if expires == "" {
+		return scopes, nil, nil
+	}
+
+	expiresAt, err := parseExpiry(expires, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return scopes, &expiresAt, nil
+}
+
+/*
+ * Expiry is either an RFC3339 timestamp,
+ * or a duration from now, e.g. 720h.
+ */
+func parseExpiry(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return time.Time{}, fmt.Errorf("invalid --expires value %q: expected RFC3339 timestamp or duration", value)
+	}
+
+	return now.Add(duration), nil
+}
+
+func renderTokenListText(stdout io.Writer, tokens []tokenRow) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "ID\tNAME\tSCOPES\tCANVAS\tEXPIRES_AT\tLAST_USED_AT")
+
+	for _, token := range tokens {
+		scopes := "all"
+		if len(token.Scopes) > 0 {
+			scopes = strings.Join(token.Scopes, ",")
+		}
+
+		canvas := "all"
+		if token.CanvasID != "" {
+			canvas = token.CanvasID
+		}
+
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%s\t%s\n",
+			token.ID,
+			token.Name,
+			scopes,
+			canvas,
+			formatTokenTime(token.ExpiresAt, "never"),
+			formatTokenTime(token.LastUsedAt, "never"),
+		)
+	}
+
+	return writer.Flush()
+}
+
+func formatTokenTime(t *time.Time, empty string) string {
+	if t == nil {
+		return empty
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,43 @@
+package access
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	expiresAt, err := parseExpiry("720h", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !expiresAt.Equal(now.Add(720 * time.Hour)) {
+		t.Fatalf("expected 30 days from now, got %s", expiresAt)
+	}
+
+	expiresAt, err = parseExpiry("2027-01-01T00:00:00Z", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !expiresAt.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected timestamp to be used as is, got %s", expiresAt)
+	}
+
+	for _, value := range []string{"-1h", "0s", "tomorrow"} {
+		if _, err := parseExpiry(value, now); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
+
+func TestParseTokenFlagsRejectsInvalidScopes(t *testing.T) {
+	scopes := []string{"canvases:read", "secrets"}
+	canvasID := ""
+	expires := ""
+
+	_, _, err := parseTokenFlags(tokenFlags{scopes: &scopes, canvasID: &canvasID, expires: &expires})
+	if err == nil {
+		t.Fatalf("expected error for invalid scope")
+	}
+}
@@ -0,0 +1,169 @@
+package access
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type listUsersCommand struct {
+	includeServiceAccounts *bool
+}
+
+func (c *listUsersCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.UsersAPI.
+		UsersListUsers(ctx.Context).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		IncludeServiceAccounts(*c.includeServiceAccounts).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	users := response.GetUsers()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(users)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderUserListText(stdout, users)
+	})
+}
+
+type getUserCommand struct{}
+
+func (c *getUserCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := findUser(ctx, organizationID, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	metadata := user.GetMetadata()
+	permissions, _, err := ctx.API.UsersAPI.
+		UsersListUserPermissions(ctx.Context, metadata.GetId()).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(map[string]any{
+			"user":        user,
+			"permissions": permissions.GetPermissions(),
+		})
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderUserText(stdout, *user, permissions.GetPermissions())
+	})
+}
+
+type removeUserCommand struct{}
+
+/*
+ * Removes the user from the organization,
+ * along with its role and group memberships.
+ */
+func (c *removeUserCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := findUser(ctx, organizationID, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	metadata := user.GetMetadata()
+	response, _, err := ctx.API.OrganizationAPI.
+		OrganizationsRemoveUser(ctx.Context, organizationID, metadata.GetId()).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := fmt.Fprintf(stdout, "User removed: %s\n", ctx.Args[0])
+			return err
+		})
+	}
+
+	return ctx.Renderer.Render(response)
+}
+
+func renderUserListText(stdout io.Writer, users []openapi_client.SuperplaneUsersUser) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "ID\tEMAIL\tNAME\tROLES\tCREATED_AT")
+
+	for _, user := range users {
+		metadata := user.GetMetadata()
+		spec := user.GetSpec()
+
+		createdAt := ""
+		if metadata.HasCreatedAt() {
+			createdAt = metadata.GetCreatedAt().Format(time.RFC3339)
+		}
+
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%s\n",
+			metadata.GetId(),
+			metadata.GetEmail(),
+			spec.GetDisplayName(),
+			strings.Join(userRoleNames(user), ","),
+			createdAt,
+		)
+	}
+
+	return writer.Flush()
+}
+
+func renderUserText(stdout io.Writer, user openapi_client.SuperplaneUsersUser, permissions []openapi_client.AuthorizationPermission) error {
+	metadata := user.GetMetadata()
+	spec := user.GetSpec()
+
+	_, _ = fmt.Fprintf(stdout, "ID: %s\n", metadata.GetId())
+	_, _ = fmt.Fprintf(stdout, "Email: %s\n", metadata.GetEmail())
+	_, _ = fmt.Fprintf(stdout, "Name: %s\n", spec.GetDisplayName())
+	if metadata.HasCreatedAt() {
+		_, _ = fmt.Fprintf(stdout, "CreatedAt: %s\n", metadata.GetCreatedAt().Format(time.RFC3339))
+	}
+
+	_, _ = fmt.Fprintf(stdout, "Roles: %s\n", strings.Join(userRoleNames(user), ", "))
+	_, _ = fmt.Fprintln(stdout, "Permissions:")
+	for _, permission := range permissions {
+		_, _ = fmt.Fprintf(stdout, "- %s\n", formatPermission(permission))
+	}
+
+	return nil
+}
+
+func userRoleNames(user openapi_client.SuperplaneUsersUser) []string {
+	status := user.GetStatus()
+	names := []string{}
+	for _, assignment := range status.GetRoleAssignments() {
+		names = append(names, assignment.GetRoleName())
+	}
+
+	return names
+}
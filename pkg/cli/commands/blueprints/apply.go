@@ -0,0 +1,91 @@
+package blueprints
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/superplanehq/superplane/pkg/cli/commands/blueprints/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type applyCommand struct {
+	file *string
+}
+
+/*
+ * The blueprint is matched by the id in the file, and then by name,
+ * so files written by `blueprints get -o yaml` can be applied to other organizations too.
+ */
+func (c *applyCommand) Execute(ctx core.CommandContext) error {
+	// #nosec
+	data, err := os.ReadFile(*c.file)
+	if err != nil {
+		return fmt.Errorf("failed to read resource file: %w", err)
+	}
+
+	resource, err := models.ParseBlueprint(data)
+	if err != nil {
+		return err
+	}
+
+	blueprint := models.BlueprintFromBlueprint(*resource)
+	blueprintID, err := c.findExisting(ctx, blueprint)
+	if err != nil {
+		return err
+	}
+
+	var applied openapi_client.BlueprintsBlueprint
+	action := "updated"
+	if blueprintID == "" {
+		blueprint.Id = nil
+		request := openapi_client.BlueprintsCreateBlueprintRequest{}
+		request.SetBlueprint(blueprint)
+		response, _, err := ctx.API.BlueprintAPI.BlueprintsCreateBlueprint(ctx.Context).Body(request).Execute()
+		if err != nil {
+			return err
+		}
+		applied = response.GetBlueprint()
+		action = "created"
+	} else {
+		blueprint.Id = &blueprintID
+		body := openapi_client.BlueprintsUpdateBlueprintBody{}
+		body.SetBlueprint(blueprint)
+		response, _, err := ctx.API.BlueprintAPI.BlueprintsUpdateBlueprint(ctx.Context, blueprintID).Body(body).Execute()
+		if err != nil {
+			return err
+		}
+		applied = response.GetBlueprint()
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(models.BlueprintResourceFromBlueprint(applied))
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Blueprint %s: %s (%s)\n", action, applied.GetName(), applied.GetId())
+		return err
+	})
+}
+
+func (c *applyCommand) findExisting(ctx core.CommandContext, blueprint openapi_client.BlueprintsBlueprint) (string, error) {
+	response, _, err := ctx.API.BlueprintAPI.BlueprintsListBlueprints(ctx.Context).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	for _, existing := range response.GetBlueprints() {
+		if blueprint.GetId() != "" && existing.GetId() == blueprint.GetId() {
+			return existing.GetId(), nil
+		}
+	}
+
+	for _, existing := range response.GetBlueprints() {
+		if existing.GetName() == blueprint.GetName() {
+			return existing.GetId(), nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,58 @@
+package blueprints
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/cli/commands/blueprints/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type getCommand struct{}
+
+func (c *getCommand) Execute(ctx core.CommandContext) error {
+	blueprintID, err := findBlueprintID(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.BlueprintAPI.BlueprintsDescribeBlueprint(ctx.Context, blueprintID).Execute()
+	if err != nil {
+		return err
+	}
+
+	blueprint := response.GetBlueprint()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(models.BlueprintResourceFromBlueprint(blueprint))
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "ID: %s\n", blueprint.GetId())
+		_, _ = fmt.Fprintf(stdout, "Name: %s\n", blueprint.GetName())
+		_, _ = fmt.Fprintf(stdout, "Nodes: %d\n", len(blueprint.GetNodes()))
+		_, _ = fmt.Fprintf(stdout, "Edges: %d\n", len(blueprint.GetEdges()))
+		_, _ = fmt.Fprintf(stdout, "Configuration fields: %d\n", len(blueprint.GetConfiguration()))
+		_, err := fmt.Fprintf(stdout, "Output channels: %d\n", len(blueprint.GetOutputChannels()))
+		return err
+	})
+}
+
+func findBlueprintID(ctx core.CommandContext, nameOrID string) (string, error) {
+	if _, err := uuid.Parse(nameOrID); err == nil {
+		return nameOrID, nil
+	}
+
+	response, _, err := ctx.API.BlueprintAPI.BlueprintsListBlueprints(ctx.Context).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	for _, blueprint := range response.GetBlueprints() {
+		if blueprint.GetName() == nameOrID {
+			return blueprint.GetId(), nil
+		}
+	}
+
+	return "", fmt.Errorf("blueprint %q not found", nameOrID)
+}
@@ -0,0 +1,45 @@
+package blueprints
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/commands/blueprints/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type listCommand struct{}
+
+func (c *listCommand) Execute(ctx core.CommandContext) error {
+	response, _, err := ctx.API.BlueprintAPI.BlueprintsListBlueprints(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	blueprints := response.GetBlueprints()
+	resources := make([]models.Blueprint, 0, len(blueprints))
+	for _, blueprint := range blueprints {
+		resources = append(resources, models.BlueprintResourceFromBlueprint(blueprint))
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(resources)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "ID\tNAME\tNODES\tCREATED_AT")
+
+		for _, blueprint := range blueprints {
+			createdAt := ""
+			if blueprint.HasCreatedAt() {
+				createdAt = blueprint.GetCreatedAt().Format(time.RFC3339)
+			}
+			_, _ = fmt.Fprintf(writer, "%s\t%s\t%d\t%s\n", blueprint.GetId(), blueprint.GetName(), len(blueprint.GetNodes()), createdAt)
+		}
+
+		return writer.Flush()
+	})
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	BlueprintKind = "Blueprint"
+)
+
+type Blueprint struct {
+	APIVersion string             `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string             `json:"kind" yaml:"kind"`
+	Metadata   *BlueprintMetadata `json:"metadata" yaml:"metadata"`
+	Spec       *BlueprintSpec     `json:"spec,omitempty" yaml:"spec,omitempty"`
+}
+
+type BlueprintMetadata struct {
+	ID          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty" yaml:"icon,omitempty"`
+	Color       *string `json:"color,omitempty" yaml:"color,omitempty"`
+}
+
+type BlueprintSpec struct {
+	Nodes          []openapi_client.ComponentsNode                    `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Edges          []openapi_client.ComponentsEdge                    `json:"edges,omitempty" yaml:"edges,omitempty"`
+	Configuration  []openapi_client.ConfigurationField                `json:"configuration,omitempty" yaml:"configuration,omitempty"`
+	OutputChannels []openapi_client.SuperplaneBlueprintsOutputChannel `json:"outputChannels,omitempty" yaml:"outputChannels,omitempty"`
+}
+
+func ParseBlueprint(raw []byte) (*Blueprint, error) {
+	var resource Blueprint
+	if err := yaml.Unmarshal(raw, &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse blueprint resource: %w", err)
+	}
+
+	if resource.Kind != BlueprintKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", resource.Kind)
+	}
+
+	if resource.APIVersion == "" {
+		return nil, fmt.Errorf("blueprint apiVersion is required")
+	}
+
+	if resource.Metadata == nil || resource.Metadata.Name == nil || *resource.Metadata.Name == "" {
+		return nil, fmt.Errorf("blueprint metadata.name is required")
+	}
+
+	if resource.Spec == nil {
+		resource.Spec = &BlueprintSpec{}
+	}
+
+	return &resource, nil
+}
+
+func BlueprintFromBlueprint(resource Blueprint) openapi_client.BlueprintsBlueprint {
+	blueprint := openapi_client.BlueprintsBlueprint{}
+	if resource.Metadata != nil {
+		blueprint.Id = resource.Metadata.ID
+		blueprint.Name = resource.Metadata.Name
+		blueprint.Description = resource.Metadata.Description
+		blueprint.Icon = resource.Metadata.Icon
+		blueprint.Color = resource.Metadata.Color
+	}
+
+	if resource.Spec != nil {
+		blueprint.Nodes = resource.Spec.Nodes
+		blueprint.Edges = resource.Spec.Edges
+		blueprint.Configuration = resource.Spec.Configuration
+		blueprint.OutputChannels = resource.Spec.OutputChannels
+	}
+
+	return blueprint
+}
+
+func BlueprintResourceFromBlueprint(blueprint openapi_client.BlueprintsBlueprint) Blueprint {
+	return Blueprint{
+		APIVersion: "v1",
+		Kind:       BlueprintKind,
+		Metadata: &BlueprintMetadata{
+			ID:          blueprint.Id,
+			Name:        blueprint.Name,
+			Description: blueprint.Description,
+			Icon:        blueprint.Icon,
+			Color:       blueprint.Color,
+		},
+		Spec: &BlueprintSpec{
+			Nodes:          blueprint.Nodes,
+			Edges:          blueprint.Edges,
+			Configuration:  blueprint.Configuration,
+			OutputChannels: blueprint.OutputChannels,
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package blueprints
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:     "blueprints",
+		Short:   "Manage blueprints",
+		Aliases: []string{"blueprint"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List blueprints",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(listCmd, &listCommand{}, options)
+
+	getCmd := &cobra.Command{
+		Use:   "get <name-or-id>",
+		Short: "Get a blueprint",
+		Long:  "Prints a blueprint. With --output yaml, the output can be edited and passed to apply.",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(getCmd, &getCommand{}, options)
+
+	var applyFile string
+	applyCmd := &cobra.Command{
+		Use:   "apply -f <file>",
+		Short: "Create or update a blueprint from a file",
+		Long:  "Creates the blueprint in the file, or updates it if a blueprint with the same id or name already exists.",
+		Args:  cobra.NoArgs,
+	}
+	applyCmd.Flags().StringVarP(&applyFile, "file", "f", "", "filename to use to apply the resource")
+	_ = applyCmd.MarkFlagRequired("file")
+	core.Bind(applyCmd, &applyCommand{file: &applyFile}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(getCmd)
+	root.AddCommand(applyCmd)
+
+	return root
+}
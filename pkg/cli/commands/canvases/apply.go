@@ -0,0 +1,63 @@
+package canvases
+
+import (
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+/*
+ * ApplyCanvas makes the given canvas the live version of an existing canvas.
+ * With sandbox mode enabled, the live canvas is updated directly.
+ * Otherwise, the current user's draft is updated and published through a change request.
+ */
+func ApplyCanvas(ctx core.CommandContext, canvasID string, canvas openapi_client.CanvasesCanvas, title string) error {
+	versioningContext, err := resolveCanvasVersioningContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	current, err := describeCanvasByID(ctx, canvasID)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.CanvasesUpdateCanvasVersionBody{}
+	body.SetCanvas(canvas)
+	body.SetAutoLayout(buildDefaultAutoLayout(current, canvas))
+
+	if versioningContext.sandboxModeEnabled {
+		_, _, err = ctx.API.CanvasVersionAPI.
+			CanvasesUpdateCanvasVersion2(ctx.Context, canvasID).
+			Body(body).
+			Execute()
+		return err
+	}
+
+	versionID, err := ensureCurrentUserDraftVersionID(ctx, canvasID)
+	if err != nil {
+		return err
+	}
+
+	body.SetVersionId(versionID)
+	_, _, err = ctx.API.CanvasVersionAPI.
+		CanvasesUpdateCanvasVersion2(ctx.Context, canvasID).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	changeRequest := openapi_client.CanvasesCreateCanvasChangeRequestBody{}
+	changeRequest.SetVersionId(versionID)
+	if trimmedTitle := strings.TrimSpace(title); trimmedTitle != "" {
+		changeRequest.SetTitle(trimmedTitle)
+	}
+
+	_, _, err = ctx.API.CanvasChangeRequestAPI.
+		CanvasesCreateCanvasChangeRequest(ctx.Context, canvasID).
+		Body(changeRequest).
+		Execute()
+	return err
+}
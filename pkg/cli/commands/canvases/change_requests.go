@@ -0,0 +1,251 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type createChangeRequestCommand struct {
+	canvas      *string
+	title       *string
+	description *string
+}
+
+/*
+ * Unlike `canvases publish`, the change request is left open,
+ * so it can be reviewed with `change-requests diff` and published later.
+ */
+func (c *createChangeRequestCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := resolveChangeRequestCanvas(ctx, *c.canvas)
+	if err != nil {
+		return err
+	}
+
+	draftVersionID, err := findCurrentUserDraftVersionID(ctx, canvasID)
+	if err != nil {
+		return err
+	}
+	if draftVersionID == "" {
+		return fmt.Errorf("no draft version found; run `superplane canvases update %s --draft ...` first", canvasID)
+	}
+
+	body := openapi_client.CanvasesCreateCanvasChangeRequestBody{}
+	body.SetVersionId(draftVersionID)
+	body.SetKeepOpen(true)
+	if title := strings.TrimSpace(*c.title); title != "" {
+		body.SetTitle(title)
+	}
+	if description := strings.TrimSpace(*c.description); description != "" {
+		body.SetDescription(description)
+	}
+
+	response, _, err := ctx.API.CanvasChangeRequestAPI.
+		CanvasesCreateCanvasChangeRequest(ctx.Context, canvasID).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	changeRequest := response.GetChangeRequest()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(changeRequest)
+	}
+
+	metadata := changeRequest.GetMetadata()
+	diff := changeRequest.GetDiff()
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Change request created: %s\n", metadata.GetId())
+		_, _ = fmt.Fprintf(stdout, "Title: %s\n", metadata.GetTitle())
+		_, err := fmt.Fprintf(stdout, "Changed nodes: %d\n", len(diff.GetChangedNodeIds()))
+		return err
+	})
+}
+
+type listChangeRequestsCommand struct {
+	canvas *string
+	status *string
+	mine   *bool
+	limit  *int64
+}
+
+func (c *listChangeRequestsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := resolveChangeRequestCanvas(ctx, *c.canvas)
+	if err != nil {
+		return err
+	}
+
+	request := ctx.API.CanvasChangeRequestAPI.
+		CanvasesListCanvasChangeRequests(ctx.Context, canvasID).
+		StatusFilter(*c.status).
+		OnlyMine(*c.mine)
+	if *c.limit > 0 {
+		request = request.Limit(*c.limit)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	changeRequests := response.GetChangeRequests()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(changeRequests)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "ID\tTITLE\tSTATUS\tOWNER\tCHANGED_NODES\tCREATED_AT")
+
+		for _, changeRequest := range changeRequests {
+			metadata := changeRequest.GetMetadata()
+			owner := metadata.GetOwner()
+			diff := changeRequest.GetDiff()
+
+			createdAt := ""
+			if metadata.HasCreatedAt() {
+				createdAt = metadata.GetCreatedAt().Format(time.RFC3339)
+			}
+
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\t%d\t%s\n",
+				metadata.GetId(),
+				metadata.GetTitle(),
+				changeRequestStatus(metadata.GetStatus()),
+				owner.GetName(),
+				len(diff.GetChangedNodeIds()),
+				createdAt,
+			)
+		}
+
+		return writer.Flush()
+	})
+}
+
+type diffChangeRequestCommand struct {
+	canvas *string
+}
+
+/*
+ * Open change requests are compared with the current live version.
+ * Published ones no longer have anything to compare with,
+ * so only the nodes they changed are listed.
+ */
+func (c *diffChangeRequestCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := resolveChangeRequestCanvas(ctx, *c.canvas)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasChangeRequestAPI.
+		CanvasesDescribeCanvasChangeRequest(ctx.Context, canvasID, ctx.Args[0]).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	changeRequest := response.GetChangeRequest()
+	metadata := changeRequest.GetMetadata()
+	if metadata.GetStatus() == openapi_client.CANVASESCANVASCHANGEREQUESTSTATUS_STATUS_PUBLISHED {
+		diff := changeRequest.GetDiff()
+		if !ctx.Renderer.IsText() {
+			return ctx.Renderer.Render(diff)
+		}
+
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, _ = fmt.Fprintf(stdout, "Change request %s was already published\n", metadata.GetId())
+			_, _ = fmt.Fprintln(stdout, "Changed nodes:")
+			for _, nodeID := range diff.GetChangedNodeIds() {
+				_, _ = fmt.Fprintf(stdout, "- %s\n", nodeID)
+			}
+			return nil
+		})
+	}
+
+	body := openapi_client.CanvasesDiffCanvasBody{}
+	body.SetCanvas(canvasFromVersion(changeRequest.GetVersion()))
+	diffResponse, _, err := ctx.API.CanvasAPI.CanvasesDiffCanvas(ctx.Context, canvasID).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	diff := diffResponse.GetDiff()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(diff)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Change request: %s\n", metadata.GetId())
+		_, _ = fmt.Fprintf(stdout, "Title: %s\n", metadata.GetTitle())
+		if description := strings.TrimSpace(metadata.GetDescription()); description != "" {
+			_, _ = fmt.Fprintf(stdout, "Description: %s\n", description)
+		}
+
+		details := CanvasDiffDetails(diff)
+		if len(details) == 0 {
+			_, err := fmt.Fprintln(stdout, "No differences with the live version")
+			return err
+		}
+
+		_, _ = fmt.Fprintln(stdout, "Changes:")
+		for _, detail := range details {
+			_, _ = fmt.Fprintf(stdout, "  %s\n", detail)
+		}
+
+		return nil
+	})
+}
+
+type publishChangeRequestCommand struct {
+	canvas *string
+}
+
+func (c *publishChangeRequestCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := resolveChangeRequestCanvas(ctx, *c.canvas)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasChangeRequestAPI.
+		CanvasesPublishCanvasChangeRequest(ctx.Context, canvasID, ctx.Args[0]).
+		Body(map[string]any{}).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	changeRequest := response.GetChangeRequest()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(changeRequest)
+	}
+
+	metadata := changeRequest.GetMetadata()
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Change request published: %s\n", metadata.GetId())
+		_, err := fmt.Fprintf(stdout, "Version: %s\n", metadata.GetVersionId())
+		return err
+	})
+}
+
+func resolveChangeRequestCanvas(ctx core.CommandContext, canvas string) (string, error) {
+	target := strings.TrimSpace(canvas)
+	if target == "" && ctx.Config != nil {
+		target = strings.TrimSpace(ctx.Config.GetActiveCanvas())
+	}
+	if target == "" {
+		return "", fmt.Errorf("--canvas is required (or set an active canvas)")
+	}
+
+	return findCanvasID(ctx, ctx.API, target)
+}
+
+func changeRequestStatus(status openapi_client.CanvasesCanvasChangeRequestStatus) string {
+	return strings.ToLower(strings.TrimPrefix(string(status), "STATUS_"))
+}
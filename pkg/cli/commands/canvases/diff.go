@@ -0,0 +1,39 @@
+package canvases
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func CanvasDiffDetails(diff openapi_client.CanvasesCanvasDiff) []string {
+	details := []string{}
+	for _, node := range diff.GetNodes() {
+		label := node.GetNodeId()
+		if node.GetNodeName() != "" && node.GetNodeName() != node.GetNodeId() {
+			label = fmt.Sprintf("%s (%s)", node.GetNodeId(), node.GetNodeName())
+		}
+
+		switch node.GetType() {
+		case openapi_client.CANVASDIFFCHANGETYPE_CHANGE_TYPE_ADDED:
+			details = append(details, fmt.Sprintf("+ node %s", label))
+		case openapi_client.CANVASDIFFCHANGETYPE_CHANGE_TYPE_REMOVED:
+			details = append(details, fmt.Sprintf("- node %s", label))
+		default:
+			details = append(details, fmt.Sprintf("~ node %s: %s", label, strings.Join(node.GetFields(), ", ")))
+		}
+	}
+
+	for _, edgeChange := range diff.GetEdges() {
+		edge := edgeChange.GetEdge()
+		description := fmt.Sprintf("edge %s -> %s (%s)", edge.GetSourceId(), edge.GetTargetId(), edge.GetChannel())
+		if edgeChange.GetType() == openapi_client.CANVASDIFFCHANGETYPE_CHANGE_TYPE_REMOVED {
+			details = append(details, "- "+description)
+		} else {
+			details = append(details, "+ "+description)
+		}
+	}
+
+	return details
+}
@@ -0,0 +1,43 @@
+package canvases
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func TestCanvasDiffDetails(t *testing.T) {
+	changed := openapi_client.CanvasDiffNodeChange{}
+	changed.SetNodeId("deploy")
+	changed.SetNodeName("Deploy")
+	changed.SetType(openapi_client.CANVASDIFFCHANGETYPE_CHANGE_TYPE_CHANGED)
+	changed.SetFields([]string{"name", "configuration.url"})
+
+	added := openapi_client.CanvasDiffNodeChange{}
+	added.SetNodeId("notify")
+	added.SetNodeName("notify")
+	added.SetType(openapi_client.CANVASDIFFCHANGETYPE_CHANGE_TYPE_ADDED)
+
+	edge := openapi_client.ComponentsEdge{}
+	edge.SetSourceId("deploy")
+	edge.SetTargetId("notify")
+	edge.SetChannel("default")
+	edgeChange := openapi_client.CanvasDiffEdgeChange{}
+	edgeChange.SetEdge(edge)
+	edgeChange.SetType(openapi_client.CANVASDIFFCHANGETYPE_CHANGE_TYPE_ADDED)
+
+	diff := openapi_client.CanvasesCanvasDiff{}
+	diff.SetNodes([]openapi_client.CanvasDiffNodeChange{changed, added})
+	diff.SetEdges([]openapi_client.CanvasDiffEdgeChange{edgeChange})
+
+	expected := []string{
+		"~ node deploy (Deploy): name, configuration.url",
+		"+ node notify",
+		"+ edge deploy -> notify (default)",
+	}
+
+	if details := CanvasDiffDetails(diff); !reflect.DeepEqual(details, expected) {
+		t.Fatalf("expected %v, got %v", expected, details)
+	}
+}
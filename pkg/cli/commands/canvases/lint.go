@@ -0,0 +1,583 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+	"github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	lintSeverityError   = "error"
+	lintSeverityWarning = "warning"
+)
+
+var lintExpressionRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
+
+type lintCommand struct {
+	offline *bool
+	refresh *bool
+}
+
+type lintResult struct {
+	File     string      `json:"file"`
+	Valid    bool        `json:"valid"`
+	Errors   int         `json:"errors"`
+	Warnings int         `json:"warnings"`
+	Issues   []lintIssue `json:"issues"`
+}
+
+type lintIssue struct {
+	Severity string `json:"severity"`
+	NodeID   string `json:"nodeId,omitempty"`
+	Message  string `json:"message"`
+}
+
+func (c *lintCommand) Execute(ctx core.CommandContext) error {
+	offline := c.offline != nil && *c.offline
+	refresh := c.refresh != nil && *c.refresh
+	if offline && refresh {
+		return fmt.Errorf("--offline and --refresh cannot be used together")
+	}
+
+	index, err := loadLintIndex(ctx, offline, refresh)
+	if err != nil {
+		return err
+	}
+
+	results := make([]lintResult, 0, len(ctx.Args))
+	errors := 0
+	for _, filePath := range ctx.Args {
+		result := lintFile(filePath, index)
+		errors += result.Errors
+		results = append(results, result)
+	}
+
+	if ctx.Renderer.IsText() {
+		err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			return renderLintResultsText(stdout, results)
+		})
+	} else {
+		err = ctx.Renderer.Render(results)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if errors > 0 {
+		return fmt.Errorf("found %d error(s)", errors)
+	}
+
+	return nil
+}
+
+func renderLintResultsText(stdout io.Writer, results []lintResult) error {
+	for _, result := range results {
+		if len(result.Issues) == 0 {
+			_, err := fmt.Fprintf(stdout, "%s: ok\n", result.File)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, issue := range result.Issues {
+			location := result.File
+			if issue.NodeID != "" {
+				location = fmt.Sprintf("%s: node %s", result.File, issue.NodeID)
+			}
+
+			_, err := fmt.Fprintf(stdout, "%s: %s: %s\n", location, issue.Severity, issue.Message)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func lintFile(filePath string, index *lintIndex) lintResult {
+	result := lintResult{File: filePath, Valid: true, Issues: []lintIssue{}}
+
+	canvas, err := loadCanvasForLint(filePath)
+	if err != nil {
+		result.addIssue(lintIssue{Severity: lintSeverityError, Message: err.Error()})
+		return result
+	}
+
+	for _, issue := range lintCanvas(canvas, index) {
+		result.addIssue(issue)
+	}
+
+	return result
+}
+
+func (r *lintResult) addIssue(issue lintIssue) {
+	r.Issues = append(r.Issues, issue)
+	if issue.Severity == lintSeverityError {
+		r.Errors++
+	} else {
+		r.Warnings++
+	}
+
+	r.Valid = r.Errors == 0
+}
+
+func loadCanvasForLint(filePath string) (openapi_client.CanvasesCanvas, error) {
+	// #nosec
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return openapi_client.CanvasesCanvas{}, fmt.Errorf("failed to read resource file: %w", err)
+	}
+
+	_, kind, err := core.ParseYamlResourceHeaders(data)
+	if err != nil {
+		return openapi_client.CanvasesCanvas{}, err
+	}
+
+	if kind != models.CanvasKind {
+		return openapi_client.CanvasesCanvas{}, fmt.Errorf("unsupported resource kind %q for lint", kind)
+	}
+
+	resource, err := models.ParseCanvas(data)
+	if err != nil {
+		return openapi_client.CanvasesCanvas{}, err
+	}
+
+	if resource.Spec == nil {
+		resource.Spec = models.EmptyCanvasSpec()
+	}
+
+	return models.CanvasFromCanvas(*resource), nil
+}
+
+/*
+ * lintCanvas runs the checks the API runs when a canvas is saved,
+ * and a few more that the API only finds out about at runtime:
+ * edge channels, node references in expressions and hidden fields.
+ */
+func lintCanvas(canvas openapi_client.CanvasesCanvas, index *lintIndex) []lintIssue {
+	linter := &canvasLinter{
+		index:  index,
+		nodes:  canvas.Spec.GetNodes(),
+		edges:  canvas.Spec.GetEdges(),
+		byID:   map[string]openapi_client.ComponentsNode{},
+		issues: []lintIssue{},
+	}
+
+	linter.checkNodes()
+	linter.checkEdges()
+	linter.checkCycles()
+	linter.checkExpressions()
+
+	return linter.issues
+}
+
+type canvasLinter struct {
+	index  *lintIndex
+	nodes  []openapi_client.ComponentsNode
+	edges  []openapi_client.ComponentsEdge
+	byID   map[string]openapi_client.ComponentsNode
+	issues []lintIssue
+}
+
+func (l *canvasLinter) errorf(nodeID string, format string, args ...any) {
+	l.issues = append(l.issues, lintIssue{Severity: lintSeverityError, NodeID: nodeID, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *canvasLinter) warnf(nodeID string, format string, args ...any) {
+	l.issues = append(l.issues, lintIssue{Severity: lintSeverityWarning, NodeID: nodeID, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *canvasLinter) checkNodes() {
+	for i, node := range l.nodes {
+		nodeID := node.GetId()
+		if nodeID == "" {
+			l.errorf("", "node %d: id is required", i)
+			continue
+		}
+
+		if _, exists := l.byID[nodeID]; exists {
+			l.errorf(nodeID, "duplicate node id")
+			continue
+		}
+
+		l.byID[nodeID] = node
+
+		if node.GetName() == "" {
+			l.errorf(nodeID, "name is required")
+		}
+
+		l.checkNodeConfiguration(node)
+	}
+}
+
+func (l *canvasLinter) checkNodeConfiguration(node openapi_client.ComponentsNode) {
+	resource, ok := l.findResource(node)
+	if !ok {
+		return
+	}
+
+	fields := configurationFieldsFromAPI(resource.Configuration)
+	config := node.GetConfiguration()
+	if config == nil {
+		config = map[string]any{}
+	}
+
+	for _, field := range fields {
+		if _, set := config[field.Name]; set && !isFieldVisible(field, config) {
+			l.warnf(node.GetId(), "field '%s' is set, but hidden by its visibility conditions", field.Name)
+		}
+	}
+
+	err := configuration.ValidateConfiguration(fields, config)
+	if err != nil {
+		l.errorf(node.GetId(), "%s", err.Error())
+	}
+}
+
+/*
+ * Blueprints are organization resources, not part of the index,
+ * so their configuration is only validated by the API.
+ */
+func (l *canvasLinter) findResource(node openapi_client.ComponentsNode) (lintIndexResource, bool) {
+	switch node.GetType() {
+	case openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT:
+		return l.lookup(node.GetId(), "component", node.Component.GetName(), l.index.Components)
+	case openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER:
+		return l.lookup(node.GetId(), "trigger", node.Trigger.GetName(), l.index.Triggers)
+	case openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET:
+		return l.lookup(node.GetId(), "widget", node.Widget.GetName(), l.index.Widgets)
+	case openapi_client.COMPONENTSNODETYPE_TYPE_BLUEPRINT:
+		if node.Blueprint.GetId() == "" {
+			l.errorf(node.GetId(), "blueprint ID is required")
+		}
+		return lintIndexResource{}, false
+	default:
+		l.errorf(node.GetId(), "invalid node type: %s", node.GetType())
+		return lintIndexResource{}, false
+	}
+}
+
+func (l *canvasLinter) lookup(nodeID, kind, name string, resources map[string]lintIndexResource) (lintIndexResource, bool) {
+	if name == "" {
+		l.errorf(nodeID, "%s name is required", kind)
+		return lintIndexResource{}, false
+	}
+
+	resource, ok := resources[name]
+	if !ok {
+		l.errorf(nodeID, "%s %q not found", kind, name)
+		return lintIndexResource{}, false
+	}
+
+	return resource, true
+}
+
+func (l *canvasLinter) checkEdges() {
+	for i, edge := range l.edges {
+		sourceID := edge.GetSourceId()
+		targetID := edge.GetTargetId()
+		if sourceID == "" || targetID == "" {
+			l.errorf("", "edge %d: source and target are required", i)
+			continue
+		}
+
+		source, sourceExists := l.byID[sourceID]
+		if !sourceExists {
+			l.errorf("", "edge %d: source node %s not found", i, sourceID)
+		}
+
+		target, targetExists := l.byID[targetID]
+		if !targetExists {
+			l.errorf("", "edge %d: target node %s not found", i, targetID)
+		}
+
+		if !sourceExists || !targetExists {
+			continue
+		}
+
+		if source.GetType() == openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET {
+			l.errorf(sourceID, "edge %d: widget nodes cannot be used as source nodes", i)
+			continue
+		}
+
+		if target.GetType() == openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET {
+			l.errorf(targetID, "edge %d: widget nodes cannot be used as target nodes", i)
+			continue
+		}
+
+		l.checkEdgeChannel(i, source, edge.GetChannel())
+	}
+}
+
+func (l *canvasLinter) checkEdgeChannel(i int, source openapi_client.ComponentsNode, channel string) {
+	if channel == "" {
+		l.errorf(source.GetId(), "edge %d: channel is required", i)
+		return
+	}
+
+	var channels []string
+	switch source.GetType() {
+	case openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT:
+		resource, ok := l.index.Components[source.Component.GetName()]
+		if !ok {
+			return
+		}
+		channels = resource.OutputChannels
+	case openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER:
+		channels = []string{defaultOutputChannel}
+	default:
+		return
+	}
+
+	if !slices.Contains(channels, channel) {
+		l.errorf(source.GetId(), "edge %d: channel %q is not an output channel; expected one of: %s", i, channel, strings.Join(channels, ", "))
+	}
+}
+
+func (l *canvasLinter) checkCycles() {
+	graph := map[string][]string{}
+	for _, edge := range l.edges {
+		graph[edge.GetSourceId()] = append(graph[edge.GetSourceId()], edge.GetTargetId())
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+	path := []string{}
+
+	var visit func(nodeID string) []string
+	visit = func(nodeID string) []string {
+		state[nodeID] = visiting
+		path = append(path, nodeID)
+
+		for _, next := range graph[nodeID] {
+			switch state[next] {
+			case visiting:
+				start := slices.Index(path, next)
+				return append(slices.Clone(path[start:]), next)
+			case unvisited:
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[nodeID] = visited
+		return nil
+	}
+
+	ids := make([]string, 0, len(graph))
+	for nodeID := range graph {
+		ids = append(ids, nodeID)
+	}
+	sort.Strings(ids)
+
+	for _, nodeID := range ids {
+		if state[nodeID] != unvisited {
+			continue
+		}
+
+		if cycle := visit(nodeID); cycle != nil {
+			l.errorf("", "graph contains a cycle: %s", strings.Join(cycle, " -> "))
+			return
+		}
+	}
+}
+
+func (l *canvasLinter) checkExpressions() {
+	names := map[string][]string{}
+	for _, node := range l.nodes {
+		if node.GetName() != "" {
+			names[node.GetName()] = append(names[node.GetName()], node.GetId())
+		}
+	}
+
+	for _, node := range l.nodes {
+		refs := []string{}
+		for _, expression := range collectExpressions(node.GetConfiguration()) {
+			nodeRefs, err := parseExpressionNodeRefs(expression)
+			if err != nil {
+				l.warnf(node.GetId(), "invalid expression {{%s}}: %v", expression, err)
+				continue
+			}
+
+			refs = append(refs, nodeRefs...)
+		}
+
+		if len(refs) == 0 {
+			continue
+		}
+
+		upstream := l.upstreamNodes(node.GetId())
+		for _, ref := range uniqueStrings(refs) {
+			nodeIDs, ok := names[ref]
+			if !ok {
+				if _, exists := l.byID[ref]; !exists {
+					l.errorf(node.GetId(), "expression references node %q, which does not exist", ref)
+					continue
+				}
+
+				nodeIDs = []string{ref}
+			}
+
+			if !slices.ContainsFunc(nodeIDs, func(id string) bool { return upstream[id] }) {
+				l.warnf(node.GetId(), "expression references node %q, which is not upstream of this node", ref)
+			}
+		}
+	}
+}
+
+func (l *canvasLinter) upstreamNodes(nodeID string) map[string]bool {
+	parents := map[string][]string{}
+	for _, edge := range l.edges {
+		parents[edge.GetTargetId()] = append(parents[edge.GetTargetId()], edge.GetSourceId())
+	}
+
+	upstream := map[string]bool{}
+	queue := []string{nodeID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, parent := range parents[current] {
+			if upstream[parent] {
+				continue
+			}
+
+			upstream[parent] = true
+			queue = append(queue, parent)
+		}
+	}
+
+	return upstream
+}
+
+func collectExpressions(value any) []string {
+	switch v := value.(type) {
+	case string:
+		expressions := []string{}
+		for _, match := range lintExpressionRegex.FindAllStringSubmatch(v, -1) {
+			expressions = append(expressions, match[1])
+		}
+		return expressions
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		expressions := []string{}
+		for _, key := range keys {
+			expressions = append(expressions, collectExpressions(v[key])...)
+		}
+		return expressions
+	case []any:
+		expressions := []string{}
+		for _, item := range v {
+			expressions = append(expressions, collectExpressions(item)...)
+		}
+		return expressions
+	}
+
+	return nil
+}
+
+/*
+ * Node references are the $['Node Name'] and $.NodeName
+ * accesses, the same ones resolved when the node executes.
+ */
+func parseExpressionNodeRefs(expression string) ([]string, error) {
+	tree, err := parser.Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &nodeRefCollector{}
+	ast.Walk(&tree.Node, collector)
+	return collector.refs, nil
+}
+
+type nodeRefCollector struct {
+	refs []string
+}
+
+func (c *nodeRefCollector) Visit(node *ast.Node) {
+	member, ok := (*node).(*ast.MemberNode)
+	if !ok {
+		return
+	}
+
+	root, ok := member.Node.(*ast.IdentifierNode)
+	if !ok || root.Value != "$" {
+		return
+	}
+
+	switch property := member.Property.(type) {
+	case *ast.StringNode:
+		c.refs = append(c.refs, property.Value)
+	case *ast.IdentifierNode:
+		c.refs = append(c.refs, property.Value)
+	}
+}
+
+func isFieldVisible(field configuration.Field, config map[string]any) bool {
+	for _, condition := range field.VisibilityConditions {
+		if condition.Field == "" || len(condition.Values) == 0 {
+			continue
+		}
+
+		value := ""
+		if v, ok := config[condition.Field]; ok && v != nil {
+			value = fmt.Sprintf("%v", v)
+		}
+
+		matches := slices.ContainsFunc(condition.Values, func(expected string) bool {
+			if expected == "*" {
+				return value != ""
+			}
+
+			return value == expected
+		})
+
+		if !matches {
+			return false
+		}
+	}
+
+	return true
+}
+
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	result := []string{}
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+
+		seen[value] = true
+		result = append(result, value)
+	}
+
+	return result
+}
@@ -0,0 +1,392 @@
+package canvases
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	lintIndexCacheTTL     = 24 * time.Hour
+	lintIndexCacheDirName = "superplane"
+	defaultOutputChannel  = "default"
+)
+
+/*
+ * lintIndex holds the component, trigger and widget schemas
+ * used to validate canvases locally. It is fetched from the
+ * same endpoints used by "superplane index", and cached on disk.
+ */
+type lintIndex struct {
+	URL        string                       `json:"url"`
+	FetchedAt  time.Time                    `json:"fetchedAt"`
+	Components map[string]lintIndexResource `json:"components"`
+	Triggers   map[string]lintIndexResource `json:"triggers"`
+	Widgets    map[string]lintIndexResource `json:"widgets"`
+}
+
+type lintIndexResource struct {
+	Configuration  []openapi_client.ConfigurationField `json:"configuration,omitempty"`
+	OutputChannels []string                            `json:"outputChannels,omitempty"`
+}
+
+func newLintIndex(url string) *lintIndex {
+	return &lintIndex{
+		URL:        url,
+		FetchedAt:  time.Now(),
+		Components: map[string]lintIndexResource{},
+		Triggers:   map[string]lintIndexResource{},
+		Widgets:    map[string]lintIndexResource{},
+	}
+}
+
+func (i *lintIndex) addComponent(name string, component openapi_client.ComponentsComponent) {
+	channels := []string{}
+	for _, channel := range component.GetOutputChannels() {
+		channels = append(channels, channel.GetName())
+	}
+
+	i.Components[name] = lintIndexResource{
+		Configuration:  component.GetConfiguration(),
+		OutputChannels: channels,
+	}
+}
+
+func (i *lintIndex) addTrigger(name string, trigger openapi_client.TriggersTrigger) {
+	i.Triggers[name] = lintIndexResource{
+		Configuration:  trigger.GetConfiguration(),
+		OutputChannels: []string{defaultOutputChannel},
+	}
+}
+
+func (i *lintIndex) isStale() bool {
+	return time.Since(i.FetchedAt) > lintIndexCacheTTL
+}
+
+/*
+ * Loads the index for the current context, using the cached copy when it is recent enough.
+ * With offline, only the cached copy is used, no matter how old it is.
+ */
+func loadLintIndex(ctx core.CommandContext, offline bool, refresh bool) (*lintIndex, error) {
+	url := apiServerURL(ctx)
+	cachePath, cachePathErr := lintIndexCachePath(url)
+
+	var cached *lintIndex
+	if cachePathErr == nil && !refresh {
+		cached, _ = readLintIndexCache(cachePath)
+	}
+
+	if offline {
+		if cached == nil {
+			return nil, fmt.Errorf("no cached index found for %s; run without --offline first", url)
+		}
+
+		return cached, nil
+	}
+
+	if cached != nil && !cached.isStale() {
+		return cached, nil
+	}
+
+	index, err := fetchLintIndex(ctx, url)
+	if err != nil {
+		if cached != nil {
+			ctx.Logger.Warnf("Failed to refresh index, using cached copy from %s: %v", cached.FetchedAt.Format(time.RFC3339), err)
+			return cached, nil
+		}
+
+		return nil, fmt.Errorf("failed to fetch index: %w", err)
+	}
+
+	if cachePathErr == nil {
+		err = writeLintIndexCache(cachePath, index)
+		if err != nil {
+			ctx.Logger.Warnf("Failed to cache index: %v", err)
+		}
+	}
+
+	return index, nil
+}
+
+func fetchLintIndex(ctx core.CommandContext, url string) (*lintIndex, error) {
+	if ctx.API == nil {
+		return nil, fmt.Errorf("api client is not configured")
+	}
+
+	index := newLintIndex(url)
+
+	components, _, err := ctx.API.ComponentAPI.ComponentsListComponents(ctx.Context).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, component := range components.GetComponents() {
+		index.addComponent(component.GetName(), component)
+	}
+
+	triggers, _, err := ctx.API.TriggerAPI.TriggersListTriggers(ctx.Context).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, trigger := range triggers.GetTriggers() {
+		index.addTrigger(trigger.GetName(), trigger)
+	}
+
+	widgets, _, err := ctx.API.WidgetAPI.WidgetsListWidgets(ctx.Context).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, widget := range widgets.GetWidgets() {
+		index.Widgets[widget.GetName()] = lintIndexResource{Configuration: widget.GetConfiguration()}
+	}
+
+	integrations, _, err := ctx.API.IntegrationAPI.IntegrationsListIntegrations(ctx.Context).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, integration := range integrations.GetIntegrations() {
+		for _, component := range integration.GetComponents() {
+			index.addComponent(integrationScopedName(integration.GetName(), component.GetName()), component)
+		}
+
+		for _, trigger := range integration.GetTriggers() {
+			index.addTrigger(integrationScopedName(integration.GetName(), trigger.GetName()), trigger)
+		}
+	}
+
+	return index, nil
+}
+
+func integrationScopedName(integration string, name string) string {
+	if strings.HasPrefix(name, integration+".") {
+		return name
+	}
+
+	return fmt.Sprintf("%s.%s", integration, name)
+}
+
+func apiServerURL(ctx core.CommandContext) string {
+	if ctx.API == nil {
+		return ""
+	}
+
+	servers := ctx.API.GetConfig().Servers
+	if len(servers) == 0 {
+		return ""
+	}
+
+	return servers[0].URL
+}
+
+func lintIndexCachePath(url string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(url))
+	name := fmt.Sprintf("index-%s.json", hex.EncodeToString(sum[:])[:16])
+	return filepath.Join(dir, lintIndexCacheDirName, name), nil
+}
+
+func readLintIndexCache(path string) (*lintIndex, error) {
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	index := lintIndex{}
+	err = json.Unmarshal(data, &index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cached index: %w", err)
+	}
+
+	return &index, nil
+}
+
+func writeLintIndexCache(path string, index *lintIndex) error {
+	data, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+/*
+ * The index returns the API representation of the configuration fields.
+ * Converting them back lets us run the same validation the API runs.
+ */
+func configurationFieldsFromAPI(fields []openapi_client.ConfigurationField) []configuration.Field {
+	result := make([]configuration.Field, 0, len(fields))
+	for _, field := range fields {
+		result = append(result, configurationFieldFromAPI(field))
+	}
+
+	return result
+}
+
+func configurationFieldFromAPI(field openapi_client.ConfigurationField) configuration.Field {
+	result := configuration.Field{
+		Name:               field.GetName(),
+		Label:              field.GetLabel(),
+		Type:               field.GetType(),
+		Description:        field.GetDescription(),
+		Required:           field.GetRequired(),
+		Togglable:          field.GetTogglable(),
+		Sensitive:          field.GetSensitive(),
+		DisallowExpression: field.GetDisallowExpression(),
+		TypeOptions:        typeOptionsFromAPI(field.TypeOptions),
+	}
+
+	for _, condition := range field.GetVisibilityConditions() {
+		result.VisibilityConditions = append(result.VisibilityConditions, configuration.VisibilityCondition{
+			Field:  condition.GetField(),
+			Values: condition.GetValues(),
+		})
+	}
+
+	for _, condition := range field.GetRequiredConditions() {
+		result.RequiredConditions = append(result.RequiredConditions, configuration.RequiredCondition{
+			Field:  condition.GetField(),
+			Values: condition.GetValues(),
+		})
+	}
+
+	for _, rule := range field.GetValidationRules() {
+		result.ValidationRules = append(result.ValidationRules, configuration.ValidationRule{
+			Type:        rule.GetType(),
+			CompareWith: rule.GetCompareWith(),
+			Message:     rule.GetMessage(),
+		})
+	}
+
+	return result
+}
+
+func typeOptionsFromAPI(options *openapi_client.ConfigurationTypeOptions) *configuration.TypeOptions {
+	if options == nil {
+		return nil
+	}
+
+	result := &configuration.TypeOptions{}
+
+	if options.Number != nil {
+		result.Number = &configuration.NumberTypeOptions{
+			Min: intFromAPI(options.Number.Min),
+			Max: intFromAPI(options.Number.Max),
+		}
+	}
+
+	if options.String != nil {
+		result.String = &configuration.StringTypeOptions{
+			MinLength: intFromAPI(options.String.MinLength),
+			MaxLength: intFromAPI(options.String.MaxLength),
+		}
+	}
+
+	if options.Expression != nil {
+		result.Expression = &configuration.ExpressionTypeOptions{
+			MinLength: intFromAPI(options.Expression.MinLength),
+			MaxLength: intFromAPI(options.Expression.MaxLength),
+		}
+	}
+
+	if options.Text != nil {
+		result.Text = &configuration.TextTypeOptions{
+			MinLength: intFromAPI(options.Text.MinLength),
+			MaxLength: intFromAPI(options.Text.MaxLength),
+		}
+	}
+
+	if options.Select != nil {
+		result.Select = &configuration.SelectTypeOptions{Options: selectOptionsFromAPI(options.Select.GetOptions())}
+	}
+
+	if options.MultiSelect != nil {
+		result.MultiSelect = &configuration.MultiSelectTypeOptions{Options: selectOptionsFromAPI(options.MultiSelect.GetOptions())}
+	}
+
+	if options.Resource != nil {
+		result.Resource = &configuration.ResourceTypeOptions{
+			Type:           options.Resource.GetType(),
+			UseNameAsValue: options.Resource.GetUseNameAsValue(),
+			Multi:          options.Resource.GetMulti(),
+		}
+	}
+
+	if options.List != nil {
+		result.List = &configuration.ListTypeOptions{
+			ItemLabel: options.List.GetItemLabel(),
+			MaxItems:  intFromAPI(options.List.MaxItems),
+		}
+
+		if options.List.ItemDefinition != nil {
+			result.List.ItemDefinition = &configuration.ListItemDefinition{
+				Type:   options.List.ItemDefinition.GetType(),
+				Schema: configurationFieldsFromAPI(options.List.ItemDefinition.GetSchema()),
+			}
+		}
+	}
+
+	if options.AnyPredicateList != nil {
+		result.AnyPredicateList = &configuration.AnyPredicateListTypeOptions{Operators: selectOptionsFromAPI(options.AnyPredicateList.GetOperators())}
+	}
+
+	if options.Object != nil {
+		result.Object = &configuration.ObjectTypeOptions{Schema: configurationFieldsFromAPI(options.Object.GetSchema())}
+	}
+
+	if options.Time != nil {
+		result.Time = &configuration.TimeTypeOptions{Format: options.Time.GetFormat()}
+	}
+
+	if options.Date != nil {
+		result.Date = &configuration.DateTypeOptions{Format: options.Date.GetFormat()}
+	}
+
+	if options.Datetime != nil {
+		result.DateTime = &configuration.DateTimeTypeOptions{Format: options.Datetime.GetFormat()}
+	}
+
+	return result
+}
+
+func selectOptionsFromAPI(options []openapi_client.ConfigurationSelectOption) []configuration.FieldOption {
+	result := make([]configuration.FieldOption, 0, len(options))
+	for _, option := range options {
+		result = append(result, configuration.FieldOption{
+			Label: option.GetLabel(),
+			Value: option.GetValue(),
+		})
+	}
+
+	return result
+}
+
+func intFromAPI(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+
+	v := int(*value)
+	return &v
+}
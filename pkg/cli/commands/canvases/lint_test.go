@@ -0,0 +1,288 @@
+package canvases
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func TestLintCanvasValidCanvas(t *testing.T) {
+	canvas := testCanvas(
+		[]openapi_client.ComponentsNode{
+			lintTestTrigger("trigger", "Manual Run", "start", nil),
+			lintTestComponent("approval", "Approval", "approval", map[string]any{"message": "Deploy {{ $['Manual Run'].data.version }}?"}),
+			lintTestComponent("deploy", "Deploy", "http", map[string]any{"url": "https://example.com", "method": "POST"}),
+		},
+		[]openapi_client.ComponentsEdge{
+			testEdge("trigger", "approval", "default"),
+			testEdge("approval", "deploy", "approved"),
+		},
+	)
+
+	issues := lintCanvas(canvas, lintTestIndex())
+	if len(issues) != 0 {
+		t.Fatalf("expected no issues, got %v", issues)
+	}
+}
+
+func TestLintCanvasConfiguration(t *testing.T) {
+	canvas := testCanvas(
+		[]openapi_client.ComponentsNode{
+			lintTestComponent("missing-url", "Missing URL", "http", map[string]any{"method": "POST"}),
+			lintTestComponent("bad-method", "Bad Method", "http", map[string]any{"url": "https://example.com", "method": "FETCH"}),
+			lintTestComponent("hidden-body", "Hidden Body", "http", map[string]any{"url": "https://example.com", "method": "GET", "body": "{}"}),
+			lintTestComponent("unknown", "Unknown", "doesNotExist", nil),
+		},
+		nil,
+	)
+
+	issues := lintCanvas(canvas, lintTestIndex())
+	assertLintIssues(t, issues, []lintIssue{
+		{Severity: lintSeverityError, NodeID: "missing-url", Message: "field 'url' is required"},
+		{Severity: lintSeverityError, NodeID: "bad-method", Message: "field 'method'"},
+		{Severity: lintSeverityWarning, NodeID: "hidden-body", Message: "field 'body' is set, but hidden"},
+		{Severity: lintSeverityError, NodeID: "unknown", Message: `component "doesNotExist" not found`},
+	})
+}
+
+func TestLintCanvasEdges(t *testing.T) {
+	canvas := testCanvas(
+		[]openapi_client.ComponentsNode{
+			lintTestTrigger("trigger", "Manual Run", "start", nil),
+			lintTestComponent("approval", "Approval", "approval", nil),
+			lintTestComponent("deploy", "Deploy", "http", map[string]any{"url": "https://example.com", "method": "GET"}),
+		},
+		[]openapi_client.ComponentsEdge{
+			testEdge("trigger", "approval", "default"),
+			testEdge("approval", "deploy", "passed"),
+			testEdge("trigger", "missing", "default"),
+			testEdge("trigger", "deploy", ""),
+		},
+	)
+
+	issues := lintCanvas(canvas, lintTestIndex())
+	assertLintIssues(t, issues, []lintIssue{
+		{Severity: lintSeverityError, NodeID: "approval", Message: `edge 1: channel "passed" is not an output channel; expected one of: approved, rejected`},
+		{Severity: lintSeverityError, Message: "edge 2: target node missing not found"},
+		{Severity: lintSeverityError, NodeID: "trigger", Message: "edge 3: channel is required"},
+	})
+}
+
+func TestLintCanvasCycles(t *testing.T) {
+	canvas := testCanvas(
+		[]openapi_client.ComponentsNode{
+			lintTestTrigger("trigger", "Manual Run", "start", nil),
+			lintTestComponent("a", "A", "noop", nil),
+			lintTestComponent("b", "B", "noop", nil),
+		},
+		[]openapi_client.ComponentsEdge{
+			testEdge("trigger", "a", "default"),
+			testEdge("a", "b", "default"),
+			testEdge("b", "a", "default"),
+		},
+	)
+
+	issues := lintCanvas(canvas, lintTestIndex())
+	assertLintIssues(t, issues, []lintIssue{
+		{Severity: lintSeverityError, Message: "graph contains a cycle: a -> b -> a"},
+	})
+}
+
+func TestLintCanvasExpressionReferences(t *testing.T) {
+	canvas := testCanvas(
+		[]openapi_client.ComponentsNode{
+			lintTestTrigger("trigger", "Manual Run", "start", nil),
+			lintTestTrigger("other", "Other Run", "start", nil),
+			lintTestComponent("first", "First", "noop", nil),
+			lintTestComponent("second", "Second", "approval", map[string]any{
+				"message": "{{ $['Manual Run'].data.version }} {{ $['Missing'].data }} {{ $['Other Run'].data }} {{ $.trigger.data }} {{ $['First' }}",
+			}),
+		},
+		[]openapi_client.ComponentsEdge{
+			testEdge("trigger", "first", "default"),
+			testEdge("first", "second", "default"),
+		},
+	)
+
+	issues := lintCanvas(canvas, lintTestIndex())
+	assertLintIssues(t, issues, []lintIssue{
+		{Severity: lintSeverityWarning, NodeID: "second", Message: "invalid expression"},
+		{Severity: lintSeverityError, NodeID: "second", Message: `node "Missing", which does not exist`},
+		{Severity: lintSeverityWarning, NodeID: "second", Message: `node "Other Run", which is not upstream`},
+	})
+}
+
+func TestLintFile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.yaml")
+	writeLintTestFile(t, valid, `apiVersion: v1
+kind: Canvas
+metadata:
+  name: release
+spec:
+  nodes:
+    - id: trigger
+      name: Manual Run
+      type: TYPE_TRIGGER
+      trigger:
+        name: start
+    - id: deploy
+      name: Deploy
+      type: TYPE_COMPONENT
+      component:
+        name: http
+      configuration:
+        url: https://example.com
+        method: GET
+  edges:
+    - sourceId: trigger
+      targetId: deploy
+      channel: default
+`)
+
+	result := lintFile(valid, lintTestIndex())
+	if !result.Valid || len(result.Issues) != 0 {
+		t.Fatalf("expected valid result, got %+v", result)
+	}
+
+	secret := filepath.Join(dir, "secret.yaml")
+	writeLintTestFile(t, secret, "apiVersion: v1\nkind: Secret\nmetadata:\n  name: token\n")
+
+	result = lintFile(secret, lintTestIndex())
+	if result.Valid || result.Errors != 1 {
+		t.Fatalf("expected one error for unsupported kind, got %+v", result)
+	}
+}
+
+func TestLintIndexCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "superplane", "index.json")
+	index := lintTestIndex()
+
+	if err := writeLintIndexCache(path, index); err != nil {
+		t.Fatalf("unexpected error writing cache: %v", err)
+	}
+
+	cached, err := readLintIndexCache(path)
+	if err != nil {
+		t.Fatalf("unexpected error reading cache: %v", err)
+	}
+
+	if cached.isStale() {
+		t.Fatalf("expected fresh index")
+	}
+
+	if !reflect.DeepEqual(cached.Components["approval"].OutputChannels, []string{"approved", "rejected"}) {
+		t.Fatalf("unexpected output channels: %v", cached.Components["approval"].OutputChannels)
+	}
+
+	fields := configurationFieldsFromAPI(cached.Components["http"].Configuration)
+	if len(fields) != 3 || fields[2].VisibilityConditions[0].Field != "method" {
+		t.Fatalf("unexpected configuration fields: %+v", fields)
+	}
+}
+
+func assertLintIssues(t *testing.T, issues []lintIssue, expected []lintIssue) {
+	t.Helper()
+
+	if len(issues) != len(expected) {
+		t.Fatalf("expected %d issues, got %d: %v", len(expected), len(issues), issues)
+	}
+
+	for i, issue := range issues {
+		if issue.Severity != expected[i].Severity || issue.NodeID != expected[i].NodeID || !strings.Contains(issue.Message, expected[i].Message) {
+			t.Fatalf("issue %d: expected %+v, got %+v", i, expected[i], issue)
+		}
+	}
+}
+
+func writeLintTestFile(t *testing.T, path string, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func lintTestIndex() *lintIndex {
+	index := newLintIndex("http://localhost:8000")
+
+	start := openapi_client.TriggersTrigger{}
+	start.SetName("start")
+	index.addTrigger("start", start)
+
+	noop := openapi_client.ComponentsComponent{}
+	noop.SetName("noop")
+	noop.SetOutputChannels([]openapi_client.SuperplaneComponentsOutputChannel{lintTestChannel("default")})
+	index.addComponent("noop", noop)
+
+	approval := openapi_client.ComponentsComponent{}
+	approval.SetName("approval")
+	approval.SetOutputChannels([]openapi_client.SuperplaneComponentsOutputChannel{lintTestChannel("approved"), lintTestChannel("rejected")})
+	approval.SetConfiguration([]openapi_client.ConfigurationField{lintTestField("message", "string", false)})
+	index.addComponent("approval", approval)
+
+	method := lintTestField("method", "select", true)
+	method.SetTypeOptions(openapi_client.ConfigurationTypeOptions{
+		Select: &openapi_client.ConfigurationSelectTypeOptions{
+			Options: []openapi_client.ConfigurationSelectOption{lintTestOption("GET"), lintTestOption("POST")},
+		},
+	})
+
+	body := lintTestField("body", "text", false)
+	bodyVisibility := openapi_client.ConfigurationVisibilityCondition{}
+	bodyVisibility.SetField("method")
+	bodyVisibility.SetValues([]string{"POST"})
+	body.SetVisibilityConditions([]openapi_client.ConfigurationVisibilityCondition{bodyVisibility})
+
+	http := openapi_client.ComponentsComponent{}
+	http.SetName("http")
+	http.SetOutputChannels([]openapi_client.SuperplaneComponentsOutputChannel{lintTestChannel("default")})
+	http.SetConfiguration([]openapi_client.ConfigurationField{lintTestField("url", "string", true), method, body})
+	index.addComponent("http", http)
+
+	return index
+}
+
+func lintTestTrigger(id, name, trigger string, configuration map[string]any) openapi_client.ComponentsNode {
+	node := testNode(id, openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER, configuration)
+	node.SetName(name)
+	ref := openapi_client.NodeTriggerRef{}
+	ref.SetName(trigger)
+	node.SetTrigger(ref)
+	return node
+}
+
+func lintTestComponent(id, name, component string, configuration map[string]any) openapi_client.ComponentsNode {
+	node := testNode(id, openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT, configuration)
+	node.SetName(name)
+	ref := openapi_client.NodeComponentRef{}
+	ref.SetName(component)
+	node.SetComponent(ref)
+	return node
+}
+
+func lintTestChannel(name string) openapi_client.SuperplaneComponentsOutputChannel {
+	channel := openapi_client.SuperplaneComponentsOutputChannel{}
+	channel.SetName(name)
+	return channel
+}
+
+func lintTestField(name, fieldType string, required bool) openapi_client.ConfigurationField {
+	field := openapi_client.ConfigurationField{}
+	field.SetName(name)
+	field.SetType(fieldType)
+	field.SetRequired(required)
+	return field
+}
+
+func lintTestOption(value string) openapi_client.ConfigurationSelectOption {
+	option := openapi_client.ConfigurationSelectOption{}
+	option.SetLabel(value)
+	option.SetValue(value)
+	return option
+}
@@ -80,12 +80,136 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		description: &publishDescription,
 	}, options)
 
+	var runNode string
+	var runParams []string
+	var runTemplate string
+	runCmd := &cobra.Command{
+		Use:   "run <name-or-id>",
+		Short: "Start a run from a manual trigger",
+		Long: "Starts a run from a manual trigger node. Input values are validated against the inputs declared on the trigger. " +
+			"With --template, the payload of one of the templates configured on the trigger is emitted instead.",
+		Args: cobra.ExactArgs(1),
+	}
+	runCmd.Flags().StringVar(&runNode, "node", "", "id or name of the trigger node to run")
+	runCmd.Flags().StringArrayVar(&runParams, "param", nil, "input value, in key=value format (repeatable)")
+	runCmd.Flags().StringVar(&runTemplate, "template", "", "name of a template configured on the trigger")
+	core.Bind(runCmd, &runCommand{node: &runNode, params: &runParams, template: &runTemplate}, options)
+
+	var lintOffline bool
+	var lintRefresh bool
+	lintCmd := &cobra.Command{
+		Use:   "lint <file>...",
+		Short: "Validate canvas files locally",
+		Long: "Validates canvas files against the component, trigger and widget schemas from the index, " +
+			"without saving them. The index is cached locally, so it can run offline. " +
+			"Exits with a non-zero status if any errors are found.",
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+	}
+	lintCmd.Flags().BoolVar(&lintOffline, "offline", false, "only use the cached index")
+	lintCmd.Flags().BoolVar(&lintRefresh, "refresh", false, "fetch the index again, even if the cached one is recent")
+	core.Bind(lintCmd, &lintCommand{offline: &lintOffline, refresh: &lintRefresh}, options)
+
+	var watchNodes []string
+	watchCmd := &cobra.Command{
+		Use:   "watch [name-or-id]",
+		Short: "Stream canvas activity as it happens",
+		Long: "Prints events, execution state transitions and queue changes of a canvas as they happen. " +
+			"With --output json, each message is printed as a single JSON line.",
+		Args:         cobra.MaximumNArgs(1),
+		SilenceUsage: true,
+	}
+	watchCmd.Flags().StringArrayVar(&watchNodes, "node", nil, "only show activity of this node id or name (repeatable)")
+	core.Bind(watchCmd, &watchCommand{nodes: &watchNodes}, options)
+
+	var testFixtures string
+	testCmd := &cobra.Command{
+		Use:   "test <file>",
+		Short: "Simulate a canvas run without calling integrations",
+		Long: "Feeds a trigger payload through a canvas file, evaluating if and filter expressions and configuration expressions, " +
+			"and prints the path that was executed. Integration and side-effect components return their example output, " +
+			"or the output given for them in the fixtures file. Nothing is saved.",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+	testCmd.Flags().StringVar(&testFixtures, "fixtures", "", "YAML file with the trigger payload and node outputs to use")
+	core.Bind(testCmd, &testCommand{fixtures: &testFixtures}, options)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(getCmd)
 	root.AddCommand(activeCmd)
 	root.AddCommand(createCmd)
 	root.AddCommand(updateCmd)
 	root.AddCommand(publishCmd)
+	root.AddCommand(runCmd)
+	root.AddCommand(lintCmd)
+	root.AddCommand(watchCmd)
+	root.AddCommand(testCmd)
+
+	return root
+}
+
+func NewChangeRequestsCommand(options core.BindOptions) *cobra.Command {
+	var canvas string
+
+	root := &cobra.Command{
+		Use:     "change-requests",
+		Short:   "Review and publish canvas change requests",
+		Aliases: []string{"change-request", "cr"},
+	}
+	root.PersistentFlags().StringVar(&canvas, "canvas", "", "canvas name or id (defaults to the active canvas)")
+
+	var createTitle string
+	var createDescription string
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Open a change request from your draft version",
+		Args:  cobra.NoArgs,
+	}
+	createCmd.Flags().StringVar(&createTitle, "title", "", "change request title")
+	createCmd.Flags().StringVar(&createDescription, "description", "", "change request description")
+	core.Bind(createCmd, &createChangeRequestCommand{
+		canvas:      &canvas,
+		title:       &createTitle,
+		description: &createDescription,
+	}, options)
+
+	var listStatus string
+	var listMine bool
+	var listLimit int64
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List change requests of a canvas",
+		Args:  cobra.NoArgs,
+	}
+	listCmd.Flags().StringVar(&listStatus, "status", "all", "only list change requests with this status: open, published or all")
+	listCmd.Flags().BoolVar(&listMine, "mine", false, "only list your change requests")
+	listCmd.Flags().Int64Var(&listLimit, "limit", 20, "maximum number of items to return")
+	core.Bind(listCmd, &listChangeRequestsCommand{
+		canvas: &canvas,
+		status: &listStatus,
+		mine:   &listMine,
+		limit:  &listLimit,
+	}, options)
+
+	diffCmd := &cobra.Command{
+		Use:   "diff <change-request-id>",
+		Short: "Show the changes of a change request against the live version",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(diffCmd, &diffChangeRequestCommand{canvas: &canvas}, options)
+
+	publishCmd := &cobra.Command{
+		Use:   "publish <change-request-id>",
+		Short: "Publish an open change request as the live version",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(publishCmd, &publishChangeRequestCommand{canvas: &canvas}, options)
+
+	root.AddCommand(createCmd)
+	root.AddCommand(listCmd)
+	root.AddCommand(diffCmd)
+	root.AddCommand(publishCmd)
 
 	return root
 }
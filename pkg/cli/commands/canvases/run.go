@@ -0,0 +1,195 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const runTriggerActionName = "run"
+
+type runCommand struct {
+	node     *string
+	params   *[]string
+	template *string
+}
+
+func (c *runCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := findCanvasID(ctx, ctx.API, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	nodeRef := ""
+	if c.node != nil {
+		nodeRef = strings.TrimSpace(*c.node)
+	}
+	if nodeRef == "" {
+		return fmt.Errorf("--node is required")
+	}
+
+	template := strings.TrimSpace(*c.template)
+	if template != "" && len(*c.params) > 0 {
+		return fmt.Errorf("--template and --param cannot be used together")
+	}
+
+	//
+	// Values are sent as strings, and converted to
+	// the declared input types by the trigger.
+	//
+	params, err := core.ParseKeyValueFlags(*c.params, nil)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesDescribeCanvas(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+
+	nodeID, err := findTriggerNodeID(*response.Canvas, nodeRef)
+	if err != nil {
+		return err
+	}
+
+	if template != "" {
+		return runTemplate(ctx, *response.Canvas, canvasID, nodeID, template)
+	}
+
+	body := openapi_client.CanvasesInvokeNodeTriggerActionBody{}
+	body.SetParameters(params)
+
+	result, _, err := ctx.API.CanvasNodeAPI.
+		CanvasesInvokeNodeTriggerAction(ctx.Context, canvasID, nodeID, runTriggerActionName).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(result.GetResult())
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Run started from node %s\n", nodeID)
+
+		inputs, _ := result.GetResult()["inputs"].(map[string]any)
+		names := make([]string, 0, len(inputs))
+		for name := range inputs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			_, _ = fmt.Fprintf(stdout, "  %s: %v\n", name, inputs[name])
+		}
+
+		return nil
+	})
+}
+
+/*
+ * Templates are not validated against the trigger inputs,
+ * their payload is emitted as is, like when they are picked in the UI.
+ */
+func runTemplate(ctx core.CommandContext, canvas openapi_client.CanvasesCanvas, canvasID, nodeID, name string) error {
+	payload, err := findRunTemplatePayload(canvas, nodeID, name)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.CanvasesEmitNodeEventBody{}
+	body.SetChannel("default")
+	body.SetData(payload)
+
+	response, _, err := ctx.API.CanvasNodeAPI.
+		CanvasesEmitNodeEvent(ctx.Context, canvasID, nodeID).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Run started from node %s with template %q\n", nodeID, name)
+		_, err := fmt.Fprintf(stdout, "Event: %s\n", response.GetEventId())
+		return err
+	})
+}
+
+func findRunTemplatePayload(canvas openapi_client.CanvasesCanvas, nodeID, name string) (map[string]any, error) {
+	var node *openapi_client.ComponentsNode
+	for _, n := range canvas.Spec.GetNodes() {
+		if n.GetId() == nodeID {
+			node = &n
+			break
+		}
+	}
+
+	if node == nil {
+		return nil, fmt.Errorf("node %q not found", nodeID)
+	}
+
+	templates, _ := node.GetConfiguration()["templates"].([]any)
+	available := make([]string, 0, len(templates))
+	for _, item := range templates {
+		template, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		templateName, _ := template["name"].(string)
+		if templateName == name {
+			payload, _ := template["payload"].(map[string]any)
+			if payload == nil {
+				payload = map[string]any{}
+			}
+
+			return payload, nil
+		}
+
+		available = append(available, templateName)
+	}
+
+	if len(available) == 0 {
+		return nil, fmt.Errorf("node %q has no templates", nodeID)
+	}
+
+	return nil, fmt.Errorf("template %q not found; available templates: %s", name, strings.Join(available, ", "))
+}
+
+func findTriggerNodeID(canvas openapi_client.CanvasesCanvas, nodeRef string) (string, error) {
+	if canvas.Spec == nil {
+		return "", fmt.Errorf("node %q not found", nodeRef)
+	}
+
+	var matches []openapi_client.ComponentsNode
+	for _, node := range canvas.Spec.GetNodes() {
+		if node.GetId() == nodeRef || node.GetName() == nodeRef {
+			matches = append(matches, node)
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", fmt.Errorf("node %q not found", nodeRef)
+	}
+
+	if len(matches) > 1 {
+		return "", fmt.Errorf("multiple nodes named %q found; use the node id", nodeRef)
+	}
+
+	if matches[0].GetType() != openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER {
+		return "", fmt.Errorf("node %q is not a trigger", nodeRef)
+	}
+
+	return matches[0].GetId(), nil
+}
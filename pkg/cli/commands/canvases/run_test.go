@@ -0,0 +1,62 @@
+package canvases
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func TestFindTriggerNodeID(t *testing.T) {
+	trigger := testNode("trigger-1", openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER, nil)
+	trigger.SetName("Manual Run")
+	component := testNode("component-1", openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT, nil)
+	component.SetName("Deploy")
+
+	canvas := testCanvas([]openapi_client.ComponentsNode{trigger, component}, nil)
+
+	nodeID, err := findTriggerNodeID(canvas, "trigger-1")
+	if err != nil || nodeID != "trigger-1" {
+		t.Fatalf("expected trigger-1 by id, got %q (%v)", nodeID, err)
+	}
+
+	nodeID, err = findTriggerNodeID(canvas, "Manual Run")
+	if err != nil || nodeID != "trigger-1" {
+		t.Fatalf("expected trigger-1 by name, got %q (%v)", nodeID, err)
+	}
+
+	if _, err := findTriggerNodeID(canvas, "Deploy"); err == nil {
+		t.Fatalf("expected error for non-trigger node")
+	}
+
+	if _, err := findTriggerNodeID(canvas, "missing"); err == nil {
+		t.Fatalf("expected error for missing node")
+	}
+}
+
+func TestFindRunTemplatePayload(t *testing.T) {
+	trigger := testNode("trigger-1", openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER, map[string]any{
+		"templates": []any{
+			map[string]any{"name": "Hello World", "payload": map[string]any{"message": "Hello, World!"}},
+			map[string]any{"name": "Rollback", "payload": map[string]any{"version": "v1"}},
+		},
+	})
+	empty := testNode("trigger-2", openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER, nil)
+	canvas := testCanvas([]openapi_client.ComponentsNode{trigger, empty}, nil)
+
+	payload, err := findRunTemplatePayload(canvas, "trigger-1", "Rollback")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(payload, map[string]any{"version": "v1"}) {
+		t.Fatalf("unexpected payload: %v", payload)
+	}
+
+	if _, err := findRunTemplatePayload(canvas, "trigger-1", "missing"); err == nil {
+		t.Fatalf("expected error for missing template")
+	}
+
+	if _, err := findRunTemplatePayload(canvas, "trigger-2", "Hello World"); err == nil {
+		t.Fatalf("expected error for node without templates")
+	}
+}
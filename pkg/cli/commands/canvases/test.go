@@ -0,0 +1,242 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type testCommand struct {
+	fixtures *string
+}
+
+/*
+ * Fixtures describe the trigger payload to simulate,
+ * and the outputs to use for nodes instead of their example outputs.
+ * Nodes can be referenced by id or name.
+ */
+type testFixtures struct {
+	Trigger testTriggerFixture           `json:"trigger"`
+	Outputs map[string]testOutputFixture `json:"outputs"`
+}
+
+type testTriggerFixture struct {
+	Node    string         `json:"node"`
+	Type    string         `json:"type"`
+	Payload map[string]any `json:"payload"`
+}
+
+type testOutputFixture struct {
+	Channel string         `json:"channel"`
+	Data    map[string]any `json:"data"`
+}
+
+type testResult struct {
+	File       string                                `json:"file"`
+	Passed     bool                                  `json:"passed"`
+	Steps      []openapi_client.CanvasSimulationStep `json:"steps"`
+	NotReached []string                              `json:"notReached"`
+}
+
+func (c *testCommand) Execute(ctx core.CommandContext) error {
+	filePath := ctx.Args[0]
+	canvas, err := loadCanvasForLint(filePath)
+	if err != nil {
+		return err
+	}
+
+	fixtures := testFixtures{}
+	if c.fixtures != nil && *c.fixtures != "" {
+		fixtures, err = loadTestFixtures(*c.fixtures)
+		if err != nil {
+			return err
+		}
+	}
+
+	body, err := buildSimulateCanvasRequest(canvas, fixtures)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesSimulateCanvas(ctx.Context).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	simulation := response.GetSimulation()
+	result := newTestResult(filePath, canvas, simulation.GetSteps())
+
+	if ctx.Renderer.IsText() {
+		err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			return renderTestResultText(stdout, result)
+		})
+	} else {
+		err = ctx.Renderer.Render(result)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !result.Passed {
+		return fmt.Errorf("simulation of %s failed", filePath)
+	}
+
+	return nil
+}
+
+func loadTestFixtures(filePath string) (testFixtures, error) {
+	// #nosec
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return testFixtures{}, fmt.Errorf("failed to read fixtures file: %w", err)
+	}
+
+	fixtures := testFixtures{}
+	if err := yaml.Unmarshal(data, &fixtures); err != nil {
+		return testFixtures{}, fmt.Errorf("failed to parse fixtures file: %w", err)
+	}
+
+	return fixtures, nil
+}
+
+func buildSimulateCanvasRequest(canvas openapi_client.CanvasesCanvas, fixtures testFixtures) (openapi_client.CanvasesSimulateCanvasRequest, error) {
+	body := openapi_client.CanvasesSimulateCanvasRequest{}
+	body.SetCanvas(canvas)
+
+	if node := strings.TrimSpace(fixtures.Trigger.Node); node != "" {
+		nodeID, err := findTriggerNodeID(canvas, node)
+		if err != nil {
+			return body, err
+		}
+		body.SetTriggerNodeId(nodeID)
+	}
+
+	if fixtures.Trigger.Type != "" {
+		body.SetPayloadType(fixtures.Trigger.Type)
+	}
+
+	if fixtures.Trigger.Payload != nil {
+		body.SetPayload(fixtures.Trigger.Payload)
+	}
+
+	spec := canvas.GetSpec()
+	refs := make([]string, 0, len(fixtures.Outputs))
+	for ref := range fixtures.Outputs {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+
+	body.Fixtures = []openapi_client.CanvasSimulationFixture{}
+	for _, ref := range refs {
+		nodeID, err := findNodeID(spec.GetNodes(), ref)
+		if err != nil {
+			return body, err
+		}
+
+		output := fixtures.Outputs[ref]
+		fixture := openapi_client.CanvasSimulationFixture{}
+		fixture.SetNodeId(nodeID)
+		if output.Channel != "" {
+			fixture.SetChannel(output.Channel)
+		}
+		if output.Data != nil {
+			fixture.SetData(output.Data)
+		}
+
+		body.Fixtures = append(body.Fixtures, fixture)
+	}
+
+	return body, nil
+}
+
+func findNodeID(nodes []openapi_client.ComponentsNode, ref string) (string, error) {
+	matches := []string{}
+	for _, node := range nodes {
+		if node.GetId() == ref || node.GetName() == ref {
+			matches = append(matches, node.GetId())
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", fmt.Errorf("node %q not found", ref)
+	}
+
+	if len(matches) > 1 {
+		return "", fmt.Errorf("multiple nodes named %q found; use the node id", ref)
+	}
+
+	return matches[0], nil
+}
+
+func newTestResult(filePath string, canvas openapi_client.CanvasesCanvas, steps []openapi_client.CanvasSimulationStep) testResult {
+	result := testResult{File: filePath, Passed: true, Steps: steps, NotReached: []string{}}
+
+	reached := map[string]struct{}{}
+	for _, step := range steps {
+		reached[step.GetNodeId()] = struct{}{}
+		if step.GetResult() == openapi_client.CANVASESCANVASSIMULATIONRESULT_RESULT_FAILED {
+			result.Passed = false
+		}
+	}
+
+	spec := canvas.GetSpec()
+	for _, node := range spec.GetNodes() {
+		if node.GetType() == openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET {
+			continue
+		}
+
+		if _, ok := reached[node.GetId()]; !ok {
+			result.NotReached = append(result.NotReached, node.GetName())
+		}
+	}
+
+	return result
+}
+
+func renderTestResultText(stdout io.Writer, result testResult) error {
+	for _, step := range result.Steps {
+		if _, err := fmt.Fprintln(stdout, formatTestStep(step)); err != nil {
+			return err
+		}
+	}
+
+	if len(result.NotReached) > 0 {
+		_, _ = fmt.Fprintf(stdout, "\nNot reached: %s\n", strings.Join(result.NotReached, ", "))
+	}
+
+	return nil
+}
+
+func formatTestStep(step openapi_client.CanvasSimulationStep) string {
+	node := step.GetNodeName()
+	if step.GetNodeId() != "" && step.GetNodeId() != node {
+		node = fmt.Sprintf("%s (%s)", node, step.GetNodeId())
+	}
+
+	source := map[openapi_client.CanvasSimulationSource]string{
+		openapi_client.CANVASSIMULATIONSOURCE_SOURCE_TRIGGER:        "trigger",
+		openapi_client.CANVASSIMULATIONSOURCE_SOURCE_COMPONENT:      "evaluated",
+		openapi_client.CANVASSIMULATIONSOURCE_SOURCE_FIXTURE:        "fixture",
+		openapi_client.CANVASSIMULATIONSOURCE_SOURCE_EXAMPLE_OUTPUT: "example",
+	}[step.GetSource()]
+	if source == "" {
+		source = "-"
+	}
+
+	if step.GetResult() == openapi_client.CANVASESCANVASSIMULATIONRESULT_RESULT_FAILED {
+		return fmt.Sprintf("%-10s %s failed: %s", source, node, step.GetError())
+	}
+
+	if step.GetChannel() == "" {
+		return fmt.Sprintf("%-10s %s -> no output", source, node)
+	}
+
+	return fmt.Sprintf("%-10s %s -> %s", source, node, step.GetChannel())
+}
@@ -0,0 +1,98 @@
+package canvases
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func simulationCanvas() openapi_client.CanvasesCanvas {
+	trigger := openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER
+	component := openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT
+
+	spec := openapi_client.CanvasesCanvasSpec{}
+	spec.SetNodes([]openapi_client.ComponentsNode{
+		{Id: openapi_client.PtrString("trigger-1"), Name: openapi_client.PtrString("push"), Type: &trigger},
+		{Id: openapi_client.PtrString("if-1"), Name: openapi_client.PtrString("is-main"), Type: &component},
+		{Id: openapi_client.PtrString("http-1"), Name: openapi_client.PtrString("deploy"), Type: &component},
+		{Id: openapi_client.PtrString("noop-1"), Name: openapi_client.PtrString("skip"), Type: &component},
+	})
+
+	canvas := openapi_client.CanvasesCanvas{}
+	canvas.SetSpec(spec)
+	return canvas
+}
+
+func TestBuildSimulateCanvasRequest(t *testing.T) {
+	fixtures := testFixtures{
+		Trigger: testTriggerFixture{Node: "push", Type: "github.push", Payload: map[string]any{"ref": "main"}},
+		Outputs: map[string]testOutputFixture{
+			"deploy": {Channel: "failed", Data: map[string]any{"status": 500}},
+		},
+	}
+
+	body, err := buildSimulateCanvasRequest(simulationCanvas(), fixtures)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body.GetTriggerNodeId() != "trigger-1" || body.GetPayloadType() != "github.push" || body.GetPayload()["ref"] != "main" {
+		t.Fatalf("unexpected trigger in request: %+v", body)
+	}
+
+	if len(body.Fixtures) != 1 || body.Fixtures[0].GetNodeId() != "http-1" || body.Fixtures[0].GetChannel() != "failed" {
+		t.Fatalf("unexpected fixtures: %+v", body.Fixtures)
+	}
+
+	fixtures.Outputs = map[string]testOutputFixture{"missing": {}}
+	if _, err := buildSimulateCanvasRequest(simulationCanvas(), fixtures); err == nil {
+		t.Fatalf("expected error for unknown fixture node")
+	}
+
+	fixtures = testFixtures{Trigger: testTriggerFixture{Node: "deploy"}}
+	if _, err := buildSimulateCanvasRequest(simulationCanvas(), fixtures); err == nil {
+		t.Fatalf("expected error for a trigger node that is not a trigger")
+	}
+}
+
+func TestRenderTestResult(t *testing.T) {
+	source := func(s openapi_client.CanvasSimulationSource) *openapi_client.CanvasSimulationSource { return &s }
+	result := func(r openapi_client.CanvasesCanvasSimulationResult) *openapi_client.CanvasesCanvasSimulationResult {
+		return &r
+	}
+
+	passed := result(openapi_client.CANVASESCANVASSIMULATIONRESULT_RESULT_PASSED)
+	steps := []openapi_client.CanvasSimulationStep{
+		{
+			NodeId: openapi_client.PtrString("trigger-1"), NodeName: openapi_client.PtrString("push"),
+			Source: source(openapi_client.CANVASSIMULATIONSOURCE_SOURCE_TRIGGER), Result: passed, Channel: openapi_client.PtrString("default"),
+		},
+		{
+			NodeId: openapi_client.PtrString("if-1"), NodeName: openapi_client.PtrString("is-main"),
+			Source: source(openapi_client.CANVASSIMULATIONSOURCE_SOURCE_COMPONENT), Result: passed, Channel: openapi_client.PtrString("true"),
+		},
+		{
+			NodeId: openapi_client.PtrString("http-1"), NodeName: openapi_client.PtrString("deploy"),
+			Result: result(openapi_client.CANVASESCANVASSIMULATIONRESULT_RESULT_FAILED), Error: openapi_client.PtrString("error resolving field url"),
+		},
+	}
+
+	r := newTestResult("canvas.yaml", simulationCanvas(), steps)
+	if r.Passed {
+		t.Fatalf("expected result to fail")
+	}
+
+	out := bytes.Buffer{}
+	if err := renderTestResultText(&out, r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "trigger    push (trigger-1) -> default\n" +
+		"evaluated  is-main (if-1) -> true\n" +
+		"-          deploy (http-1) failed: error resolving field url\n" +
+		"\nNot reached: skip\n"
+	if out.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
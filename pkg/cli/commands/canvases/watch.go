@@ -0,0 +1,362 @@
+package canvases
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/gorilla/websocket"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	watchReconnectDelay = 2 * time.Second
+
+	watchEventCreated         = "event_created"
+	watchExecutionCreated     = "execution_created"
+	watchExecutionStarted     = "execution_started"
+	watchExecutionFinished    = "execution_finished"
+	watchQueueItemCreated     = "queue_item_created"
+	watchQueueItemConsumed    = "queue_item_consumed"
+	watchCanvasUpdated        = "canvas_updated"
+	watchCanvasVersionUpdated = "canvas_version_updated"
+	watchCanvasDeleted        = "canvas_deleted"
+)
+
+type watchCommand struct {
+	nodes *[]string
+}
+
+/*
+ * A message received from the canvas websocket.
+ * The payload depends on the event: events, executions and queue items
+ * use the same JSON representation as the API.
+ */
+type watchMessage struct {
+	Event   string          `json:"event"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+type watchNodePayload struct {
+	NodeID string `json:"nodeId"`
+}
+
+func (c *watchCommand) Execute(ctx core.CommandContext) error {
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = strings.TrimSpace(ctx.Args[0])
+	} else if ctx.Config != nil {
+		target = strings.TrimSpace(ctx.Config.GetActiveCanvas())
+	}
+	if target == "" {
+		return fmt.Errorf("<name-or-id> is required (or set an active canvas)")
+	}
+
+	canvasID, err := findCanvasID(ctx, ctx.API, target)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesDescribeCanvas(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+
+	canvas := response.GetCanvas()
+	spec := canvas.GetSpec()
+	nodeNames := map[string]string{}
+	for _, node := range spec.GetNodes() {
+		nodeNames[node.GetId()] = node.GetName()
+	}
+
+	filter, err := resolveWatchNodeFilter(spec.GetNodes(), *c.nodes)
+	if err != nil {
+		return err
+	}
+
+	endpoint, header, err := watchEndpoint(ctx.API.GetConfig(), canvasID)
+	if err != nil {
+		return err
+	}
+
+	signalCtx, stop := signal.NotifyContext(ctx.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	stdout := ctx.Cmd.OutOrStdout()
+	if ctx.Renderer.IsText() {
+		metadata := canvas.GetMetadata()
+		_, _ = fmt.Fprintf(stdout, "Watching canvas %s (%s). Press Ctrl+C to stop.\n", metadata.GetName(), canvasID)
+	}
+
+	for {
+		done, err := watchConnection(signalCtx, endpoint, header, func(message watchMessage, raw []byte) (bool, error) {
+			if !filter.matches(message) {
+				return message.Event == watchCanvasDeleted, nil
+			}
+
+			if err := renderWatchMessage(ctx.Renderer, stdout, message, raw, nodeNames, time.Now()); err != nil {
+				return true, err
+			}
+
+			return message.Event == watchCanvasDeleted, nil
+		})
+		if signalCtx.Err() != nil {
+			return nil
+		}
+
+		if done {
+			return err
+		}
+
+		ctx.Logger.Debugf("websocket connection closed: %v", err)
+		if ctx.Renderer.IsText() {
+			_, _ = fmt.Fprintf(stdout, "%s connection lost, reconnecting\n", time.Now().Format(time.TimeOnly))
+		}
+
+		select {
+		case <-signalCtx.Done():
+			return nil
+		case <-time.After(watchReconnectDelay):
+		}
+	}
+}
+
+/*
+ * Reads messages until the connection is closed, or the context is cancelled.
+ * Returns true when watching should stop, without reconnecting.
+ */
+func watchConnection(
+	ctx context.Context,
+	endpoint string,
+	header http.Header,
+	handle func(message watchMessage, raw []byte) (bool, error),
+) (bool, error) {
+	conn, response, err := websocket.DefaultDialer.DialContext(ctx, endpoint, header)
+	if err != nil {
+		if response != nil && response.StatusCode >= 400 && response.StatusCode < 500 {
+			return true, fmt.Errorf("failed to connect to canvas stream: %s", response.Status)
+		}
+
+		return false, err
+	}
+
+	closed := make(chan struct{})
+	defer close(closed)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-closed:
+			_ = conn.Close()
+		}
+	}()
+
+	for {
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil {
+				return true, nil
+			}
+
+			return false, err
+		}
+
+		message := watchMessage{}
+		if err := json.Unmarshal(raw, &message); err != nil {
+			continue
+		}
+
+		done, err := handle(message, raw)
+		if done || err != nil {
+			return true, err
+		}
+	}
+}
+
+/*
+ * The websocket endpoint is served next to the API,
+ * and accepts the same API token.
+ */
+func watchEndpoint(config *openapi_client.Configuration, canvasID string) (string, http.Header, error) {
+	if config == nil || len(config.Servers) == 0 {
+		return "", nil, fmt.Errorf("API URL is not configured")
+	}
+
+	u, err := url.Parse(config.Servers[0].URL)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid API URL: %w", err)
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	default:
+		return "", nil, fmt.Errorf("unsupported API URL scheme %q", u.Scheme)
+	}
+
+	u.Path = strings.TrimRight(u.Path, "/") + "/ws/" + url.PathEscape(canvasID)
+	u.RawQuery = ""
+
+	header := http.Header{}
+	if authorization := config.DefaultHeader["Authorization"]; authorization != "" {
+		header.Set("Authorization", authorization)
+	}
+
+	return u.String(), header, nil
+}
+
+type watchNodeFilter map[string]struct{}
+
+/*
+ * Nodes can be referenced by id or name.
+ * Canvas-level messages, which are not about a node, always match.
+ */
+func resolveWatchNodeFilter(nodes []openapi_client.ComponentsNode, refs []string) (watchNodeFilter, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	filter := watchNodeFilter{}
+	for _, ref := range refs {
+		nodeID, err := findNodeID(nodes, strings.TrimSpace(ref))
+		if err != nil {
+			return nil, err
+		}
+
+		filter[nodeID] = struct{}{}
+	}
+
+	return filter, nil
+}
+
+func (f watchNodeFilter) matches(message watchMessage) bool {
+	if len(f) == 0 {
+		return true
+	}
+
+	nodeID := message.nodeID()
+	if nodeID == "" {
+		return true
+	}
+
+	_, ok := f[nodeID]
+	return ok
+}
+
+func (m watchMessage) nodeID() string {
+	payload := watchNodePayload{}
+	if err := json.Unmarshal(m.Payload, &payload); err != nil {
+		return ""
+	}
+
+	return payload.NodeID
+}
+
+func renderWatchMessage(
+	renderer core.Renderer,
+	stdout io.Writer,
+	message watchMessage,
+	raw []byte,
+	nodeNames map[string]string,
+	now time.Time,
+) error {
+	switch renderer.Format() {
+	case core.OutputFormatJSON:
+		line := bytes.Buffer{}
+		if err := json.Compact(&line, raw); err != nil {
+			return err
+		}
+
+		_, err := fmt.Fprintln(stdout, line.String())
+		return err
+	case core.OutputFormatYAML:
+		payload, err := yaml.JSONToYAML(raw)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(stdout, "---\n%s", payload)
+		return err
+	default:
+		_, err := fmt.Fprintln(stdout, formatWatchMessage(message, nodeNames, now))
+		return err
+	}
+}
+
+func formatWatchMessage(message watchMessage, nodeNames map[string]string, now time.Time) string {
+	prefix := now.Format(time.TimeOnly)
+	node := func(nodeID string) string {
+		if name := nodeNames[nodeID]; name != "" && name != nodeID {
+			return fmt.Sprintf("%s (%s)", name, nodeID)
+		}
+
+		return nodeID
+	}
+
+	switch message.Event {
+	case watchEventCreated:
+		event := openapi_client.CanvasesCanvasEvent{}
+		if err := json.Unmarshal(message.Payload, &event); err != nil {
+			break
+		}
+
+		return fmt.Sprintf("%s event      %s emitted on %s (%s)", prefix, node(event.GetNodeId()), event.GetChannel(), event.GetId())
+
+	case watchExecutionCreated, watchExecutionStarted, watchExecutionFinished:
+		execution := openapi_client.CanvasesCanvasNodeExecution{}
+		if err := json.Unmarshal(message.Payload, &execution); err != nil {
+			break
+		}
+
+		state := strings.ToLower(strings.TrimPrefix(string(execution.GetState()), "STATE_"))
+		line := fmt.Sprintf("%s execution  %s %s (%s)", prefix, node(execution.GetNodeId()), state, execution.GetId())
+		if message.Event != watchExecutionFinished {
+			return line
+		}
+
+		result := strings.ToLower(strings.TrimPrefix(string(execution.GetResult()), "RESULT_"))
+		line = fmt.Sprintf("%s: %s", line, result)
+		if execution.GetResultMessage() != "" {
+			line = fmt.Sprintf("%s, %s", line, execution.GetResultMessage())
+		}
+
+		return line
+
+	case watchQueueItemCreated, watchQueueItemConsumed:
+		item := openapi_client.CanvasesCanvasNodeQueueItem{}
+		if err := json.Unmarshal(message.Payload, &item); err != nil {
+			break
+		}
+
+		action := "queued"
+		if message.Event == watchQueueItemConsumed {
+			action = "dequeued"
+		}
+
+		return fmt.Sprintf("%s queue      %s %s (%s)", prefix, node(item.GetNodeId()), action, item.GetId())
+
+	case watchCanvasUpdated:
+		return fmt.Sprintf("%s canvas     updated", prefix)
+
+	case watchCanvasVersionUpdated:
+		return fmt.Sprintf("%s canvas     version updated", prefix)
+
+	case watchCanvasDeleted:
+		return fmt.Sprintf("%s canvas     deleted", prefix)
+	}
+
+	return fmt.Sprintf("%s %s", prefix, message.Event)
+}
@@ -0,0 +1,154 @@
+package canvases
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func TestWatchEndpoint(t *testing.T) {
+	config := openapi_client.NewConfiguration()
+	config.Servers = openapi_client.ServerConfigurations{{URL: "https://app.example.com/"}}
+	config.DefaultHeader["Authorization"] = "Bearer token"
+
+	endpoint, header, err := watchEndpoint(config, "canvas-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if endpoint != "wss://app.example.com/ws/canvas-1" {
+		t.Fatalf("unexpected endpoint %q", endpoint)
+	}
+
+	if header.Get("Authorization") != "Bearer token" {
+		t.Fatalf("expected authorization header to be forwarded, got %q", header.Get("Authorization"))
+	}
+
+	config.Servers = openapi_client.ServerConfigurations{{URL: "http://localhost:8000"}}
+	endpoint, _, err = watchEndpoint(config, "canvas-1")
+	if err != nil || endpoint != "ws://localhost:8000/ws/canvas-1" {
+		t.Fatalf("unexpected endpoint %q, error %v", endpoint, err)
+	}
+}
+
+func TestWatchNodeFilter(t *testing.T) {
+	nodes := []openapi_client.ComponentsNode{
+		{Id: openapi_client.PtrString("node-1"), Name: openapi_client.PtrString("deploy")},
+		{Id: openapi_client.PtrString("node-2"), Name: openapi_client.PtrString("notify")},
+	}
+
+	filter, err := resolveWatchNodeFilter(nodes, []string{"deploy"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	deploy := watchMessage{Event: watchExecutionStarted, Payload: []byte(`{"id":"e1","nodeId":"node-1"}`)}
+	notify := watchMessage{Event: watchExecutionStarted, Payload: []byte(`{"id":"e2","nodeId":"node-2"}`)}
+	canvas := watchMessage{Event: watchCanvasUpdated, Payload: []byte(`{"id":"canvas-1","canvasId":"canvas-1"}`)}
+
+	if !filter.matches(deploy) || filter.matches(notify) || !filter.matches(canvas) {
+		t.Fatalf("unexpected filter results")
+	}
+
+	if _, err := resolveWatchNodeFilter(nodes, []string{"missing"}); err == nil {
+		t.Fatalf("expected error for unknown node")
+	}
+}
+
+func TestFormatWatchMessage(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	names := map[string]string{"node-1": "deploy"}
+
+	cases := []struct {
+		message  watchMessage
+		expected string
+	}{
+		{
+			watchMessage{Event: watchEventCreated, Payload: []byte(`{"id":"ev1","nodeId":"node-1","channel":"default"}`)},
+			"15:04:05 event      deploy (node-1) emitted on default (ev1)",
+		},
+		{
+			watchMessage{Event: watchExecutionStarted, Payload: []byte(`{"id":"ex1","nodeId":"node-1","state":"STATE_STARTED"}`)},
+			"15:04:05 execution  deploy (node-1) started (ex1)",
+		},
+		{
+			watchMessage{Event: watchExecutionFinished, Payload: []byte(`{"id":"ex1","nodeId":"node-2","state":"STATE_FINISHED","result":"RESULT_FAILED","resultMessage":"timeout"}`)},
+			"15:04:05 execution  node-2 finished (ex1): failed, timeout",
+		},
+		{
+			watchMessage{Event: watchQueueItemConsumed, Payload: []byte(`{"id":"q1","nodeId":"node-1"}`)},
+			"15:04:05 queue      deploy (node-1) dequeued (q1)",
+		},
+		{
+			watchMessage{Event: watchCanvasDeleted, Payload: []byte(`{"id":"canvas-1"}`)},
+			"15:04:05 canvas     deleted",
+		},
+	}
+
+	for _, c := range cases {
+		if got := formatWatchMessage(c.message, names, now); got != c.expected {
+			t.Fatalf("expected %q, got %q", c.expected, got)
+		}
+	}
+}
+
+func TestWatchConnection(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"event":"execution_started","payload":{"id":"ex1","nodeId":"node-1"}}`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"event":"canvas_deleted","payload":{"id":"canvas-1"}}`))
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	endpoint := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/canvas-1"
+	header := http.Header{}
+	header.Set("Authorization", "Bearer token")
+
+	renderer, err := core.NewRenderer("json", &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := bytes.Buffer{}
+	done, err := watchConnection(context.Background(), endpoint, header, func(message watchMessage, raw []byte) (bool, error) {
+		if err := renderWatchMessage(renderer, &out, message, raw, nil, time.Now()); err != nil {
+			return true, err
+		}
+
+		return message.Event == watchCanvasDeleted, nil
+	})
+	if !done || err != nil {
+		t.Fatalf("expected watch to stop on canvas deletion, got done=%v err=%v", done, err)
+	}
+
+	expected := `{"event":"execution_started","payload":{"id":"ex1","nodeId":"node-1"}}` + "\n" +
+		`{"event":"canvas_deleted","payload":{"id":"canvas-1"}}` + "\n"
+	if out.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+
+	done, err = watchConnection(context.Background(), endpoint, http.Header{}, nil)
+	if !done || err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected unauthorized error without retry, got done=%v err=%v", done, err)
+	}
+}
@@ -14,6 +14,8 @@ type ListEventsCommand struct {
 	NodeID   *string
 	Limit    *int64
 	Before   *string
+	NodeIDs  *[]string
+	List     core.ListFlags
 }
 
 func (c *ListEventsCommand) Execute(ctx core.CommandContext) error {
@@ -45,6 +47,11 @@ func (c *ListEventsCommand) listNodeEvents(ctx core.CommandContext) error {
 		request = request.Before(beforeTime)
 	}
 
+	request, err = core.ApplyListFlags(request, c.List)
+	if err != nil {
+		return err
+	}
+
 	response, _, err := request.Execute()
 
 	if err != nil {
@@ -68,7 +75,11 @@ func (c *ListEventsCommand) listNodeEvents(ctx core.CommandContext) error {
 			)
 		}
 
-		return writer.Flush()
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		return core.PrintNextPage(stdout, response.GetTotalCount(), response.GetNextPageToken())
 	})
 }
 
@@ -93,6 +104,15 @@ func (c *ListEventsCommand) listCanvasEvents(ctx core.CommandContext) error {
 		request = request.Before(beforeTime)
 	}
 
+	if len(*c.NodeIDs) > 0 {
+		request = request.NodeIds(*c.NodeIDs)
+	}
+
+	request, err = core.ApplyListFlags(request, c.List)
+	if err != nil {
+		return err
+	}
+
 	response, _, err := request.Execute()
 
 	if err != nil {
@@ -118,6 +138,10 @@ func (c *ListEventsCommand) listCanvasEvents(ctx core.CommandContext) error {
 			)
 		}
 
-		return writer.Flush()
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		return core.PrintNextPage(stdout, response.GetTotalCount(), response.GetNextPageToken())
 	})
 }
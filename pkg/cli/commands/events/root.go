@@ -11,6 +11,7 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var eventID string
 	var limit int64
 	var before string
+	var nodeIDs []string
 
 	root := &cobra.Command{
 		Use:     "events",
@@ -30,11 +31,15 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	listCmd.Flags().StringVar(&nodeID, "node-id", "", "node ID")
 	listCmd.Flags().Int64Var(&limit, "limit", 20, "maximum number of items to return")
 	listCmd.Flags().StringVar(&before, "before", "", "return items before this timestamp (RFC3339)")
+	listCmd.Flags().StringSliceVar(&nodeIDs, "node", nil, "only root events emitted by these nodes (repeatable)")
+	listFlags := core.BindListFlags(listCmd)
 	core.Bind(listCmd, &ListEventsCommand{
 		CanvasID: &canvasID,
 		NodeID:   &nodeID,
 		Limit:    &limit,
 		Before:   &before,
+		NodeIDs:  &nodeIDs,
+		List:     listFlags,
 	}, options)
 
 	//
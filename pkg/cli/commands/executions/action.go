@@ -0,0 +1,69 @@
+package executions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type InvokeExecutionActionCommand struct {
+	CanvasID *string
+	Params   *[]string
+}
+
+func (c *InvokeExecutionActionCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	params, err := core.ParseKeyValueFlags(*c.Params, parseActionParamValue)
+	if err != nil {
+		return err
+	}
+
+	executionID := ctx.Args[0]
+	actionName := ctx.Args[1]
+
+	body := openapi_client.CanvasesInvokeNodeExecutionActionBody{}
+	body.SetParameters(params)
+
+	response, _, err := ctx.API.CanvasNodeExecutionAPI.
+		CanvasesInvokeNodeExecutionAction(ctx.Context, canvasID, executionID, actionName).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Action %s invoked on execution %s\n", actionName, executionID)
+		return err
+	})
+}
+
+/*
+ * Action parameters are typed, e.g. the approval index is a number,
+ * so values that are valid JSON numbers or booleans are sent as such.
+ * Everything else is sent as a string.
+ */
+func parseActionParamValue(value string) any {
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+
+	switch decoded.(type) {
+	case float64, bool:
+		return decoded
+	default:
+		return value
+	}
+}
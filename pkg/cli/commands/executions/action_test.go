@@ -0,0 +1,25 @@
+package executions
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func TestParseActionParamValue(t *testing.T) {
+	params, err := core.ParseKeyValueFlags([]string{"index=0", "comment=looks good", "force=true", "ref={\"a\":1}"}, parseActionParamValue)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]any{
+		"index":   float64(0),
+		"comment": "looks good",
+		"force":   true,
+		"ref":     "{\"a\":1}",
+	}
+	if !reflect.DeepEqual(params, expected) {
+		t.Fatalf("expected %v, got %v", expected, params)
+	}
+}
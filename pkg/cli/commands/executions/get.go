@@ -0,0 +1,284 @@
+package executions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type GetExecutionCommand struct {
+	CanvasID *string
+	Chain    *bool
+}
+
+func (c *GetExecutionCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasNodeExecutionAPI.
+		CanvasesDescribeNodeExecution(ctx.Context, canvasID, ctx.Args[0]).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if *c.Chain {
+		return c.renderChain(ctx, canvasID, response.GetExecution())
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderExecutionText(stdout, response.GetExecution(), response.GetKvs())
+	})
+}
+
+/*
+ * The chain includes every execution started by the same root event,
+ * not only the ones leading to or following the requested execution.
+ */
+func (c *GetExecutionCommand) renderChain(ctx core.CommandContext, canvasID string, execution openapi_client.CanvasesCanvasNodeExecution) error {
+	rootEvent := execution.GetRootEvent()
+	if rootEvent.GetId() == "" {
+		return fmt.Errorf("execution %s has no root event", execution.GetId())
+	}
+
+	response, _, err := ctx.API.CanvasEventAPI.
+		CanvasesListEventExecutions(ctx.Context, canvasID, rootEvent.GetId()).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	chain := buildExecutionChain(response.GetExecutions())
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(executionChain{RootEvent: rootEvent, Executions: chain})
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Root event: %s (node %s, channel %s)\n", rootEvent.GetId(), rootEvent.GetNodeId(), rootEvent.GetChannel())
+		renderExecutionChainText(stdout, chain, 1, execution.GetId())
+		return nil
+	})
+}
+
+type executionChain struct {
+	RootEvent  openapi_client.CanvasesCanvasEvent `json:"rootEvent"`
+	Executions []executionChainNode               `json:"executions"`
+}
+
+type executionChainNode struct {
+	Execution openapi_client.CanvasesCanvasNodeExecution `json:"execution"`
+	Children  []executionChainNode                       `json:"children,omitempty"`
+	Next      []executionChainNode                       `json:"next,omitempty"`
+}
+
+/*
+ * Executions are linked to the execution that emitted their input event.
+ * The ones with no previous execution in the list were started by the root event.
+ * Child executions of blueprint nodes are chained the same way, under their parent.
+ */
+func buildExecutionChain(executions []openapi_client.CanvasesCanvasNodeExecution) []executionChainNode {
+	ids := make(map[string]bool, len(executions))
+	for _, execution := range executions {
+		ids[execution.GetId()] = true
+	}
+
+	next := map[string][]openapi_client.CanvasesCanvasNodeExecution{}
+	roots := []openapi_client.CanvasesCanvasNodeExecution{}
+	for _, execution := range executions {
+		previousID := execution.GetPreviousExecutionId()
+		if previousID == "" || !ids[previousID] {
+			roots = append(roots, execution)
+			continue
+		}
+
+		next[previousID] = append(next[previousID], execution)
+	}
+
+	var build func(items []openapi_client.CanvasesCanvasNodeExecution) []executionChainNode
+	build = func(items []openapi_client.CanvasesCanvasNodeExecution) []executionChainNode {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].GetCreatedAt().Before(items[j].GetCreatedAt())
+		})
+
+		nodes := make([]executionChainNode, 0, len(items))
+		for _, item := range items {
+			nodes = append(nodes, executionChainNode{
+				Execution: item,
+				Children:  buildExecutionChain(item.GetChildExecutions()),
+				Next:      build(next[item.GetId()]),
+			})
+		}
+
+		return nodes
+	}
+
+	return build(roots)
+}
+
+func renderExecutionChainText(stdout io.Writer, nodes []executionChainNode, depth int, selectedID string) {
+	indent := strings.Repeat("  ", depth)
+	for _, node := range nodes {
+		execution := node.Execution
+		fields := []string{execution.GetNodeId(), execution.GetId(), executionStatus(execution)}
+		if duration := formatDuration(execution); duration != "" {
+			fields = append(fields, duration)
+		}
+		if execution.GetId() == selectedID {
+			fields = append(fields, "<-")
+		}
+
+		_, _ = fmt.Fprintf(stdout, "%s- %s\n", indent, strings.Join(fields, " "))
+
+		if len(node.Children) > 0 {
+			_, _ = fmt.Fprintf(stdout, "%s  children:\n", indent)
+			renderExecutionChainText(stdout, node.Children, depth+2, selectedID)
+		}
+
+		renderExecutionChainText(stdout, node.Next, depth+1, selectedID)
+	}
+}
+
+func renderExecutionText(stdout io.Writer, execution openapi_client.CanvasesCanvasNodeExecution, kvs []openapi_client.CanvasesCanvasNodeExecutionKV) error {
+	_, _ = fmt.Fprintf(stdout, "ID: %s\n", execution.GetId())
+	_, _ = fmt.Fprintf(stdout, "Node: %s\n", execution.GetNodeId())
+	if execution.GetParentExecutionId() != "" {
+		_, _ = fmt.Fprintf(stdout, "Parent execution: %s\n", execution.GetParentExecutionId())
+	}
+	if execution.GetPreviousExecutionId() != "" {
+		_, _ = fmt.Fprintf(stdout, "Previous execution: %s\n", execution.GetPreviousExecutionId())
+	}
+	rootEvent := execution.GetRootEvent()
+	_, _ = fmt.Fprintf(stdout, "Root event: %s\n", stringOrDash(rootEvent.GetId()))
+	_, _ = fmt.Fprintf(stdout, "State: %s\n", execution.GetState())
+	_, _ = fmt.Fprintf(stdout, "Result: %s\n", execution.GetResult())
+	if execution.GetResult() == openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_FAILED {
+		_, _ = fmt.Fprintf(stdout, "Reason: %s\n", execution.GetResultReason())
+	}
+	if execution.GetResultMessage() != "" {
+		_, _ = fmt.Fprintf(stdout, "Message: %s\n", execution.GetResultMessage())
+	}
+	if execution.HasCancelledBy() {
+		cancelledBy := execution.GetCancelledBy()
+		_, _ = fmt.Fprintf(stdout, "Cancelled by: %s\n", cancelledBy.GetName())
+	}
+	_, _ = fmt.Fprintf(stdout, "Created at: %s\n", execution.GetCreatedAt().Format(time.RFC3339))
+	_, _ = fmt.Fprintf(stdout, "Updated at: %s\n", execution.GetUpdatedAt().Format(time.RFC3339))
+	_, _ = fmt.Fprintf(stdout, "Duration: %s\n", stringOrDash(formatDuration(execution)))
+
+	if err := renderSection(stdout, "Configuration", execution.GetConfiguration()); err != nil {
+		return err
+	}
+	if err := renderSection(stdout, "Input", execution.GetInput()); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(stdout, "Outputs:")
+	outputs := execution.GetOutputs()
+	if len(outputs) == 0 {
+		_, _ = fmt.Fprintln(stdout, "  (none)")
+	}
+	channels := make([]string, 0, len(outputs))
+	for channel := range outputs {
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+	for _, channel := range channels {
+		_, _ = fmt.Fprintf(stdout, "  %s:\n", channel)
+		if err := renderJSON(stdout, outputs[channel], "    "); err != nil {
+			return err
+		}
+	}
+
+	if err := renderSection(stdout, "Metadata", execution.GetMetadata()); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(stdout, "KVs:")
+	if len(kvs) == 0 {
+		_, _ = fmt.Fprintln(stdout, "  (none)")
+	}
+	for _, kv := range kvs {
+		_, _ = fmt.Fprintf(stdout, "  %s=%s\n", kv.GetKey(), kv.GetValue())
+	}
+
+	children := execution.GetChildExecutions()
+	if len(children) == 0 {
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(stdout, "Child executions:")
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "  ID\tNODE_ID\tSTATE\tRESULT\tMESSAGE")
+	for _, child := range children {
+		_, _ = fmt.Fprintf(
+			writer,
+			"  %s\t%s\t%s\t%s\t%s\n",
+			child.GetId(),
+			child.GetNodeId(),
+			child.GetState(),
+			child.GetResult(),
+			stringOrDash(child.GetResultMessage()),
+		)
+	}
+
+	return writer.Flush()
+}
+
+func renderSection(stdout io.Writer, title string, value map[string]interface{}) error {
+	_, _ = fmt.Fprintf(stdout, "%s:\n", title)
+	if len(value) == 0 {
+		_, err := fmt.Fprintln(stdout, "  (none)")
+		return err
+	}
+
+	return renderJSON(stdout, value, "  ")
+}
+
+func renderJSON(stdout io.Writer, value any, indent string) error {
+	serialized, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	for _, line := range strings.Split(string(serialized), "\n") {
+		_, err = fmt.Fprintf(stdout, "%s%s\n", indent, line)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func executionStatus(execution openapi_client.CanvasesCanvasNodeExecution) string {
+	if execution.GetState() != openapi_client.CANVASNODEEXECUTIONSTATE_STATE_FINISHED {
+		return string(execution.GetState())
+	}
+
+	return string(execution.GetResult())
+}
+
+/*
+ * Executions have no separate start and finish timestamps,
+ * so the duration of a finished execution is the time between its creation and last update.
+ */
+func formatDuration(execution openapi_client.CanvasesCanvasNodeExecution) string {
+	if execution.GetState() != openapi_client.CANVASNODEEXECUTIONSTATE_STATE_FINISHED {
+		return ""
+	}
+
+	return execution.GetUpdatedAt().Sub(execution.GetCreatedAt()).Round(time.Millisecond).String()
+}
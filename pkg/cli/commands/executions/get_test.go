@@ -0,0 +1,79 @@
+package executions
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func newExecution(id, nodeID, previousID string, createdAt time.Time) openapi_client.CanvasesCanvasNodeExecution {
+	execution := openapi_client.CanvasesCanvasNodeExecution{}
+	execution.SetId(id)
+	execution.SetNodeId(nodeID)
+	execution.SetCreatedAt(createdAt)
+	execution.SetUpdatedAt(createdAt.Add(2 * time.Second))
+	execution.SetState(openapi_client.CANVASNODEEXECUTIONSTATE_STATE_FINISHED)
+	execution.SetResult(openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_PASSED)
+	if previousID != "" {
+		execution.SetPreviousExecutionId(previousID)
+	}
+
+	return execution
+}
+
+func TestBuildExecutionChain(t *testing.T) {
+	now := time.Now()
+	build := newExecution("e1", "build", "", now)
+	test := newExecution("e2", "test", "e1", now.Add(time.Second))
+	lint := newExecution("e3", "lint", "e1", now.Add(500*time.Millisecond))
+	deploy := newExecution("e4", "deploy", "e2", now.Add(2*time.Second))
+	deploy.SetChildExecutions([]openapi_client.CanvasesCanvasNodeExecution{
+		newExecution("c2", "rollout", "c1", now.Add(4*time.Second)),
+		newExecution("c1", "approve", "", now.Add(3*time.Second)),
+	})
+
+	chain := buildExecutionChain([]openapi_client.CanvasesCanvasNodeExecution{deploy, test, lint, build})
+	if len(chain) != 1 || chain[0].Execution.GetId() != "e1" {
+		t.Fatalf("expected a single root e1, got %+v", chain)
+	}
+
+	next := chain[0].Next
+	if len(next) != 2 || next[0].Execution.GetId() != "e3" || next[1].Execution.GetId() != "e2" {
+		t.Fatalf("expected e3 and e2 after e1, got %+v", next)
+	}
+
+	if len(next[1].Next) != 1 || next[1].Next[0].Execution.GetId() != "e4" {
+		t.Fatalf("expected e4 after e2, got %+v", next[1].Next)
+	}
+
+	children := next[1].Next[0].Children
+	if len(children) != 1 || children[0].Execution.GetId() != "c1" {
+		t.Fatalf("expected child chain to start at c1, got %+v", children)
+	}
+	if len(children[0].Next) != 1 || children[0].Next[0].Execution.GetId() != "c2" {
+		t.Fatalf("expected c2 after c1, got %+v", children[0].Next)
+	}
+}
+
+func TestRenderExecutionChainText(t *testing.T) {
+	now := time.Now()
+	chain := buildExecutionChain([]openapi_client.CanvasesCanvasNodeExecution{
+		newExecution("e1", "build", "", now),
+		newExecution("e2", "deploy", "e1", now.Add(time.Second)),
+	})
+
+	var out bytes.Buffer
+	renderExecutionChainText(&out, chain, 1, "e2")
+
+	expected := strings.Join([]string{
+		"  - build e1 RESULT_PASSED 2s",
+		"    - deploy e2 RESULT_PASSED 2s <-",
+		"",
+	}, "\n")
+	if out.String() != expected {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+}
@@ -3,6 +3,8 @@ package executions
 import (
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -14,6 +16,9 @@ type ListExecutionsCommand struct {
 	NodeID   *string
 	Limit    *int64
 	Before   *string
+	States   *[]string
+	Results  *[]string
+	List     core.ListFlags
 }
 
 func (c *ListExecutionsCommand) Execute(ctx core.CommandContext) error {
@@ -37,6 +42,27 @@ func (c *ListExecutionsCommand) Execute(ctx core.CommandContext) error {
 		request = request.Before(beforeTime)
 	}
 
+	states, err := enumValues("state", "STATE_", *c.States, []string{"pending", "started", "finished"})
+	if err != nil {
+		return err
+	}
+	if len(states) > 0 {
+		request = request.States(states)
+	}
+
+	results, err := enumValues("result", "RESULT_", *c.Results, []string{"passed", "failed", "cancelled"})
+	if err != nil {
+		return err
+	}
+	if len(results) > 0 {
+		request = request.Results(results)
+	}
+
+	request, err = core.ApplyListFlags(request, c.List)
+	if err != nil {
+		return err
+	}
+
 	response, _, err := request.Execute()
 	if err != nil {
 		return err
@@ -63,7 +89,11 @@ func (c *ListExecutionsCommand) Execute(ctx core.CommandContext) error {
 			)
 		}
 
-		return writer.Flush()
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		return core.PrintNextPage(stdout, response.GetTotalCount(), response.GetNextPageToken())
 	})
 }
 
@@ -74,3 +104,21 @@ func stringOrDash(s string) string {
 
 	return s
 }
+
+/*
+ * Flags take the lowercase names, e.g. --state finished,
+ * while the API expects the enum names, e.g. STATE_FINISHED.
+ */
+func enumValues(flag, prefix string, values []string, allowed []string) ([]string, error) {
+	result := []string{}
+	for _, value := range values {
+		name := strings.ToLower(strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), prefix))
+		if !slices.Contains(allowed, name) {
+			return nil, fmt.Errorf("invalid --%s value %q: expected one of %s", flag, value, strings.Join(allowed, ", "))
+		}
+
+		result = append(result, prefix+strings.ToUpper(name))
+	}
+
+	return result, nil
+}
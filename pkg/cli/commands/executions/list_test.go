@@ -0,0 +1,22 @@
+package executions
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEnumValues(t *testing.T) {
+	values, err := enumValues("result", "RESULT_", []string{"failed", "RESULT_CANCELLED", " Passed "}, []string{"passed", "failed", "cancelled"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"RESULT_FAILED", "RESULT_CANCELLED", "RESULT_PASSED"}
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("expected %v, got %v", expected, values)
+	}
+
+	if _, err := enumValues("result", "RESULT_", []string{"unknown"}, []string{"passed", "failed", "cancelled"}); err == nil {
+		t.Fatalf("expected error for unknown result")
+	}
+}
@@ -11,6 +11,10 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var executionID string
 	var limit int64
 	var before string
+	var chain bool
+	var params []string
+	var states []string
+	var results []string
 
 	root := &cobra.Command{
 		Use:     "executions",
@@ -27,12 +31,18 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	listCmd.Flags().StringVar(&nodeID, "node-id", "", "node ID")
 	listCmd.Flags().Int64Var(&limit, "limit", 20, "maximum number of items to return")
 	listCmd.Flags().StringVar(&before, "before", "", "return items before this timestamp (RFC3339)")
+	listCmd.Flags().StringSliceVar(&states, "state", nil, "only executions in these states: pending, started or finished (repeatable)")
+	listCmd.Flags().StringSliceVar(&results, "result", nil, "only executions with these results: passed, failed or cancelled (repeatable)")
+	listFlags := core.BindListFlags(listCmd)
 	_ = listCmd.MarkFlagRequired("node-id")
 	core.Bind(listCmd, &ListExecutionsCommand{
 		CanvasID: &canvasID,
 		NodeID:   &nodeID,
 		Limit:    &limit,
 		Before:   &before,
+		States:   &states,
+		Results:  &results,
+		List:     listFlags,
 	}, options)
 
 	cancelCmd := &cobra.Command{
@@ -48,8 +58,34 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		ExecutionID: &executionID,
 	}, options)
 
+	getCmd := &cobra.Command{
+		Use:   "get <execution-id>",
+		Short: "Show an execution, its payloads and child executions",
+		Args:  cobra.ExactArgs(1),
+	}
+	getCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	getCmd.Flags().BoolVar(&chain, "chain", false, "print all executions started by the same root event as a tree")
+	core.Bind(getCmd, &GetExecutionCommand{
+		CanvasID: &canvasID,
+		Chain:    &chain,
+	}, options)
+
+	actionCmd := &cobra.Command{
+		Use:   "action <execution-id> <action>",
+		Short: "Invoke an action on a running execution, e.g. approve or pushThrough",
+		Args:  cobra.ExactArgs(2),
+	}
+	actionCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	actionCmd.Flags().StringArrayVar(&params, "param", nil, "action parameter, in key=value format (repeatable)")
+	core.Bind(actionCmd, &InvokeExecutionActionCommand{
+		CanvasID: &canvasID,
+		Params:   &params,
+	}, options)
+
 	root.AddCommand(listCmd)
+	root.AddCommand(getCmd)
 	root.AddCommand(cancelCmd)
+	root.AddCommand(actionCmd)
 
 	return root
 }
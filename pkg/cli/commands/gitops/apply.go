@@ -0,0 +1,58 @@
+package gitops
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type applyCommand struct {
+	files  *[]string
+	prune  *bool
+	dryRun *bool
+}
+
+type applyResult struct {
+	DryRun  bool      `json:"dryRun"`
+	Changes []*change `json:"changes"`
+	Summary summary   `json:"summary"`
+}
+
+func (c *applyCommand) Execute(ctx core.CommandContext) error {
+	resources, err := loadResources(*c.files)
+	if err != nil {
+		return err
+	}
+
+	p, err := buildPlan(ctx, resources, *c.prune)
+	if err != nil {
+		return err
+	}
+
+	if !*c.dryRun {
+		for _, planned := range p.Changes {
+			if planned.apply == nil {
+				continue
+			}
+
+			if err := planned.apply(ctx); err != nil {
+				return fmt.Errorf("failed to %s %s %q: %w", planned.Action, planned.Kind, planned.Name, err)
+			}
+
+			ctx.Logger.Debugf("%s %s %q", planned.Action, planned.Kind, planned.Name)
+		}
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(applyResult{DryRun: *c.dryRun, Changes: p.Changes, Summary: p.Summary})
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		if *c.dryRun {
+			_, _ = fmt.Fprintln(stdout, "Dry run, no changes were applied.")
+		}
+
+		return renderPlan(stdout, p, false)
+	})
+}
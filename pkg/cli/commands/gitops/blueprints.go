@@ -0,0 +1,177 @@
+package gitops
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	blueprintmodels "github.com/superplanehq/superplane/pkg/cli/commands/blueprints/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type blueprintPlanner struct{}
+
+func (p *blueprintPlanner) plan(ctx core.CommandContext, resources []resource, prune bool) ([]*change, error) {
+	response, _, err := ctx.API.BlueprintAPI.BlueprintsListBlueprints(ctx.Context).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	liveByName := map[string]openapi_client.BlueprintsBlueprint{}
+	for _, blueprint := range response.GetBlueprints() {
+		liveByName[blueprint.GetName()] = blueprint
+	}
+
+	changes := []*change{}
+	desired := map[string]struct{}{}
+	for _, r := range resources {
+		desired[r.Name] = struct{}{}
+
+		parsed, err := blueprintmodels.ParseBlueprint(r.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.File, err)
+		}
+
+		blueprint := blueprintmodels.BlueprintFromBlueprint(*parsed)
+		live, exists := liveByName[r.Name]
+		if !exists {
+			blueprint.Id = nil
+			changes = append(changes, &change{
+				Kind:   r.Kind,
+				Name:   r.Name,
+				File:   r.File,
+				Action: actionCreate,
+				apply: func(ctx core.CommandContext) error {
+					request := openapi_client.BlueprintsCreateBlueprintRequest{}
+					request.SetBlueprint(blueprint)
+					_, _, err := ctx.API.BlueprintAPI.BlueprintsCreateBlueprint(ctx.Context).Body(request).Execute()
+					return err
+				},
+			})
+			continue
+		}
+
+		details, err := blueprintChanges(live, blueprint)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.File, err)
+		}
+
+		if len(details) == 0 {
+			changes = append(changes, &change{Kind: r.Kind, Name: r.Name, File: r.File, Action: actionUnchanged})
+			continue
+		}
+
+		blueprintID := live.GetId()
+		blueprint.Id = &blueprintID
+		changes = append(changes, &change{
+			Kind:    r.Kind,
+			Name:    r.Name,
+			File:    r.File,
+			Action:  actionUpdate,
+			Details: details,
+			apply: func(ctx core.CommandContext) error {
+				body := openapi_client.BlueprintsUpdateBlueprintBody{}
+				body.SetBlueprint(blueprint)
+				_, _, err := ctx.API.BlueprintAPI.BlueprintsUpdateBlueprint(ctx.Context, blueprintID).Body(body).Execute()
+				return err
+			},
+		})
+	}
+
+	if !prune {
+		return changes, nil
+	}
+
+	for _, blueprint := range response.GetBlueprints() {
+		if _, ok := desired[blueprint.GetName()]; ok {
+			continue
+		}
+
+		blueprintID := blueprint.GetId()
+		changes = append(changes, &change{
+			Kind:   blueprintmodels.BlueprintKind,
+			Name:   blueprint.GetName(),
+			Action: actionDelete,
+			apply: func(ctx core.CommandContext) error {
+				_, _, err := ctx.API.BlueprintAPI.BlueprintsDeleteBlueprint(ctx.Context, blueprintID).Execute()
+				return err
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+/*
+ * Blueprints are compared field by field, through their JSON representation,
+ * so fields omitted in the file and empty fields in the API are treated the same.
+ */
+func blueprintChanges(live openapi_client.BlueprintsBlueprint, desired openapi_client.BlueprintsBlueprint) ([]string, error) {
+	fields := []struct {
+		name    string
+		live    any
+		desired any
+	}{
+		{"description", live.GetDescription(), desired.GetDescription()},
+		{"icon", live.GetIcon(), desired.GetIcon()},
+		{"color", live.GetColor(), desired.GetColor()},
+		{"nodes", live.GetNodes(), desired.GetNodes()},
+		{"edges", live.GetEdges(), desired.GetEdges()},
+		{"configuration", live.GetConfiguration(), desired.GetConfiguration()},
+		{"outputChannels", live.GetOutputChannels(), desired.GetOutputChannels()},
+	}
+
+	details := []string{}
+	for _, field := range fields {
+		equal, err := jsonEqual(field.live, field.desired)
+		if err != nil {
+			return nil, err
+		}
+
+		if !equal {
+			details = append(details, fmt.Sprintf("~ %s", field.name))
+		}
+	}
+
+	return details, nil
+}
+
+func jsonEqual(a any, b any) (bool, error) {
+	normalizedA, err := normalizeJSON(a)
+	if err != nil {
+		return false, err
+	}
+
+	normalizedB, err := normalizeJSON(b)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(normalizedA, normalizedB), nil
+}
+
+func normalizeJSON(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+
+	switch v := normalized.(type) {
+	case []any:
+		if len(v) == 0 {
+			return nil, nil
+		}
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,217 @@
+package gitops
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ghodss/yaml"
+	blueprintmodels "github.com/superplanehq/superplane/pkg/cli/commands/blueprints/models"
+	canvasmodels "github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	RoleKind  = "Role"
+	GroupKind = "Group"
+)
+
+/*
+ * A bundle holds everything needed to recreate an organization elsewhere.
+ * Resources are imported in this order, so roles exist before the groups using them,
+ * and integrations and blueprints exist before the canvases referencing them.
+ */
+var bundleKinds = []string{
+	SecretKind,
+	IntegrationKind,
+	RoleKind,
+	GroupKind,
+	blueprintmodels.BlueprintKind,
+	canvasmodels.CanvasKind,
+}
+
+/*
+ * Default roles exist in every organization,
+ * so they are never exported.
+ */
+var defaultRoles = map[string]struct{}{
+	"org_owner":  {},
+	"org_admin":  {},
+	"org_viewer": {},
+}
+
+type roleResource struct {
+	APIVersion string       `json:"apiVersion"`
+	Kind       string       `json:"kind"`
+	Metadata   nameMetadata `json:"metadata"`
+	Spec       roleSpec     `json:"spec"`
+}
+
+type roleSpec struct {
+	DisplayName   string           `json:"displayName,omitempty"`
+	Description   string           `json:"description,omitempty"`
+	InheritedRole string           `json:"inheritedRole,omitempty"`
+	Permissions   []rolePermission `json:"permissions"`
+}
+
+type rolePermission struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+}
+
+type groupResource struct {
+	APIVersion string       `json:"apiVersion"`
+	Kind       string       `json:"kind"`
+	Metadata   nameMetadata `json:"metadata"`
+	Spec       groupSpec    `json:"spec"`
+}
+
+type groupSpec struct {
+	Role        string `json:"role"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type nameMetadata struct {
+	Name string `json:"name"`
+}
+
+/*
+ * A credential is a sensitive value that is not part of the bundle.
+ * It is read from the environment variable on import,
+ * and must be re-entered if the variable is not set.
+ */
+type credential struct {
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	Field    string `json:"field"`
+	Variable string `json:"variable"`
+}
+
+/*
+ * Builds the environment variable name used for a credential,
+ * e.g. integration "github-prod" and field "apiToken" use GITHUB_PROD_API_TOKEN.
+ */
+func credentialVariable(name string, field string) string {
+	var b strings.Builder
+	previous := '_'
+	for _, r := range name + "_" + field {
+		if r > unicode.MaxASCII || (!unicode.IsLetter(r) && !unicode.IsDigit(r)) {
+			r = '_'
+		}
+
+		if r == '_' && previous == '_' {
+			continue
+		}
+
+		if unicode.IsUpper(r) && (unicode.IsLower(previous) || unicode.IsDigit(previous)) {
+			b.WriteRune('_')
+		}
+
+		b.WriteRune(unicode.ToUpper(r))
+		previous = r
+	}
+
+	variable := strings.TrimRight(b.String(), "_")
+	if variable == "" || unicode.IsDigit(rune(variable[0])) {
+		variable = "_" + variable
+	}
+
+	return variable
+}
+
+func credentialReference(name string, field string) string {
+	return fmt.Sprintf("${%s}", credentialVariable(name, field))
+}
+
+func writeBundle(w io.Writer, documents []any) error {
+	for i, document := range documents {
+		data, err := yaml.Marshal(document)
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+
+		if _, err := w.Write(bytes.TrimRight(data, "\n")); err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sortCredentials(credentials []credential) {
+	sort.SliceStable(credentials, func(i, j int) bool {
+		a, b := credentials[i], credentials[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Field < b.Field
+	})
+}
+
+/*
+ * Canvases and blueprints reference integrations and blueprints by ID.
+ * IDs are different in every organization, so references are
+ * mapped from the IDs in the bundle to the IDs in the target organization.
+ */
+type idMapping struct {
+	integrations map[string]string
+	blueprints   map[string]string
+}
+
+func newIDMapping() *idMapping {
+	return &idMapping{
+		integrations: map[string]string{},
+		blueprints:   map[string]string{},
+	}
+}
+
+/*
+ * Rewrites the references of the given nodes in place,
+ * and returns a description of the references that could not be mapped.
+ * Unmapped references are removed, so the node shows an error
+ * until it is pointed to an existing resource.
+ */
+func (m *idMapping) remapNodes(nodes []openapi_client.ComponentsNode) []string {
+	unresolved := []string{}
+	for i := range nodes {
+		node := &nodes[i]
+		if node.Integration != nil && node.Integration.GetId() != "" {
+			targetID, ok := m.integrations[node.Integration.GetId()]
+			if ok {
+				node.Integration.SetId(targetID)
+			} else {
+				unresolved = append(unresolved, fmt.Sprintf("! node %s: integration %s is not in the bundle", node.GetId(), node.Integration.GetId()))
+				node.Integration = nil
+			}
+		}
+
+		if node.Blueprint != nil && node.Blueprint.GetId() != "" {
+			targetID, ok := m.blueprints[node.Blueprint.GetId()]
+			if ok {
+				node.Blueprint.SetId(targetID)
+			} else {
+				unresolved = append(unresolved, fmt.Sprintf("! node %s: blueprint %s is not in the bundle", node.GetId(), node.Blueprint.GetId()))
+				node.Blueprint = nil
+			}
+		}
+	}
+
+	return unresolved
+}
@@ -0,0 +1,108 @@
+package gitops
+
+import (
+	"fmt"
+
+	canvascommands "github.com/superplanehq/superplane/pkg/cli/commands/canvases"
+	canvasmodels "github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type canvasPlanner struct{}
+
+func (p *canvasPlanner) plan(ctx core.CommandContext, resources []resource, prune bool) ([]*change, error) {
+	response, _, err := ctx.API.CanvasAPI.CanvasesListCanvases(ctx.Context).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	liveByName := map[string]string{}
+	for _, canvas := range response.GetCanvases() {
+		metadata := canvas.GetMetadata()
+		liveByName[metadata.GetName()] = metadata.GetId()
+	}
+
+	changes := []*change{}
+	desired := map[string]struct{}{}
+	for _, r := range resources {
+		desired[r.Name] = struct{}{}
+
+		parsed, err := canvasmodels.ParseCanvas(r.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.File, err)
+		}
+
+		if parsed.Spec == nil {
+			parsed.Spec = canvasmodels.EmptyCanvasSpec()
+		}
+
+		canvas := canvasmodels.CanvasFromCanvas(*parsed)
+		canvasID, exists := liveByName[r.Name]
+		if !exists {
+			changes = append(changes, &change{
+				Kind:   r.Kind,
+				Name:   r.Name,
+				File:   r.File,
+				Action: actionCreate,
+				apply: func(ctx core.CommandContext) error {
+					request := openapi_client.CanvasesCreateCanvasRequest{}
+					request.SetCanvas(canvas)
+					_, _, err := ctx.API.CanvasAPI.CanvasesCreateCanvas(ctx.Context).Body(request).Execute()
+					return err
+				},
+			})
+			continue
+		}
+
+		canvas.Metadata.Id = &canvasID
+		body := openapi_client.CanvasesDiffCanvasBody{}
+		body.SetCanvas(canvas)
+		diffResponse, _, err := ctx.API.CanvasAPI.CanvasesDiffCanvas(ctx.Context, canvasID).Body(body).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.File, err)
+		}
+
+		details := canvascommands.CanvasDiffDetails(diffResponse.GetDiff())
+		if len(details) == 0 {
+			changes = append(changes, &change{Kind: r.Kind, Name: r.Name, File: r.File, Action: actionUnchanged})
+			continue
+		}
+
+		title := fmt.Sprintf("Apply %s", r.File)
+		changes = append(changes, &change{
+			Kind:    r.Kind,
+			Name:    r.Name,
+			File:    r.File,
+			Action:  actionUpdate,
+			Details: details,
+			apply: func(ctx core.CommandContext) error {
+				return canvascommands.ApplyCanvas(ctx, canvasID, canvas, title)
+			},
+		})
+	}
+
+	if !prune {
+		return changes, nil
+	}
+
+	for _, canvas := range response.GetCanvases() {
+		metadata := canvas.GetMetadata()
+		if _, ok := desired[metadata.GetName()]; ok {
+			continue
+		}
+
+		canvasID := metadata.GetId()
+		changes = append(changes, &change{
+			Kind:   canvasmodels.CanvasKind,
+			Name:   metadata.GetName(),
+			Action: actionDelete,
+			apply: func(ctx core.CommandContext) error {
+				_, _, err := ctx.API.CanvasAPI.CanvasesDeleteCanvas(ctx.Context, canvasID).Execute()
+				return err
+			},
+		})
+	}
+
+	return changes, nil
+}
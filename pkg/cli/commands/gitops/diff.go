@@ -0,0 +1,44 @@
+package gitops
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type diffCommand struct {
+	files    *[]string
+	prune    *bool
+	exitCode *bool
+}
+
+func (c *diffCommand) Execute(ctx core.CommandContext) error {
+	resources, err := loadResources(*c.files)
+	if err != nil {
+		return err
+	}
+
+	p, err := buildPlan(ctx, resources, *c.prune)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		err = ctx.Renderer.Render(p)
+	} else {
+		err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			return renderPlan(stdout, p, false)
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if *c.exitCode && p.hasChanges() {
+		return fmt.Errorf("live resources differ from files")
+	}
+
+	return nil
+}
@@ -0,0 +1,364 @@
+package gitops
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	blueprintmodels "github.com/superplanehq/superplane/pkg/cli/commands/blueprints/models"
+	canvasmodels "github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type exportCommand struct {
+	file *string
+}
+
+type exportedResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+type exportResult struct {
+	File        string             `json:"file"`
+	Resources   []exportedResource `json:"resources"`
+	Credentials []credential       `json:"credentials"`
+}
+
+/*
+ * An exporter collects the resources of the organization into bundle documents.
+ * Credentials are never exported: secret values and sensitive integration fields
+ * are replaced by ${NAME} references, and listed so they can be provided on import.
+ */
+type exporter struct {
+	organizationID string
+	documents      []any
+	resources      []exportedResource
+	credentials    []credential
+}
+
+func (c *exportCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := currentOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	e := &exporter{
+		organizationID: organizationID,
+		documents:      []any{},
+		resources:      []exportedResource{},
+		credentials:    []credential{},
+	}
+
+	steps := []func(ctx core.CommandContext) error{
+		e.exportSecrets,
+		e.exportIntegrations,
+		e.exportRoles,
+		e.exportGroups,
+		e.exportBlueprints,
+		e.exportCanvases,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+
+	buffer := bytes.Buffer{}
+	if err := writeBundle(&buffer, e.documents); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(*c.file, buffer.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	sortCredentials(e.credentials)
+	result := exportResult{File: *c.file, Resources: e.resources, Credentials: e.credentials}
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(result)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Exported %d resources to %s\n", len(result.Resources), result.File)
+		return renderCredentials(stdout, "Credentials are not exported. Set these variables before importing:", result.Credentials)
+	})
+}
+
+func (e *exporter) add(kind string, name string, document any) {
+	e.documents = append(e.documents, document)
+	e.resources = append(e.resources, exportedResource{Kind: kind, Name: name})
+}
+
+func (e *exporter) exportSecrets(ctx core.CommandContext) error {
+	response, _, err := ctx.API.SecretAPI.
+		SecretsListSecrets(ctx.Context).
+		DomainType(string(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)).
+		DomainId(e.organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	secrets := response.GetSecrets()
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].Metadata.GetName() < secrets[j].Metadata.GetName()
+	})
+
+	for _, secret := range secrets {
+		metadata := secret.GetMetadata()
+		spec := secret.GetSpec()
+		if spec.GetProvider() != openapi_client.SECRETPROVIDER_PROVIDER_LOCAL {
+			continue
+		}
+
+		name := metadata.GetName()
+		local := spec.GetLocal()
+		data := map[string]string{}
+		for _, key := range sortedKeys(local.GetData()) {
+			data[key] = credentialReference(name, key)
+			e.credentials = append(e.credentials, credential{
+				Kind:     SecretKind,
+				Name:     name,
+				Field:    key,
+				Variable: credentialVariable(name, key),
+			})
+		}
+
+		exportedMetadata := openapi_client.SecretsSecretMetadata{}
+		exportedMetadata.SetName(name)
+		exportedLocal := openapi_client.SecretLocal{}
+		exportedLocal.SetData(data)
+		exportedSpec := openapi_client.SecretsSecretSpec{}
+		exportedSpec.SetProvider(openapi_client.SECRETPROVIDER_PROVIDER_LOCAL)
+		exportedSpec.SetLocal(exportedLocal)
+
+		e.add(SecretKind, name, secretResource{
+			APIVersion: core.APIVersion,
+			Kind:       SecretKind,
+			Metadata:   &exportedMetadata,
+			Spec:       &exportedSpec,
+		})
+	}
+
+	return nil
+}
+
+func (e *exporter) exportIntegrations(ctx core.CommandContext) error {
+	response, _, err := ctx.API.OrganizationAPI.OrganizationsListIntegrations(ctx.Context, e.organizationID).Execute()
+	if err != nil {
+		return err
+	}
+
+	integrations := response.GetIntegrations()
+	sort.SliceStable(integrations, func(i, j int) bool {
+		return integrations[i].Metadata.GetName() < integrations[j].Metadata.GetName()
+	})
+
+	for _, integration := range integrations {
+		metadata := integration.GetMetadata()
+		spec := integration.GetSpec()
+
+		r := integrationResource{APIVersion: core.APIVersion, Kind: IntegrationKind}
+		r.Metadata.ID = metadata.GetId()
+		r.Metadata.Name = metadata.GetName()
+		r.Spec.IntegrationName = spec.GetIntegrationName()
+		r.Spec.Configuration = map[string]any{}
+		for key, value := range spec.GetConfiguration() {
+			if value != redactedValue {
+				r.Spec.Configuration[key] = value
+				continue
+			}
+
+			r.Spec.Configuration[key] = credentialReference(r.Metadata.Name, key)
+			e.credentials = append(e.credentials, credential{
+				Kind:     IntegrationKind,
+				Name:     r.Metadata.Name,
+				Field:    key,
+				Variable: credentialVariable(r.Metadata.Name, key),
+			})
+		}
+
+		e.add(IntegrationKind, r.Metadata.Name, r)
+	}
+
+	return nil
+}
+
+func (e *exporter) exportRoles(ctx core.CommandContext) error {
+	response, _, err := ctx.API.RolesAPI.
+		RolesListRoles(ctx.Context).
+		DomainType(string(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)).
+		DomainId(e.organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	roles := []roleResource{}
+	for _, role := range response.GetRoles() {
+		metadata := role.GetMetadata()
+		if _, ok := defaultRoles[metadata.GetName()]; ok {
+			continue
+		}
+
+		roles = append(roles, roleResourceFromRole(role))
+	}
+
+	sort.SliceStable(roles, func(i, j int) bool {
+		return roles[i].Metadata.Name < roles[j].Metadata.Name
+	})
+
+	for _, role := range roles {
+		e.add(RoleKind, role.Metadata.Name, role)
+	}
+
+	return nil
+}
+
+func roleResourceFromRole(role openapi_client.RolesRole) roleResource {
+	metadata := role.GetMetadata()
+	spec := role.GetSpec()
+
+	r := roleResource{
+		APIVersion: core.APIVersion,
+		Kind:       RoleKind,
+		Metadata:   nameMetadata{Name: metadata.GetName()},
+		Spec: roleSpec{
+			DisplayName: spec.GetDisplayName(),
+			Description: spec.GetDescription(),
+			Permissions: []rolePermission{},
+		},
+	}
+
+	if inherited, ok := spec.GetInheritedRoleOk(); ok {
+		inheritedMetadata := inherited.GetMetadata()
+		r.Spec.InheritedRole = inheritedMetadata.GetName()
+	}
+
+	for _, permission := range spec.GetPermissions() {
+		r.Spec.Permissions = append(r.Spec.Permissions, rolePermission{
+			Resource: permission.GetResource(),
+			Action:   permission.GetAction(),
+		})
+	}
+
+	return r
+}
+
+func (e *exporter) exportGroups(ctx core.CommandContext) error {
+	response, _, err := ctx.API.GroupsAPI.
+		GroupsListGroups(ctx.Context).
+		DomainType(string(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)).
+		DomainId(e.organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	groups := response.GetGroups()
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Metadata.GetName() < groups[j].Metadata.GetName()
+	})
+
+	for _, group := range groups {
+		metadata := group.GetMetadata()
+		spec := group.GetSpec()
+		e.add(GroupKind, metadata.GetName(), groupResource{
+			APIVersion: core.APIVersion,
+			Kind:       GroupKind,
+			Metadata:   nameMetadata{Name: metadata.GetName()},
+			Spec: groupSpec{
+				Role:        spec.GetRole(),
+				DisplayName: spec.GetDisplayName(),
+				Description: spec.GetDescription(),
+			},
+		})
+	}
+
+	return nil
+}
+
+func (e *exporter) exportBlueprints(ctx core.CommandContext) error {
+	response, _, err := ctx.API.BlueprintAPI.BlueprintsListBlueprints(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	blueprints := response.GetBlueprints()
+	sort.SliceStable(blueprints, func(i, j int) bool {
+		return blueprints[i].GetName() < blueprints[j].GetName()
+	})
+
+	for _, blueprint := range blueprints {
+		e.add(blueprintmodels.BlueprintKind, blueprint.GetName(), blueprintmodels.BlueprintResourceFromBlueprint(blueprint))
+	}
+
+	return nil
+}
+
+/*
+ * Canvases are exported from their live version,
+ * so drafts and pending change requests are not part of the bundle.
+ */
+func (e *exporter) exportCanvases(ctx core.CommandContext) error {
+	response, _, err := ctx.API.CanvasAPI.CanvasesListCanvases(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	canvases := response.GetCanvases()
+	sort.SliceStable(canvases, func(i, j int) bool {
+		return canvases[i].Metadata.GetName() < canvases[j].Metadata.GetName()
+	})
+
+	for _, canvas := range canvases {
+		metadata := canvas.GetMetadata()
+		described, _, err := ctx.API.CanvasAPI.CanvasesDescribeCanvas(ctx.Context, metadata.GetId()).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to describe canvas %q: %w", metadata.GetName(), err)
+		}
+
+		live := described.GetCanvas()
+		spec := live.GetSpec()
+		for i := range spec.Nodes {
+			spec.Nodes[i].ErrorMessage = nil
+			spec.Nodes[i].WarningMessage = nil
+		}
+
+		exportedMetadata := openapi_client.CanvasesCanvasMetadata{}
+		exportedMetadata.SetName(metadata.GetName())
+		if metadata.GetDescription() != "" {
+			exportedMetadata.SetDescription(metadata.GetDescription())
+		}
+
+		e.add(canvasmodels.CanvasKind, metadata.GetName(), canvasmodels.Canvas{
+			APIVersion: core.APIVersion,
+			Kind:       canvasmodels.CanvasKind,
+			Metadata:   &exportedMetadata,
+			Spec:       &spec,
+		})
+	}
+
+	return nil
+}
+
+func renderCredentials(stdout io.Writer, title string, credentials []credential) error {
+	if len(credentials) == 0 {
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(stdout, title)
+	for _, c := range credentials {
+		_, err := fmt.Fprintf(stdout, "    %s  (%s %s, %s)\n", c.Variable, c.Kind, c.Name, c.Field)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,353 @@
+package gitops
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func TestLoadResources(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "canvases", "release.yaml"), `apiVersion: v1
+kind: Canvas
+metadata:
+  name: release
+spec:
+  nodes: []
+---
+apiVersion: v1
+kind: Blueprint
+metadata:
+  name: deploy
+`)
+	writeTestFile(t, filepath.Join(dir, "secrets.yml"), "apiVersion: v1\nkind: Secret\nmetadata:\n  name: tokens\n")
+	writeTestFile(t, filepath.Join(dir, "README.md"), "not a resource")
+
+	resources, err := loadResources([]string{dir})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := []string{}
+	for _, r := range resources {
+		got = append(got, r.Kind+"/"+r.Name)
+	}
+
+	expected := []string{"Canvas/release", "Blueprint/deploy", "Secret/tokens"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestLoadResourcesErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	duplicate := filepath.Join(dir, "duplicate.yaml")
+	writeTestFile(t, duplicate, "apiVersion: v1\nkind: Secret\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: Secret\nmetadata:\n  name: a\n")
+	_, err := loadResources([]string{duplicate})
+	if err == nil || !strings.Contains(err.Error(), `Secret "a" is already defined`) {
+		t.Fatalf("expected duplicate error, got %v", err)
+	}
+
+	unsupported := filepath.Join(dir, "unsupported.yaml")
+	writeTestFile(t, unsupported, "apiVersion: v1\nkind: Widget\nmetadata:\n  name: a\n")
+	_, err = loadResources([]string{unsupported})
+	if err == nil || !strings.Contains(err.Error(), `unsupported resource kind "Widget"`) {
+		t.Fatalf("expected unsupported kind error, got %v", err)
+	}
+
+	unnamed := filepath.Join(dir, "unnamed.yaml")
+	writeTestFile(t, unnamed, "apiVersion: v1\nkind: Canvas\nmetadata: {}\n")
+	_, err = loadResources([]string{unnamed})
+	if err == nil || !strings.Contains(err.Error(), "metadata.name is required") {
+		t.Fatalf("expected missing name error, got %v", err)
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("GITOPS_TEST_TOKEN", "abc")
+
+	value, missing := expandEnv("Bearer ${GITOPS_TEST_TOKEN} $['Node'] ${GITOPS_TEST_MISSING}")
+	if value != "Bearer abc $['Node'] " {
+		t.Fatalf("unexpected value %q", value)
+	}
+
+	if !reflect.DeepEqual(missing, []string{"GITOPS_TEST_MISSING"}) {
+		t.Fatalf("unexpected missing variables %v", missing)
+	}
+}
+
+func TestSecretKeyChanges(t *testing.T) {
+	values := map[string]string{"existing": "", "new": "value"}
+
+	changes, err := secretKeyChanges(values, []string{"existing", "stale"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(changes.added, []string{"new"}) || len(changes.removed) != 0 {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+
+	changes, err = secretKeyChanges(values, []string{"existing", "stale"}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(changes.removed, []string{"stale"}) {
+		t.Fatalf("expected stale key to be removed, got %+v", changes)
+	}
+
+	_, err = secretKeyChanges(map[string]string{"missing": ""}, []string{}, false)
+	if err == nil {
+		t.Fatalf("expected error for key without value")
+	}
+}
+
+func TestParseSecretValues(t *testing.T) {
+	t.Setenv("GITOPS_TEST_SECRET", "s3cr3t")
+
+	values, err := parseSecretValues([]byte(`apiVersion: v1
+kind: Secret
+metadata:
+  name: tokens
+spec:
+  provider: PROVIDER_LOCAL
+  local:
+    data:
+      fromEnv: ${GITOPS_TEST_SECRET}
+      unset: ${GITOPS_TEST_UNSET}
+      reference: ""
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{"fromEnv": "s3cr3t", "unset": "", "reference": ""}
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("expected %v, got %v", expected, values)
+	}
+}
+
+func TestIntegrationConfiguration(t *testing.T) {
+	t.Setenv("GITOPS_TEST_API_TOKEN", "token")
+
+	live := map[string]any{"url": "https://old.example.com", "apiToken": redactedValue, "clientSecret": redactedValue, "removed": "x"}
+	configuration, missing := expandConfiguration(map[string]any{
+		"url":          "https://new.example.com",
+		"apiToken":     "${GITOPS_TEST_API_TOKEN}",
+		"clientSecret": "${GITOPS_TEST_UNSET}",
+		"added":        true,
+	}, live)
+
+	if len(missing) != 0 {
+		t.Fatalf("unexpected missing variables %v", missing)
+	}
+
+	if configuration["apiToken"] != "token" || configuration["clientSecret"] != redactedValue {
+		t.Fatalf("unexpected configuration %v", configuration)
+	}
+
+	details, err := integrationConfigurationChanges(configuration, live)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"+ configuration.added", "- configuration.removed", "~ configuration.url"}
+	if !reflect.DeepEqual(details, expected) {
+		t.Fatalf("expected %v, got %v", expected, details)
+	}
+
+	_, missing = expandConfiguration(map[string]any{"url": "${GITOPS_TEST_UNSET}"}, live)
+	if !reflect.DeepEqual(missing, []string{"GITOPS_TEST_UNSET"}) {
+		t.Fatalf("expected missing variable for non-sensitive field, got %v", missing)
+	}
+}
+
+func TestBlueprintChanges(t *testing.T) {
+	live := openapi_client.BlueprintsBlueprint{}
+	live.SetName("deploy")
+	live.SetDescription("Deploys")
+	live.SetEdges([]openapi_client.ComponentsEdge{})
+
+	desired := openapi_client.BlueprintsBlueprint{}
+	desired.SetName("deploy")
+	desired.SetDescription("Deploys")
+
+	details, err := blueprintChanges(live, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(details) != 0 {
+		t.Fatalf("expected no changes, got %v", details)
+	}
+
+	desired.SetColor("blue")
+	desired.SetOutputChannels([]openapi_client.SuperplaneBlueprintsOutputChannel{{Name: openapi_client.PtrString("default")}})
+	details, err = blueprintChanges(live, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(details, []string{"~ color", "~ outputChannels"}) {
+		t.Fatalf("unexpected changes %v", details)
+	}
+}
+
+func TestRenderPlan(t *testing.T) {
+	p := &plan{
+		Changes: []*change{
+			{Kind: "Canvas", Name: "release", Action: actionUpdate, Details: []string{"+ node notify"}},
+			{Kind: "Secret", Name: "tokens", Action: actionUnchanged},
+			{Kind: "Blueprint", Name: "old", Action: actionDelete},
+		},
+		Summary: summary{Update: 1, Delete: 1, Unchanged: 1},
+	}
+
+	out := bytes.Buffer{}
+	if err := renderPlan(&out, p, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "~ Canvas release\n    + node notify\n- Blueprint old\n0 to create, 1 to update, 1 to delete, 1 unchanged\n"
+	if out.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
+
+func TestCredentialVariable(t *testing.T) {
+	cases := map[[2]string]string{
+		{"github-prod", "apiToken"}:  "GITHUB_PROD_API_TOKEN",
+		{"Tokens", "aws.secret_key"}: "TOKENS_AWS_SECRET_KEY",
+		{"1password", "token"}:       "_1PASSWORD_TOKEN",
+		{"--slack--", "OAuth2Token"}: "SLACK_OAUTH2_TOKEN",
+	}
+
+	for input, expected := range cases {
+		if got := credentialVariable(input[0], input[1]); got != expected {
+			t.Fatalf("credentialVariable(%q, %q): expected %q, got %q", input[0], input[1], expected, got)
+		}
+	}
+}
+
+func TestBundleRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "bundle.yaml")
+
+	integration := integrationResource{APIVersion: "v1", Kind: IntegrationKind}
+	integration.Metadata.ID = "source-integration"
+	integration.Metadata.Name = "github"
+	integration.Spec.IntegrationName = "github"
+	integration.Spec.Configuration = map[string]any{"apiToken": credentialReference("github", "apiToken")}
+
+	documents := []any{
+		integration,
+		roleResource{
+			APIVersion: "v1",
+			Kind:       RoleKind,
+			Metadata:   nameMetadata{Name: "deployer"},
+			Spec:       roleSpec{Permissions: []rolePermission{{Resource: "canvases", Action: "read"}}},
+		},
+		groupResource{
+			APIVersion: "v1",
+			Kind:       GroupKind,
+			Metadata:   nameMetadata{Name: "release-team"},
+			Spec:       groupSpec{Role: "deployer"},
+		},
+	}
+
+	out := bytes.Buffer{}
+	if err := writeBundle(&out, documents); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	writeTestFile(t, file, out.String())
+
+	if _, err := loadResources([]string{file}); err == nil || !strings.Contains(err.Error(), `unsupported resource kind "Role"`) {
+		t.Fatalf("expected apply to reject roles, got %v", err)
+	}
+
+	resources, err := loadResourcesOfKinds([]string{file}, bundleKinds)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := []string{}
+	for _, r := range resources {
+		got = append(got, r.Kind+"/"+r.Name)
+	}
+
+	expected := []string{"Integration/github", "Role/deployer", "Group/release-team"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	if !strings.Contains(string(resources[0].Data), "apiToken: ${GITHUB_API_TOKEN}") {
+		t.Fatalf("expected credential reference in bundle, got:\n%s", resources[0].Data)
+	}
+}
+
+func TestRemapNodes(t *testing.T) {
+	ids := newIDMapping()
+	ids.integrations["source-integration"] = "target-integration"
+	ids.blueprints["source-blueprint"] = "target-blueprint"
+
+	nodes := []openapi_client.ComponentsNode{
+		{Id: openapi_client.PtrString("issue"), Integration: &openapi_client.ComponentsIntegrationRef{Id: openapi_client.PtrString("source-integration")}},
+		{Id: openapi_client.PtrString("deploy"), Blueprint: &openapi_client.NodeBlueprintRef{Id: openapi_client.PtrString("source-blueprint")}},
+		{Id: openapi_client.PtrString("page"), Integration: &openapi_client.ComponentsIntegrationRef{Id: openapi_client.PtrString("unknown")}},
+		{Id: openapi_client.PtrString("wait")},
+	}
+
+	unresolved := ids.remapNodes(nodes)
+
+	if nodes[0].Integration.GetId() != "target-integration" {
+		t.Fatalf("expected integration to be remapped, got %q", nodes[0].Integration.GetId())
+	}
+
+	if nodes[1].Blueprint.GetId() != "target-blueprint" {
+		t.Fatalf("expected blueprint to be remapped, got %q", nodes[1].Blueprint.GetId())
+	}
+
+	if nodes[2].Integration != nil {
+		t.Fatalf("expected unknown integration reference to be removed")
+	}
+
+	expected := []string{"! node page: integration unknown is not in the bundle"}
+	if !reflect.DeepEqual(unresolved, expected) {
+		t.Fatalf("expected %v, got %v", expected, unresolved)
+	}
+}
+
+func TestOrderRoles(t *testing.T) {
+	resources := []resource{{Kind: RoleKind, Name: "release-admin"}, {Kind: RoleKind, Name: "deployer"}, {Kind: RoleKind, Name: "auditor"}}
+	roles := map[string]roleResource{
+		"release-admin": {Spec: roleSpec{InheritedRole: "deployer"}},
+		"deployer":      {Spec: roleSpec{InheritedRole: "org_viewer"}},
+		"auditor":       {},
+	}
+
+	got := []string{}
+	for _, r := range orderRoles(resources, roles) {
+		got = append(got, r.Name)
+	}
+
+	expected := []string{"deployer", "auditor", "release-admin"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func writeTestFile(t *testing.T, path string, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
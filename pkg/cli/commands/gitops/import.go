@@ -0,0 +1,565 @@
+package gitops
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ghodss/yaml"
+	blueprintmodels "github.com/superplanehq/superplane/pkg/cli/commands/blueprints/models"
+	canvasmodels "github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type importCommand struct {
+	files  *[]string
+	dryRun *bool
+}
+
+type importResult struct {
+	DryRun      bool         `json:"dryRun"`
+	Changes     []*change    `json:"changes"`
+	Credentials []credential `json:"credentials"`
+}
+
+/*
+ * An importer recreates bundle resources in the current organization.
+ * Resources that already exist, matched by name, are left untouched.
+ * Credentials are read from the environment; the ones not set
+ * are left empty, and reported so they can be re-entered.
+ */
+type importer struct {
+	organizationID string
+	dryRun         bool
+	ids            *idMapping
+	changes        []*change
+	credentials    []credential
+}
+
+func (c *importCommand) Execute(ctx core.CommandContext) error {
+	resources, err := loadResourcesOfKinds(*c.files, bundleKinds)
+	if err != nil {
+		return err
+	}
+
+	organizationID, err := currentOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	i := &importer{
+		organizationID: organizationID,
+		dryRun:         *c.dryRun,
+		ids:            newIDMapping(),
+		changes:        []*change{},
+		credentials:    []credential{},
+	}
+
+	steps := map[string]func(ctx core.CommandContext, resources []resource) error{
+		SecretKind:                    i.importSecrets,
+		IntegrationKind:               i.importIntegrations,
+		RoleKind:                      i.importRoles,
+		GroupKind:                     i.importGroups,
+		blueprintmodels.BlueprintKind: i.importBlueprints,
+		canvasmodels.CanvasKind:       i.importCanvases,
+	}
+
+	for _, kind := range bundleKinds {
+		kindResources := resourcesOfKind(resources, kind)
+		if len(kindResources) == 0 {
+			continue
+		}
+
+		if err := steps[kind](ctx, kindResources); err != nil {
+			return err
+		}
+	}
+
+	sortCredentials(i.credentials)
+	result := importResult{DryRun: i.dryRun, Changes: i.changes, Credentials: i.credentials}
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(result)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		if result.DryRun {
+			_, _ = fmt.Fprintln(stdout, "Dry run, nothing was imported.")
+		}
+
+		renderChanges(stdout, result.Changes, true)
+
+		created := 0
+		for _, c := range result.Changes {
+			if c.Action == actionCreate {
+				created++
+			}
+		}
+
+		_, _ = fmt.Fprintf(stdout, "%d to create, %d already exist\n", created, len(result.Changes)-created)
+		return renderCredentials(stdout, "Credentials to re-enter:", result.Credentials)
+	})
+}
+
+func (i *importer) exists(r resource) {
+	i.changes = append(i.changes, &change{
+		Kind:    r.Kind,
+		Name:    r.Name,
+		File:    r.File,
+		Action:  actionUnchanged,
+		Details: []string{"already exists"},
+	})
+}
+
+func (i *importer) create(ctx core.CommandContext, r resource, details []string, apply func(ctx core.CommandContext) error) error {
+	if !i.dryRun {
+		if err := apply(ctx); err != nil {
+			return fmt.Errorf("failed to create %s %q: %w", r.Kind, r.Name, err)
+		}
+
+		ctx.Logger.Debugf("created %s %q", r.Kind, r.Name)
+	}
+
+	i.changes = append(i.changes, &change{Kind: r.Kind, Name: r.Name, File: r.File, Action: actionCreate, Details: details})
+	return nil
+}
+
+/*
+ * Expands ${NAME} references in a credential value.
+ * If a referenced variable is not set, the credential is recorded as missing.
+ */
+func (i *importer) expandCredential(kind string, name string, field string, value string) (string, bool) {
+	expanded, missing := expandEnv(value)
+	if len(missing) == 0 {
+		return expanded, true
+	}
+
+	for _, variable := range missing {
+		i.credentials = append(i.credentials, credential{Kind: kind, Name: name, Field: field, Variable: variable})
+	}
+
+	return "", false
+}
+
+func (i *importer) importSecrets(ctx core.CommandContext, resources []resource) error {
+	response, _, err := ctx.API.SecretAPI.
+		SecretsListSecrets(ctx.Context).
+		DomainType(string(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)).
+		DomainId(i.organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	live := map[string]struct{}{}
+	for _, secret := range response.GetSecrets() {
+		metadata := secret.GetMetadata()
+		live[metadata.GetName()] = struct{}{}
+	}
+
+	for _, r := range resources {
+		if _, ok := live[r.Name]; ok {
+			i.exists(r)
+			continue
+		}
+
+		parsed := secretResource{}
+		if err := yaml.Unmarshal(r.Data, &parsed); err != nil {
+			return fmt.Errorf("%s: failed to parse secret resource: %w", r.File, err)
+		}
+
+		if provider := parsed.Spec.GetProvider(); provider != "" && provider != openapi_client.SECRETPROVIDER_PROVIDER_LOCAL {
+			return fmt.Errorf("%s: unsupported secret provider %q", r.File, provider)
+		}
+
+		local := parsed.Spec.GetLocal()
+		data := map[string]string{}
+		for _, key := range sortedKeys(local.GetData()) {
+			data[key], _ = i.expandCredential(r.Kind, r.Name, key, local.GetData()[key])
+		}
+
+		err := i.create(ctx, r, nil, func(ctx core.CommandContext) error {
+			metadata := openapi_client.SecretsSecretMetadata{}
+			metadata.SetName(r.Name)
+			secretLocal := openapi_client.SecretLocal{}
+			secretLocal.SetData(data)
+			spec := openapi_client.SecretsSecretSpec{}
+			spec.SetProvider(openapi_client.SECRETPROVIDER_PROVIDER_LOCAL)
+			spec.SetLocal(secretLocal)
+			secret := openapi_client.SecretsSecret{}
+			secret.SetMetadata(metadata)
+			secret.SetSpec(spec)
+
+			request := openapi_client.SecretsCreateSecretRequest{}
+			request.SetSecret(secret)
+			request.SetDomainType(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)
+			request.SetDomainId(i.organizationID)
+			_, _, err := ctx.API.SecretAPI.SecretsCreateSecret(ctx.Context).Body(request).Execute()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+ * Sensitive fields that are not set in the environment are not sent,
+ * so the integration is created, but shows an error until they are entered.
+ */
+func (i *importer) importIntegrations(ctx core.CommandContext, resources []resource) error {
+	response, _, err := ctx.API.OrganizationAPI.OrganizationsListIntegrations(ctx.Context, i.organizationID).Execute()
+	if err != nil {
+		return err
+	}
+
+	liveByName := map[string]string{}
+	for _, integration := range response.GetIntegrations() {
+		metadata := integration.GetMetadata()
+		liveByName[metadata.GetName()] = metadata.GetId()
+	}
+
+	for _, r := range resources {
+		parsed := integrationResource{}
+		if err := yaml.Unmarshal(r.Data, &parsed); err != nil {
+			return fmt.Errorf("%s: failed to parse integration resource: %w", r.File, err)
+		}
+
+		if parsed.Spec.IntegrationName == "" {
+			return fmt.Errorf("%s: integration spec.integrationName is required", r.File)
+		}
+
+		sourceID := parsed.Metadata.ID
+		if liveID, ok := liveByName[r.Name]; ok {
+			mapID(i.ids.integrations, sourceID, liveID)
+			i.exists(r)
+			continue
+		}
+
+		configuration := map[string]any{}
+		for _, key := range sortedKeys(parsed.Spec.Configuration) {
+			value, ok := parsed.Spec.Configuration[key].(string)
+			if !ok {
+				configuration[key] = parsed.Spec.Configuration[key]
+				continue
+			}
+
+			if expanded, ok := i.expandCredential(r.Kind, r.Name, key, value); ok {
+				configuration[key] = expanded
+			}
+		}
+
+		mapID(i.ids.integrations, sourceID, sourceID)
+		details := []string{fmt.Sprintf("+ %s integration", parsed.Spec.IntegrationName)}
+		err := i.create(ctx, r, details, func(ctx core.CommandContext) error {
+			body := openapi_client.OrganizationsCreateIntegrationBody{}
+			body.SetName(r.Name)
+			body.SetIntegrationName(parsed.Spec.IntegrationName)
+			body.SetConfiguration(configuration)
+			created, _, err := ctx.API.OrganizationAPI.
+				OrganizationsCreateIntegration(ctx.Context, i.organizationID).
+				Body(body).
+				Execute()
+			if err != nil {
+				return err
+			}
+
+			integration := created.GetIntegration()
+			metadata := integration.GetMetadata()
+			mapID(i.ids.integrations, sourceID, metadata.GetId())
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *importer) importRoles(ctx core.CommandContext, resources []resource) error {
+	response, _, err := ctx.API.RolesAPI.
+		RolesListRoles(ctx.Context).
+		DomainType(string(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)).
+		DomainId(i.organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	live := map[string]struct{}{}
+	for _, role := range response.GetRoles() {
+		metadata := role.GetMetadata()
+		live[metadata.GetName()] = struct{}{}
+	}
+
+	roles := map[string]roleResource{}
+	for _, r := range resources {
+		parsed := roleResource{}
+		if err := yaml.Unmarshal(r.Data, &parsed); err != nil {
+			return fmt.Errorf("%s: failed to parse role resource: %w", r.File, err)
+		}
+
+		roles[r.Name] = parsed
+	}
+
+	for _, r := range orderRoles(resources, roles) {
+		if _, ok := live[r.Name]; ok {
+			i.exists(r)
+			continue
+		}
+
+		parsed := roles[r.Name]
+		err := i.create(ctx, r, nil, func(ctx core.CommandContext) error {
+			permissions := []openapi_client.AuthorizationPermission{}
+			for _, p := range parsed.Spec.Permissions {
+				permission := openapi_client.AuthorizationPermission{}
+				permission.SetResource(p.Resource)
+				permission.SetAction(p.Action)
+				permission.SetDomainType(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)
+				permissions = append(permissions, permission)
+			}
+
+			metadata := openapi_client.RolesRoleMetadata{}
+			metadata.SetName(r.Name)
+			spec := openapi_client.RolesRoleSpec{}
+			spec.SetDisplayName(parsed.Spec.DisplayName)
+			spec.SetDescription(parsed.Spec.Description)
+			spec.SetPermissions(permissions)
+			if parsed.Spec.InheritedRole != "" {
+				inheritedMetadata := openapi_client.RolesRoleMetadata{}
+				inheritedMetadata.SetName(parsed.Spec.InheritedRole)
+				inherited := openapi_client.RolesRole{}
+				inherited.SetMetadata(inheritedMetadata)
+				spec.SetInheritedRole(inherited)
+			}
+
+			role := openapi_client.RolesRole{}
+			role.SetMetadata(metadata)
+			role.SetSpec(spec)
+
+			request := openapi_client.RolesCreateRoleRequest{}
+			request.SetRole(role)
+			request.SetDomainType(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)
+			request.SetDomainId(i.organizationID)
+			_, _, err := ctx.API.RolesAPI.RolesCreateRole(ctx.Context).Body(request).Execute()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+ * Roles inheriting from other roles in the bundle are created after them.
+ * Roles inheriting from roles outside the bundle keep their original order.
+ */
+func orderRoles(resources []resource, roles map[string]roleResource) []resource {
+	ordered := []resource{}
+	placed := map[string]struct{}{}
+	pending := resources
+	for len(pending) > 0 {
+		next := []resource{}
+		for _, r := range pending {
+			inherited := roles[r.Name].Spec.InheritedRole
+			_, inBundle := roles[inherited]
+			_, isPlaced := placed[inherited]
+			if inBundle && !isPlaced && inherited != r.Name {
+				next = append(next, r)
+				continue
+			}
+
+			ordered = append(ordered, r)
+			placed[r.Name] = struct{}{}
+		}
+
+		if len(next) == len(pending) {
+			return append(ordered, next...)
+		}
+
+		pending = next
+	}
+
+	return ordered
+}
+
+func (i *importer) importGroups(ctx core.CommandContext, resources []resource) error {
+	response, _, err := ctx.API.GroupsAPI.
+		GroupsListGroups(ctx.Context).
+		DomainType(string(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)).
+		DomainId(i.organizationID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	live := map[string]struct{}{}
+	for _, group := range response.GetGroups() {
+		metadata := group.GetMetadata()
+		live[metadata.GetName()] = struct{}{}
+	}
+
+	for _, r := range resources {
+		if _, ok := live[r.Name]; ok {
+			i.exists(r)
+			continue
+		}
+
+		parsed := groupResource{}
+		if err := yaml.Unmarshal(r.Data, &parsed); err != nil {
+			return fmt.Errorf("%s: failed to parse group resource: %w", r.File, err)
+		}
+
+		if parsed.Spec.Role == "" {
+			return fmt.Errorf("%s: group spec.role is required", r.File)
+		}
+
+		details := []string{fmt.Sprintf("+ role %s", parsed.Spec.Role)}
+		err := i.create(ctx, r, details, func(ctx core.CommandContext) error {
+			metadata := openapi_client.GroupsGroupMetadata{}
+			metadata.SetName(r.Name)
+			spec := openapi_client.GroupsGroupSpec{}
+			spec.SetRole(parsed.Spec.Role)
+			spec.SetDisplayName(parsed.Spec.DisplayName)
+			spec.SetDescription(parsed.Spec.Description)
+			group := openapi_client.GroupsGroup{}
+			group.SetMetadata(metadata)
+			group.SetSpec(spec)
+
+			request := openapi_client.GroupsCreateGroupRequest{}
+			request.SetGroup(group)
+			request.SetDomainType(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)
+			request.SetDomainId(i.organizationID)
+			_, _, err := ctx.API.GroupsAPI.GroupsCreateGroup(ctx.Context).Body(request).Execute()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *importer) importBlueprints(ctx core.CommandContext, resources []resource) error {
+	response, _, err := ctx.API.BlueprintAPI.BlueprintsListBlueprints(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	liveByName := map[string]string{}
+	for _, blueprint := range response.GetBlueprints() {
+		liveByName[blueprint.GetName()] = blueprint.GetId()
+	}
+
+	for _, r := range resources {
+		parsed, err := blueprintmodels.ParseBlueprint(r.Data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", r.File, err)
+		}
+
+		sourceID := parsed.Metadata.ID
+		if liveID, ok := liveByName[r.Name]; ok {
+			mapID(i.ids.blueprints, derefString(sourceID), liveID)
+			i.exists(r)
+			continue
+		}
+
+		blueprint := blueprintmodels.BlueprintFromBlueprint(*parsed)
+		blueprint.Id = nil
+		details := i.ids.remapNodes(blueprint.Nodes)
+
+		mapID(i.ids.blueprints, derefString(sourceID), derefString(sourceID))
+		err = i.create(ctx, r, details, func(ctx core.CommandContext) error {
+			request := openapi_client.BlueprintsCreateBlueprintRequest{}
+			request.SetBlueprint(blueprint)
+			created, _, err := ctx.API.BlueprintAPI.BlueprintsCreateBlueprint(ctx.Context).Body(request).Execute()
+			if err != nil {
+				return err
+			}
+
+			createdBlueprint := created.GetBlueprint()
+			mapID(i.ids.blueprints, derefString(sourceID), createdBlueprint.GetId())
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *importer) importCanvases(ctx core.CommandContext, resources []resource) error {
+	response, _, err := ctx.API.CanvasAPI.CanvasesListCanvases(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	live := map[string]struct{}{}
+	for _, canvas := range response.GetCanvases() {
+		metadata := canvas.GetMetadata()
+		live[metadata.GetName()] = struct{}{}
+	}
+
+	for _, r := range resources {
+		if _, ok := live[r.Name]; ok {
+			i.exists(r)
+			continue
+		}
+
+		parsed, err := canvasmodels.ParseCanvas(r.Data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", r.File, err)
+		}
+
+		if parsed.Spec == nil {
+			parsed.Spec = canvasmodels.EmptyCanvasSpec()
+		}
+
+		metadata := openapi_client.CanvasesCanvasMetadata{}
+		metadata.SetName(r.Name)
+		if parsed.Metadata.GetDescription() != "" {
+			metadata.SetDescription(parsed.Metadata.GetDescription())
+		}
+
+		parsed.Metadata = &metadata
+		canvas := canvasmodels.CanvasFromCanvas(*parsed)
+		details := i.ids.remapNodes(canvas.Spec.Nodes)
+		err = i.create(ctx, r, details, func(ctx core.CommandContext) error {
+			request := openapi_client.CanvasesCreateCanvasRequest{}
+			request.SetCanvas(canvas)
+			_, _, err := ctx.API.CanvasAPI.CanvasesCreateCanvas(ctx.Context).Body(request).Execute()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+ * Resources without an ID in the bundle were written by hand,
+ * so nothing in the bundle can reference them by ID.
+ */
+func mapID(ids map[string]string, sourceID string, targetID string) {
+	if sourceID == "" {
+		return
+	}
+
+	ids[sourceID] = targetID
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
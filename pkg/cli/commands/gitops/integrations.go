@@ -0,0 +1,236 @@
+package gitops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const redactedValue = "<redacted>"
+
+/*
+ * Integration configuration is kept in git, except for sensitive values,
+ * which are referenced as ${NAME} and read from the environment.
+ * Sensitive values are redacted by the API, so they are never reported as changed.
+ * When an integration is updated, unset sensitive values keep their current value.
+ */
+type integrationResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		ID   string `json:"id,omitempty"`
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		IntegrationName string         `json:"integrationName"`
+		Configuration   map[string]any `json:"configuration,omitempty"`
+	} `json:"spec"`
+}
+
+type integrationPlanner struct {
+	organizationID string
+}
+
+func (p *integrationPlanner) plan(ctx core.CommandContext, resources []resource, prune bool) ([]*change, error) {
+	response, _, err := ctx.API.OrganizationAPI.OrganizationsListIntegrations(ctx.Context, p.organizationID).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	liveByName := map[string]openapi_client.OrganizationsIntegration{}
+	for _, integration := range response.GetIntegrations() {
+		metadata := integration.GetMetadata()
+		liveByName[metadata.GetName()] = integration
+	}
+
+	changes := []*change{}
+	desired := map[string]struct{}{}
+	for _, r := range resources {
+		desired[r.Name] = struct{}{}
+
+		parsed := integrationResource{}
+		if err := yaml.Unmarshal(r.Data, &parsed); err != nil {
+			return nil, fmt.Errorf("%s: failed to parse integration resource: %w", r.File, err)
+		}
+
+		if parsed.Spec.IntegrationName == "" {
+			return nil, fmt.Errorf("%s: integration spec.integrationName is required", r.File)
+		}
+
+		live, exists := liveByName[r.Name]
+		if !exists {
+			configuration, missing := expandConfiguration(parsed.Spec.Configuration, nil)
+			if len(missing) > 0 {
+				return nil, fmt.Errorf("%s: environment variables not set: %s", r.File, strings.Join(missing, ", "))
+			}
+
+			changes = append(changes, p.createChange(r, parsed.Spec.IntegrationName, configuration))
+			continue
+		}
+
+		liveSpec := live.GetSpec()
+		if liveSpec.GetIntegrationName() != parsed.Spec.IntegrationName {
+			return nil, fmt.Errorf(
+				"%s: integration %q is a %s integration, and cannot be changed to %s",
+				r.File,
+				r.Name,
+				liveSpec.GetIntegrationName(),
+				parsed.Spec.IntegrationName,
+			)
+		}
+
+		liveConfiguration := liveSpec.GetConfiguration()
+		configuration, missing := expandConfiguration(parsed.Spec.Configuration, liveConfiguration)
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("%s: environment variables not set: %s", r.File, strings.Join(missing, ", "))
+		}
+
+		details, err := integrationConfigurationChanges(configuration, liveConfiguration)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.File, err)
+		}
+
+		if len(details) == 0 {
+			changes = append(changes, &change{Kind: r.Kind, Name: r.Name, File: r.File, Action: actionUnchanged})
+			continue
+		}
+
+		metadata := live.GetMetadata()
+		integrationID := metadata.GetId()
+		changes = append(changes, &change{
+			Kind:    r.Kind,
+			Name:    r.Name,
+			File:    r.File,
+			Action:  actionUpdate,
+			Details: details,
+			apply: func(ctx core.CommandContext) error {
+				body := openapi_client.OrganizationsUpdateIntegrationBody{}
+				body.SetConfiguration(configuration)
+				_, _, err := ctx.API.OrganizationAPI.
+					OrganizationsUpdateIntegration(ctx.Context, p.organizationID, integrationID).
+					Body(body).
+					Execute()
+				return err
+			},
+		})
+	}
+
+	if !prune {
+		return changes, nil
+	}
+
+	for _, integration := range response.GetIntegrations() {
+		metadata := integration.GetMetadata()
+		if _, ok := desired[metadata.GetName()]; ok {
+			continue
+		}
+
+		integrationID := metadata.GetId()
+		changes = append(changes, &change{
+			Kind:   IntegrationKind,
+			Name:   metadata.GetName(),
+			Action: actionDelete,
+			apply: func(ctx core.CommandContext) error {
+				_, _, err := ctx.API.OrganizationAPI.
+					OrganizationsDeleteIntegration(ctx.Context, p.organizationID, integrationID).
+					Execute()
+				return err
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+func (p *integrationPlanner) createChange(r resource, integrationName string, configuration map[string]any) *change {
+	return &change{
+		Kind:    r.Kind,
+		Name:    r.Name,
+		File:    r.File,
+		Action:  actionCreate,
+		Details: []string{fmt.Sprintf("+ %s integration", integrationName)},
+		apply: func(ctx core.CommandContext) error {
+			body := openapi_client.OrganizationsCreateIntegrationBody{}
+			body.SetName(r.Name)
+			body.SetIntegrationName(integrationName)
+			body.SetConfiguration(configuration)
+			_, _, err := ctx.API.OrganizationAPI.
+				OrganizationsCreateIntegration(ctx.Context, p.organizationID).
+				Body(body).
+				Execute()
+			return err
+		},
+	}
+}
+
+/*
+ * Expands ${NAME} references in top-level string values.
+ * If a referenced variable is not set, and the live value is redacted,
+ * the redacted placeholder is sent, so the API keeps the current value.
+ */
+func expandConfiguration(configuration map[string]any, live map[string]any) (map[string]any, []string) {
+	result := map[string]any{}
+	missing := []string{}
+	for _, key := range sortedKeys(configuration) {
+		value, ok := configuration[key].(string)
+		if !ok {
+			result[key] = configuration[key]
+			continue
+		}
+
+		expanded, missingVars := expandEnv(value)
+		if len(missingVars) == 0 {
+			result[key] = expanded
+			continue
+		}
+
+		if live[key] == redactedValue {
+			result[key] = redactedValue
+			continue
+		}
+
+		missing = append(missing, missingVars...)
+	}
+
+	return result, missing
+}
+
+func integrationConfigurationChanges(desired map[string]any, live map[string]any) ([]string, error) {
+	keys := map[string]struct{}{}
+	for key := range desired {
+		keys[key] = struct{}{}
+	}
+	for key := range live {
+		keys[key] = struct{}{}
+	}
+
+	details := []string{}
+	for _, key := range sortedKeys(keys) {
+		liveValue, hasLive := live[key]
+		desiredValue, hasDesired := desired[key]
+		if liveValue == redactedValue && hasDesired {
+			continue
+		}
+
+		switch {
+		case !hasLive:
+			details = append(details, fmt.Sprintf("+ configuration.%s", key))
+		case !hasDesired:
+			details = append(details, fmt.Sprintf("- configuration.%s", key))
+		default:
+			equal, err := jsonEqual(liveValue, desiredValue)
+			if err != nil {
+				return nil, err
+			}
+
+			if !equal {
+				details = append(details, fmt.Sprintf("~ configuration.%s", key))
+			}
+		}
+	}
+
+	return details, nil
+}
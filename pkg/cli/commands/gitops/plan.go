@@ -0,0 +1,179 @@
+package gitops
+
+import (
+	"fmt"
+	"io"
+
+	blueprintmodels "github.com/superplanehq/superplane/pkg/cli/commands/blueprints/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type changeAction string
+
+const (
+	actionCreate    changeAction = "create"
+	actionUpdate    changeAction = "update"
+	actionDelete    changeAction = "delete"
+	actionUnchanged changeAction = "unchanged"
+)
+
+type change struct {
+	Kind    string       `json:"kind"`
+	Name    string       `json:"name"`
+	File    string       `json:"file,omitempty"`
+	Action  changeAction `json:"action"`
+	Details []string     `json:"details,omitempty"`
+
+	apply func(ctx core.CommandContext) error
+}
+
+type plan struct {
+	Changes []*change `json:"changes"`
+	Summary summary   `json:"summary"`
+}
+
+type summary struct {
+	Create    int `json:"create"`
+	Update    int `json:"update"`
+	Delete    int `json:"delete"`
+	Unchanged int `json:"unchanged"`
+}
+
+/*
+ * A planner compares the resources of one kind against
+ * what is live in the organization, and returns the changes needed.
+ * With prune, live resources that are not in the files are deleted.
+ */
+type planner interface {
+	plan(ctx core.CommandContext, resources []resource, prune bool) ([]*change, error)
+}
+
+func newPlanner(kind string, organizationID string) planner {
+	switch kind {
+	case SecretKind:
+		return &secretPlanner{organizationID: organizationID}
+	case IntegrationKind:
+		return &integrationPlanner{organizationID: organizationID}
+	case blueprintmodels.BlueprintKind:
+		return &blueprintPlanner{}
+	default:
+		return &canvasPlanner{}
+	}
+}
+
+/*
+ * Only kinds present in the files are planned,
+ * so pruning never touches kinds that are not managed from git.
+ */
+func buildPlan(ctx core.CommandContext, resources []resource, prune bool) (*plan, error) {
+	organizationID := ""
+	if len(resourcesOfKind(resources, SecretKind))+len(resourcesOfKind(resources, IntegrationKind)) > 0 {
+		var err error
+		organizationID, err = currentOrganizationID(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &plan{Changes: []*change{}}
+	deletions := [][]*change{}
+	for _, kind := range supportedKinds {
+		kindResources := resourcesOfKind(resources, kind)
+		if len(kindResources) == 0 {
+			continue
+		}
+
+		changes, err := newPlanner(kind, organizationID).plan(ctx, kindResources, prune)
+		if err != nil {
+			return nil, err
+		}
+
+		kindDeletions := []*change{}
+		for _, c := range changes {
+			if c.Action == actionDelete {
+				kindDeletions = append(kindDeletions, c)
+				continue
+			}
+
+			result.Changes = append(result.Changes, c)
+		}
+
+		deletions = append(deletions, kindDeletions)
+	}
+
+	for i := len(deletions) - 1; i >= 0; i-- {
+		result.Changes = append(result.Changes, deletions[i]...)
+	}
+
+	for _, c := range result.Changes {
+		switch c.Action {
+		case actionCreate:
+			result.Summary.Create++
+		case actionUpdate:
+			result.Summary.Update++
+		case actionDelete:
+			result.Summary.Delete++
+		default:
+			result.Summary.Unchanged++
+		}
+	}
+
+	return result, nil
+}
+
+func currentOrganizationID(ctx core.CommandContext) (string, error) {
+	me, _, err := ctx.API.MeAPI.MeMe(ctx.Context).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	if me.GetOrganizationId() == "" {
+		return "", fmt.Errorf("organization id not found for authenticated user")
+	}
+
+	return me.GetOrganizationId(), nil
+}
+
+func (p *plan) hasChanges() bool {
+	return p.Summary.Create+p.Summary.Update+p.Summary.Delete > 0
+}
+
+func renderPlan(stdout io.Writer, p *plan, showUnchanged bool) error {
+	renderChanges(stdout, p.Changes, showUnchanged)
+	_, err := fmt.Fprintf(
+		stdout,
+		"%d to create, %d to update, %d to delete, %d unchanged\n",
+		p.Summary.Create,
+		p.Summary.Update,
+		p.Summary.Delete,
+		p.Summary.Unchanged,
+	)
+
+	return err
+}
+
+func renderChanges(stdout io.Writer, changes []*change, showUnchanged bool) {
+	for _, c := range changes {
+		if c.Action == actionUnchanged && !showUnchanged {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(stdout, "%s %s %s\n", actionSymbol(c.Action), c.Kind, c.Name)
+		for _, detail := range c.Details {
+			_, _ = fmt.Fprintf(stdout, "    %s\n", detail)
+		}
+	}
+}
+
+func actionSymbol(action changeAction) string {
+	switch action {
+	case actionCreate:
+		return "+"
+	case actionUpdate:
+		return "~"
+	case actionDelete:
+		return "-"
+	default:
+		return "="
+	}
+}
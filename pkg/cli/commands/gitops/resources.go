@@ -0,0 +1,234 @@
+package gitops
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	blueprintmodels "github.com/superplanehq/superplane/pkg/cli/commands/blueprints/models"
+	canvasmodels "github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+const (
+	SecretKind      = "Secret"
+	IntegrationKind = "Integration"
+)
+
+/*
+ * Kinds are reconciled in this order, so resources
+ * referenced by canvases exist before the canvases are saved.
+ * Deletions happen in the reverse order.
+ */
+var supportedKinds = []string{
+	SecretKind,
+	IntegrationKind,
+	blueprintmodels.BlueprintKind,
+	canvasmodels.CanvasKind,
+}
+
+type resource struct {
+	Kind string
+	Name string
+	File string
+	Data []byte
+}
+
+type resourceHeader struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+}
+
+/*
+ * Loads all resources from the given files and directories.
+ * Directories are walked recursively, and only .yaml and .yml files are loaded.
+ * A single file may hold multiple resources, separated by "---".
+ */
+func loadResources(paths []string) ([]resource, error) {
+	return loadResourcesOfKinds(paths, supportedKinds)
+}
+
+func loadResourcesOfKinds(paths []string, kinds []string) ([]resource, error) {
+	files, err := resolveResourceFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []resource{}
+	seen := map[string]string{}
+	for _, file := range files {
+		// #nosec
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read resource file: %w", err)
+		}
+
+		for _, document := range splitYamlDocuments(data) {
+			r, err := parseResource(file, document, kinds)
+			if err != nil {
+				return nil, err
+			}
+
+			key := r.Kind + "/" + r.Name
+			if previous, ok := seen[key]; ok {
+				return nil, fmt.Errorf("%s: %s %q is already defined in %s", file, r.Kind, r.Name, previous)
+			}
+
+			seen[key] = file
+			resources = append(resources, r)
+		}
+	}
+
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("no resources found in %s", strings.Join(paths, ", "))
+	}
+
+	return resources, nil
+}
+
+func resolveResourceFiles(paths []string) ([]string, error) {
+	files := []string{}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		dirFiles := []string{}
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() {
+				return nil
+			}
+
+			ext := strings.ToLower(filepath.Ext(p))
+			if ext == ".yaml" || ext == ".yml" {
+				dirFiles = append(dirFiles, p)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Strings(dirFiles)
+		files = append(files, dirFiles...)
+	}
+
+	return files, nil
+}
+
+func splitYamlDocuments(data []byte) [][]byte {
+	documents := [][]byte{}
+	current := bytes.Buffer{}
+
+	flush := func() {
+		if len(bytes.TrimSpace(current.Bytes())) > 0 {
+			documents = append(documents, append([]byte(nil), current.Bytes()...))
+		}
+		current.Reset()
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimRight(line, " \t") == "---" {
+			flush()
+			continue
+		}
+
+		current.WriteString(line)
+		current.WriteByte('\n')
+	}
+
+	flush()
+	return documents
+}
+
+func parseResource(file string, data []byte, kinds []string) (resource, error) {
+	apiVersion, kind, err := core.ParseYamlResourceHeaders(data)
+	if err != nil {
+		return resource{}, fmt.Errorf("%s: %w", file, err)
+	}
+
+	if apiVersion != core.APIVersion {
+		return resource{}, fmt.Errorf("%s: unsupported apiVersion %q", file, apiVersion)
+	}
+
+	if !isKindOf(kind, kinds) {
+		return resource{}, fmt.Errorf("%s: unsupported resource kind %q", file, kind)
+	}
+
+	header := resourceHeader{}
+	if err := yaml.Unmarshal(data, &header); err != nil {
+		return resource{}, fmt.Errorf("%s: failed to parse resource: %w", file, err)
+	}
+
+	name := strings.TrimSpace(header.Metadata.Name)
+	if name == "" {
+		return resource{}, fmt.Errorf("%s: %s metadata.name is required", file, kind)
+	}
+
+	return resource{Kind: kind, Name: name, File: file, Data: data}, nil
+}
+
+func isKindOf(kind string, kinds []string) bool {
+	for _, supported := range kinds {
+		if supported == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resourcesOfKind(resources []resource, kind string) []resource {
+	result := []resource{}
+	for _, r := range resources {
+		if r.Kind == kind {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
+
+var envReferencePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+/*
+ * Values for secrets and integrations are not kept in git.
+ * They are referenced as ${NAME}, and read from the environment on apply.
+ * Only the ${NAME} form is expanded, so other uses of "$" are left untouched.
+ */
+func expandEnv(value string) (string, []string) {
+	missing := []string{}
+	expanded := envReferencePattern.ReplaceAllStringFunc(value, func(match string) string {
+		name := envReferencePattern.FindStringSubmatch(match)[1]
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+
+		return v
+	})
+
+	return expanded, missing
+}
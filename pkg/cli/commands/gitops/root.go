@@ -0,0 +1,95 @@
+package gitops
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewApplyCommand(options core.BindOptions) *cobra.Command {
+	var files []string
+	var prune bool
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "apply -f <file-or-directory>",
+		Short: "Create or update resources from files",
+		Long: `Reconciles canvases, blueprints, secrets and integrations with the resources defined in files.
+Resources are matched by name. Missing resources are created, and changed ones are updated.
+With --prune, resources of the same kinds that are not in the files are deleted.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringSliceVarP(&files, "file", "f", nil, "filename or directory with resources to apply (repeatable)")
+	cmd.Flags().BoolVar(&prune, "prune", false, "delete resources that are not in the files")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only show what would change")
+	_ = cmd.MarkFlagRequired("file")
+	core.Bind(cmd, &applyCommand{files: &files, prune: &prune, dryRun: &dryRun}, options)
+
+	return cmd
+}
+
+func NewDiffCommand(options core.BindOptions) *cobra.Command {
+	var files []string
+	var prune bool
+	var exitCode bool
+
+	cmd := &cobra.Command{
+		Use:   "diff -f <file-or-directory>",
+		Short: "Show differences between files and live resources",
+		Long: `Compares canvases, blueprints, secrets and integrations defined in files with the live resources.
+For canvases, node, edge and configuration changes are shown against the live version.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringSliceVarP(&files, "file", "f", nil, "filename or directory with resources to compare (repeatable)")
+	cmd.Flags().BoolVar(&prune, "prune", false, "include resources that would be deleted with apply --prune")
+	cmd.Flags().BoolVar(&exitCode, "exit-code", false, "exit with an error if there are differences")
+	_ = cmd.MarkFlagRequired("file")
+	core.Bind(cmd, &diffCommand{files: &files, prune: &prune, exitCode: &exitCode}, options)
+
+	return cmd
+}
+
+func NewExportCommand(options core.BindOptions) *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "export -f <file>",
+		Short: "Export the organization into a bundle",
+		Long: `Writes the canvases, blueprints, custom roles, groups, integrations and secrets of the organization
+into a single bundle file, which can be imported into another organization.
+Canvases are exported from their live version.
+Credentials are not exported: secret values and sensitive integration fields are replaced by
+${NAME} references, which are read from the environment on import.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "file to write the bundle to")
+	_ = cmd.MarkFlagRequired("file")
+	core.Bind(cmd, &exportCommand{file: &file}, options)
+
+	return cmd
+}
+
+func NewImportCommand(options core.BindOptions) *cobra.Command {
+	var files []string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import -f <file>",
+		Short: "Import a bundle into the organization",
+		Long: `Creates the resources of a bundle written by export in the current organization.
+Resources that already exist are left untouched, and references between resources
+are updated to the IDs in this organization.
+Credentials are read from the environment variables referenced in the bundle;
+the ones that are not set are reported, and must be re-entered after the import.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringSliceVarP(&files, "file", "f", nil, "bundle file or directory to import (repeatable)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only show what would be created, and which credentials are missing")
+	_ = cmd.MarkFlagRequired("file")
+	core.Bind(cmd, &importCommand{files: &files, dryRun: &dryRun}, options)
+
+	return cmd
+}
@@ -0,0 +1,282 @@
+package gitops
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+/*
+ * Secrets are managed as references: the file lists the keys a secret must have.
+ * Values are never stored in git, and are only used to create missing keys.
+ * A key with a value like ${NAME} reads it from the environment;
+ * a key without a value must already exist.
+ * Live values cannot be read back, so existing keys are never overwritten.
+ */
+type secretResource struct {
+	APIVersion string                                `json:"apiVersion"`
+	Kind       string                                `json:"kind"`
+	Metadata   *openapi_client.SecretsSecretMetadata `json:"metadata,omitempty"`
+	Spec       *openapi_client.SecretsSecretSpec     `json:"spec,omitempty"`
+}
+
+type secretPlanner struct {
+	organizationID string
+}
+
+func (p *secretPlanner) plan(ctx core.CommandContext, resources []resource, prune bool) ([]*change, error) {
+	response, _, err := ctx.API.SecretAPI.
+		SecretsListSecrets(ctx.Context).
+		DomainType(string(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)).
+		DomainId(p.organizationID).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	liveByName := map[string]openapi_client.SecretsSecret{}
+	for _, secret := range response.GetSecrets() {
+		metadata := secret.GetMetadata()
+		liveByName[metadata.GetName()] = secret
+	}
+
+	changes := []*change{}
+	desired := map[string]struct{}{}
+	for _, r := range resources {
+		desired[r.Name] = struct{}{}
+
+		values, err := parseSecretValues(r.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.File, err)
+		}
+
+		live, exists := liveByName[r.Name]
+		if !exists {
+			c, err := p.createChange(r, values)
+			if err != nil {
+				return nil, err
+			}
+
+			changes = append(changes, c)
+			continue
+		}
+
+		liveKeys := []string{}
+		liveSpec := live.GetSpec()
+		if local, ok := liveSpec.GetLocalOk(); ok {
+			for key := range local.GetData() {
+				liveKeys = append(liveKeys, key)
+			}
+		}
+
+		keyChanges, err := secretKeyChanges(values, liveKeys, prune)
+		if err != nil {
+			return nil, fmt.Errorf("%s: secret %q %w", r.File, r.Name, err)
+		}
+
+		if len(keyChanges.added) == 0 && len(keyChanges.removed) == 0 {
+			changes = append(changes, &change{Kind: r.Kind, Name: r.Name, File: r.File, Action: actionUnchanged})
+			continue
+		}
+
+		changes = append(changes, p.updateChange(r, values, keyChanges))
+	}
+
+	if !prune {
+		return changes, nil
+	}
+
+	for _, secret := range response.GetSecrets() {
+		metadata := secret.GetMetadata()
+		if _, ok := desired[metadata.GetName()]; ok {
+			continue
+		}
+
+		name := metadata.GetName()
+		changes = append(changes, &change{
+			Kind:   SecretKind,
+			Name:   name,
+			Action: actionDelete,
+			apply: func(ctx core.CommandContext) error {
+				_, _, err := ctx.API.SecretAPI.
+					SecretsDeleteSecret(ctx.Context, name).
+					DomainType(string(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)).
+					DomainId(p.organizationID).
+					Execute()
+				return err
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+func (p *secretPlanner) createChange(r resource, values map[string]string) (*change, error) {
+	keys := sortedKeys(values)
+	details := []string{}
+	for _, key := range keys {
+		if values[key] == "" {
+			return nil, fmt.Errorf("%s: secret %q does not exist, and key %q has no value", r.File, r.Name, key)
+		}
+
+		details = append(details, fmt.Sprintf("+ key %s", key))
+	}
+
+	return &change{
+		Kind:    r.Kind,
+		Name:    r.Name,
+		File:    r.File,
+		Action:  actionCreate,
+		Details: details,
+		apply: func(ctx core.CommandContext) error {
+			data := map[string]string{}
+			for key, value := range values {
+				data[key] = value
+			}
+
+			metadata := openapi_client.SecretsSecretMetadata{}
+			metadata.SetName(r.Name)
+			local := openapi_client.SecretLocal{}
+			local.SetData(data)
+			spec := openapi_client.SecretsSecretSpec{}
+			spec.SetProvider(openapi_client.SECRETPROVIDER_PROVIDER_LOCAL)
+			spec.SetLocal(local)
+			secret := openapi_client.SecretsSecret{}
+			secret.SetMetadata(metadata)
+			secret.SetSpec(spec)
+
+			request := openapi_client.SecretsCreateSecretRequest{}
+			request.SetSecret(secret)
+			request.SetDomainType(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)
+			request.SetDomainId(p.organizationID)
+			_, _, err := ctx.API.SecretAPI.SecretsCreateSecret(ctx.Context).Body(request).Execute()
+			return err
+		},
+	}, nil
+}
+
+func (p *secretPlanner) updateChange(r resource, values map[string]string, keyChanges secretKeyChangeSet) *change {
+	details := []string{}
+	for _, key := range keyChanges.added {
+		details = append(details, fmt.Sprintf("+ key %s", key))
+	}
+	for _, key := range keyChanges.removed {
+		details = append(details, fmt.Sprintf("- key %s", key))
+	}
+
+	return &change{
+		Kind:    r.Kind,
+		Name:    r.Name,
+		File:    r.File,
+		Action:  actionUpdate,
+		Details: details,
+		apply: func(ctx core.CommandContext) error {
+			for _, key := range keyChanges.added {
+				body := openapi_client.SecretsSetSecretKeyBody{}
+				body.SetValue(values[key])
+				body.SetDomainType(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)
+				body.SetDomainId(p.organizationID)
+				_, _, err := ctx.API.SecretAPI.SecretsSetSecretKey(ctx.Context, r.Name, key).Body(body).Execute()
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, key := range keyChanges.removed {
+				_, _, err := ctx.API.SecretAPI.
+					SecretsDeleteSecretKey(ctx.Context, r.Name, key).
+					DomainType(string(openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION)).
+					DomainId(p.organizationID).
+					Execute()
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+type secretKeyChangeSet struct {
+	added   []string
+	removed []string
+}
+
+/*
+ * Keys in the file but not live are added, and need a value.
+ * With prune, live keys that are not in the file are removed.
+ */
+func secretKeyChanges(values map[string]string, liveKeys []string, prune bool) (secretKeyChangeSet, error) {
+	result := secretKeyChangeSet{added: []string{}, removed: []string{}}
+	live := map[string]struct{}{}
+	for _, key := range liveKeys {
+		live[key] = struct{}{}
+	}
+
+	for _, key := range sortedKeys(values) {
+		if _, ok := live[key]; ok {
+			continue
+		}
+
+		if values[key] == "" {
+			return result, fmt.Errorf("is missing key %q, and no value was given for it", key)
+		}
+
+		result.added = append(result.added, key)
+	}
+
+	if !prune {
+		return result, nil
+	}
+
+	sort.Strings(liveKeys)
+	for _, key := range liveKeys {
+		if _, ok := values[key]; !ok {
+			result.removed = append(result.removed, key)
+		}
+	}
+
+	return result, nil
+}
+
+func parseSecretValues(data []byte) (map[string]string, error) {
+	r := secretResource{}
+	if err := yaml.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to parse secret resource: %w", err)
+	}
+
+	values := map[string]string{}
+	if r.Spec == nil {
+		return values, nil
+	}
+
+	if provider := r.Spec.GetProvider(); provider != "" && provider != openapi_client.SECRETPROVIDER_PROVIDER_LOCAL {
+		return nil, fmt.Errorf("unsupported secret provider %q", provider)
+	}
+
+	local := r.Spec.GetLocal()
+	for key, value := range local.GetData() {
+		expanded, missing := expandEnv(value)
+		if len(missing) > 0 {
+			expanded = ""
+		}
+
+		values[key] = expanded
+	}
+
+	return values, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -25,10 +25,6 @@ func normalizeContext(context ConfigContext) ConfigContext {
 	context.Organization = strings.TrimSpace(context.Organization)
 	context.APIToken = strings.TrimSpace(context.APIToken)
 
-	if context.Canvas != nil {
-		context.Canvas = context.Canvas
-	}
-
 	return context
 }
 
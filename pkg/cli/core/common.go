@@ -33,6 +33,33 @@ func ParseYamlResourceHeaders(raw []byte) (string, string, error) {
 	return apiVersion, kind, nil
 }
 
+// ParseKeyValueFlags parses repeated key=value flags.
+// parseValue converts each value before it is stored,
+// and values are kept as strings when it is nil.
+func ParseKeyValueFlags(values []string, parseValue func(string) any) (map[string]any, error) {
+	params := map[string]any{}
+	for _, value := range values {
+		key, v, ok := strings.Cut(value, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid parameter %q: expected key=value", value)
+		}
+
+		if _, exists := params[key]; exists {
+			return nil, fmt.Errorf("parameter %q specified more than once", key)
+		}
+
+		if parseValue == nil {
+			params[key] = v
+			continue
+		}
+
+		params[key] = parseValue(v)
+	}
+
+	return params, nil
+}
+
 func ParseIntegrationScopedName(name string) (string, string, bool) {
 	integrationName, resourceName, hasDot := strings.Cut(name, ".")
 	if !hasDot || integrationName == "" || resourceName == "" {
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseKeyValueFlags(t *testing.T) {
+	params, err := ParseKeyValueFlags([]string{"environment=production", "replicas=3", "message=a=b", "empty="}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]any{
+		"environment": "production",
+		"replicas":    "3",
+		"message":     "a=b",
+		"empty":       "",
+	}
+	if !reflect.DeepEqual(params, expected) {
+		t.Fatalf("expected %v, got %v", expected, params)
+	}
+
+	params, err = ParseKeyValueFlags([]string{"replicas=3"}, func(value string) any { return len(value) })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(params, map[string]any{"replicas": 1}) {
+		t.Fatalf("expected parsed value, got %v", params)
+	}
+
+	if _, err := ParseKeyValueFlags([]string{"environment"}, nil); err == nil {
+		t.Fatalf("expected error for parameter without value")
+	}
+
+	if _, err := ParseKeyValueFlags([]string{"=production"}, nil); err == nil {
+		t.Fatalf("expected error for parameter without key")
+	}
+
+	if _, err := ParseKeyValueFlags([]string{"a=1", "a=2"}, nil); err == nil {
+		t.Fatalf("expected error for duplicated parameter")
+	}
+}
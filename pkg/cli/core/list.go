@@ -0,0 +1,102 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+/*
+ * Filtering, ordering and pagination flags
+ * shared by the commands listing events and executions.
+ */
+type ListFlags struct {
+	CreatedAfter  *string
+	CreatedBefore *string
+	Order         *string
+	PageToken     *string
+}
+
+type listRequest[T any] interface {
+	CreatedAfter(time.Time) T
+	CreatedBefore(time.Time) T
+	Order(string) T
+	PageToken(string) T
+}
+
+func BindListFlags(cmd *cobra.Command) ListFlags {
+	flags := ListFlags{
+		CreatedAfter:  new(string),
+		CreatedBefore: new(string),
+		Order:         new(string),
+		PageToken:     new(string),
+	}
+
+	cmd.Flags().StringVar(flags.CreatedAfter, "created-after", "", "only items created at or after this time (RFC3339, or a duration ago, e.g. 168h)")
+	cmd.Flags().StringVar(flags.CreatedBefore, "created-before", "", "only items created before this time (RFC3339, or a duration ago, e.g. 24h)")
+	cmd.Flags().StringVar(flags.Order, "order", "desc", "order by creation time: asc or desc")
+	cmd.Flags().StringVar(flags.PageToken, "page-token", "", "token of the page to return, from a previous list")
+
+	return flags
+}
+
+func ApplyListFlags[T listRequest[T]](request T, flags ListFlags) (T, error) {
+	if value := strings.TrimSpace(*flags.CreatedAfter); value != "" {
+		createdAfter, err := ParseTimeFlag("created-after", value)
+		if err != nil {
+			return request, err
+		}
+		request = request.CreatedAfter(createdAfter)
+	}
+
+	if value := strings.TrimSpace(*flags.CreatedBefore); value != "" {
+		createdBefore, err := ParseTimeFlag("created-before", value)
+		if err != nil {
+			return request, err
+		}
+		request = request.CreatedBefore(createdBefore)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(*flags.Order)) {
+	case "", "desc":
+	case "asc":
+		request = request.Order("LIST_ORDER_ASC")
+	default:
+		return request, fmt.Errorf("invalid --order value %q: expected asc or desc", *flags.Order)
+	}
+
+	if value := strings.TrimSpace(*flags.PageToken); value != "" {
+		request = request.PageToken(value)
+	}
+
+	return request, nil
+}
+
+func PrintNextPage(stdout io.Writer, totalCount int64, nextPageToken string) error {
+	if nextPageToken == "" {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(stdout, "\n%d items in total. Next page: --page-token %s\n", totalCount, nextPageToken)
+	return err
+}
+
+/*
+ * Accepts an RFC3339 timestamp,
+ * or a duration, which is subtracted from the current time.
+ */
+func ParseTimeFlag(flag, value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return time.Time{}, fmt.Errorf("invalid --%s value %q: expected RFC3339 timestamp or duration", flag, value)
+	}
+
+	return time.Now().Add(-duration), nil
+}
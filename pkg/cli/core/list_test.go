@@ -0,0 +1,30 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimeFlag(t *testing.T) {
+	parsed, err := ParseTimeFlag("created-after", "2026-10-12T00:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !parsed.Equal(time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected time %s", parsed)
+	}
+
+	parsed, err = ParseTimeFlag("created-after", "168h")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ago := time.Since(parsed); ago < 168*time.Hour || ago > 169*time.Hour {
+		t.Fatalf("expected a week ago, got %s", parsed)
+	}
+
+	for _, value := range []string{"yesterday", "-1h"} {
+		if _, err := ParseTimeFlag("created-after", value); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
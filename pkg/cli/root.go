@@ -10,9 +10,12 @@ import (
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	access "github.com/superplanehq/superplane/pkg/cli/commands/access"
+	blueprints "github.com/superplanehq/superplane/pkg/cli/commands/blueprints"
 	canvases "github.com/superplanehq/superplane/pkg/cli/commands/canvases"
 	events "github.com/superplanehq/superplane/pkg/cli/commands/events"
 	executions "github.com/superplanehq/superplane/pkg/cli/commands/executions"
+	gitops "github.com/superplanehq/superplane/pkg/cli/commands/gitops"
 	index "github.com/superplanehq/superplane/pkg/cli/commands/index"
 	integrations "github.com/superplanehq/superplane/pkg/cli/commands/integrations"
 	queue "github.com/superplanehq/superplane/pkg/cli/commands/queue"
@@ -52,12 +55,25 @@ func init() {
 
 	options := defaultBindOptions()
 	RootCmd.AddCommand(canvases.NewCommand(options))
+	RootCmd.AddCommand(canvases.NewChangeRequestsCommand(options))
+	RootCmd.AddCommand(blueprints.NewCommand(options))
 	RootCmd.AddCommand(executions.NewCommand(options))
 	RootCmd.AddCommand(events.NewCommand(options))
 	RootCmd.AddCommand(index.NewCommand(options))
 	RootCmd.AddCommand(integrations.NewCommand(options))
 	RootCmd.AddCommand(queue.NewCommand(options))
 	RootCmd.AddCommand(secrets.NewCommand(options))
+	RootCmd.AddCommand(access.NewRolesCommand(options))
+	RootCmd.AddCommand(access.NewGroupsCommand(options))
+	RootCmd.AddCommand(access.NewUsersCommand(options))
+	RootCmd.AddCommand(access.NewServiceAccountsCommand(options))
+	RootCmd.AddCommand(access.NewTokensCommand(options))
+	RootCmd.AddCommand(access.NewSSOCommand(options))
+	RootCmd.AddCommand(access.NewSCIMCommand(options))
+	RootCmd.AddCommand(gitops.NewApplyCommand(options))
+	RootCmd.AddCommand(gitops.NewDiffCommand(options))
+	RootCmd.AddCommand(gitops.NewExportCommand(options))
+	RootCmd.AddCommand(gitops.NewImportCommand(options))
 }
 
 func initConfig() {
@@ -322,7 +322,7 @@ func (a *Approval) Documentation() string {
   - **Any user**: Any authenticated user can approve
   - **Specific user**: Only the specified user can approve
   - **Group**: Any member of the specified group can approve
-  - **Role**: Any user with the specified role can approve
+  - **Role**: Any user with the specified role can approve. Canvas roles, such as canvas_operator, apply to the canvas of the approval
 
 ## Output Channels
 
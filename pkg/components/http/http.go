@@ -40,6 +40,7 @@ type Spec struct {
 	URL             string      `json:"url"`
 	QueryParams     *[]KeyValue `json:"queryParams,omitempty"`
 	Headers         *[]Header   `json:"headers,omitempty"`
+	Identity        *string     `json:"identity,omitempty"`
 	ContentType     *string     `json:"contentType,omitempty"`
 	JSON            *any        `json:"json,omitempty"`
 	XML             *string     `json:"xml,omitempty"`
@@ -96,12 +97,20 @@ func (e *HTTP) Documentation() string {
 - **Method**: HTTP method to use
 - **Query Parameters**: Optional URL query parameters
 - **Headers**: Custom HTTP headers (header names cannot use expressions)
+- **Workload Identity**: Send a short-lived OIDC token for the configured audience in the Authorization header
 - **Body**: Request body in various formats:
   - **JSON**: Structured JSON payload
   - **Form Data**: URL-encoded form data
   - **Plain Text**: Raw text content
   - **XML**: XML formatted content
 
+## Workload Identity
+
+When enabled, each request carries a short-lived OIDC token signed by SuperPlane as a bearer token.
+The token audience is the configured value, and its claims identify the canvas, node and execution making the request.
+Services that trust SuperPlane as an OIDC issuer, such as Vault, can verify it using the published keys, without static secrets.
+Tokens carry a ` + "`token_use`" + ` claim set to ` + "`workload`" + `, and audiences reserved for integration tokens, such as integration IDs and GCP workload identity provider names, are refused.
+
 ## Response Handling
 
 The component emits the response with:
@@ -146,6 +155,10 @@ func (e *HTTP) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("method is required")
 	}
 
+	if spec.Identity != nil && strings.TrimSpace(*spec.Identity) == "" {
+		return fmt.Errorf("identity audience is required")
+	}
+
 	if spec.ContentType == nil {
 		return nil
 	}
@@ -273,6 +286,15 @@ func (e *HTTP) Configuration() []configuration.Field {
 			},
 			Default: "[{\"name\": \"X-Foo\", \"value\": \"Bar\"}]",
 		},
+		{
+			Name:        "identity",
+			Label:       "Workload Identity",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Audience of a short-lived OIDC token sent in the Authorization header",
+			Placeholder: "https://vault.example.com",
+		},
 		{
 			Name:        "contentType",
 			Label:       "Body",
@@ -494,8 +516,29 @@ func (e *HTTP) Execute(ctx core.ExecutionContext) error {
 func (e *HTTP) executeHTTPRequest(ctx core.ExecutionContext, spec Spec, retryMetadata RetryMetadata) error {
 	currentTimeout := e.calculateTimeoutForAttempt(retryMetadata.TimeoutStrategy, retryMetadata.TimeoutSeconds, retryMetadata.Attempt)
 
-	resp, err := e.executeRequest(ctx.HTTP, spec, currentTimeout)
+	//
+	// Identity tokens are short-lived, so a new one is minted for every attempt.
+	//
+	identityToken := ""
+	if spec.Identity != nil {
+		token, err := e.identityToken(ctx.Identity, *spec.Identity)
+		if err != nil {
+			return e.handleRequestError(ctx, err, retryMetadata.Attempt+1)
+		}
+
+		identityToken = token
+	}
+
+	resp, err := e.executeRequest(ctx.Context, ctx.HTTP, spec, identityToken, currentTimeout)
 	if err != nil {
+		//
+		// If the execution was interrupted, the error is returned
+		// instead of retrying or failing, so the execution is processed again later.
+		//
+		if ctx.Context != nil && ctx.Context.Err() != nil {
+			return err
+		}
+
 		if retryMetadata.Attempt < retryMetadata.MaxRetries {
 			return e.scheduleRetry(ctx, err.Error(), retryMetadata)
 		}
@@ -557,6 +600,7 @@ func (e *HTTP) handleRetryRequest(ctx core.ActionContext) error {
 		Requests:       ctx.Requests,
 		Auth:           ctx.Auth,
 		HTTP:           ctx.HTTP,
+		Identity:       ctx.Identity,
 	}
 
 	return e.executeHTTPRequest(execCtx, spec, retryMetadata)
@@ -578,7 +622,20 @@ func (e *HTTP) calculateTimeoutForAttempt(strategy string, timeoutSeconds int, a
 	return baseTimeout
 }
 
-func (e *HTTP) executeRequest(httpCtx core.HTTPContext, spec Spec, timeout time.Duration) (*http.Response, error) {
+func (e *HTTP) identityToken(identity core.IdentityContext, audience string) (string, error) {
+	if identity == nil {
+		return "", fmt.Errorf("workload identity is not available")
+	}
+
+	token, err := identity.Token(audience, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate identity token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (e *HTTP) executeRequest(parent context.Context, httpCtx core.HTTPContext, spec Spec, identityToken string, timeout time.Duration) (*http.Response, error) {
 	var body io.Reader
 	var contentType string
 	var err error
@@ -589,7 +646,11 @@ func (e *HTTP) executeRequest(httpCtx core.HTTPContext, spec Spec, timeout time.
 		}
 	}
 
-	reqCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	if parent == nil {
+		parent = context.Background()
+	}
+
+	reqCtx, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
 
 	requestURL := spec.URL
@@ -623,6 +684,10 @@ func (e *HTTP) executeRequest(httpCtx core.HTTPContext, spec Spec, timeout time.
 		}
 	}
 
+	if identityToken != "" {
+		req.Header.Set("Authorization", "Bearer "+identityToken)
+	}
+
 	resp, err := httpCtx.Do(req)
 	if err != nil {
 		if reqCtx.Err() == context.DeadlineExceeded {
@@ -192,6 +192,15 @@ func TestHTTP__Setup__ValidationErrors(t *testing.T) {
 			},
 			expectErr: "form data is required",
 		},
+		{
+			name: "empty identity audience",
+			config: map[string]any{
+				"method":   "GET",
+				"url":      "https://api.example.com",
+				"identity": " ",
+			},
+			expectErr: "identity audience is required",
+		},
 	}
 
 	for _, tt := range tests {
@@ -516,6 +525,52 @@ func TestHTTP__Execute__WithCustomHeaders(t *testing.T) {
 	assert.True(t, stateCtx.Passed)
 }
 
+func TestHTTP__Execute__WithIdentityToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer identity-token-for-https://vault.example.com", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	h := &HTTP{}
+	ctx, stateCtx, _ := createExecutionContext(map[string]any{
+		"method":   "GET",
+		"url":      server.URL,
+		"identity": "https://vault.example.com",
+	})
+
+	identityCtx := &contexts.IdentityContext{}
+	ctx.Identity = identityCtx
+
+	err := h.Execute(ctx)
+	require.NoError(t, err)
+	assert.True(t, stateCtx.Passed)
+	require.Len(t, identityCtx.Tokens, 1)
+	assert.Equal(t, "https://vault.example.com", identityCtx.Tokens[0].Audience)
+}
+
+func TestHTTP__Execute__WithIdentityTokenUnavailable(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	h := &HTTP{}
+	ctx, stateCtx, _ := createExecutionContext(map[string]any{
+		"method":   "GET",
+		"url":      server.URL,
+		"identity": "https://vault.example.com",
+	})
+
+	err := h.Execute(ctx)
+	require.NoError(t, err)
+	assert.False(t, stateCtx.Passed)
+	assert.Contains(t, stateCtx.FailureMessage, "workload identity is not available")
+	assert.Equal(t, int32(0), requests.Load())
+}
+
 func TestHTTP__Execute__HeadersOverrideContentType(t *testing.T) {
 	//
 	// Create test server
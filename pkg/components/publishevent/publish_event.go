@@ -0,0 +1,243 @@
+package publishevent
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/triggers/onevent"
+)
+
+const ComponentName = "publishEvent"
+const PayloadType = "event.published"
+
+func init() {
+	registry.RegisterComponent(ComponentName, &PublishEvent{})
+}
+
+type PublishEvent struct{}
+
+type Spec struct {
+	Topic     string      `json:"topic"`
+	EventType string      `json:"eventType" mapstructure:"eventType"`
+	DataList  []ValuePair `json:"dataList,omitempty" mapstructure:"dataList"`
+}
+
+type ValuePair struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+func (c *PublishEvent) Name() string {
+	return ComponentName
+}
+
+func (c *PublishEvent) Label() string {
+	return "Publish Event"
+}
+
+func (c *PublishEvent) Description() string {
+	return "Publish an event to an organization-level topic"
+}
+
+func (c *PublishEvent) Documentation() string {
+	return `The Publish Event component publishes an event to an organization-level topic. Every canvas in the organization with an On Event trigger subscribed to the topic receives it.
+
+## Use Cases
+
+- **Release fan-out**: Drive many per-service deploy canvases from a single release canvas
+- **Cross-canvas handoffs**: Continue a process in another canvas once this one reaches a given point
+- **Notifications between teams**: Let other canvases react to what happens in this one
+
+## How It Works
+
+1. Builds the event data from the configured fields
+2. Delivers the event to all the On Event triggers subscribed to the topic
+3. Each subscriber evaluates its own filter, and starts an execution if the event matches
+4. Emits ` + "`event.published`" + ` with the event and the number of subscribers it was delivered to
+
+Publishing to a topic without subscribers is not an error.`
+}
+
+func (c *PublishEvent) Icon() string {
+	return "radio-tower"
+}
+
+func (c *PublishEvent) Color() string {
+	return "purple"
+}
+
+func (c *PublishEvent) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *PublishEvent) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "topic",
+			Label:       "Topic",
+			Type:        configuration.FieldTypeString,
+			Description: "Topic to publish the event to",
+			Placeholder: "releases",
+			Required:    true,
+		},
+		{
+			Name:        "eventType",
+			Label:       "Event Type",
+			Type:        configuration.FieldTypeString,
+			Description: "Name of the event, used by subscribers to tell events apart",
+			Placeholder: "release.created",
+			Required:    true,
+		},
+		{
+			Name:        "dataList",
+			Label:       "Data",
+			Type:        configuration.FieldTypeList,
+			Description: "Fields included in the event data",
+			Required:    false,
+			Togglable:   true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Field",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:        "name",
+								Label:       "Field Name",
+								Type:        configuration.FieldTypeString,
+								Description: "Event data field name",
+								Required:    true,
+							},
+							{
+								Name:        "value",
+								Label:       "Field Value",
+								Type:        configuration.FieldTypeExpression,
+								Description: "Event data field value (can be expression)",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *PublishEvent) Setup(ctx core.SetupContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	//
+	// The topic might only be known at runtime.
+	//
+	if !strings.Contains(spec.Topic, "{{") {
+		err = onevent.ValidateTopic(spec.Topic)
+		if err != nil {
+			return err
+		}
+	}
+
+	if spec.EventType == "" {
+		return fmt.Errorf("event type is required")
+	}
+
+	return nil
+}
+
+func (c *PublishEvent) Execute(ctx core.ExecutionContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	if spec.EventType == "" {
+		return fmt.Errorf("event type is required")
+	}
+
+	data := buildData(spec.DataList)
+	subscribers, err := ctx.EventBus.Publish(spec.Topic, spec.EventType, data)
+	if err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	err = ctx.Metadata.Set(map[string]any{
+		"topic":       spec.Topic,
+		"eventType":   spec.EventType,
+		"subscribers": subscribers,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to set execution metadata: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		PayloadType,
+		[]any{
+			map[string]any{
+				"topic":       spec.Topic,
+				"type":        spec.EventType,
+				"data":        data,
+				"subscribers": subscribers,
+			},
+		},
+	)
+}
+
+func decodeSpec(configuration any) (*Spec, error) {
+	spec := Spec{}
+	err := mapstructure.Decode(configuration, &spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	spec.Topic = strings.TrimSpace(spec.Topic)
+	spec.EventType = strings.TrimSpace(spec.EventType)
+	return &spec, nil
+}
+
+func buildData(pairs []ValuePair) map[string]any {
+	data := make(map[string]any, len(pairs))
+	for _, pair := range pairs {
+		name := strings.TrimSpace(pair.Name)
+		if name == "" {
+			continue
+		}
+
+		data[name] = pair.Value
+	}
+
+	return data
+}
+
+func (c *PublishEvent) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *PublishEvent) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *PublishEvent) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("publishEvent does not support actions")
+}
+
+func (c *PublishEvent) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *PublishEvent) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (c *PublishEvent) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
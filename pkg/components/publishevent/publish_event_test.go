@@ -0,0 +1,114 @@
+package publishevent
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+type eventBusContext struct {
+	topic       string
+	eventType   string
+	data        any
+	subscribers int
+	err         error
+}
+
+func (c *eventBusContext) Publish(topic, eventType string, data any) (int, error) {
+	c.topic = topic
+	c.eventType = eventType
+	c.data = data
+	return c.subscribers, c.err
+}
+
+func TestPublishEventSetup(t *testing.T) {
+	component := &PublishEvent{}
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"topic": "releases", "eventType": "release.created"},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("topic with expression is validated at runtime", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"topic": "releases/{{ $.service }}", "eventType": "release.created"},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid topic", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"topic": "not a topic", "eventType": "release.created"},
+		})
+
+		require.ErrorContains(t, err, "invalid topic")
+	})
+
+	t.Run("missing event type", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"topic": "releases"},
+		})
+
+		require.ErrorContains(t, err, "event type is required")
+	})
+}
+
+func TestPublishEventExecute(t *testing.T) {
+	t.Run("publishes event and emits payload", func(t *testing.T) {
+		component := &PublishEvent{}
+		execState := &contexts.ExecutionStateContext{}
+		metadata := &contexts.MetadataContext{}
+		eventBus := &eventBusContext{subscribers: 2}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"topic":     " releases ",
+				"eventType": "release.created",
+				"dataList": []map[string]any{
+					{"name": "version", "value": "v1.4.0"},
+					{"name": " ", "value": "ignored"},
+				},
+			},
+			Metadata:       metadata,
+			EventBus:       eventBus,
+			ExecutionState: execState,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "releases", eventBus.topic)
+		assert.Equal(t, "release.created", eventBus.eventType)
+		assert.Equal(t, map[string]any{"version": "v1.4.0"}, eventBus.data)
+		assert.Equal(t, map[string]any{"topic": "releases", "eventType": "release.created", "subscribers": 2}, metadata.Get())
+
+		assert.True(t, execState.Passed)
+		assert.Equal(t, "default", execState.Channel)
+		assert.Equal(t, PayloadType, execState.Type)
+		require.Len(t, execState.Payloads, 1)
+		payload := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, 2, payload["subscribers"])
+		assert.Equal(t, "release.created", payload["type"])
+	})
+
+	t.Run("publish error fails execution", func(t *testing.T) {
+		component := &PublishEvent{}
+		execState := &contexts.ExecutionStateContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"topic": "releases", "eventType": "release.created"},
+			Metadata:       &contexts.MetadataContext{},
+			EventBus:       &eventBusContext{err: fmt.Errorf("invalid topic")},
+			ExecutionState: execState,
+		})
+
+		require.ErrorContains(t, err, "failed to publish event")
+		assert.False(t, execState.Passed)
+	})
+}
@@ -2,6 +2,7 @@ package ssh
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"strings"
@@ -138,7 +139,9 @@ func parseSigner(keyBytes []byte, passphrase []byte) (ssh.Signer, error) {
 	return ssh.ParsePrivateKey(keyBytes)
 }
 
-func (c *Client) ExecuteCommand(command string, timeout time.Duration) (*CommandResult, error) {
+// ExecuteCommand runs a command in a new session.
+// If ctx is canceled before the command finishes, the session is closed and ctx's error is returned.
+func (c *Client) ExecuteCommand(ctx context.Context, command string, timeout time.Duration) (*CommandResult, error) {
 	conn, err := c.Connect()
 	if err != nil {
 		return nil, err
@@ -161,7 +164,25 @@ func (c *Client) ExecuteCommand(command string, timeout time.Duration) (*Command
 		}()
 	}
 
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = session.Close()
+		case <-done:
+		}
+	}()
+
 	err = session.Run(command)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
 	exitCode := 0
 	if err != nil {
 		if exitError, ok := err.(*ssh.ExitError); ok {
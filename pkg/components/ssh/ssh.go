@@ -1,6 +1,7 @@
 package ssh
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -19,6 +20,11 @@ const (
 	channelFailed  = "failed"
 )
 
+/*
+ * Environment variable exposing the workload identity token to the command.
+ */
+const IdentityTokenEnvironmentVariable = "SUPERPLANE_IDENTITY_TOKEN"
+
 var environmentVariableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
 
 func init() {
@@ -53,6 +59,7 @@ type Spec struct {
 	Command          string                `json:"command" mapstructure:"command"`
 	WorkingDirectory string                `json:"workingDirectory,omitempty" mapstructure:"workingDirectory"`
 	Environment      []EnvironmentVariable `json:"environment,omitempty" mapstructure:"environment"`
+	Identity         string                `json:"identity,omitempty" mapstructure:"identity"`
 	Timeout          int                   `json:"timeout" mapstructure:"timeout"`
 	ConnectionRetry  *ConnectionRetrySpec  `json:"connectionRetry,omitempty" mapstructure:"connectionRetry"`
 }
@@ -65,6 +72,7 @@ type ExecutionMetadata struct {
 	Command          string                `json:"command" mapstructure:"command"`
 	WorkingDirectory string                `json:"workingDirectory" mapstructure:"workingDirectory"`
 	Environment      []EnvironmentVariable `json:"environment" mapstructure:"environment"`
+	Identity         string                `json:"identity" mapstructure:"identity"`
 	Timeout          int                   `json:"timeout" mapstructure:"timeout"`
 	ConnectionRetry  *ConnectionRetrySpec  `json:"connectionRetry" mapstructure:"connectionRetry"`
 	Attempt          int                   `json:"attempt" mapstructure:"attempt"`
@@ -100,6 +108,7 @@ Choose **SSH key** or **Password**, then select the organization Secret and the
 - **Command**: The command to run (supports expressions).
 - **Working directory**: Optional; Changes to this directory before running the command.
 - **Environment variables**: Optional list of key/value pairs available during command execution.
+- **Workload identity audience**: Optional; Exposes a short-lived OIDC token for this audience to the command as ` + "`SUPERPLANE_IDENTITY_TOKEN`" + `.
 - **Timeout (seconds)**: How long the command may run (default 60).
 - **Connection retry** (optional): Enable to retry connecting when the host is not reachable yet (e.g. server still booting). Set number of retries and interval between attempts.
 
@@ -263,6 +272,14 @@ func (c *SSHCommand) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "identity",
+			Label:       "Workload identity audience",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "Expose a short-lived OIDC token for this audience to the command as SUPERPLANE_IDENTITY_TOKEN",
+			Placeholder: "e.g. https://vault.example.com",
+		},
 		{
 			Name:        "timeout",
 			Label:       "Timeout (seconds)",
@@ -349,6 +366,9 @@ func (c *SSHCommand) Setup(ctx core.SetupContext) error {
 		if !environmentVariableNameRegex.MatchString(variable.Name) {
 			return fmt.Errorf("invalid environment variable name: %s", variable.Name)
 		}
+		if spec.Identity != "" && variable.Name == IdentityTokenEnvironmentVariable {
+			return fmt.Errorf("environment variable %s is reserved for the workload identity token", variable.Name)
+		}
 	}
 
 	switch spec.Authentication.Method {
@@ -397,6 +417,7 @@ func (c *SSHCommand) Execute(ctx core.ExecutionContext) error {
 		Command:          spec.Command,
 		WorkingDirectory: spec.WorkingDirectory,
 		Environment:      spec.Environment,
+		Identity:         strings.TrimSpace(spec.Identity),
 		Timeout:          spec.Timeout,
 		ConnectionRetry:  spec.ConnectionRetry,
 		Attempt:          0,
@@ -415,7 +436,9 @@ func (c *SSHCommand) Execute(ctx core.ExecutionContext) error {
 	}
 
 	execCtx := ExecuteSSHContext{
+		context:      ctx.Context,
 		secretsCtx:   ctx.Secrets,
+		identityCtx:  ctx.Identity,
 		requestsCtx:  ctx.Requests,
 		stateCtx:     ctx.ExecutionState,
 		metadataCtx:  ctx.Metadata,
@@ -439,6 +462,7 @@ func (c *SSHCommand) HandleAction(ctx core.ActionContext) error {
 
 		execCtx := ExecuteSSHContext{
 			secretsCtx:   ctx.Secrets,
+			identityCtx:  ctx.Identity,
 			requestsCtx:  ctx.Requests,
 			stateCtx:     ctx.ExecutionState,
 			metadataCtx:  ctx.Metadata,
@@ -452,7 +476,9 @@ func (c *SSHCommand) HandleAction(ctx core.ActionContext) error {
 }
 
 type ExecuteSSHContext struct {
+	context     context.Context
 	secretsCtx  core.SecretsContext
+	identityCtx core.IdentityContext
 	requestsCtx core.RequestContext
 	stateCtx    core.ExecutionStateContext
 	metadataCtx core.MetadataContext
@@ -467,12 +493,26 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 	}
 	defer client.Close()
 
+	environment, err := c.buildEnvironment(ctx.identityCtx, ctx.execMetadata)
+	if err != nil {
+		return err
+	}
+
 	command := c.buildRemoteCommand(
 		ctx.execMetadata.WorkingDirectory,
-		ctx.execMetadata.Environment,
+		environment,
 		ctx.execMetadata.Command,
 	)
-	result, err := client.ExecuteCommand(command, time.Duration(ctx.execMetadata.Timeout)*time.Second)
+	result, err := client.ExecuteCommand(ctx.context, command, time.Duration(ctx.execMetadata.Timeout)*time.Second)
+
+	//
+	// If the execution was interrupted, the error is returned,
+	// so the execution is processed again later.
+	//
+	if ctx.context != nil && ctx.context.Err() != nil {
+		return err
+	}
+
 	if c.isConnectError(err) {
 		if c.shouldRetry(ctx.execMetadata.ConnectionRetry, ctx.metadataCtx) {
 			err = c.incrementRetryCount(ctx.metadataCtx)
@@ -585,6 +625,28 @@ func (c *SSHCommand) isConnectError(err error) bool {
 		strings.Contains(s, "no route to host")
 }
 
+/*
+ * The identity token is only added to the environment of the command,
+ * and never stored in the execution metadata.
+ */
+func (c *SSHCommand) buildEnvironment(identity core.IdentityContext, metadata ExecutionMetadata) ([]EnvironmentVariable, error) {
+	if metadata.Identity == "" {
+		return metadata.Environment, nil
+	}
+
+	if identity == nil {
+		return nil, errors.New("workload identity is not available")
+	}
+
+	token, err := identity.Token(metadata.Identity, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate identity token: %w", err)
+	}
+
+	environment := append([]EnvironmentVariable{}, metadata.Environment...)
+	return append(environment, EnvironmentVariable{Name: IdentityTokenEnvironmentVariable, Value: token}), nil
+}
+
 func (c *SSHCommand) buildRemoteCommand(workingDirectory string, environment []EnvironmentVariable, command string) string {
 	finalCommand := command
 
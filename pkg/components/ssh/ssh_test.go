@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
 )
 
 type testMetadataContext struct {
@@ -207,3 +208,36 @@ func TestSSHCommand_BuildRemoteCommand(t *testing.T) {
 		)
 	})
 }
+
+func TestSSHCommand_BuildEnvironment(t *testing.T) {
+	c := &SSHCommand{}
+	environment := []EnvironmentVariable{{Name: "PLAIN", Value: "ok"}}
+
+	t.Run("without identity", func(t *testing.T) {
+		result, err := c.buildEnvironment(nil, ExecutionMetadata{Environment: environment})
+		require.NoError(t, err)
+		assert.Equal(t, environment, result)
+	})
+
+	t.Run("with identity", func(t *testing.T) {
+		identity := &contexts.IdentityContext{}
+		result, err := c.buildEnvironment(identity, ExecutionMetadata{
+			Environment: environment,
+			Identity:    "https://vault.example.com",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []EnvironmentVariable{
+			{Name: "PLAIN", Value: "ok"},
+			{Name: IdentityTokenEnvironmentVariable, Value: "identity-token-for-https://vault.example.com"},
+		}, result)
+		assert.Len(t, environment, 1)
+		require.Len(t, identity.Tokens, 1)
+		assert.Equal(t, "https://vault.example.com", identity.Tokens[0].Audience)
+	})
+
+	t.Run("identity not available", func(t *testing.T) {
+		_, err := c.buildEnvironment(nil, ExecutionMetadata{Identity: "https://vault.example.com"})
+		require.ErrorContains(t, err, "workload identity is not available")
+	})
+}
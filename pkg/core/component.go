@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"time"
@@ -123,6 +124,20 @@ type Component interface {
 	Cleanup(ctx SetupContext) error
 }
 
+/*
+ * WaitingComponent is implemented by components whose executions
+ * wait for something external to finish them, like a webhook.
+ */
+type WaitingComponent interface {
+	Component
+
+	/*
+	 * MaxWaitingTime is a hint for how long an execution can go without any activity,
+	 * before it is considered stuck. Zero means executions are never considered stuck.
+	 */
+	MaxWaitingTime() time.Duration
+}
+
 type OutputChannel struct {
 	Name        string
 	Label       string
@@ -132,8 +147,14 @@ type OutputChannel struct {
 /*
  * ExecutionContext allows the component
  * to control the state and metadata of each execution of it.
+ *
+ * Context is canceled when the execution is interrupted,
+ * for example, when the worker running it is shutting down.
+ * Long-running components should stop and return its error,
+ * so the execution is processed again later.
  */
 type ExecutionContext struct {
+	Context        context.Context
 	ID             uuid.UUID
 	WorkflowID     string
 	OrganizationID string
@@ -153,7 +174,9 @@ type ExecutionContext struct {
 	Integration    IntegrationContext
 	Notifications  NotificationContext
 	Secrets        SecretsContext
+	Identity       IdentityContext
 	CanvasMemory   CanvasMemoryContext
+	EventBus       EventBusContext
 	Webhook        NodeWebhookContext
 }
 
@@ -261,6 +284,7 @@ type ActionContext struct {
 	Integration    IntegrationContext
 	Notifications  NotificationContext
 	Secrets        SecretsContext
+	Identity       IdentityContext
 }
 
 /*
@@ -326,6 +350,20 @@ type NotificationReceivers struct {
 	Roles  []string
 }
 
+/*
+ * EventBusContext allows components to publish events
+ * to organization-level topics. Events are delivered
+ * to the onEvent triggers subscribed to the topic,
+ * in every canvas of the organization.
+ */
+type EventBusContext interface {
+
+	/*
+	 * Publish an event, returning the number of subscribers it was delivered to.
+	 */
+	Publish(topic, eventType string, data any) (int, error)
+}
+
 type NotificationContext interface {
 	Send(title, body, url, urlLabel string, receivers NotificationReceivers) error
 }
@@ -334,6 +372,20 @@ type SecretsContext interface {
 	GetKey(secretName, keyName string) ([]byte, error)
 }
 
+/*
+ * IdentityContext allows components to mint short-lived OIDC tokens
+ * identifying the execution, so external services can trust
+ * requests coming from SuperPlane without static secrets.
+ */
+type IdentityContext interface {
+
+	/*
+	 * Returns a signed JWT for the audience, valid for the given duration.
+	 * A zero duration uses the default lifetime.
+	 */
+	Token(audience string, duration time.Duration) (string, error)
+}
+
 type User struct {
 	ID    string `mapstructure:"id" json:"id"`
 	Name  string `mapstructure:"name" json:"name"`
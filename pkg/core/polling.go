@@ -0,0 +1,98 @@
+package core
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+const (
+	DefaultPollInterval = time.Minute
+	MinPollInterval     = 30 * time.Second
+)
+
+/*
+ * PollingTrigger is implemented by triggers for providers
+ * that do not offer usable webhooks. Use registry.NewPollingTrigger()
+ * to turn it into a Trigger that periodically calls Poll(),
+ * keeps track of the cursor and of the items already seen,
+ * and emits an event for each new item.
+ */
+type PollingTrigger interface {
+
+	/*
+	 * Definition methods, with the same meaning as in Trigger.
+	 */
+	Name() string
+	Label() string
+	Description() string
+	Documentation() string
+	Icon() string
+	Color() string
+	ExampleData() map[string]any
+	Configuration() []configuration.Field
+
+	/*
+	 * Validate the configuration, and set node metadata, if needed.
+	 * Polling is scheduled after Setup() succeeds.
+	 */
+	Setup(ctx TriggerContext) error
+
+	/*
+	 * How often to poll, based on the node configuration.
+	 * Intervals shorter than MinPollInterval are not allowed.
+	 */
+	PollInterval(configuration any) time.Duration
+
+	/*
+	 * Fetch the items created or updated since the cursor.
+	 * Errors are recorded and polling continues on the next interval.
+	 */
+	Poll(ctx PollContext) (*PollResult, error)
+}
+
+type PollContext struct {
+	Logger        *log.Entry
+	Configuration any
+	HTTP          HTTPContext
+	Integration   IntegrationContext
+
+	//
+	// The cursor returned by the previous Poll() call.
+	// Empty on the first poll.
+	//
+	Cursor string
+
+	//
+	// When the previous Poll() call happened.
+	// Nil on the first poll.
+	//
+	LastPolledAt *time.Time
+}
+
+type PollResult struct {
+
+	//
+	// Opaque value passed to the next Poll() call.
+	//
+	Cursor string
+
+	//
+	// New or updated items, oldest first.
+	//
+	Items []PolledItem
+}
+
+type PolledItem struct {
+
+	//
+	// Identifies the item for deduplication purposes.
+	// Items with a key that was already seen are not emitted again,
+	// so triggers emitting updates should include the update timestamp in it.
+	//
+	Key string
+
+	PayloadType string
+	Payload     any
+}
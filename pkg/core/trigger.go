@@ -2,6 +2,7 @@ package core
 
 import (
 	"net/http"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/configuration"
@@ -90,6 +91,7 @@ type TriggerContext struct {
 	Events        EventContext
 	Webhook       NodeWebhookContext
 	Integration   IntegrationContext
+	Calendars     CalendarContext
 }
 
 type EventContext interface {
@@ -107,6 +109,17 @@ type TriggerActionContext struct {
 	Events        EventContext
 	Webhook       NodeWebhookContext
 	Integration   IntegrationContext
+	Calendars     CalendarContext
+}
+
+/*
+ * CalendarContext gives triggers read access to
+ * the organization calendars, which hold dates
+ * shared across canvases, like public holidays.
+ * The date of t is evaluated in its own location.
+ */
+type CalendarContext interface {
+	IsExcluded(calendarName string, t time.Time) (bool, error)
 }
 
 type WebhookRequestContext struct {
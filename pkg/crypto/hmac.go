@@ -7,13 +7,18 @@ import (
 )
 
 func VerifySignature(key []byte, data []byte, signature string) error {
-	h := hmac.New(sha256.New, key)
-	h.Write(data)
-
-	computed := fmt.Sprintf("%x", h.Sum(nil))
+	computed := Sign(key, data)
 	if computed != signature {
 		return fmt.Errorf("invalid signature")
 	}
 
 	return nil
 }
+
+// Sign returns the hex encoded HMAC-SHA256 of data,
+// in the format expected by VerifySignature.
+func Sign(key []byte, data []byte) string {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
@@ -22,3 +22,8 @@ func Test__VerifySignature(t *testing.T) {
 		require.Error(t, VerifySignature(key, data, signature))
 	})
 }
+
+func Test__Sign(t *testing.T) {
+	signature := Sign([]byte("secret key"), []byte("data to sign"))
+	require.Equal(t, "246df9c6ede92636184fbcf4f03abe33216384885bd018e882870ee3c869967e", signature)
+}
@@ -43,7 +43,9 @@ func dbPoolSize() int {
 	return size
 }
 
-func connect() *gorm.DB {
+// DSN returns the connection string for the database.
+// It is also used by the few places that need a connection outside of the pool.
+func DSN() string {
 	postgresDbSSL := os.Getenv("POSTGRES_DB_SSL")
 	sslMode := "disable"
 	if postgresDbSSL == "true" {
@@ -61,8 +63,11 @@ func connect() *gorm.DB {
 	}
 
 	dsnTemplate := "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s application_name=%s"
-	dsn := fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
+	return fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
+}
 
+func connect() *gorm.DB {
+	dsn := DSN()
 	logger := gormLogger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), gormLogger.Config{
 		SlowThreshold:             200 * time.Millisecond,
 		LogLevel:                  gormLogger.Warn,
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const MaxAPITokenNameLength = 128
+
+type APITokenOptions struct {
+	Name      string
+	Scopes    []string
+	CanvasID  string
+	ExpiresAt *timestamppb.Timestamp
+}
+
+/*
+ * Creates a named API token for a user or service account,
+ * and returns it with its plain value, which is not stored.
+ */
+func CreateAPIToken(authService authorization.Authorization, user *models.User, createdBy uuid.UUID, options APITokenOptions) (*models.APIToken, string, error) {
+	name := strings.TrimSpace(options.Name)
+	if name == "" {
+		return nil, "", status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if len(name) > MaxAPITokenNameLength {
+		return nil, "", status.Errorf(codes.InvalidArgument, "name must be at most %d characters", MaxAPITokenNameLength)
+	}
+
+	scopes, err := validateAPITokenScopes(authService, options.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	token := &models.APIToken{
+		OrganizationID: user.OrganizationID,
+		UserID:         user.ID,
+		Name:           name,
+		Scopes:         scopes,
+		CreatedBy:      &createdBy,
+	}
+
+	if options.CanvasID != "" {
+		canvasID, err := uuid.Parse(options.CanvasID)
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, "invalid canvas_id")
+		}
+
+		if _, err := models.FindCanvas(user.OrganizationID, canvasID); err != nil {
+			return nil, "", status.Error(codes.NotFound, "canvas not found")
+		}
+
+		token.CanvasID = &canvasID
+	}
+
+	if options.ExpiresAt != nil {
+		expiresAt := options.ExpiresAt.AsTime()
+		if !expiresAt.After(time.Now()) {
+			return nil, "", status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+
+		token.ExpiresAt = &expiresAt
+	}
+
+	plainToken, err := crypto.Base64String(64)
+	if err != nil {
+		return nil, "", status.Error(codes.Internal, "failed to generate new token")
+	}
+
+	token.TokenHash = crypto.HashToken(plainToken)
+	err = models.CreateAPIToken(token)
+	if err != nil {
+		if errors.Is(err, models.ErrNameAlreadyUsed) {
+			return nil, "", status.Errorf(codes.AlreadyExists, "token %s already exists", name)
+		}
+
+		return nil, "", status.Error(codes.Internal, "failed to create token")
+	}
+
+	return token, plainToken, nil
+}
+
+func RevokeAPIToken(user *models.User, tokenID string) error {
+	if _, err := uuid.Parse(tokenID); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid token id")
+	}
+
+	token, err := models.FindAPITokenForUser(user.ID, tokenID)
+	if err != nil {
+		return status.Error(codes.NotFound, "token not found")
+	}
+
+	err = token.Delete()
+	if err != nil {
+		return status.Error(codes.Internal, "failed to revoke token")
+	}
+
+	return nil
+}
+
+/*
+ * Scopes are the permissions of the organization and canvas roles,
+ * so they can be checked against the same authorization rules.
+ */
+func validateAPITokenScopes(authService authorization.Authorization, scopes []string) ([]string, error) {
+	result := []string{}
+	for _, scope := range scopes {
+		resource, action, err := models.ParseAPITokenScope(scope)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		permission := &authorization.Permission{Resource: resource, Action: action}
+		if !authService.IsValidPermission(models.DomainTypeOrganization, permission) && !authService.IsValidPermission(models.DomainTypeCanvas, permission) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid scope %s", scope)
+		}
+
+		normalized := resource + ":" + action
+		if !slices.Contains(result, normalized) {
+			result = append(result, normalized)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,39 @@
+package canvases
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func AssignCanvasRole(ctx context.Context, authService authorization.Authorization, organizationID, canvasID, role, userID, groupName string) (*pb.AssignCanvasRoleResponse, error) {
+	if !authService.IsDefaultRole(role, models.DomainTypeCanvas) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas role %q", role)
+	}
+
+	canvas, err := findCanvasForRoleBindings(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	binding, userEmail, err := findCanvasRoleSubject(organizationID, userID, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	binding.Role = role
+	err = authService.AssignCanvasRole(canvas.ID.String(), *binding)
+	if err != nil {
+		log.Errorf("failed to assign role %s in canvas %s: %v", role, canvas.ID, err)
+		return nil, status.Error(codes.Internal, "failed to assign canvas role")
+	}
+
+	return &pb.AssignCanvasRoleResponse{
+		Binding: serializeCanvasRoleBinding(binding, userEmail),
+	}, nil
+}
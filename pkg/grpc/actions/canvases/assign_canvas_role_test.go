@@ -0,0 +1,62 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__CanvasRoles(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	canvasID := canvas.ID.String()
+
+	t.Run("invalid role -> error", func(t *testing.T) {
+		_, err := AssignCanvasRole(context.Background(), r.AuthService, orgID, canvasID, models.RoleOrgAdmin, r.User.String(), "")
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("canvas does not exist -> error", func(t *testing.T) {
+		_, err := AssignCanvasRole(context.Background(), r.AuthService, orgID, uuid.New().String(), models.RoleCanvasViewer, r.User.String(), "")
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("assign, list and remove user role", func(t *testing.T) {
+		response, err := AssignCanvasRole(context.Background(), r.AuthService, orgID, canvasID, models.RoleCanvasOperator, r.User.String(), "")
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleCanvasOperator, response.Binding.Role)
+		assert.Equal(t, r.User.String(), response.Binding.UserId)
+
+		listResponse, err := ListCanvasRoleBindings(context.Background(), r.AuthService, orgID, canvasID)
+		require.NoError(t, err)
+		require.Len(t, listResponse.Bindings, 1)
+		assert.Equal(t, models.RoleCanvasOperator, listResponse.Bindings[0].Role)
+		assert.NotEmpty(t, listResponse.Bindings[0].UserEmail)
+
+		_, err = RemoveCanvasRole(context.Background(), r.AuthService, orgID, canvasID, r.User.String(), "")
+		require.NoError(t, err)
+
+		listResponse, err = ListCanvasRoleBindings(context.Background(), r.AuthService, orgID, canvasID)
+		require.NoError(t, err)
+		assert.Empty(t, listResponse.Bindings)
+	})
+
+	t.Run("remove role that is not bound -> error", func(t *testing.T) {
+		_, err := RemoveCanvasRole(context.Background(), r.AuthService, orgID, canvasID, r.User.String(), "")
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -92,7 +92,7 @@ func cancelExecutionInTransaction(tx *gorm.DB, authService authorization.Authori
 				Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 				ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 				Requests:       contexts.NewExecutionRequestContext(tx, execution),
-				Auth:           contexts.NewAuthContext(tx, orgUUID, authService, user),
+				Auth:           contexts.NewAuthContext(tx, orgUUID, execution.WorkflowID, authService, user),
 				Notifications:  contexts.NewNotificationContext(tx, orgUUID, execution.WorkflowID),
 				CanvasMemory:   contexts.NewCanvasMemoryContext(tx, execution.WorkflowID),
 			}
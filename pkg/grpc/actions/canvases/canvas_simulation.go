@@ -0,0 +1,596 @@
+package canvases
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/expr-lang/expr"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const maxSimulationSteps = 100
+
+var simulationExpressionRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
+
+/*
+ * Components that only evaluate expressions against their input,
+ * so they can be executed as they are during a simulation.
+ * Every other component returns its fixture or example output.
+ */
+var simulatedComponents = map[string]struct{}{
+	"if":     {},
+	"filter": {},
+	"noop":   {},
+}
+
+/*
+ * A canvasSimulation feeds a trigger payload through the nodes of a canvas spec,
+ * entirely in memory. Nothing is persisted, and no integration is called.
+ */
+type canvasSimulation struct {
+	registry *registry.Registry
+	nodes    map[string]models.Node
+	edges    []models.Edge
+	fixtures map[string]*pb.CanvasSimulation_Fixture
+	steps    []*pb.CanvasSimulation_Step
+}
+
+/*
+ * A message emitted by a node during the simulation.
+ * Messages keep a reference to the message that caused them,
+ * which is used to build the $ message chain, root() and previous().
+ */
+type simulationMessage struct {
+	nodeID   string
+	nodeName string
+	channel  string
+	payload  map[string]any
+	previous *simulationMessage
+}
+
+func newCanvasSimulation(registry *registry.Registry, nodes []models.Node, edges []models.Edge, fixtures []*pb.CanvasSimulation_Fixture) (*canvasSimulation, error) {
+	s := &canvasSimulation{
+		registry: registry,
+		nodes:    make(map[string]models.Node, len(nodes)),
+		edges:    edges,
+		fixtures: make(map[string]*pb.CanvasSimulation_Fixture, len(fixtures)),
+		steps:    []*pb.CanvasSimulation_Step{},
+	}
+
+	for _, node := range nodes {
+		s.nodes[node.ID] = node
+	}
+
+	for _, fixture := range fixtures {
+		if _, ok := s.nodes[fixture.NodeId]; !ok {
+			return nil, fmt.Errorf("fixture node %s not found", fixture.NodeId)
+		}
+
+		s.fixtures[fixture.NodeId] = fixture
+	}
+
+	return s, nil
+}
+
+func (s *canvasSimulation) run(triggerNodeID string, payloadType string, payload map[string]any) ([]*pb.CanvasSimulation_Step, error) {
+	trigger, ok := s.nodes[triggerNodeID]
+	if !ok {
+		return nil, fmt.Errorf("trigger node %s not found", triggerNodeID)
+	}
+
+	if trigger.Type != models.NodeTypeTrigger {
+		return nil, fmt.Errorf("node %s is not a trigger", triggerNodeID)
+	}
+
+	root := &simulationMessage{
+		nodeID:   trigger.ID,
+		nodeName: trigger.Name,
+		channel:  core.DefaultOutputChannel.Name,
+		payload:  simulationEnvelope(payloadType, payload),
+	}
+
+	step := &pb.CanvasSimulation_Step{
+		NodeId:   trigger.ID,
+		NodeName: trigger.Name,
+		Source:   pb.CanvasSimulation_SOURCE_TRIGGER,
+		Result:   pb.CanvasSimulation_RESULT_PASSED,
+		Channel:  root.channel,
+	}
+
+	if err := s.addStep(step, trigger.Configuration, []*simulationMessage{root}); err != nil {
+		return nil, err
+	}
+
+	queue := []*simulationMessage{root}
+	for len(queue) > 0 {
+		message := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range s.edges {
+			if edge.SourceID != message.nodeID || edge.Channel != message.channel {
+				continue
+			}
+
+			if len(s.steps) >= maxSimulationSteps {
+				return nil, fmt.Errorf("simulation stopped after %d steps", maxSimulationSteps)
+			}
+
+			target, ok := s.nodes[edge.TargetID]
+			if !ok {
+				continue
+			}
+
+			emitted, err := s.execute(target, message)
+			if err != nil {
+				return nil, err
+			}
+
+			queue = append(queue, emitted...)
+		}
+	}
+
+	return s.steps, nil
+}
+
+/*
+ * Executes one node with the given input message,
+ * returning the messages it emitted.
+ */
+func (s *canvasSimulation) execute(node models.Node, input *simulationMessage) ([]*simulationMessage, error) {
+	step := &pb.CanvasSimulation_Step{
+		NodeId:       node.ID,
+		NodeName:     node.Name,
+		SourceNodeId: input.nodeID,
+	}
+
+	var component core.Component
+	if node.Ref.Component != nil {
+		c, err := s.registry.GetComponent(node.Ref.Component.Name)
+		if err != nil {
+			step.Result = pb.CanvasSimulation_RESULT_FAILED
+			step.Error = fmt.Sprintf("component %s not found", node.Ref.Component.Name)
+			return nil, s.addStep(step, nil, nil)
+		}
+
+		component = c
+	}
+
+	env := input.expressionEnv()
+	configuration, err := resolveSimulationConfiguration(component, node.Configuration, env)
+	if err != nil {
+		step.Result = pb.CanvasSimulation_RESULT_FAILED
+		step.Error = err.Error()
+		return nil, s.addStep(step, node.Configuration, nil)
+	}
+
+	if fixture, ok := s.fixtures[node.ID]; ok {
+		step.Source = pb.CanvasSimulation_SOURCE_FIXTURE
+		step.Result = pb.CanvasSimulation_RESULT_PASSED
+		step.Channel = fixture.Channel
+		if step.Channel == "" {
+			step.Channel = simulationOutputChannel(component, configuration)
+		}
+
+		data := map[string]any{}
+		if fixture.Data != nil {
+			data = fixture.Data.AsMap()
+		}
+
+		message := input.next(node, step.Channel, simulationEnvelope(simulationPayloadType(node, component), data))
+		return []*simulationMessage{message}, s.addStep(step, configuration, []*simulationMessage{message})
+	}
+
+	if component == nil {
+		return s.executeWithExampleOutput(step, node, nil, configuration, input)
+	}
+
+	if _, ok := simulatedComponents[component.Name()]; !ok {
+		return s.executeWithExampleOutput(step, node, component, configuration, input)
+	}
+
+	return s.executeComponent(step, node, component, configuration, input, env)
+}
+
+func (s *canvasSimulation) executeWithExampleOutput(
+	step *pb.CanvasSimulation_Step,
+	node models.Node,
+	component core.Component,
+	configuration map[string]any,
+	input *simulationMessage,
+) ([]*simulationMessage, error) {
+	step.Source = pb.CanvasSimulation_SOURCE_EXAMPLE_OUTPUT
+	step.Result = pb.CanvasSimulation_RESULT_PASSED
+	step.Channel = simulationOutputChannel(component, configuration)
+
+	payload := simulationEnvelope(simulationPayloadType(node, component), map[string]any{})
+	if component != nil && component.ExampleOutput() != nil {
+		payload = normalizeSimulationPayload(component.ExampleOutput())
+	}
+
+	message := input.next(node, step.Channel, payload)
+	return []*simulationMessage{message}, s.addStep(step, configuration, []*simulationMessage{message})
+}
+
+func (s *canvasSimulation) executeComponent(
+	step *pb.CanvasSimulation_Step,
+	node models.Node,
+	component core.Component,
+	configuration map[string]any,
+	input *simulationMessage,
+	env map[string]any,
+) ([]*simulationMessage, error) {
+	state := &simulationExecutionState{}
+	err := component.Execute(core.ExecutionContext{
+		NodeID:        node.ID,
+		SourceNodeID:  input.nodeID,
+		Data:          input.payload,
+		Configuration: configuration,
+		ExpressionEnv: func(expression string) (map[string]any, error) {
+			return env, nil
+		},
+		Logger:         log.WithFields(log.Fields{"node_id": node.ID, "simulation": true}),
+		Metadata:       &simulationMetadata{},
+		NodeMetadata:   &simulationMetadata{},
+		ExecutionState: state,
+		Requests:       &simulationRequests{},
+	})
+
+	step.Source = pb.CanvasSimulation_SOURCE_COMPONENT
+	if err != nil {
+		step.Result = pb.CanvasSimulation_RESULT_FAILED
+		step.Error = err.Error()
+		return nil, s.addStep(step, configuration, nil)
+	}
+
+	if state.failed {
+		step.Result = pb.CanvasSimulation_RESULT_FAILED
+		step.Error = state.failureMessage
+		return nil, s.addStep(step, configuration, nil)
+	}
+
+	step.Result = pb.CanvasSimulation_RESULT_PASSED
+	step.Channel = state.channel
+
+	messages := make([]*simulationMessage, 0, len(state.payloads))
+	for _, payload := range state.payloads {
+		messages = append(messages, input.next(node, state.channel, simulationEnvelope(state.payloadType, payload)))
+	}
+
+	return messages, s.addStep(step, configuration, messages)
+}
+
+func (s *canvasSimulation) addStep(step *pb.CanvasSimulation_Step, configuration map[string]any, messages []*simulationMessage) error {
+	if configuration != nil {
+		c, err := simulationStruct(configuration)
+		if err != nil {
+			return fmt.Errorf("node %s: invalid configuration: %w", step.NodeId, err)
+		}
+
+		step.Configuration = c
+	}
+
+	step.Outputs = make([]*structpb.Struct, 0, len(messages))
+	for _, message := range messages {
+		output, err := simulationStruct(message.payload)
+		if err != nil {
+			return fmt.Errorf("node %s: invalid output: %w", step.NodeId, err)
+		}
+
+		step.Outputs = append(step.Outputs, output)
+	}
+
+	s.steps = append(s.steps, step)
+	return nil
+}
+
+func (m *simulationMessage) next(node models.Node, channel string, payload map[string]any) *simulationMessage {
+	return &simulationMessage{
+		nodeID:   node.ID,
+		nodeName: node.Name,
+		channel:  channel,
+		payload:  payload,
+		previous: m,
+	}
+}
+
+/*
+ * Mirrors the expression environment used during real executions:
+ * $ holds the payloads of the nodes along the path, keyed by node name,
+ * and root() / previous() walk that path back to the trigger.
+ */
+func (m *simulationMessage) expressionEnv() map[string]any {
+	messageChain := map[string]any{m.nodeID: m.payload}
+	previousByDepth := map[string]any{}
+
+	var root map[string]any
+	depth := 1
+	for current := m; current != nil; current = current.previous {
+		if _, ok := messageChain[current.nodeName]; !ok {
+			messageChain[current.nodeName] = current.payload
+		}
+
+		previousByDepth[strconv.Itoa(depth)] = current.payload
+		root = current.payload
+		depth++
+	}
+
+	return map[string]any{
+		"$":                 messageChain,
+		"memory":            simulationMemoryNamespace(),
+		"__root":            root,
+		"__previousByDepth": previousByDepth,
+	}
+}
+
+/*
+ * Canvas memory is not available in a simulation,
+ * so lookups always come back empty.
+ */
+func simulationMemoryNamespace() map[string]any {
+	return map[string]any{
+		"find": func(params ...any) (any, error) {
+			return []any{}, nil
+		},
+		"findFirst": func(params ...any) (any, error) {
+			return nil, nil
+		},
+	}
+}
+
+func resolveSimulationConfiguration(component core.Component, configuration map[string]any, env map[string]any) (map[string]any, error) {
+	disallowed := map[string]struct{}{}
+	if component != nil {
+		for _, field := range component.Configuration() {
+			if field.DisallowExpression {
+				disallowed[field.Name] = struct{}{}
+			}
+		}
+	}
+
+	result := make(map[string]any, len(configuration))
+	for key, value := range configuration {
+		if _, ok := disallowed[key]; ok {
+			result[key] = value
+			continue
+		}
+
+		resolved, err := resolveSimulationValue(value, env)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving field %s: %w", key, err)
+		}
+
+		result[key] = resolved
+	}
+
+	return result, nil
+}
+
+func resolveSimulationValue(value any, env map[string]any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return resolveSimulationExpression(v, env)
+
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			resolved, err := resolveSimulationValue(item, env)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = resolved
+		}
+		return result, nil
+
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			resolved, err := resolveSimulationValue(item, env)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = resolved
+		}
+		return result, nil
+
+	default:
+		return v, nil
+	}
+}
+
+func resolveSimulationExpression(expression string, env map[string]any) (any, error) {
+	if !simulationExpressionRegex.MatchString(expression) {
+		return expression, nil
+	}
+
+	var err error
+	result := simulationExpressionRegex.ReplaceAllStringFunc(expression, func(match string) string {
+		matches := simulationExpressionRegex.FindStringSubmatch(match)
+		if len(matches) != 2 {
+			return match
+		}
+
+		vm, e := expr.Compile(matches[1], simulationExpressionOptions(env)...)
+		if e != nil {
+			err = e
+			return ""
+		}
+
+		value, e := expr.Run(vm, env)
+		if e != nil {
+			err = fmt.Errorf("expression evaluation failed: %w", e)
+			return ""
+		}
+
+		return fmt.Sprintf("%v", value)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func simulationExpressionOptions(env map[string]any) []expr.Option {
+	return []expr.Option{
+		expr.Env(env),
+		expr.AsAny(),
+		expr.WithContext("ctx"),
+		expr.Timezone(time.UTC.String()),
+		expr.Function("root", func(params ...any) (any, error) {
+			if len(params) != 0 {
+				return nil, fmt.Errorf("root() takes no arguments")
+			}
+
+			return env["__root"], nil
+		}),
+		expr.Function("previous", func(params ...any) (any, error) {
+			depth := 1
+			if len(params) > 1 {
+				return nil, fmt.Errorf("previous() accepts zero or one argument")
+			}
+
+			if len(params) == 1 {
+				value, ok := params[0].(int)
+				if !ok || value < 1 {
+					return nil, fmt.Errorf("depth must be an integer >= 1")
+				}
+				depth = value
+			}
+
+			previousByDepth, _ := env["__previousByDepth"].(map[string]any)
+			return previousByDepth[strconv.Itoa(depth)], nil
+		}),
+	}
+}
+
+/*
+ * Fixtures and example outputs are emitted on the first output channel,
+ * unless the fixture names another one.
+ */
+func simulationOutputChannel(component core.Component, configuration map[string]any) string {
+	if component == nil {
+		return core.DefaultOutputChannel.Name
+	}
+
+	channels := component.OutputChannels(configuration)
+	if len(channels) == 0 {
+		return core.DefaultOutputChannel.Name
+	}
+
+	return channels[0].Name
+}
+
+func simulationPayloadType(node models.Node, component core.Component) string {
+	if component != nil {
+		if payloadType, ok := component.ExampleOutput()["type"].(string); ok && payloadType != "" {
+			return payloadType
+		}
+
+		return component.Name()
+	}
+
+	if node.Ref.Blueprint != nil {
+		return node.Ref.Blueprint.ID
+	}
+
+	return node.Name
+}
+
+func simulationEnvelope(payloadType string, data any) map[string]any {
+	return normalizeSimulationPayload(map[string]any{
+		"type":      payloadType,
+		"timestamp": time.Now().UTC().Format(time.RFC3339Nano),
+		"data":      data,
+	})
+}
+
+/*
+ * Payloads go through JSON, like they do when events are stored,
+ * so expressions see the same types they would see in a real run.
+ */
+func normalizeSimulationPayload(payload map[string]any) map[string]any {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return payload
+	}
+
+	normalized := map[string]any{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return payload
+	}
+
+	return normalized
+}
+
+func simulationStruct(value map[string]any) (*structpb.Struct, error) {
+	return structpb.NewStruct(normalizeSimulationPayload(value))
+}
+
+type simulationExecutionState struct {
+	finished       bool
+	failed         bool
+	failureMessage string
+	channel        string
+	payloadType    string
+	payloads       []any
+}
+
+func (s *simulationExecutionState) IsFinished() bool {
+	return s.finished
+}
+
+func (s *simulationExecutionState) SetKV(key, value string) error {
+	return nil
+}
+
+func (s *simulationExecutionState) Emit(channel, payloadType string, payloads []any) error {
+	s.finished = true
+	s.channel = channel
+	s.payloadType = payloadType
+	s.payloads = payloads
+	return nil
+}
+
+func (s *simulationExecutionState) Pass() error {
+	s.finished = true
+	return nil
+}
+
+func (s *simulationExecutionState) Fail(reason, message string) error {
+	s.finished = true
+	s.failed = true
+	s.failureMessage = message
+	if message == "" {
+		s.failureMessage = reason
+	}
+
+	return nil
+}
+
+type simulationMetadata struct {
+	value any
+}
+
+func (m *simulationMetadata) Get() any {
+	return m.value
+}
+
+func (m *simulationMetadata) Set(value any) error {
+	m.value = value
+	return nil
+}
+
+type simulationRequests struct{}
+
+func (r *simulationRequests) ScheduleActionCall(actionName string, parameters map[string]any, interval time.Duration) error {
+	return nil
+}
@@ -0,0 +1,134 @@
+package canvases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/components/filter"
+	httpcomponent "github.com/superplanehq/superplane/pkg/components/http"
+	ifp "github.com/superplanehq/superplane/pkg/components/if"
+	"github.com/superplanehq/superplane/pkg/components/noop"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test__CanvasSimulation(t *testing.T) {
+	r := &registry.Registry{
+		Components: map[string]core.Component{
+			"if":     &ifp.If{},
+			"filter": &filter.Filter{},
+			"noop":   &noop.NoOp{},
+			"http":   &httpcomponent.HTTP{},
+		},
+	}
+
+	component := func(id, name, componentName string, configuration map[string]any) models.Node {
+		return models.Node{
+			ID:            id,
+			Name:          name,
+			Type:          models.NodeTypeComponent,
+			Ref:           models.NodeRef{Component: &models.ComponentRef{Name: componentName}},
+			Configuration: configuration,
+		}
+	}
+
+	nodes := []models.Node{
+		{
+			ID:   "trigger-1",
+			Name: "push",
+			Type: models.NodeTypeTrigger,
+			Ref:  models.NodeRef{Trigger: &models.TriggerRef{Name: "webhook"}},
+		},
+		component("if-1", "is-main", "if", map[string]any{"expression": `$['push'].data.branch == "main"`}),
+		component("http-1", "deploy", "http", map[string]any{
+			"method": "POST",
+			"url":    "https://deploy.example.com/{{ $['push'].data.branch }}",
+		}),
+		component("noop-1", "skip", "noop", map[string]any{}),
+		component("filter-1", "succeeded", "filter", map[string]any{"expression": `$['deploy'].data.status == 200`}),
+		component("noop-2", "notify", "noop", map[string]any{}),
+	}
+
+	edges := []models.Edge{
+		{SourceID: "trigger-1", TargetID: "if-1", Channel: "default"},
+		{SourceID: "if-1", TargetID: "http-1", Channel: "true"},
+		{SourceID: "if-1", TargetID: "noop-1", Channel: "false"},
+		{SourceID: "http-1", TargetID: "filter-1", Channel: "default"},
+		{SourceID: "filter-1", TargetID: "noop-2", Channel: "default"},
+	}
+
+	path := func(steps []*pb.CanvasSimulation_Step) []string {
+		result := []string{}
+		for _, step := range steps {
+			result = append(result, step.NodeName+":"+step.Channel)
+		}
+		return result
+	}
+
+	t.Run("evaluates expressions and uses example outputs", func(t *testing.T) {
+		simulation, err := newCanvasSimulation(r, nodes, edges, nil)
+		require.NoError(t, err)
+
+		steps, err := simulation.run("trigger-1", "webhook", map[string]any{"branch": "main"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"push:default", "is-main:true", "deploy:default", "succeeded:default", "notify:default"}, path(steps))
+
+		deploy := steps[2]
+		assert.Equal(t, pb.CanvasSimulation_SOURCE_EXAMPLE_OUTPUT, deploy.Source)
+		assert.Equal(t, "if-1", deploy.SourceNodeId)
+		assert.Equal(t, "https://deploy.example.com/main", deploy.Configuration.AsMap()["url"])
+		require.Len(t, deploy.Outputs, 1)
+		assert.Equal(t, "http.request.finished", deploy.Outputs[0].AsMap()["type"])
+
+		assert.Equal(t, pb.CanvasSimulation_SOURCE_COMPONENT, steps[1].Source)
+		assert.Equal(t, pb.CanvasSimulation_RESULT_PASSED, steps[1].Result)
+	})
+
+	t.Run("other branch is taken", func(t *testing.T) {
+		simulation, err := newCanvasSimulation(r, nodes, edges, nil)
+		require.NoError(t, err)
+
+		steps, err := simulation.run("trigger-1", "webhook", map[string]any{"branch": "feature"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"push:default", "is-main:false", "skip:default"}, path(steps))
+	})
+
+	t.Run("fixtures replace outputs", func(t *testing.T) {
+		data, err := structpb.NewStruct(map[string]any{"status": 500})
+		require.NoError(t, err)
+
+		simulation, err := newCanvasSimulation(r, nodes, edges, []*pb.CanvasSimulation_Fixture{
+			{NodeId: "http-1", Data: data},
+		})
+		require.NoError(t, err)
+
+		steps, err := simulation.run("trigger-1", "webhook", map[string]any{"branch": "main"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"push:default", "is-main:true", "deploy:default", "succeeded:"}, path(steps))
+		assert.Equal(t, pb.CanvasSimulation_SOURCE_FIXTURE, steps[2].Source)
+		assert.Empty(t, steps[3].Outputs)
+	})
+
+	t.Run("expression errors fail the step", func(t *testing.T) {
+		broken := append([]models.Node{}, nodes...)
+		broken[1] = component("if-1", "is-main", "if", map[string]any{"expression": `$['push'].data.branch`})
+
+		simulation, err := newCanvasSimulation(r, broken, edges, nil)
+		require.NoError(t, err)
+
+		steps, err := simulation.run("trigger-1", "webhook", map[string]any{"branch": "main"})
+		require.NoError(t, err)
+		require.Len(t, steps, 2)
+		assert.Equal(t, pb.CanvasSimulation_RESULT_FAILED, steps[1].Result)
+		assert.NotEmpty(t, steps[1].Error)
+	})
+
+	t.Run("fixture for unknown node -> error", func(t *testing.T) {
+		_, err := newCanvasSimulation(r, nodes, edges, []*pb.CanvasSimulation_Fixture{{NodeId: "missing"}})
+		require.Error(t, err)
+	})
+}
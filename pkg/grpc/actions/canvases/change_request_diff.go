@@ -5,6 +5,7 @@ import (
 	"sort"
 
 	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 )
 
 type comparableCanvasNode struct {
@@ -130,8 +131,7 @@ func mapNodesByID(nodes []models.Node) map[string]models.Node {
 func mapEdgesByKey(edges []models.Edge) map[string]models.Edge {
 	result := make(map[string]models.Edge, len(edges))
 	for _, edge := range edges {
-		key := edge.SourceID + "|" + edge.TargetID + "|" + edge.Channel
-		result[key] = edge
+		result[edgeKey(edge)] = edge
 	}
 	return result
 }
@@ -148,3 +148,113 @@ func toComparableCanvasNode(node models.Node) comparableCanvasNode {
 		IntegrationID: node.IntegrationID,
 	}
 }
+
+type canvasNodeDiff struct {
+	NodeID string
+	Name   string
+	Type   pb.CanvasDiff_ChangeType
+	Fields []string
+}
+
+type canvasEdgeDiff struct {
+	Edge models.Edge
+	Type pb.CanvasDiff_ChangeType
+}
+
+/*
+ * Same comparison used for change requests, but keeping
+ * track of what changed in each node, instead of only which nodes changed.
+ */
+func resolveCanvasDiff(
+	baseNodes []models.Node,
+	baseEdges []models.Edge,
+	targetNodes []models.Node,
+	targetEdges []models.Edge,
+) ([]canvasNodeDiff, []canvasEdgeDiff) {
+	baseByID := mapNodesByID(baseNodes)
+	targetByID := mapNodesByID(targetNodes)
+
+	nodeDiffs := []canvasNodeDiff{}
+	for _, nodeID := range resolveOrderedNodeIDs(resolveChangedNodeIDSet(baseNodes, nil, targetNodes, nil), targetNodes, baseNodes) {
+		baseNode, hasBase := baseByID[nodeID]
+		targetNode, hasTarget := targetByID[nodeID]
+
+		switch {
+		case !hasBase:
+			nodeDiffs = append(nodeDiffs, canvasNodeDiff{NodeID: nodeID, Name: targetNode.Name, Type: pb.CanvasDiff_CHANGE_TYPE_ADDED})
+		case !hasTarget:
+			nodeDiffs = append(nodeDiffs, canvasNodeDiff{NodeID: nodeID, Name: baseNode.Name, Type: pb.CanvasDiff_CHANGE_TYPE_REMOVED})
+		default:
+			nodeDiffs = append(nodeDiffs, canvasNodeDiff{
+				NodeID: nodeID,
+				Name:   targetNode.Name,
+				Type:   pb.CanvasDiff_CHANGE_TYPE_CHANGED,
+				Fields: resolveChangedNodeFields(toComparableCanvasNode(baseNode), toComparableCanvasNode(targetNode)),
+			})
+		}
+	}
+
+	edgeDiffs := []canvasEdgeDiff{}
+	baseEdgesByKey := mapEdgesByKey(baseEdges)
+	targetEdgesByKey := mapEdgesByKey(targetEdges)
+	for _, edge := range targetEdges {
+		if _, ok := baseEdgesByKey[edgeKey(edge)]; !ok {
+			edgeDiffs = append(edgeDiffs, canvasEdgeDiff{Edge: edge, Type: pb.CanvasDiff_CHANGE_TYPE_ADDED})
+		}
+	}
+	for _, edge := range baseEdges {
+		if _, ok := targetEdgesByKey[edgeKey(edge)]; !ok {
+			edgeDiffs = append(edgeDiffs, canvasEdgeDiff{Edge: edge, Type: pb.CanvasDiff_CHANGE_TYPE_REMOVED})
+		}
+	}
+
+	return nodeDiffs, edgeDiffs
+}
+
+func resolveChangedNodeFields(base comparableCanvasNode, target comparableCanvasNode) []string {
+	fields := []string{}
+	if base.Name != target.Name {
+		fields = append(fields, "name")
+	}
+	if base.Type != target.Type {
+		fields = append(fields, "type")
+	}
+	if !reflect.DeepEqual(base.Ref, target.Ref) {
+		fields = append(fields, "ref")
+	}
+	if !reflect.DeepEqual(base.IntegrationID, target.IntegrationID) {
+		fields = append(fields, "integration")
+	}
+
+	keys := make(map[string]struct{}, len(base.Configuration)+len(target.Configuration))
+	for key := range base.Configuration {
+		keys[key] = struct{}{}
+	}
+	for key := range target.Configuration {
+		keys[key] = struct{}{}
+	}
+
+	changedKeys := []string{}
+	for key := range keys {
+		baseValue, hasBase := base.Configuration[key]
+		targetValue, hasTarget := target.Configuration[key]
+		if hasBase != hasTarget || !reflect.DeepEqual(baseValue, targetValue) {
+			changedKeys = append(changedKeys, "configuration."+key)
+		}
+	}
+	sort.Strings(changedKeys)
+	fields = append(fields, changedKeys...)
+
+	if base.Position != target.Position {
+		fields = append(fields, "position")
+	}
+	if base.IsCollapsed != target.IsCollapsed {
+		fields = append(fields, "isCollapsed")
+	}
+
+	return fields
+}
+
+func edgeKey(edge models.Edge) string {
+	return edge.SourceID + "|" + edge.TargetID + "|" + edge.Channel
+}
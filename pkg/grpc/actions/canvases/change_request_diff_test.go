@@ -0,0 +1,46 @@
+package canvases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+)
+
+func TestResolveCanvasDiff(t *testing.T) {
+	base := []models.Node{
+		{ID: "a", Name: "A", Type: models.NodeTypeComponent, Configuration: map[string]any{"x": 1.0, "y": "keep"}},
+		{ID: "b", Name: "B", Type: models.NodeTypeComponent, Position: models.Position{X: 10}},
+		{ID: "c", Name: "C", Type: models.NodeTypeComponent},
+	}
+	target := []models.Node{
+		{ID: "a", Name: "A", Type: models.NodeTypeComponent, Configuration: map[string]any{"x": 2.0, "y": "keep", "z": true}},
+		{ID: "b", Name: "B", Type: models.NodeTypeComponent, Position: models.Position{X: 10}},
+		{ID: "d", Name: "D", Type: models.NodeTypeComponent},
+	}
+
+	nodes, edges := resolveCanvasDiff(
+		base,
+		[]models.Edge{{SourceID: "a", TargetID: "b", Channel: "default"}},
+		target,
+		[]models.Edge{{SourceID: "a", TargetID: "b", Channel: "default"}, {SourceID: "b", TargetID: "d", Channel: "default"}},
+	)
+
+	require.Len(t, nodes, 3)
+	assert.Equal(t, canvasNodeDiff{NodeID: "a", Name: "A", Type: pb.CanvasDiff_CHANGE_TYPE_CHANGED, Fields: []string{"configuration.x", "configuration.z"}}, nodes[0])
+	assert.Equal(t, canvasNodeDiff{NodeID: "d", Name: "D", Type: pb.CanvasDiff_CHANGE_TYPE_ADDED}, nodes[1])
+	assert.Equal(t, canvasNodeDiff{NodeID: "c", Name: "C", Type: pb.CanvasDiff_CHANGE_TYPE_REMOVED}, nodes[2])
+
+	require.Len(t, edges, 1)
+	assert.Equal(t, canvasEdgeDiff{Edge: models.Edge{SourceID: "b", TargetID: "d", Channel: "default"}, Type: pb.CanvasDiff_CHANGE_TYPE_ADDED}, edges[0])
+}
+
+func TestKeepLivePositions(t *testing.T) {
+	live := []models.Node{{ID: "a", Position: models.Position{X: 10, Y: 20}}}
+	nodes := keepLivePositions(live, []models.Node{{ID: "a"}, {ID: "b"}})
+
+	assert.Equal(t, models.Position{X: 10, Y: 20}, nodes[0].Position)
+	assert.Equal(t, models.Position{}, nodes[1].Position)
+}
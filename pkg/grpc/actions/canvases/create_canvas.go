@@ -10,6 +10,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/quotas"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -43,6 +44,14 @@ func CreateCanvas(ctx context.Context, registry *registry.Registry, organization
 	isTemplate := pbCanvas.Metadata.GetIsTemplate()
 	if isTemplate {
 		targetOrganizationID = models.TemplateOrganizationID
+	} else {
+		if err := quotas.CheckCanvases(database.Conn(), targetOrganizationID); err != nil {
+			return nil, quotaStatusError(err)
+		}
+
+		if err := quotas.CheckNodesPerCanvas(database.Conn(), targetOrganizationID, len(nodes)); err != nil {
+			return nil, quotaStatusError(err)
+		}
 	}
 	liveVersionID := uuid.New()
 
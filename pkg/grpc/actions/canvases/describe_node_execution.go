@@ -0,0 +1,57 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func DescribeNodeExecution(ctx context.Context, registry *registry.Registry, workflowID, executionID uuid.UUID) (*pb.DescribeNodeExecutionResponse, error) {
+	execution, err := models.FindNodeExecution(workflowID, executionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "execution not found")
+		}
+
+		return nil, err
+	}
+
+	childExecutions, err := models.FindChildExecutionsForMultiple([]string{execution.ID.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := SerializeNodeExecutions([]models.CanvasNodeExecution{*execution}, childExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	kvs, err := models.ListNodeExecutionKVs(workflowID, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DescribeNodeExecutionResponse{
+		Execution: serialized[0],
+		Kvs:       serializeNodeExecutionKVs(kvs),
+	}, nil
+}
+
+func serializeNodeExecutionKVs(kvs []models.CanvasNodeExecutionKV) []*pb.CanvasNodeExecutionKV {
+	result := make([]*pb.CanvasNodeExecutionKV, 0, len(kvs))
+	for _, kv := range kvs {
+		result = append(result, &pb.CanvasNodeExecutionKV{
+			Key:   kv.Key,
+			Value: kv.Value,
+		})
+	}
+
+	return result
+}
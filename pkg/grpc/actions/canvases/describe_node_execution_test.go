@@ -0,0 +1,75 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__DescribeNodeExecution__ReturnsNotFoundForUnknownExecution(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	_, err := DescribeNodeExecution(context.Background(), r.Registry, canvas.ID, uuid.New())
+	require.Error(t, err)
+
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, s.Code())
+}
+
+func Test__DescribeNodeExecution__ReturnsExecutionWithChildExecutionsAndKVs(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeBlueprint,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Blueprint: &models.BlueprintRef{ID: "test-blueprint"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	parentExecution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, event.ID, nil)
+
+	childEvent := support.EmitCanvasEventForNode(t, canvas.ID, "child-node-1", "default", nil)
+	childExecution := support.CreateCanvasNodeExecution(t, canvas.ID, "child-node-1", rootEvent.ID, childEvent.ID, &parentExecution.ID)
+
+	err := models.CreateNodeExecutionKVInTransaction(database.Conn(), canvas.ID, "node-1", parentExecution.ID, "pipeline_id", "123")
+	require.NoError(t, err)
+
+	response, err := DescribeNodeExecution(context.Background(), r.Registry, canvas.ID, parentExecution.ID)
+	require.NoError(t, err)
+	require.NotNil(t, response.Execution)
+
+	assert.Equal(t, parentExecution.ID.String(), response.Execution.Id)
+	assert.Equal(t, "node-1", response.Execution.NodeId)
+	assert.Equal(t, rootEvent.ID.String(), response.Execution.RootEvent.Id)
+
+	require.Len(t, response.Execution.ChildExecutions, 1)
+	assert.Equal(t, childExecution.ID.String(), response.Execution.ChildExecutions[0].Id)
+
+	require.Len(t, response.Kvs, 1)
+	assert.Equal(t, "pipeline_id", response.Kvs[0].Key)
+	assert.Equal(t, "123", response.Kvs[0].Value)
+}
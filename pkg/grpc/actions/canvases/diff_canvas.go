@@ -0,0 +1,98 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	compb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func DiffCanvas(
+	ctx context.Context,
+	registry *registry.Registry,
+	organizationID string,
+	canvasID string,
+	pbCanvas *pb.Canvas,
+) (*pb.DiffCanvasResponse, error) {
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
+	}
+
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
+	}
+
+	nodes, edges, err := ParseCanvas(registry, organizationID, pbCanvas)
+	if err != nil {
+		return nil, err
+	}
+
+	liveNodes, liveEdges, err := models.FindLiveCanvasSpecInTransaction(database.Conn(), canvas.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to load live canvas version: %v", err)
+	}
+
+	nodeDiffs, edgeDiffs := resolveCanvasDiff(liveNodes, liveEdges, keepLivePositions(liveNodes, nodes), edges)
+
+	return &pb.DiffCanvasResponse{
+		Diff: serializeCanvasDiff(nodeDiffs, edgeDiffs),
+	}, nil
+}
+
+/*
+ * Nodes without a position are laid out when the canvas is saved,
+ * so a missing position is not reported as a change.
+ */
+func keepLivePositions(liveNodes []models.Node, nodes []models.Node) []models.Node {
+	liveByID := mapNodesByID(liveNodes)
+	result := make([]models.Node, 0, len(nodes))
+	for _, node := range nodes {
+		liveNode, ok := liveByID[node.ID]
+		if ok && node.Position == (models.Position{}) {
+			node.Position = liveNode.Position
+		}
+
+		result = append(result, node)
+	}
+
+	return result
+}
+
+func serializeCanvasDiff(nodeDiffs []canvasNodeDiff, edgeDiffs []canvasEdgeDiff) *pb.CanvasDiff {
+	diff := &pb.CanvasDiff{
+		Nodes: make([]*pb.CanvasDiff_NodeChange, 0, len(nodeDiffs)),
+		Edges: make([]*pb.CanvasDiff_EdgeChange, 0, len(edgeDiffs)),
+	}
+
+	for _, nodeDiff := range nodeDiffs {
+		diff.Nodes = append(diff.Nodes, &pb.CanvasDiff_NodeChange{
+			NodeId:   nodeDiff.NodeID,
+			NodeName: nodeDiff.Name,
+			Type:     nodeDiff.Type,
+			Fields:   nodeDiff.Fields,
+		})
+	}
+
+	for _, edgeDiff := range edgeDiffs {
+		diff.Edges = append(diff.Edges, &pb.CanvasDiff_EdgeChange{
+			Edge: &compb.Edge{
+				SourceId: edgeDiff.Edge.SourceID,
+				TargetId: edgeDiff.Edge.TargetID,
+				Channel:  edgeDiff.Edge.Channel,
+			},
+			Type: edgeDiff.Type,
+		})
+	}
+
+	return diff
+}
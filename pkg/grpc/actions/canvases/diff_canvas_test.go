@@ -0,0 +1,102 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	compb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/datatypes"
+)
+
+func Test__DiffCanvas(t *testing.T) {
+	r := support.Setup(t)
+
+	noopRef := datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}})
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: "node-1", Name: "First", Type: models.NodeTypeComponent, Ref: noopRef},
+			{NodeID: "node-2", Name: "Second", Type: models.NodeTypeComponent, Ref: noopRef},
+		},
+		[]models.Edge{
+			{SourceID: "node-1", TargetID: "node-2", Channel: "default"},
+		},
+	)
+
+	t.Run("canvas does not exist -> error", func(t *testing.T) {
+		_, err := DiffCanvas(context.Background(), r.Registry, r.Organization.ID.String(), uuid.New().String(), &pb.Canvas{})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("same spec -> empty diff", func(t *testing.T) {
+		response, err := DiffCanvas(context.Background(), r.Registry, r.Organization.ID.String(), canvas.ID.String(), &pb.Canvas{
+			Metadata: &pb.Canvas_Metadata{Name: canvas.Name},
+			Spec: &pb.Canvas_Spec{
+				Nodes: []*compb.Node{
+					diffTestNode("node-1", "First", nil),
+					diffTestNode("node-2", "Second", nil),
+				},
+				Edges: []*compb.Edge{{SourceId: "node-1", TargetId: "node-2", Channel: "default"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, response.Diff.Nodes)
+		assert.Empty(t, response.Diff.Edges)
+	})
+
+	t.Run("changed spec -> node and edge changes", func(t *testing.T) {
+		configuration, err := structpb.NewStruct(map[string]any{"message": "hello"})
+		require.NoError(t, err)
+
+		response, err := DiffCanvas(context.Background(), r.Registry, r.Organization.ID.String(), canvas.ID.String(), &pb.Canvas{
+			Metadata: &pb.Canvas_Metadata{Name: canvas.Name},
+			Spec: &pb.Canvas_Spec{
+				Nodes: []*compb.Node{
+					diffTestNode("node-1", "First Renamed", configuration),
+					diffTestNode("node-3", "Third", nil),
+				},
+				Edges: []*compb.Edge{{SourceId: "node-1", TargetId: "node-3", Channel: "default"}},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Diff.Nodes, 3)
+		assert.Equal(t, "node-1", response.Diff.Nodes[0].NodeId)
+		assert.Equal(t, pb.CanvasDiff_CHANGE_TYPE_CHANGED, response.Diff.Nodes[0].Type)
+		assert.Equal(t, []string{"name", "configuration.message"}, response.Diff.Nodes[0].Fields)
+		assert.Equal(t, "node-3", response.Diff.Nodes[1].NodeId)
+		assert.Equal(t, pb.CanvasDiff_CHANGE_TYPE_ADDED, response.Diff.Nodes[1].Type)
+		assert.Equal(t, "node-2", response.Diff.Nodes[2].NodeId)
+		assert.Equal(t, pb.CanvasDiff_CHANGE_TYPE_REMOVED, response.Diff.Nodes[2].Type)
+
+		require.Len(t, response.Diff.Edges, 2)
+		assert.Equal(t, pb.CanvasDiff_CHANGE_TYPE_ADDED, response.Diff.Edges[0].Type)
+		assert.Equal(t, "node-3", response.Diff.Edges[0].Edge.TargetId)
+		assert.Equal(t, pb.CanvasDiff_CHANGE_TYPE_REMOVED, response.Diff.Edges[1].Type)
+		assert.Equal(t, "node-2", response.Diff.Edges[1].Edge.TargetId)
+	})
+}
+
+func diffTestNode(id string, name string, configuration *structpb.Struct) *compb.Node {
+	return &compb.Node{
+		Id:            id,
+		Name:          name,
+		Type:          compb.Node_TYPE_COMPONENT,
+		Component:     &compb.Node_ComponentRef{Name: "noop"},
+		Configuration: configuration,
+	}
+}
@@ -12,7 +12,11 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/quotas"
+	manual "github.com/superplanehq/superplane/pkg/triggers/start"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/datatypes"
 )
 
@@ -34,6 +38,15 @@ func EmitNodeEvent(
 		return nil, fmt.Errorf("canvas node not found: %w", err)
 	}
 
+	data, err = resolveStartInputs(node, data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid inputs: %v", err)
+	}
+
+	if err := quotas.CheckEvents(database.Conn(), orgID); err != nil {
+		return nil, quotaStatusError(err)
+	}
+
 	now := time.Now()
 	event := models.CanvasEvent{
 		WorkflowID: canvas.ID,
@@ -65,6 +78,17 @@ func EmitNodeEvent(
 	}, nil
 }
 
+// Runs started from the UI or the API emit the event for the start
+// trigger directly, so its declared inputs are validated here too.
+func resolveStartInputs(node *models.CanvasNode, data map[string]any) (map[string]any, error) {
+	ref := node.Ref.Data()
+	if node.Type != models.NodeTypeTrigger || ref.Trigger == nil || ref.Trigger.Name != "start" {
+		return data, nil
+	}
+
+	return manual.ResolveEventInputs(node.Configuration.Data(), data)
+}
+
 func resolveCustomName(node *models.CanvasNode, payload map[string]any) (*string, error) {
 	config := node.Configuration.Data()
 	if config == nil {
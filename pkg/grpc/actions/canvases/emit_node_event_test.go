@@ -13,6 +13,8 @@ import (
 	"github.com/superplanehq/superplane/pkg/models"
 	testconsumer "github.com/superplanehq/superplane/test/consumer"
 	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/datatypes"
 )
 
@@ -282,4 +284,57 @@ func Test__EmitNodeEvent(t *testing.T) {
 		eventData := event.Data.Data()
 		assert.Nil(t, eventData)
 	})
+
+	t.Run("start trigger inputs are validated", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "start-1",
+					Name:   "Start",
+					Type:   models.NodeTypeTrigger,
+					Ref: datatypes.NewJSONType(models.NodeRef{
+						Trigger: &models.TriggerRef{Name: "start"},
+					}),
+					Configuration: datatypes.NewJSONType(map[string]any{
+						"inputs": []any{
+							map[string]any{"name": "environment", "type": "select", "options": []any{"staging", "production"}, "required": true},
+							map[string]any{"name": "replicas", "type": "number", "default": "2"},
+						},
+					}),
+				},
+			},
+			[]models.Edge{},
+		)
+
+		for _, data := range []map[string]any{
+			nil,
+			{"inputs": map[string]any{"environment": "qa"}},
+			{"inputs": map[string]any{"environment": "staging", "region": "eu"}},
+		} {
+			_, err := EmitNodeEvent(ctx, r.Organization.ID, canvas.ID, "start-1", "default", data)
+			s, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, s.Code())
+		}
+
+		response, err := EmitNodeEvent(
+			ctx,
+			r.Organization.ID,
+			canvas.ID,
+			"start-1",
+			"default",
+			map[string]any{"inputs": map[string]any{"environment": "production"}},
+		)
+
+		require.NoError(t, err)
+
+		event, err := models.FindCanvasEvent(uuid.MustParse(response.EventId))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"inputs": map[string]any{"environment": "production", "replicas": float64(2)},
+		}, event.Data.Data())
+	})
 }
@@ -88,7 +88,7 @@ func InvokeNodeExecutionAction(
 		HTTP:           registry.HTTPContext(),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
-		Auth:           contexts.NewAuthContext(tx, orgID, authService, user),
+		Auth:           contexts.NewAuthContext(tx, orgID, canvas.ID, authService, user),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, orgID, canvas.ID),
 	}
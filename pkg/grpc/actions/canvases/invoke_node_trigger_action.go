@@ -86,7 +86,9 @@ func InvokeNodeTriggerAction(
 		HTTP:          registry.HTTPContext(),
 		Metadata:      contexts.NewNodeMetadataContext(tx, node),
 		Requests:      contexts.NewNodeRequestContext(tx, node),
+		Events:        contexts.NewEventContext(tx, node),
 		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, encryptor, node, webhookBaseURL),
+		Calendars:     contexts.NewCalendarContext(tx, node),
 	}
 
 	if node.AppInstallationID != nil {
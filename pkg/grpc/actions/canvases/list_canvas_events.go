@@ -12,19 +12,24 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func ListCanvasEvents(ctx context.Context, registry *registry.Registry, canvasID uuid.UUID, limit uint32, before *timestamppb.Timestamp) (*pb.ListCanvasEventsResponse, error) {
-	limit = getLimit(limit)
-	beforeTime := getBefore(before)
-	events, err := models.ListRootCanvasEvents(canvasID, int(limit), beforeTime)
+func ListCanvasEvents(ctx context.Context, registry *registry.Registry, canvasID uuid.UUID, nodeIDs []string, query ListQuery) (*pb.ListCanvasEventsResponse, error) {
+	options, err := query.toListOptions()
 	if err != nil {
 		return nil, err
 	}
 
-	count, err := models.CountRootCanvasEvents(canvasID)
+	options.NodeIDs = nodeIDs
+	events, err := models.ListRootCanvasEventsFiltered(canvasID, options)
 	if err != nil {
 		return nil, err
 	}
 
+	count, err := models.CountRootCanvasEventsFiltered(canvasID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	events, hasNextPage, nextPageToken := paginate(events, options.Limit, eventCursor)
 	executionsByEventID, childExecutionsByEventID, err := listExecutionsForCanvasEvents(events)
 	if err != nil {
 		return nil, err
@@ -38,8 +43,9 @@ func ListCanvasEvents(ctx context.Context, registry *registry.Registry, canvasID
 	return &pb.ListCanvasEventsResponse{
 		Events:        serialized,
 		TotalCount:    uint32(count),
-		HasNextPage:   hasNextPage(len(events), int(limit), count),
+		HasNextPage:   hasNextPage,
 		LastTimestamp: getLastEventTimestamp(events),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
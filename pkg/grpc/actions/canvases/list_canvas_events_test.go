@@ -42,7 +42,7 @@ func Test__ListCanvasEvents__ReturnsEventsWithExecutions(t *testing.T) {
 	parentExecution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent1.ID, rootEvent1.ID, nil)
 	nextExecution := support.CreateNextNodeExecution(t, canvas.ID, "node-1", rootEvent1.ID, rootEvent1.ID, &parentExecution.ID)
 
-	response, err := ListCanvasEvents(context.Background(), r.Registry, canvas.ID, 0, nil)
+	response, err := ListCanvasEvents(context.Background(), r.Registry, canvas.ID, nil, ListQuery{})
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	require.Len(t, response.Events, 2)
@@ -0,0 +1,121 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ListCanvasRoleBindings(ctx context.Context, authService authorization.Authorization, organizationID, canvasID string) (*pb.ListCanvasRoleBindingsResponse, error) {
+	canvas, err := findCanvasForRoleBindings(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := authService.GetCanvasRoleBindings(canvas.ID.String())
+	if err != nil {
+		log.Errorf("failed to list role bindings for canvas %s: %v", canvas.ID, err)
+		return nil, status.Error(codes.Internal, "failed to list canvas role bindings")
+	}
+
+	userIDs := []string{}
+	for _, binding := range bindings {
+		if binding.UserID != "" {
+			userIDs = append(userIDs, binding.UserID)
+		}
+	}
+
+	users, err := models.ListActiveUsersByID(organizationID, userIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list canvas role bindings")
+	}
+
+	emails := map[string]string{}
+	for _, user := range users {
+		emails[user.ID.String()] = user.GetEmail()
+	}
+
+	response := &pb.ListCanvasRoleBindingsResponse{Bindings: []*pb.CanvasRoleBinding{}}
+	for _, binding := range bindings {
+		//
+		// Users removed from the organization keep their bindings,
+		// but those are not listed, since they cannot use them anymore.
+		//
+		if binding.UserID != "" && emails[binding.UserID] == "" {
+			continue
+		}
+
+		response.Bindings = append(response.Bindings, serializeCanvasRoleBinding(binding, emails[binding.UserID]))
+	}
+
+	return response, nil
+}
+
+func serializeCanvasRoleBinding(binding *authorization.CanvasRoleBinding, userEmail string) *pb.CanvasRoleBinding {
+	return &pb.CanvasRoleBinding{
+		Role:      binding.Role,
+		UserId:    binding.UserID,
+		UserEmail: userEmail,
+		GroupName: binding.Group,
+	}
+}
+
+func findCanvasForRoleBindings(organizationID, canvasID string) (*models.Canvas, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	canvas, err := models.FindCanvas(orgUUID, canvasUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	return canvas, nil
+}
+
+/*
+ * Role bindings reference either a user of the organization,
+ * or one of its groups, but not both.
+ */
+func findCanvasRoleSubject(organizationID, userID, groupName string) (*authorization.CanvasRoleBinding, string, error) {
+	if (userID == "") == (groupName == "") {
+		return nil, "", status.Error(codes.InvalidArgument, "either user_id or group_name is required")
+	}
+
+	if groupName != "" {
+		if _, err := models.FindGroupMetadata(groupName, models.DomainTypeOrganization, organizationID); err != nil {
+			return nil, "", status.Errorf(codes.NotFound, "group %s not found", groupName)
+		}
+
+		return &authorization.CanvasRoleBinding{Group: groupName}, "", nil
+	}
+
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	user, err := models.FindActiveUserByID(organizationID, userID)
+	if err != nil {
+		return nil, "", status.Errorf(codes.NotFound, "user %s not found", userID)
+	}
+
+	return &authorization.CanvasRoleBinding{UserID: user.ID.String()}, user.GetEmail(), nil
+}
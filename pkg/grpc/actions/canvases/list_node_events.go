@@ -7,26 +7,28 @@ import (
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func ListNodeEvents(ctx context.Context, registry *registry.Registry, workflowID uuid.UUID, nodeID string, limit uint32, before *timestamppb.Timestamp) (*pb.ListNodeEventsResponse, error) {
-	limit = getLimit(limit)
-	beforeTime := getBefore(before)
+func ListNodeEvents(ctx context.Context, registry *registry.Registry, workflowID uuid.UUID, nodeID string, query ListQuery) (*pb.ListNodeEventsResponse, error) {
+	options, err := query.toListOptions()
+	if err != nil {
+		return nil, err
+	}
 
 	//
 	// List and count events
 	//
-	events, err := models.ListCanvasEvents(workflowID, nodeID, int(limit), beforeTime)
+	events, err := models.ListCanvasEventsFiltered(workflowID, nodeID, options)
 	if err != nil {
 		return nil, err
 	}
 
-	totalCount, err := models.CountCanvasEvents(workflowID, nodeID)
+	totalCount, err := models.CountCanvasEventsFiltered(workflowID, nodeID, options)
 	if err != nil {
 		return nil, err
 	}
 
+	events, hasNextPage, nextPageToken := paginate(events, options.Limit, eventCursor)
 	serialized, err := SerializeCanvasEvents(events)
 	if err != nil {
 		return nil, err
@@ -35,7 +37,8 @@ func ListNodeEvents(ctx context.Context, registry *registry.Registry, workflowID
 	return &pb.ListNodeEventsResponse{
 		Events:        serialized,
 		TotalCount:    uint32(totalCount),
-		HasNextPage:   hasNextPage(len(events), int(limit), totalCount),
+		HasNextPage:   hasNextPage,
 		LastTimestamp: getLastEventTimestamp(events),
+		NextPageToken: nextPageToken,
 	}, nil
 }
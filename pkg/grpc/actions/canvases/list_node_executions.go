@@ -20,7 +20,7 @@ import (
 	"gorm.io/gorm"
 )
 
-func ListNodeExecutions(ctx context.Context, registry *registry.Registry, workflowID, nodeID string, pbStates []pb.CanvasNodeExecution_State, pbResults []pb.CanvasNodeExecution_Result, limit uint32, before *timestamppb.Timestamp) (*pb.ListNodeExecutionsResponse, error) {
+func ListNodeExecutions(ctx context.Context, registry *registry.Registry, workflowID, nodeID string, pbStates []pb.CanvasNodeExecution_State, pbResults []pb.CanvasNodeExecution_Result, query ListQuery) (*pb.ListNodeExecutionsResponse, error) {
 	wfID, err := uuid.Parse(workflowID)
 	if err != nil {
 		return nil, err
@@ -45,22 +45,28 @@ func ListNodeExecutions(ctx context.Context, registry *registry.Registry, workfl
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	limit = getLimit(limit)
-	beforeTime := getBefore(before)
+	options, err := query.toListOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	options.States = states
+	options.Results = results
 
 	//
 	// List and count executions
 	//
-	executions, err := models.ListNodeExecutions(wfID, nodeID, states, results, int(limit), beforeTime)
+	executions, err := models.ListNodeExecutionsFiltered(wfID, nodeID, options)
 	if err != nil {
 		return nil, err
 	}
 
-	totalCount, err := models.CountNodeExecutions(wfID, nodeID, states, results)
+	totalCount, err := models.CountNodeExecutionsFiltered(wfID, nodeID, options)
 	if err != nil {
 		return nil, err
 	}
 
+	executions, hasNextPage, nextPageToken := paginate(executions, options.Limit, executionCursor)
 	serialized, err := SerializeNodeExecutionsForSingleNode(workflowNode, executions)
 	if err != nil {
 		return nil, err
@@ -69,8 +75,9 @@ func ListNodeExecutions(ctx context.Context, registry *registry.Registry, workfl
 	return &pb.ListNodeExecutionsResponse{
 		Executions:    serialized,
 		TotalCount:    uint32(totalCount),
-		HasNextPage:   hasNextPage(len(executions), int(limit), totalCount),
+		HasNextPage:   hasNextPage,
 		LastTimestamp: getLastExecutionTimestamp(executions),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -202,6 +209,10 @@ func SerializeNodeExecutions(executions []models.CanvasNodeExecution, childExecu
 			CancelledBy:         cancelledByRef(execution.CancelledBy, cancelledByUsersByID),
 		}
 
+		if execution.LastActivityAt != nil {
+			pbExecution.LastActivityAt = timestamppb.New(*execution.LastActivityAt)
+		}
+
 		if len(childExecutions) == 0 {
 			result = append(result, pbExecution)
 			continue
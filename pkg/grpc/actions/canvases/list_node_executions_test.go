@@ -50,8 +50,7 @@ func Test__ListNodeExecutions(t *testing.T) {
 			"non-existent-node",
 			[]pb.CanvasNodeExecution_State{},
 			[]pb.CanvasNodeExecution_Result{},
-			0,
-			nil,
+			ListQuery{},
 		)
 
 		//
@@ -74,8 +73,7 @@ func Test__ListNodeExecutions(t *testing.T) {
 			"some-node",
 			[]pb.CanvasNodeExecution_State{},
 			[]pb.CanvasNodeExecution_Result{},
-			0,
-			nil,
+			ListQuery{},
 		)
 
 		//
@@ -128,8 +126,7 @@ func Test__ListNodeExecutions(t *testing.T) {
 			"node-1",
 			[]pb.CanvasNodeExecution_State{},
 			[]pb.CanvasNodeExecution_Result{},
-			0,
-			nil,
+			ListQuery{},
 		)
 
 		//
@@ -143,4 +140,111 @@ func Test__ListNodeExecutions(t *testing.T) {
 		require.NotNil(t, response.Executions[0].RootEvent)
 		assert.Equal(t, customName, response.Executions[0].RootEvent.CustomName)
 	})
+
+	t.Run("filters by result and payload, and paginates with page tokens", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "deploy",
+					Name:   "Deploy",
+					Type:   models.NodeTypeComponent,
+					Ref: datatypes.NewJSONType(models.NodeRef{
+						Component: &models.ComponentRef{Name: "noop"},
+					}),
+				},
+			},
+			[]models.Edge{},
+		)
+
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "deploy", "default", nil)
+		failedIDs := []string{}
+		for i, ref := range []string{"refs/heads/main", "refs/heads/main", "refs/heads/main", "refs/heads/dev"} {
+			event := support.EmitCanvasEventForNodeWithData(t, canvas.ID, "deploy", "default", nil, map[string]any{
+				"data": map[string]any{"ref": ref},
+			})
+
+			execution := support.CreateCanvasNodeExecution(t, canvas.ID, "deploy", rootEvent.ID, event.ID, nil)
+			result := models.CanvasNodeExecutionResultFailed
+			if i == 1 {
+				result = models.CanvasNodeExecutionResultPassed
+			}
+
+			require.NoError(t, database.Conn().Model(execution).Updates(map[string]any{
+				"state":  models.CanvasNodeExecutionStateFinished,
+				"result": result,
+			}).Error)
+
+			if result == models.CanvasNodeExecutionResultFailed && ref == "refs/heads/main" {
+				failedIDs = append(failedIDs, execution.ID.String())
+			}
+		}
+
+		query := ListQuery{
+			Limit:   1,
+			Order:   pb.ListOrder_LIST_ORDER_ASC,
+			Payload: map[string]string{"data.ref": "refs/heads/main"},
+		}
+
+		listedIDs := []string{}
+		for {
+			response, err := ListNodeExecutions(
+				context.Background(),
+				r.Registry,
+				canvas.ID.String(),
+				"deploy",
+				[]pb.CanvasNodeExecution_State{},
+				[]pb.CanvasNodeExecution_Result{pb.CanvasNodeExecution_RESULT_FAILED},
+				query,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(2), response.TotalCount)
+			require.Len(t, response.Executions, 1)
+			listedIDs = append(listedIDs, response.Executions[0].Id)
+
+			if !response.HasNextPage {
+				assert.Empty(t, response.NextPageToken)
+				break
+			}
+
+			query.PageToken = response.NextPageToken
+		}
+
+		assert.Equal(t, failedIDs, listedIDs)
+	})
+
+	t.Run("invalid page token -> 400 error", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "node-1",
+					Name:   "Test Node",
+					Type:   models.NodeTypeComponent,
+					Ref: datatypes.NewJSONType(models.NodeRef{
+						Component: &models.ComponentRef{Name: "noop"},
+					}),
+				},
+			},
+			[]models.Edge{},
+		)
+
+		_, err := ListNodeExecutions(
+			context.Background(),
+			r.Registry,
+			canvas.ID.String(),
+			"node-1",
+			[]pb.CanvasNodeExecution_State{},
+			[]pb.CanvasNodeExecution_Result{},
+			ListQuery{PageToken: "not-a-token"},
+		)
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
 }
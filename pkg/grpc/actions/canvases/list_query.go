@@ -0,0 +1,141 @@
+package canvases
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//
+// ListQuery holds the filtering, ordering and pagination
+// parameters shared by the event and execution list requests.
+//
+
+type ListQuery struct {
+	Limit         uint32
+	Before        *timestamppb.Timestamp
+	CreatedAfter  *timestamppb.Timestamp
+	CreatedBefore *timestamppb.Timestamp
+	Order         pb.ListOrder
+	PageToken     string
+	Payload       map[string]string
+}
+
+func (q ListQuery) toListOptions() (models.ListOptions, error) {
+	options := models.ListOptions{
+		CreatedAfter:  timeOrNil(q.CreatedAfter),
+		CreatedBefore: timeOrNil(q.CreatedBefore),
+		Ascending:     q.Order == pb.ListOrder_LIST_ORDER_ASC,
+		Before:        getBefore(q.Before),
+
+		//
+		// One more record than requested is loaded,
+		// to know if there is a next page without counting.
+		//
+		Limit: int(getLimit(q.Limit)) + 1,
+	}
+
+	if options.CreatedAfter != nil && options.CreatedBefore != nil && !options.CreatedAfter.Before(*options.CreatedBefore) {
+		return options, status.Error(codes.InvalidArgument, "created_after must be before created_before")
+	}
+
+	for path := range q.Payload {
+		if !validPayloadPath(path) {
+			return options, status.Errorf(codes.InvalidArgument, "invalid payload filter %q", path)
+		}
+	}
+	options.Payload = q.Payload
+
+	if q.PageToken != "" {
+		cursor, err := decodePageToken(q.PageToken)
+		if err != nil {
+			return options, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+
+		options.Cursor = cursor
+	}
+
+	return options, nil
+}
+
+func timeOrNil(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	value := t.AsTime()
+	return &value
+}
+
+func validPayloadPath(path string) bool {
+	for _, key := range strings.Split(path, ".") {
+		if strings.TrimSpace(key) == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+ * Page tokens are opaque to clients,
+ * but are just the creation time and ID of the last record of the page.
+ */
+func encodePageToken(createdAt time.Time, id uuid.UUID) string {
+	raw := fmt.Sprintf("%s|%s", createdAt.UTC().Format(time.RFC3339Nano), id.String())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (*models.ListCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAtValue, idValue, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, fmt.Errorf("malformed page token")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, createdAtValue)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(idValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ListCursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+/*
+ * Trims the extra record loaded by toListOptions,
+ * and returns the token for the next page, if there is one.
+ */
+func paginate[T any](items []T, limit int, cursor func(T) (time.Time, uuid.UUID)) ([]T, bool, string) {
+	if len(items) < limit {
+		return items, false, ""
+	}
+
+	items = items[:limit-1]
+	createdAt, id := cursor(items[len(items)-1])
+	return items, true, encodePageToken(createdAt, id)
+}
+
+func executionCursor(execution models.CanvasNodeExecution) (time.Time, uuid.UUID) {
+	return *execution.CreatedAt, execution.ID
+}
+
+func eventCursor(event models.CanvasEvent) (time.Time, uuid.UUID) {
+	return *event.CreatedAt, event.ID
+}
@@ -0,0 +1,51 @@
+package canvases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__PageToken__RoundTrip(t *testing.T) {
+	createdAt := time.Date(2026, 10, 12, 8, 30, 15, 123456000, time.UTC)
+	id := uuid.New()
+
+	cursor, err := decodePageToken(encodePageToken(createdAt, id))
+	require.NoError(t, err)
+	assert.True(t, createdAt.Equal(cursor.CreatedAt))
+	assert.Equal(t, id, cursor.ID)
+
+	_, err = decodePageToken("not-a-token")
+	assert.Error(t, err)
+}
+
+func Test__Paginate(t *testing.T) {
+	now := time.Now()
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	cursor := func(id uuid.UUID) (time.Time, uuid.UUID) { return now, id }
+
+	items, hasNextPage, token := paginate(ids, 3, cursor)
+	assert.True(t, hasNextPage)
+	assert.Equal(t, ids[:2], items)
+
+	decoded, err := decodePageToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, ids[1], decoded.ID)
+
+	items, hasNextPage, token = paginate(ids[:2], 3, cursor)
+	assert.False(t, hasNextPage)
+	assert.Empty(t, token)
+	assert.Equal(t, ids[:2], items)
+}
+
+func Test__ListQuery__RejectsInvalidPayloadPaths(t *testing.T) {
+	_, err := ListQuery{Payload: map[string]string{"data..ref": "main"}}.toListOptions()
+	assert.Error(t, err)
+
+	options, err := ListQuery{Limit: 10, Payload: map[string]string{"data.ref": "main"}}.toListOptions()
+	require.NoError(t, err)
+	assert.Equal(t, 11, options.Limit)
+}
@@ -0,0 +1,25 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+)
+
+func ListStuckExecutions(ctx context.Context, workflowID uuid.UUID) (*pb.ListStuckExecutionsResponse, error) {
+	executions, err := models.ListStuckNodeExecutionsForCanvas(workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := SerializeNodeExecutions(executions, []models.CanvasNodeExecution{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListStuckExecutionsResponse{
+		Executions: serialized,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package canvases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/workers"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__ListStuckExecutions(t *testing.T) {
+	r := support.Setup(t)
+	support.RegisterWaitingComponent(t, r, "waiting-approval", "approval", time.Hour)
+
+	approvalConfiguration := map[string]any{
+		"items": []any{
+			map[string]any{
+				"type": "user",
+				"user": r.User.String(),
+			},
+		},
+	}
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID:        "node-1",
+				Name:          "Node 1",
+				Type:          models.NodeTypeComponent,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "waiting-approval"}}),
+				Configuration: datatypes.NewJSONType(approvalConfiguration),
+			},
+			{
+				NodeID:        "node-2",
+				Name:          "Node 2",
+				Type:          models.NodeTypeComponent,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "approval"}}),
+				Configuration: datatypes.NewJSONType(approvalConfiguration),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	executor := workers.NewNodeExecutor(r.Encryptor, r.Registry, nil, "http://localhost", "http://localhost")
+
+	//
+	// Runs an execution through the executor, and moves its
+	// last activity back in time, as if it was waiting since then.
+	//
+	runExecution := func(nodeID string, idleFor time.Duration) *models.CanvasNodeExecution {
+		execution := support.CreateNodeExecutionWithConfiguration(t, canvas.ID, nodeID, rootEvent.ID, rootEvent.ID, nil, approvalConfiguration)
+		require.NoError(t, executor.LockAndProcessNodeExecution(context.Background(), execution.ID))
+		require.NoError(t, database.Conn().Model(execution).Update("last_activity_at", time.Now().Add(-idleFor)).Error)
+		return execution
+	}
+
+	stuck := runExecution("node-1", 2*time.Hour)
+	runExecution("node-1", time.Minute)
+
+	//
+	// Executions of components without a maximum waiting time are never stuck.
+	//
+	runExecution("node-2", 2*time.Hour)
+
+	response, err := canvases.ListStuckExecutions(context.Background(), canvas.ID)
+	require.NoError(t, err)
+	require.Len(t, response.Executions, 1)
+	assert.Equal(t, stuck.ID.String(), response.Executions[0].Id)
+	assert.NotNil(t, response.Executions[0].LastActivityAt)
+}
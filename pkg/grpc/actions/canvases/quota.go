@@ -0,0 +1,15 @@
+package canvases
+
+import (
+	"github.com/superplanehq/superplane/pkg/quotas"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func quotaStatusError(err error) error {
+	if quotas.IsExceeded(err) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return status.Errorf(codes.Internal, "failed to check organization quota: %v", err)
+}
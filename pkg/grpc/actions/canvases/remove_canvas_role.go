@@ -0,0 +1,29 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/authorization"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func RemoveCanvasRole(ctx context.Context, authService authorization.Authorization, organizationID, canvasID, userID, groupName string) (*pb.RemoveCanvasRoleResponse, error) {
+	canvas, err := findCanvasForRoleBindings(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	binding, _, err := findCanvasRoleSubject(organizationID, userID, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = authService.RemoveCanvasRole(canvas.ID.String(), *binding)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas role binding not found")
+	}
+
+	return &pb.RemoveCanvasRoleResponse{}, nil
+}
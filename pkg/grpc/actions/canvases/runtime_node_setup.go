@@ -184,6 +184,7 @@ func setupTrigger(ctx context.Context, tx *gorm.DB, encryptor crypto.Encryptor,
 		Requests:      contexts.NewNodeRequestContext(tx, node),
 		Events:        contexts.NewEventContext(tx, node),
 		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, encryptor, node, webhookBaseURL),
+		Calendars:     contexts.NewCalendarContext(tx, node),
 	}
 
 	if node.AppInstallationID != nil {
@@ -50,6 +50,7 @@ func SerializeCanvas(canvas *models.Canvas, includeStatus bool) (*pb.Canvas, err
 				UpdatedAt:      timestamppb.New(*canvas.UpdatedAt),
 				CreatedBy:      createdBy,
 				IsTemplate:     canvas.IsTemplate,
+				Priority:       CanvasPriorityToProto(canvas.Priority),
 			},
 			Spec: &pb.Canvas_Spec{
 				Nodes: serializedNodes,
@@ -112,6 +113,7 @@ func SerializeCanvas(canvas *models.Canvas, includeStatus bool) (*pb.Canvas, err
 			UpdatedAt:      timestamppb.New(*canvas.UpdatedAt),
 			CreatedBy:      createdBy,
 			IsTemplate:     canvas.IsTemplate,
+			Priority:       CanvasPriorityToProto(canvas.Priority),
 		},
 		Spec: &pb.Canvas_Spec{
 			Nodes: serializedNodes,
@@ -0,0 +1,90 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func SimulateCanvas(
+	ctx context.Context,
+	registry *registry.Registry,
+	organizationID string,
+	req *pb.SimulateCanvasRequest,
+) (*pb.SimulateCanvasResponse, error) {
+	if req.Canvas == nil {
+		return nil, status.Error(codes.InvalidArgument, "canvas is required")
+	}
+
+	nodes, edges, err := ParseCanvas(registry, organizationID, req.Canvas)
+	if err != nil {
+		return nil, err
+	}
+
+	trigger, err := findSimulationTrigger(nodes, req.TriggerNodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadType := req.PayloadType
+	if payloadType == "" {
+		payloadType = trigger.Ref.Trigger.Name
+	}
+
+	payload := map[string]any{}
+	if req.Payload != nil {
+		payload = req.Payload.AsMap()
+	} else if t, err := registry.GetTrigger(trigger.Ref.Trigger.Name); err == nil && t.ExampleData() != nil {
+		payload = t.ExampleData()
+	}
+
+	simulation, err := newCanvasSimulation(registry, nodes, edges, req.Fixtures)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	steps, err := simulation.run(trigger.ID, payloadType, payload)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &pb.SimulateCanvasResponse{
+		Simulation: &pb.CanvasSimulation{Steps: steps},
+	}, nil
+}
+
+/*
+ * The trigger node can be omitted when the canvas has only one.
+ */
+func findSimulationTrigger(nodes []models.Node, triggerNodeID string) (*models.Node, error) {
+	triggers := []models.Node{}
+	for _, node := range nodes {
+		if node.Type != models.NodeTypeTrigger || node.Ref.Trigger == nil {
+			continue
+		}
+
+		if node.ID == triggerNodeID {
+			return &node, nil
+		}
+
+		triggers = append(triggers, node)
+	}
+
+	if triggerNodeID != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "trigger node %s not found", triggerNodeID)
+	}
+
+	if len(triggers) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "canvas has no trigger nodes")
+	}
+
+	if len(triggers) > 1 {
+		return nil, status.Error(codes.InvalidArgument, "canvas has multiple trigger nodes, trigger node id is required")
+	}
+
+	return &triggers[0], nil
+}
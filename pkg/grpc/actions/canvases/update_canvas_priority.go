@@ -0,0 +1,77 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func UpdateCanvasPriority(ctx context.Context, organizationID uuid.UUID, id string, priority pb.Canvas_Priority) (*pb.UpdateCanvasPriorityResponse, error) {
+	canvasID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
+	}
+
+	value := CanvasPriorityFromProto(priority)
+	if value == "" {
+		return nil, status.Error(codes.InvalidArgument, "priority is required")
+	}
+
+	canvas, err := models.FindCanvas(organizationID, canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return nil, err
+	}
+
+	if canvas.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
+	}
+
+	err = database.Conn().Model(canvas).Update("priority", value).Error
+	if err != nil {
+		log.Errorf("failed to update priority of canvas %s: %v", canvas.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to update canvas priority")
+	}
+
+	serialized, err := SerializeCanvas(canvas, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCanvasPriorityResponse{Canvas: serialized}, nil
+}
+
+func CanvasPriorityFromProto(priority pb.Canvas_Priority) string {
+	switch priority {
+	case pb.Canvas_PRIORITY_LOW:
+		return models.CanvasPriorityLow
+	case pb.Canvas_PRIORITY_NORMAL:
+		return models.CanvasPriorityNormal
+	case pb.Canvas_PRIORITY_HIGH:
+		return models.CanvasPriorityHigh
+	default:
+		return ""
+	}
+}
+
+func CanvasPriorityToProto(priority string) pb.Canvas_Priority {
+	switch priority {
+	case models.CanvasPriorityLow:
+		return pb.Canvas_PRIORITY_LOW
+	case models.CanvasPriorityHigh:
+		return pb.Canvas_PRIORITY_HIGH
+	default:
+		return pb.Canvas_PRIORITY_NORMAL
+	}
+}
@@ -0,0 +1,66 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__UpdateCanvasPriority(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	t.Run("canvas does not exist -> error", func(t *testing.T) {
+		_, err := UpdateCanvasPriority(context.Background(), r.Organization.ID, uuid.New().String(), pb.Canvas_PRIORITY_HIGH)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("priority is required -> error", func(t *testing.T) {
+		_, err := UpdateCanvasPriority(context.Background(), r.Organization.ID, canvas.ID.String(), pb.Canvas_PRIORITY_UNSPECIFIED)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("canvases have normal priority by default", func(t *testing.T) {
+		serialized, err := SerializeCanvas(canvas, false)
+		require.NoError(t, err)
+		assert.Equal(t, pb.Canvas_PRIORITY_NORMAL, serialized.Metadata.Priority)
+	})
+
+	t.Run("priority is updated", func(t *testing.T) {
+		response, err := UpdateCanvasPriority(context.Background(), r.Organization.ID, canvas.ID.String(), pb.Canvas_PRIORITY_HIGH)
+		require.NoError(t, err)
+		assert.Equal(t, pb.Canvas_PRIORITY_HIGH, response.Canvas.Metadata.Priority)
+
+		updated, err := models.FindCanvas(r.Organization.ID, canvas.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasPriorityHigh, updated.Priority)
+	})
+}
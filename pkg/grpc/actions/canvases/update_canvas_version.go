@@ -14,6 +14,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/quotas"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -57,6 +58,10 @@ func UpdateCanvasVersion(
 		return nil, err
 	}
 
+	if err := quotas.CheckNodesPerCanvas(database.Conn(), organizationUUID, len(nodes)); err != nil {
+		return nil, quotaStatusError(err)
+	}
+
 	nodes, edges, err = applyCanvasAutoLayout(nodes, edges, autoLayout, registry)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,32 @@
+package me
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/auth"
+	pb "github.com/superplanehq/superplane/pkg/protos/me"
+)
+
+func CreateToken(ctx context.Context, authService authorization.Authorization, req *pb.CreateTokenRequest) (*pb.CreateTokenResponse, error) {
+	user, err := findTokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, plainToken, err := auth.CreateAPIToken(authService, user, user.ID, auth.APITokenOptions{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		CanvasID:  req.CanvasId,
+		ExpiresAt: req.ExpiresAt,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTokenResponse{
+		ApiToken: serializeAPIToken(token),
+		Token:    plainToken,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package me
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/me"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ListTokens(ctx context.Context) (*pb.ListTokensResponse, error) {
+	user, err := findTokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := models.ListAPITokensForUser(user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list tokens")
+	}
+
+	response := &pb.ListTokensResponse{Tokens: []*pb.ApiToken{}}
+	for _, token := range tokens {
+		response.Tokens = append(response.Tokens, serializeAPIToken(&token))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,22 @@
+package me
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/grpc/actions/auth"
+	pb "github.com/superplanehq/superplane/pkg/protos/me"
+)
+
+func RevokeToken(ctx context.Context, req *pb.RevokeTokenRequest) (*pb.RevokeTokenResponse, error) {
+	user, err := findTokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = auth.RevokeAPIToken(user, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeTokenResponse{}, nil
+}
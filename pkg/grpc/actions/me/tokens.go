@@ -0,0 +1,62 @@
+package me
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/me"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+/*
+ * Named API tokens of service accounts are managed
+ * through the service account endpoints, like their single token.
+ */
+func findTokenOwner(ctx context.Context) (*models.User, error) {
+	userID, userIsSet := authentication.GetUserIdFromMetadata(ctx)
+	if !userIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	orgID, orgIsSet := authentication.GetOrganizationIdFromMetadata(ctx)
+	if !orgIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	user, err := models.FindActiveUserByID(orgID, userID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if user.IsServiceAccount() {
+		return nil, status.Error(codes.PermissionDenied, "service accounts must use the service account token endpoints")
+	}
+
+	return user, nil
+}
+
+func serializeAPIToken(token *models.APIToken) *pb.ApiToken {
+	result := &pb.ApiToken{
+		Id:        token.ID.String(),
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: timestamppb.New(token.CreatedAt),
+	}
+
+	if token.CanvasID != nil {
+		result.CanvasId = token.CanvasID.String()
+	}
+
+	if token.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*token.ExpiresAt)
+	}
+
+	if token.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+
+	return result
+}
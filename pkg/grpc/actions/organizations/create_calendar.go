@@ -0,0 +1,71 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func CreateCalendar(ctx context.Context, orgID string, calendar *pb.Calendar) (*pb.CreateCalendarResponse, error) {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	org, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
+	}
+
+	if calendar == nil || calendar.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "calendar metadata is required")
+	}
+
+	name := strings.TrimSpace(calendar.Metadata.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "calendar name is required")
+	}
+
+	_, err = models.FindCalendarByName(org, name)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "a calendar with the name %s already exists in this organization", name)
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.Internal, "failed to verify calendar name uniqueness")
+	}
+
+	exclusions, err := parseCalendarExclusions(calendar.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	createdBy := uuid.MustParse(userID)
+	model := models.Calendar{
+		ID:             uuid.New(),
+		OrganizationID: org,
+		Name:           name,
+		Description:    calendar.Metadata.Description,
+		Exclusions:     exclusions,
+		CreatedBy:      &createdBy,
+	}
+
+	err = models.CreateCalendar(&model)
+	if err != nil {
+		log.Errorf("error creating calendar %s in organization %s: %v", name, orgID, err)
+		return nil, status.Error(codes.Internal, "failed to create calendar")
+	}
+
+	return &pb.CreateCalendarResponse{
+		Calendar: serializeCalendar(&model),
+	}, nil
+}
@@ -0,0 +1,32 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func DeleteCalendar(ctx context.Context, orgID string, calendarID string) (*pb.DeleteCalendarResponse, error) {
+	org, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
+	}
+
+	calendar, err := models.FindCalendar(org, calendarID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	err = calendar.Delete()
+	if err != nil {
+		log.Errorf("error deleting calendar %s in organization %s: %v", calendarID, orgID, err)
+		return nil, status.Error(codes.Internal, "failed to delete calendar")
+	}
+
+	return &pb.DeleteCalendarResponse{}, nil
+}
@@ -0,0 +1,29 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func DeleteSCIMToken(orgID string) (*pb.DeleteSCIMTokenResponse, error) {
+	_, err := models.FindOrganizationSCIMToken(orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "SCIM provisioning is not enabled")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load SCIM token")
+	}
+
+	err = models.DeleteOrganizationSCIMToken(orgID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete SCIM token")
+	}
+
+	return &pb.DeleteSCIMTokenResponse{}, nil
+}
@@ -0,0 +1,29 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func DeleteSSOConfig(orgID string) (*pb.DeleteSSOConfigResponse, error) {
+	_, err := models.FindOrganizationSSOConfig(orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "single sign-on is not configured")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load single sign-on configuration")
+	}
+
+	err = models.DeleteOrganizationSSOConfig(orgID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete single sign-on configuration")
+	}
+
+	return &pb.DeleteSSOConfigResponse{}, nil
+}
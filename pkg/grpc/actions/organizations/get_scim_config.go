@@ -0,0 +1,22 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func GetSCIMConfig(orgID string, baseURL string) (*pb.GetSCIMConfigResponse, error) {
+	token, err := models.FindOrganizationSCIMToken(orgID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.Internal, "failed to load SCIM configuration")
+	}
+
+	return &pb.GetSCIMConfigResponse{
+		ScimConfig: serializeSCIMConfig(orgID, token, baseURL),
+	}, nil
+}
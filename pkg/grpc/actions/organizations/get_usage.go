@@ -0,0 +1,36 @@
+package organizations
+
+import (
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/quotas"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func GetUsage(orgID string) (*pb.GetUsageResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	usage, err := quotas.GetUsage(database.Conn(), organizationID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load organization usage")
+	}
+
+	response := &pb.GetUsageResponse{
+		Quotas: make([]*pb.QuotaUsage, 0, len(usage)),
+	}
+
+	for _, u := range usage {
+		response.Quotas = append(response.Quotas, &pb.QuotaUsage{
+			Quota: u.Quota,
+			Used:  u.Used,
+			Limit: int64(u.Limit),
+		})
+	}
+
+	return response, nil
+}
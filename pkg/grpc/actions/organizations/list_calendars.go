@@ -0,0 +1,91 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListCalendars(ctx context.Context, orgID string) (*pb.ListCalendarsResponse, error) {
+	org, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
+	}
+
+	calendars, err := models.ListCalendars(org)
+	if err != nil {
+		log.Errorf("error listing calendars for %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "error listing calendars")
+	}
+
+	response := &pb.ListCalendarsResponse{
+		Calendars: make([]*pb.Calendar, 0, len(calendars)),
+	}
+
+	for _, calendar := range calendars {
+		response.Calendars = append(response.Calendars, serializeCalendar(&calendar))
+	}
+
+	return response, nil
+}
+
+func serializeCalendar(calendar *models.Calendar) *pb.Calendar {
+	exclusions := make([]*pb.Calendar_Exclusion, 0, len(calendar.Exclusions))
+	for _, exclusion := range calendar.Exclusions {
+		exclusions = append(exclusions, &pb.Calendar_Exclusion{
+			Start:       exclusion.Start,
+			End:         exclusion.End,
+			Description: exclusion.Description,
+		})
+	}
+
+	metadata := &pb.Calendar_Metadata{
+		Id:          calendar.ID.String(),
+		Name:        calendar.Name,
+		Description: calendar.Description,
+	}
+
+	if calendar.CreatedAt != nil {
+		metadata.CreatedAt = timestamppb.New(*calendar.CreatedAt)
+	}
+
+	if calendar.UpdatedAt != nil {
+		metadata.UpdatedAt = timestamppb.New(*calendar.UpdatedAt)
+	}
+
+	return &pb.Calendar{
+		Metadata: metadata,
+		Spec: &pb.Calendar_Spec{
+			Exclusions: exclusions,
+		},
+	}
+}
+
+func parseCalendarExclusions(spec *pb.Calendar_Spec) ([]models.CalendarExclusion, error) {
+	exclusions := []models.CalendarExclusion{}
+	if spec == nil {
+		return exclusions, nil
+	}
+
+	for i, e := range spec.Exclusions {
+		exclusion := models.CalendarExclusion{
+			Start:       e.Start,
+			End:         e.End,
+			Description: e.Description,
+		}
+
+		if err := exclusion.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "exclusion %d: %v", i, err)
+		}
+
+		exclusions = append(exclusions, exclusion)
+	}
+
+	return exclusions, nil
+}
@@ -0,0 +1,43 @@
+package organizations
+
+import (
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func RegenerateSCIMToken(orgID string, requesterUserID string, baseURL string) (*pb.RegenerateSCIMTokenResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	createdBy, err := optionalUUID(requesterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	plainToken, err := crypto.Base64String(64)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate new token")
+	}
+
+	token := &models.OrganizationSCIMToken{
+		OrganizationID: organizationID,
+		TokenHash:      crypto.HashToken(plainToken),
+		CreatedBy:      createdBy,
+	}
+
+	err = models.UpsertOrganizationSCIMToken(token)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to regenerate SCIM token")
+	}
+
+	return &pb.RegenerateSCIMTokenResponse{
+		ScimConfig: serializeSCIMConfig(orgID, token, baseURL),
+		Token:      plainToken,
+	}, nil
+}
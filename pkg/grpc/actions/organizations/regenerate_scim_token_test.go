@@ -0,0 +1,59 @@
+package organizations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__RegenerateSCIMToken(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	baseURL := "https://superplane.example.com"
+
+	t.Run("not enabled by default", func(t *testing.T) {
+		response, err := GetSCIMConfig(orgID, baseURL)
+		require.NoError(t, err)
+		assert.False(t, response.ScimConfig.Enabled)
+		assert.Equal(t, baseURL+"/scim/v2", response.ScimConfig.BaseUrl)
+	})
+
+	t.Run("regenerating replaces the previous token", func(t *testing.T) {
+		first, err := RegenerateSCIMToken(orgID, r.User.String(), baseURL)
+		require.NoError(t, err)
+		assert.True(t, first.ScimConfig.Enabled)
+		assert.Equal(t, r.User.String(), first.ScimConfig.TokenCreatedBy)
+		require.NotEmpty(t, first.Token)
+
+		second, err := RegenerateSCIMToken(orgID, r.User.String(), baseURL)
+		require.NoError(t, err)
+		assert.NotEqual(t, first.Token, second.Token)
+
+		_, err = models.FindOrganizationSCIMTokenByHash(crypto.HashToken(first.Token))
+		require.Error(t, err)
+
+		token, err := models.FindOrganizationSCIMTokenByHash(crypto.HashToken(second.Token))
+		require.NoError(t, err)
+		assert.Equal(t, r.Organization.ID, token.OrganizationID)
+	})
+
+	t.Run("deleting the token disables provisioning", func(t *testing.T) {
+		_, err := DeleteSCIMToken(orgID)
+		require.NoError(t, err)
+
+		response, err := GetSCIMConfig(orgID, baseURL)
+		require.NoError(t, err)
+		assert.False(t, response.ScimConfig.Enabled)
+
+		_, err = DeleteSCIMToken(orgID)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -46,6 +46,26 @@ func RemoveUser(ctx context.Context, authService authorization.Authorization, or
 		}
 	}
 
+	//
+	// Remove canvas roles
+	//
+	canvases, err := models.ListCanvases(orgID, false)
+	if err != nil {
+		log.Errorf("Error listing canvases for org %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "error removing canvas roles")
+	}
+
+	canvasIDs := make([]string, 0, len(canvases))
+	for _, canvas := range canvases {
+		canvasIDs = append(canvasIDs, canvas.ID.String())
+	}
+
+	err = authService.RemoveUserCanvasRoles(user.ID.String(), canvasIDs)
+	if err != nil {
+		log.Errorf("Error removing canvas roles for %s: %v", user.ID.String(), err)
+		return nil, status.Error(codes.Internal, "error removing canvas roles")
+	}
+
 	err = user.Delete()
 	if err != nil {
 		return nil, status.Error(codes.Internal, "error deleting user")
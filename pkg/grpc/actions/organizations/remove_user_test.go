@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
@@ -41,6 +42,12 @@ func Test_RemoveUser(t *testing.T) {
 		require.NoError(t, err)
 		require.NoError(t, newUser.UpdateTokenHash(crypto.HashToken(plainToken)))
 
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		require.NoError(t, r.AuthService.AssignCanvasRole(canvas.ID.String(), authorization.CanvasRoleBinding{
+			UserID: newUser.ID.String(),
+			Role:   models.RoleCanvasEditor,
+		}))
+
 		//
 		// Remove the user from the organization
 		//
@@ -67,5 +74,12 @@ func Test_RemoveUser(t *testing.T) {
 		roles, err := r.AuthService.GetUserRolesForOrg(newUser.ID.String(), orgID)
 		require.NoError(t, err)
 		require.Len(t, roles, 0)
+
+		//
+		// Verify no canvas roles exist anymore for that user
+		//
+		canvasRoles, err := r.AuthService.GetUserCanvasRoles(newUser.ID.String(), orgID, canvas.ID.String())
+		require.NoError(t, err)
+		require.Empty(t, canvasRoles)
 	})
 }
@@ -0,0 +1,31 @@
+package organizations
+
+import (
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/scim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func serializeSCIMConfig(organizationID string, token *models.OrganizationSCIMToken, baseURL string) *pb.SCIMConfig {
+	result := &pb.SCIMConfig{
+		OrganizationId: organizationID,
+		BaseUrl:        scim.BaseURL(baseURL),
+	}
+
+	if token == nil {
+		return result
+	}
+
+	result.Enabled = true
+	result.TokenCreatedAt = timestamppb.New(token.CreatedAt)
+	if token.LastUsedAt != nil {
+		result.TokenLastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+
+	if token.CreatedBy != nil {
+		result.TokenCreatedBy = token.CreatedBy.String()
+	}
+
+	return result
+}
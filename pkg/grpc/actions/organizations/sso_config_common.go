@@ -0,0 +1,67 @@
+package organizations
+
+import (
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const MaxSSOGroupsClaimLength = 128
+
+func serializeSSOConfig(config *models.OrganizationSSOConfig, baseURL string) *pb.SSOConfig {
+	organizationID := config.OrganizationID.String()
+	result := &pb.SSOConfig{
+		OrganizationId: organizationID,
+		Protocol:       config.Protocol,
+		Enabled:        config.Enabled,
+		Enforced:       config.Enforced,
+		GroupsClaim:    config.GroupsClaim,
+		GroupMappings:  []*pb.SSOGroupMapping{},
+		LoginUrl:       authentication.SSOLoginURL(baseURL, organizationID),
+		CallbackUrl:    authentication.SSOCallbackURL(baseURL, organizationID),
+		UpdatedAt:      timestamppb.New(config.UpdatedAt),
+	}
+
+	for _, mapping := range config.GroupMappings {
+		result.GroupMappings = append(result.GroupMappings, &pb.SSOGroupMapping{
+			IdpGroup: mapping.IdPGroup,
+			Group:    mapping.Group,
+		})
+	}
+
+	if config.UpdatedBy != nil {
+		result.UpdatedBy = config.UpdatedBy.String()
+	}
+
+	switch config.Protocol {
+	case models.SSOProtocolOIDC:
+		result.Oidc = &pb.SSOConfig_OIDC{
+			ClientSecretConfigured: len(config.OIDCClientSecretCiphertext) > 0,
+		}
+
+		if config.OIDCIssuerURL != nil {
+			result.Oidc.IssuerUrl = *config.OIDCIssuerURL
+		}
+
+		if config.OIDCClientID != nil {
+			result.Oidc.ClientId = *config.OIDCClientID
+		}
+
+	case models.SSOProtocolSAML:
+		metadataURL := authentication.SSOMetadataURL(baseURL, organizationID)
+		result.Saml = &pb.SSOConfig_SAML{
+			SpEntityId:    metadataURL,
+			SpMetadataUrl: metadataURL,
+		}
+
+		if config.SAMLIdPMetadata != nil {
+			result.Saml.IdpMetadata = *config.SAMLIdPMetadata
+			if descriptor, err := authentication.ParseSAMLIdPMetadata(*config.SAMLIdPMetadata); err == nil {
+				result.Saml.IdpEntityId = descriptor.EntityID
+			}
+		}
+	}
+
+	return result
+}
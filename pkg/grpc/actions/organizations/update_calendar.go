@@ -0,0 +1,56 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func UpdateCalendar(ctx context.Context, orgID string, calendarID string, calendar *pb.Calendar) (*pb.UpdateCalendarResponse, error) {
+	org, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
+	}
+
+	if calendar == nil {
+		return nil, status.Error(codes.InvalidArgument, "calendar is required")
+	}
+
+	model, err := models.FindCalendar(org, calendarID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	//
+	// Calendar names are referenced by schedule triggers,
+	// so renaming is not supported - only the description
+	// and the excluded dates can be updated.
+	//
+	if calendar.Metadata != nil {
+		model.Description = calendar.Metadata.Description
+	}
+
+	if calendar.Spec != nil {
+		exclusions, err := parseCalendarExclusions(calendar.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		model.Exclusions = exclusions
+	}
+
+	err = model.Update()
+	if err != nil {
+		log.Errorf("error updating calendar %s in organization %s: %v", calendarID, orgID, err)
+		return nil, status.Error(codes.Internal, "failed to update calendar")
+	}
+
+	return &pb.UpdateCalendarResponse{
+		Calendar: serializeCalendar(model),
+	}, nil
+}
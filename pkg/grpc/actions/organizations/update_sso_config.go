@@ -0,0 +1,218 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func UpdateSSOConfig(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	authService authorization.Authorization,
+	baseURL string,
+	orgID string,
+	requesterUserID string,
+	spec *pb.SSOConfig,
+) (*pb.UpdateSSOConfigResponse, error) {
+	if spec == nil {
+		return nil, status.Error(codes.InvalidArgument, "sso_config is required")
+	}
+
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	updatedBy, err := optionalUUID(requesterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Enforced && !spec.Enabled {
+		return nil, status.Error(codes.InvalidArgument, "single sign-on must be enabled to be enforced")
+	}
+
+	groupsClaim := strings.TrimSpace(spec.GroupsClaim)
+	if groupsClaim == "" {
+		groupsClaim = models.DefaultSSOGroupsClaim
+	}
+
+	if len(groupsClaim) > MaxSSOGroupsClaimLength {
+		return nil, status.Errorf(codes.InvalidArgument, "groups_claim must be at most %d characters", MaxSSOGroupsClaimLength)
+	}
+
+	groupMappings, err := validateSSOGroupMappings(authService, orgID, spec.GroupMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	var config *models.OrganizationSSOConfig
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		existing, txErr := models.FindOrganizationSSOConfigInTransaction(tx, orgID)
+		if txErr != nil && !errors.Is(txErr, gorm.ErrRecordNotFound) {
+			return status.Error(codes.Internal, "failed to load single sign-on configuration")
+		}
+
+		now := time.Now()
+		config = &models.OrganizationSSOConfig{
+			OrganizationID: organizationID,
+			Protocol:       spec.Protocol,
+			Enabled:        spec.Enabled,
+			Enforced:       spec.Enforced,
+			GroupsClaim:    groupsClaim,
+			GroupMappings:  groupMappings,
+			UpdatedBy:      updatedBy,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+
+		switch spec.Protocol {
+		case models.SSOProtocolOIDC:
+			txErr = setOIDCConfig(ctx, encryptor, config, existing, spec.Oidc)
+		case models.SSOProtocolSAML:
+			txErr = setSAMLConfig(config, spec.Saml)
+		default:
+			txErr = status.Error(codes.InvalidArgument, "protocol must be oidc or saml")
+		}
+
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = models.UpsertOrganizationSSOConfigInTransaction(tx, config); txErr != nil {
+			return status.Error(codes.Internal, "failed to update single sign-on configuration")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateSSOConfigResponse{
+		SsoConfig: serializeSSOConfig(config, baseURL),
+	}, nil
+}
+
+/*
+ * The client secret is write-only.
+ * If it is not given, the current one is kept,
+ * as long as the configuration was already using OIDC.
+ */
+func setOIDCConfig(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	config *models.OrganizationSSOConfig,
+	existing *models.OrganizationSSOConfig,
+	spec *pb.SSOConfig_OIDC,
+) error {
+	if spec == nil {
+		return status.Error(codes.InvalidArgument, "oidc is required for the oidc protocol")
+	}
+
+	issuerURL := strings.TrimRight(strings.TrimSpace(spec.IssuerUrl), "/")
+	parsed, err := url.Parse(issuerURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return status.Error(codes.InvalidArgument, "oidc.issuer_url must be a valid URL")
+	}
+
+	clientID := strings.TrimSpace(spec.ClientId)
+	if clientID == "" {
+		return status.Error(codes.InvalidArgument, "oidc.client_id is required")
+	}
+
+	config.OIDCIssuerURL = &issuerURL
+	config.OIDCClientID = &clientID
+
+	clientSecret := strings.TrimSpace(spec.ClientSecret)
+	if clientSecret == "" {
+		if existing == nil || existing.Protocol != models.SSOProtocolOIDC || len(existing.OIDCClientSecretCiphertext) == 0 {
+			return status.Error(codes.InvalidArgument, "oidc.client_secret is required")
+		}
+
+		config.OIDCClientSecretCiphertext = existing.OIDCClientSecretCiphertext
+		return nil
+	}
+
+	credentialName := authentication.SSOClientSecretCredentialName(config.OrganizationID.String())
+	ciphertext, err := encryptor.Encrypt(ctx, []byte(clientSecret), []byte(credentialName))
+	if err != nil {
+		return status.Error(codes.Internal, "failed to encrypt client secret")
+	}
+
+	config.OIDCClientSecretCiphertext = ciphertext
+	return nil
+}
+
+func setSAMLConfig(config *models.OrganizationSSOConfig, spec *pb.SSOConfig_SAML) error {
+	if spec == nil {
+		return status.Error(codes.InvalidArgument, "saml is required for the saml protocol")
+	}
+
+	metadata := strings.TrimSpace(spec.IdpMetadata)
+	if metadata == "" {
+		return status.Error(codes.InvalidArgument, "saml.idp_metadata is required")
+	}
+
+	if _, err := authentication.ParseSAMLIdPMetadata(metadata); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	config.SAMLIdPMetadata = &metadata
+	return nil
+}
+
+/*
+ * Mappings can only use existing groups of the organization.
+ * An identity provider group can be mapped to multiple groups.
+ */
+func validateSSOGroupMappings(
+	authService authorization.Authorization,
+	orgID string,
+	mappings []*pb.SSOGroupMapping,
+) ([]models.SSOGroupMapping, error) {
+	result := []models.SSOGroupMapping{}
+	if len(mappings) == 0 {
+		return result, nil
+	}
+
+	groups, err := authService.GetGroups(orgID, models.DomainTypeOrganization)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load groups")
+	}
+
+	for _, mapping := range mappings {
+		idpGroup := strings.TrimSpace(mapping.IdpGroup)
+		group := strings.TrimSpace(mapping.Group)
+		if idpGroup == "" || group == "" {
+			return nil, status.Error(codes.InvalidArgument, "group mappings require idp_group and group")
+		}
+
+		if !slices.Contains(groups, group) {
+			return nil, status.Errorf(codes.InvalidArgument, "group %s not found", group)
+		}
+
+		m := models.SSOGroupMapping{IdPGroup: idpGroup, Group: group}
+		if !slices.Contains(result, m) {
+			result = append(result, m)
+		}
+	}
+
+	return result, nil
+}
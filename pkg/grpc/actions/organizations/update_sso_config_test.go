@@ -0,0 +1,131 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testSSOBaseURL = "https://superplane.example.com"
+
+func Test__UpdateSSOConfig(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "developers", models.RoleOrgViewer, "Developers", ""))
+
+	t.Run("unknown protocol -> error", func(t *testing.T) {
+		_, err := UpdateSSOConfig(context.Background(), r.Encryptor, r.AuthService, testSSOBaseURL, orgID, r.User.String(), &protos.SSOConfig{
+			Protocol: "ldap",
+			Enabled:  true,
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "protocol must be oidc or saml", s.Message())
+	})
+
+	t.Run("enforced without enabled -> error", func(t *testing.T) {
+		_, err := UpdateSSOConfig(context.Background(), r.Encryptor, r.AuthService, testSSOBaseURL, orgID, r.User.String(), &protos.SSOConfig{
+			Protocol: models.SSOProtocolOIDC,
+			Enforced: true,
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("mapping to unknown group -> error", func(t *testing.T) {
+		_, err := UpdateSSOConfig(context.Background(), r.Encryptor, r.AuthService, testSSOBaseURL, orgID, r.User.String(), &protos.SSOConfig{
+			Protocol: models.SSOProtocolOIDC,
+			Enabled:  true,
+			Oidc: &protos.SSOConfig_OIDC{
+				IssuerUrl:    "https://idp.example.com",
+				ClientId:     "client",
+				ClientSecret: "secret",
+			},
+			GroupMappings: []*protos.SSOGroupMapping{{IdpGroup: "engineering", Group: "does-not-exist"}},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "group does-not-exist not found", s.Message())
+	})
+
+	t.Run("OIDC without client secret on first configuration -> error", func(t *testing.T) {
+		_, err := UpdateSSOConfig(context.Background(), r.Encryptor, r.AuthService, testSSOBaseURL, orgID, r.User.String(), &protos.SSOConfig{
+			Protocol: models.SSOProtocolOIDC,
+			Enabled:  true,
+			Oidc: &protos.SSOConfig_OIDC{
+				IssuerUrl: "https://idp.example.com",
+				ClientId:  "client",
+			},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "oidc.client_secret is required", s.Message())
+	})
+
+	t.Run("OIDC configuration -> success, secret is kept on later updates", func(t *testing.T) {
+		response, err := UpdateSSOConfig(context.Background(), r.Encryptor, r.AuthService, testSSOBaseURL, orgID, r.User.String(), &protos.SSOConfig{
+			Protocol: models.SSOProtocolOIDC,
+			Enabled:  true,
+			Enforced: true,
+			Oidc: &protos.SSOConfig_OIDC{
+				IssuerUrl:    "https://idp.example.com/",
+				ClientId:     "client",
+				ClientSecret: "secret",
+			},
+			GroupMappings: []*protos.SSOGroupMapping{{IdpGroup: "engineering", Group: "developers"}},
+		})
+
+		require.NoError(t, err)
+		config := response.SsoConfig
+		assert.Equal(t, models.SSOProtocolOIDC, config.Protocol)
+		assert.True(t, config.Enforced)
+		assert.Equal(t, "https://idp.example.com", config.Oidc.IssuerUrl)
+		assert.Empty(t, config.Oidc.ClientSecret)
+		assert.True(t, config.Oidc.ClientSecretConfigured)
+		assert.Equal(t, models.DefaultSSOGroupsClaim, config.GroupsClaim)
+		assert.Equal(t, testSSOBaseURL+"/auth/sso/"+orgID, config.LoginUrl)
+		require.Len(t, config.GroupMappings, 1)
+
+		_, err = UpdateSSOConfig(context.Background(), r.Encryptor, r.AuthService, testSSOBaseURL, orgID, r.User.String(), &protos.SSOConfig{
+			Protocol: models.SSOProtocolOIDC,
+			Enabled:  true,
+			Oidc: &protos.SSOConfig_OIDC{
+				IssuerUrl: "https://idp.example.com",
+				ClientId:  "other-client",
+			},
+		})
+		require.NoError(t, err)
+
+		stored, err := models.FindOrganizationSSOConfig(orgID)
+		require.NoError(t, err)
+		assert.Equal(t, "other-client", *stored.OIDCClientID)
+		assert.NotEmpty(t, stored.OIDCClientSecretCiphertext)
+		assert.False(t, stored.Enforced)
+	})
+
+	t.Run("delete configuration -> success", func(t *testing.T) {
+		_, err := DeleteSSOConfig(orgID)
+		require.NoError(t, err)
+
+		_, err = GetSSOConfig(orgID, testSSOBaseURL)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
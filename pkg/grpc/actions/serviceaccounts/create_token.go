@@ -0,0 +1,42 @@
+package serviceaccounts
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/auth"
+	pb "github.com/superplanehq/superplane/pkg/protos/service_accounts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func CreateServiceAccountToken(ctx context.Context, req *pb.CreateServiceAccountTokenRequest, authService authorization.Authorization) (*pb.CreateServiceAccountTokenResponse, error) {
+	serviceAccount, err := findServiceAccount(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, _ := authentication.GetUserIdFromMetadata(ctx)
+	createdByUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	token, plainToken, err := auth.CreateAPIToken(authService, serviceAccount, createdByUUID, auth.APITokenOptions{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		CanvasID:  req.CanvasId,
+		ExpiresAt: req.ExpiresAt,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateServiceAccountTokenResponse{
+		ApiToken: serializeServiceAccountToken(token),
+		Token:    plainToken,
+	}, nil
+}
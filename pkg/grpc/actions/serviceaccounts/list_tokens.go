@@ -0,0 +1,29 @@
+package serviceaccounts
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/service_accounts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ListServiceAccountTokens(ctx context.Context, req *pb.ListServiceAccountTokensRequest) (*pb.ListServiceAccountTokensResponse, error) {
+	serviceAccount, err := findServiceAccount(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := models.ListAPITokensForUser(serviceAccount.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list tokens")
+	}
+
+	response := &pb.ListServiceAccountTokensResponse{Tokens: []*pb.ServiceAccountToken{}}
+	for _, token := range tokens {
+		response.Tokens = append(response.Tokens, serializeServiceAccountToken(&token))
+	}
+
+	return response, nil
+}
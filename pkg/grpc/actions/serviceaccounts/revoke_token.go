@@ -0,0 +1,22 @@
+package serviceaccounts
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/grpc/actions/auth"
+	pb "github.com/superplanehq/superplane/pkg/protos/service_accounts"
+)
+
+func RevokeServiceAccountToken(ctx context.Context, req *pb.RevokeServiceAccountTokenRequest) (*pb.RevokeServiceAccountTokenResponse, error) {
+	serviceAccount, err := findServiceAccount(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = auth.RevokeAPIToken(serviceAccount, req.TokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeServiceAccountTokenResponse{}, nil
+}
@@ -0,0 +1,58 @@
+package serviceaccounts
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/service_accounts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func findServiceAccount(ctx context.Context, id string) (*models.User, error) {
+	_, userIsSet := authentication.GetUserIdFromMetadata(ctx)
+	if !userIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	orgID, orgIsSet := authentication.GetOrganizationIdFromMetadata(ctx)
+	if !orgIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	user, err := models.FindActiveUserByID(orgID, id)
+	if err != nil || !user.IsServiceAccount() {
+		return nil, status.Error(codes.NotFound, "service account not found")
+	}
+
+	return user, nil
+}
+
+func serializeServiceAccountToken(token *models.APIToken) *pb.ServiceAccountToken {
+	result := &pb.ServiceAccountToken{
+		Id:        token.ID.String(),
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: timestamppb.New(token.CreatedAt),
+	}
+
+	if token.CanvasID != nil {
+		result.CanvasId = token.CanvasID.String()
+	}
+
+	if token.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*token.ExpiresAt)
+	}
+
+	if token.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+
+	return result
+}
@@ -96,7 +96,7 @@ func (s *CanvasService) CreateCanvasChangeRequest(ctx context.Context, req *pb.C
 	}
 
 	changeRequestID := createResponse.GetChangeRequest().GetMetadata().GetId()
-	if changeRequestID == "" {
+	if changeRequestID == "" || req.KeepOpen {
 		return createResponse, nil
 	}
 
@@ -137,11 +137,46 @@ func (s *CanvasService) DescribeCanvasChangeRequest(ctx context.Context, req *pb
 	return canvases.DescribeCanvasChangeRequest(ctx, organizationID, req.CanvasId, req.ChangeRequestId)
 }
 
+func (s *CanvasService) PublishCanvasChangeRequest(ctx context.Context, req *pb.PublishCanvasChangeRequestRequest) (*pb.PublishCanvasChangeRequestResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	publishedRequest, publishedVersion, err := canvases.PublishCanvasChangeRequest(
+		ctx,
+		s.encryptor,
+		s.registry,
+		organizationID,
+		req.CanvasId,
+		req.ChangeRequestId,
+		s.webhookBaseURL,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PublishCanvasChangeRequestResponse{
+		ChangeRequest: canvases.SerializeCanvasChangeRequest(publishedRequest, publishedVersion, organizationID),
+	}, nil
+}
+
+func (s *CanvasService) DiffCanvas(ctx context.Context, req *pb.DiffCanvasRequest) (*pb.DiffCanvasResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DiffCanvas(ctx, s.registry, organizationID, req.CanvasId, req.Canvas)
+}
+
+func (s *CanvasService) SimulateCanvas(ctx context.Context, req *pb.SimulateCanvasRequest) (*pb.SimulateCanvasResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.SimulateCanvas(ctx, s.registry, organizationID, req)
+}
+
 func (s *CanvasService) DeleteCanvas(ctx context.Context, req *pb.DeleteCanvasRequest) (*pb.DeleteCanvasResponse, error) {
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
 	return canvases.DeleteCanvas(ctx, s.registry, uuid.MustParse(organizationID), req.Id)
 }
 
+func (s *CanvasService) UpdateCanvasPriority(ctx context.Context, req *pb.UpdateCanvasPriorityRequest) (*pb.UpdateCanvasPriorityResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasPriority(ctx, uuid.MustParse(organizationID), req.Id, req.Priority)
+}
+
 func (s *CanvasService) ListNodeQueueItems(ctx context.Context, req *pb.ListNodeQueueItemsRequest) (*pb.ListNodeQueueItemsResponse, error) {
 	return canvases.ListNodeQueueItems(ctx, s.registry, req.CanvasId, req.NodeId, req.Limit, req.Before)
 }
@@ -155,7 +190,15 @@ func (s *CanvasService) UpdateNodePause(ctx context.Context, req *pb.UpdateNodeP
 }
 
 func (s *CanvasService) ListNodeExecutions(ctx context.Context, req *pb.ListNodeExecutionsRequest) (*pb.ListNodeExecutionsResponse, error) {
-	return canvases.ListNodeExecutions(ctx, s.registry, req.CanvasId, req.NodeId, req.States, req.Results, req.Limit, req.Before)
+	return canvases.ListNodeExecutions(ctx, s.registry, req.CanvasId, req.NodeId, req.States, req.Results, canvases.ListQuery{
+		Limit:         req.Limit,
+		Before:        req.Before,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+		Order:         req.Order,
+		PageToken:     req.PageToken,
+		Payload:       req.Payload,
+	})
 }
 
 func (s *CanvasService) ListNodeEvents(ctx context.Context, req *pb.ListNodeEventsRequest) (*pb.ListNodeEventsResponse, error) {
@@ -168,7 +211,15 @@ func (s *CanvasService) ListNodeEvents(ctx context.Context, req *pb.ListNodeEven
 		return nil, status.Error(codes.InvalidArgument, "node_id is required")
 	}
 
-	return canvases.ListNodeEvents(ctx, s.registry, canvasID, req.NodeId, req.Limit, req.Before)
+	return canvases.ListNodeEvents(ctx, s.registry, canvasID, req.NodeId, canvases.ListQuery{
+		Limit:         req.Limit,
+		Before:        req.Before,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+		Order:         req.Order,
+		PageToken:     req.PageToken,
+		Payload:       req.Payload,
+	})
 }
 
 func (s *CanvasService) EmitNodeEvent(ctx context.Context, req *pb.EmitNodeEventRequest) (*pb.EmitNodeEventResponse, error) {
@@ -259,7 +310,15 @@ func (s *CanvasService) ListCanvasEvents(ctx context.Context, req *pb.ListCanvas
 		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
 	}
 
-	return canvases.ListCanvasEvents(ctx, s.registry, canvasID, req.Limit, req.Before)
+	return canvases.ListCanvasEvents(ctx, s.registry, canvasID, req.NodeIds, canvases.ListQuery{
+		Limit:         req.Limit,
+		Before:        req.Before,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+		Order:         req.Order,
+		PageToken:     req.PageToken,
+		Payload:       req.Payload,
+	})
 }
 
 func (s *CanvasService) ListCanvasMemories(ctx context.Context, req *pb.ListCanvasMemoriesRequest) (*pb.ListCanvasMemoriesResponse, error) {
@@ -267,6 +326,21 @@ func (s *CanvasService) ListCanvasMemories(ctx context.Context, req *pb.ListCanv
 	return canvases.ListCanvasMemories(ctx, s.registry, organizationID, req.CanvasId)
 }
 
+func (s *CanvasService) ListCanvasRoleBindings(ctx context.Context, req *pb.ListCanvasRoleBindingsRequest) (*pb.ListCanvasRoleBindingsResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListCanvasRoleBindings(ctx, s.authService, organizationID, req.CanvasId)
+}
+
+func (s *CanvasService) AssignCanvasRole(ctx context.Context, req *pb.AssignCanvasRoleRequest) (*pb.AssignCanvasRoleResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.AssignCanvasRole(ctx, s.authService, organizationID, req.CanvasId, req.Role, req.UserId, req.GroupName)
+}
+
+func (s *CanvasService) RemoveCanvasRole(ctx context.Context, req *pb.RemoveCanvasRoleRequest) (*pb.RemoveCanvasRoleResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.RemoveCanvasRole(ctx, s.authService, organizationID, req.CanvasId, req.UserId, req.GroupName)
+}
+
 func (s *CanvasService) DeleteCanvasMemory(ctx context.Context, req *pb.DeleteCanvasMemoryRequest) (*pb.DeleteCanvasMemoryResponse, error) {
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
 	return canvases.DeleteCanvasMemory(ctx, s.registry, organizationID, req.CanvasId, req.MemoryId)
@@ -276,6 +350,20 @@ func (s *CanvasService) ListEventExecutions(ctx context.Context, req *pb.ListEve
 	return canvases.ListEventExecutions(ctx, s.registry, req.CanvasId, req.EventId)
 }
 
+func (s *CanvasService) DescribeNodeExecution(ctx context.Context, req *pb.DescribeNodeExecutionRequest) (*pb.DescribeNodeExecutionResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	return canvases.DescribeNodeExecution(ctx, s.registry, canvasID, executionID)
+}
+
 func (s *CanvasService) ListChildExecutions(ctx context.Context, req *pb.ListChildExecutionsRequest) (*pb.ListChildExecutionsResponse, error) {
 	canvasID, err := uuid.Parse(req.CanvasId)
 	if err != nil {
@@ -306,6 +394,15 @@ func (s *CanvasService) CancelExecution(ctx context.Context, req *pb.CancelExecu
 	return canvases.CancelExecution(ctx, s.authService, s.encryptor, organizationID, s.registry, canvasID, executionID)
 }
 
+func (s *CanvasService) ListStuckExecutions(ctx context.Context, req *pb.ListStuckExecutionsRequest) (*pb.ListStuckExecutionsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	return canvases.ListStuckExecutions(ctx, canvasID)
+}
+
 func (s *CanvasService) ResolveExecutionErrors(ctx context.Context, req *pb.ResolveExecutionErrorsRequest) (*pb.ResolveExecutionErrorsResponse, error) {
 	canvasID, err := uuid.Parse(req.CanvasId)
 	if err != nil {
@@ -3,15 +3,20 @@ package grpc
 import (
 	"context"
 
+	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/me"
 	pb "github.com/superplanehq/superplane/pkg/protos/me"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-type MeService struct{}
+type MeService struct {
+	authService authorization.Authorization
+}
 
-func NewMeService() *MeService {
-	return &MeService{}
+func NewMeService(authService authorization.Authorization) *MeService {
+	return &MeService{
+		authService: authService,
+	}
 }
 
 func (s *MeService) Me(ctx context.Context, req *emptypb.Empty) (*pb.User, error) {
@@ -21,3 +26,15 @@ func (s *MeService) Me(ctx context.Context, req *emptypb.Empty) (*pb.User, error
 func (s *MeService) RegenerateToken(ctx context.Context, req *emptypb.Empty) (*pb.RegenerateTokenResponse, error) {
 	return me.RegenerateToken(ctx)
 }
+
+func (s *MeService) ListTokens(ctx context.Context, req *emptypb.Empty) (*pb.ListTokensResponse, error) {
+	return me.ListTokens(ctx)
+}
+
+func (s *MeService) CreateToken(ctx context.Context, req *pb.CreateTokenRequest) (*pb.CreateTokenResponse, error) {
+	return me.CreateToken(ctx, s.authService, req)
+}
+
+func (s *MeService) RevokeToken(ctx context.Context, req *pb.RevokeTokenRequest) (*pb.RevokeTokenResponse, error) {
+	return me.RevokeToken(ctx, req)
+}
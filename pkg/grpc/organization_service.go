@@ -136,6 +136,70 @@ func (s *OrganizationService) DeleteAgentOpenAIKey(
 	return organizations.DeleteAgentOpenAIKey(orgID, userID)
 }
 
+func (s *OrganizationService) GetSSOConfig(
+	ctx context.Context,
+	req *pb.GetSSOConfigRequest,
+) (*pb.GetSSOConfigResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetSSOConfig(orgID, s.baseURL)
+}
+
+func (s *OrganizationService) UpdateSSOConfig(
+	ctx context.Context,
+	req *pb.UpdateSSOConfigRequest,
+) (*pb.UpdateSSOConfigResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.UpdateSSOConfig(ctx, s.encryptor, s.authorizationService, s.baseURL, orgID, userID, req.SsoConfig)
+}
+
+func (s *OrganizationService) DeleteSSOConfig(
+	ctx context.Context,
+	req *pb.DeleteSSOConfigRequest,
+) (*pb.DeleteSSOConfigResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.DeleteSSOConfig(orgID)
+}
+
+func (s *OrganizationService) GetSCIMConfig(
+	ctx context.Context,
+	req *pb.GetSCIMConfigRequest,
+) (*pb.GetSCIMConfigResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetSCIMConfig(orgID, s.baseURL)
+}
+
+func (s *OrganizationService) RegenerateSCIMToken(
+	ctx context.Context,
+	req *pb.RegenerateSCIMTokenRequest,
+) (*pb.RegenerateSCIMTokenResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.RegenerateSCIMToken(orgID, userID, s.baseURL)
+}
+
+func (s *OrganizationService) DeleteSCIMToken(
+	ctx context.Context,
+	req *pb.DeleteSCIMTokenRequest,
+) (*pb.DeleteSCIMTokenResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.DeleteSCIMToken(orgID)
+}
+
+func (s *OrganizationService) GetUsage(
+	ctx context.Context,
+	req *pb.GetUsageRequest,
+) (*pb.GetUsageResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetUsage(orgID)
+}
+
 func (s *OrganizationService) AcceptInviteLink(ctx context.Context, req *pb.InviteLink) (*structpb.Struct, error) {
 	accountID, err := accountIDFromContext(ctx)
 	if err != nil {
@@ -200,6 +264,26 @@ func (s *OrganizationService) DeleteIntegration(ctx context.Context, req *pb.Del
 	return organizations.DeleteIntegration(ctx, orgID, req.IntegrationId)
 }
 
+func (s *OrganizationService) ListCalendars(ctx context.Context, req *pb.ListCalendarsRequest) (*pb.ListCalendarsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ListCalendars(ctx, orgID)
+}
+
+func (s *OrganizationService) CreateCalendar(ctx context.Context, req *pb.CreateCalendarRequest) (*pb.CreateCalendarResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.CreateCalendar(ctx, orgID, req.Calendar)
+}
+
+func (s *OrganizationService) UpdateCalendar(ctx context.Context, req *pb.UpdateCalendarRequest) (*pb.UpdateCalendarResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.UpdateCalendar(ctx, orgID, req.CalendarId, req.Calendar)
+}
+
+func (s *OrganizationService) DeleteCalendar(ctx context.Context, req *pb.DeleteCalendarRequest) (*pb.DeleteCalendarResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.DeleteCalendar(ctx, orgID, req.CalendarId)
+}
+
 func accountIDFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
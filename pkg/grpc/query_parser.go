@@ -8,21 +8,61 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
 	"google.golang.org/protobuf/proto"
 
+	canvasespb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
 )
 
+const payloadFilterPrefix = "payload."
+
 type QueryParser struct{}
 
 func (p *QueryParser) Parse(target proto.Message, values url.Values, filter *utilities.DoubleArray) error {
 	switch req := target.(type) {
 	case *pb.ListIntegrationResourcesRequest:
 		return populateListIntegrationResourcesParams(values, req)
+	case *canvasespb.ListNodeExecutionsRequest:
+		values, req.Payload = extractListParams(values)
+	case *canvasespb.ListNodeEventsRequest:
+		values, req.Payload = extractListParams(values)
+	case *canvasespb.ListCanvasEventsRequest:
+		values, req.Payload = extractListParams(values)
 	}
 
 	defaultParser := runtime.DefaultQueryParser{}
 	return defaultParser.Parse(target, values, filter)
 }
 
+// extractListParams moves payload.<path>=<value> parameters into a map,
+// since the default parser only supports payload[<path>]=<value> for map fields.
+// It also accepts order=asc and order=desc, in addition to the enum values.
+func extractListParams(values url.Values) (url.Values, map[string]string) {
+	remaining := url.Values{}
+	payload := map[string]string{}
+
+	for key, vals := range values {
+		path, ok := strings.CutPrefix(key, payloadFilterPrefix)
+		if !ok || path == "" || len(vals) == 0 {
+			remaining[key] = vals
+			continue
+		}
+
+		payload[path] = vals[len(vals)-1]
+	}
+
+	switch strings.ToLower(remaining.Get("order")) {
+	case "asc":
+		remaining.Set("order", canvasespb.ListOrder_LIST_ORDER_ASC.String())
+	case "desc":
+		remaining.Set("order", canvasespb.ListOrder_LIST_ORDER_DESC.String())
+	}
+
+	if len(payload) == 0 {
+		return remaining, nil
+	}
+
+	return remaining, payload
+}
+
 func populateListIntegrationResourcesParams(values url.Values, r *pb.ListIntegrationResourcesRequest) error {
 	parameters := map[string]string{}
 
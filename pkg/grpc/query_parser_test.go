@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+)
+
+func Test__QueryParser__ListNodeExecutions(t *testing.T) {
+	values, err := url.ParseQuery("states=STATE_FINISHED&results=RESULT_FAILED&order=asc&limit=10" +
+		"&createdAfter=2026-10-12T00:00:00Z&pageToken=abc&payload.data.ref=refs/heads/main&payload.replicas=3")
+	require.NoError(t, err)
+
+	req := &pb.ListNodeExecutionsRequest{}
+	parser := &QueryParser{}
+	require.NoError(t, parser.Parse(req, values, utilities.NewDoubleArray(nil)))
+
+	assert.Equal(t, []pb.CanvasNodeExecution_State{pb.CanvasNodeExecution_STATE_FINISHED}, req.States)
+	assert.Equal(t, []pb.CanvasNodeExecution_Result{pb.CanvasNodeExecution_RESULT_FAILED}, req.Results)
+	assert.Equal(t, pb.ListOrder_LIST_ORDER_ASC, req.Order)
+	assert.Equal(t, uint32(10), req.Limit)
+	assert.Equal(t, "2026-10-12T00:00:00Z", req.CreatedAfter.AsTime().Format("2006-01-02T15:04:05Z07:00"))
+	assert.Equal(t, "abc", req.PageToken)
+	assert.Equal(t, map[string]string{"data.ref": "refs/heads/main", "replicas": "3"}, req.Payload)
+}
+
+func Test__QueryParser__ListCanvasEvents(t *testing.T) {
+	values, err := url.ParseQuery("nodeIds=deploy&nodeIds=build&order=LIST_ORDER_DESC")
+	require.NoError(t, err)
+
+	req := &pb.ListCanvasEventsRequest{}
+	parser := &QueryParser{}
+	require.NoError(t, parser.Parse(req, values, utilities.NewDoubleArray(nil)))
+
+	assert.Equal(t, []string{"deploy", "build"}, req.NodeIds)
+	assert.Equal(t, pb.ListOrder_LIST_ORDER_DESC, req.Order)
+	assert.Nil(t, req.Payload)
+}
@@ -104,7 +104,7 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	secretsService := NewSecretService(encryptor, authService)
 	secretPb.RegisterSecretsServer(grpcServer, secretsService)
 
-	meService := NewMeService()
+	meService := NewMeService(authService)
 	mepb.RegisterMeServer(grpcServer, meService)
 
 	componentService := NewComponentService(registry)
@@ -42,3 +42,15 @@ func (s *ServiceAccountsService) DeleteServiceAccount(ctx context.Context, req *
 func (s *ServiceAccountsService) RegenerateServiceAccountToken(ctx context.Context, req *pb.RegenerateServiceAccountTokenRequest) (*pb.RegenerateServiceAccountTokenResponse, error) {
 	return serviceaccounts.RegenerateServiceAccountToken(ctx, req)
 }
+
+func (s *ServiceAccountsService) ListServiceAccountTokens(ctx context.Context, req *pb.ListServiceAccountTokensRequest) (*pb.ListServiceAccountTokensResponse, error) {
+	return serviceaccounts.ListServiceAccountTokens(ctx, req)
+}
+
+func (s *ServiceAccountsService) CreateServiceAccountToken(ctx context.Context, req *pb.CreateServiceAccountTokenRequest) (*pb.CreateServiceAccountTokenResponse, error) {
+	return serviceaccounts.CreateServiceAccountToken(ctx, req, s.authService)
+}
+
+func (s *ServiceAccountsService) RevokeServiceAccountToken(ctx context.Context, req *pb.RevokeServiceAccountTokenRequest) (*pb.RevokeServiceAccountTokenResponse, error) {
+	return serviceaccounts.RevokeServiceAccountToken(ctx, req)
+}
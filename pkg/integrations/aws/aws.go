@@ -29,6 +29,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/integrations/aws/route53"
 	"github.com/superplanehq/superplane/pkg/integrations/aws/sns"
 	"github.com/superplanehq/superplane/pkg/integrations/aws/sqs"
+	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/registry"
 )
 
@@ -346,6 +347,7 @@ func (a *AWS) showBrowserAction(ctx core.SyncContext) error {
 - Go to AWS IAM Console → Roles → Create role
 - Choose "Web identity" as trusted entity type
 - Select the identity provider created in step 1
+- In the trust policy, also require the **%s:sub** condition to equal **app-installation:%s**. Without it, any token issued by SuperPlane for this audience could assume the role
 - Add permissions for the integration to manage EventBridge connections, API destinations, and rules. To get started, you can use the **AmazonEventBridgeFullAccess** managed policy
 - Add permissions for the integration manage IAM roles needed for itself. To get started, you can use the **IAMFullAccess** managed policy
 - Add permissions for the integration to manage SQS. To get started, you can use the **AmazonSQSFullAccess** managed policy
@@ -356,12 +358,20 @@ func (a *AWS) showBrowserAction(ctx core.SyncContext) error {
 
 - Copy the ARN of the IAM role created in step 2
 - Paste it into the "Role ARN" field in the installation configuration
-`, ctx.BaseURL, ctx.Integration.ID().String()),
+`, ctx.BaseURL, ctx.Integration.ID().String(), issuerHost(ctx.BaseURL), ctx.Integration.ID().String()),
 	})
 
 	return nil
 }
 
+// issuerHost returns the issuer URL without its scheme,
+// which is how IAM prefixes the condition keys of an OIDC provider.
+func issuerHost(baseURL string) string {
+	host := strings.TrimPrefix(baseURL, "https://")
+	host = strings.TrimPrefix(host, "http://")
+	return strings.TrimSuffix(host, "/")
+}
+
 func (a *AWS) generateCredentials(ctx core.SyncContext, config Configuration, accountID string, metadata *common.IntegrationMetadata) (*aws.Credentials, error) {
 	durationSeconds := config.SessionDurationSeconds
 	if durationSeconds <= 0 {
@@ -369,7 +379,9 @@ func (a *AWS) generateCredentials(ctx core.SyncContext, config Configuration, ac
 	}
 
 	subject := fmt.Sprintf("app-installation:%s", ctx.Integration.ID())
-	oidcToken, err := ctx.OIDC.Sign(subject, 5*time.Minute, ctx.Integration.ID().String(), nil)
+	oidcToken, err := ctx.OIDC.Sign(subject, 5*time.Minute, ctx.Integration.ID().String(), map[string]any{
+		oidc.TokenUseClaim: oidc.TokenUseIntegration,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate OIDC token: %w", err)
 	}
@@ -113,6 +113,12 @@ func (t *RunPipeline) Color() string {
 	return "gray"
 }
 
+// MaxWaitingTime is how long an execution can wait for the pipeline to finish
+// without any updates from webhooks or polling, before it is considered stuck.
+func (t *RunPipeline) MaxWaitingTime() time.Duration {
+	return 24 * time.Hour
+}
+
 func (t *RunPipeline) OutputChannels(configuration any) []core.OutputChannel {
 	return []core.OutputChannel{
 		{
@@ -19,6 +19,7 @@ import (
 	gcpcommon "github.com/superplanehq/superplane/pkg/integrations/gcp/common"
 	"github.com/superplanehq/superplane/pkg/integrations/gcp/compute"
 	gcppubsub "github.com/superplanehq/superplane/pkg/integrations/gcp/pubsub"
+	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/registry"
 )
 
@@ -83,8 +84,9 @@ func (g *GCP) Instructions() string {
 1. Create a [Workload Identity Pool](https://cloud.google.com/iam/docs/workload-identity-federation) with an OIDC provider.
 2. Set the **Issuer URL** to this SuperPlane instance's URL.
 3. Set the **Audience** to the pool provider resource name.
-4. Grant the federated identity permission to [impersonate a service account](https://cloud.google.com/iam/docs/workload-identity-federation-with-other-providers#mapping) with the roles your workflows need.
-5. Enter the **pool provider resource name** and **Project ID** below.
+4. Set the **Attribute condition** to ` + "`assertion.token_use == \"integration\"`" + `, so workload identity tokens minted for canvas executions are not accepted.
+5. Grant the federated identity permission to [impersonate a service account](https://cloud.google.com/iam/docs/workload-identity-federation-with-other-providers#mapping) with the roles your workflows need.
+6. Enter the **pool provider resource name** and **Project ID** below.
 
 ## Required IAM roles
 
@@ -191,7 +193,9 @@ func (g *GCP) syncWIF(ctx core.SyncContext, config Configuration) error {
 	}
 
 	subject := fmt.Sprintf("app-installation:%s", ctx.Integration.ID())
-	oidcToken, err := ctx.OIDC.Sign(subject, 5*time.Minute, provider, nil)
+	oidcToken, err := ctx.OIDC.Sign(subject, 5*time.Minute, provider, map[string]any{
+		oidc.TokenUseClaim: oidc.TokenUseIntegration,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to generate OIDC token: %w", err)
 	}
@@ -107,6 +107,12 @@ func (r *RunWorkflow) Color() string {
 	return "gray"
 }
 
+// MaxWaitingTime is how long an execution can wait for the workflow run to finish
+// without any updates from webhooks or polling, before it is considered stuck.
+func (r *RunWorkflow) MaxWaitingTime() time.Duration {
+	return 24 * time.Hour
+}
+
 func (r *RunWorkflow) OutputChannels(configuration any) []core.OutputChannel {
 	return []core.OutputChannel{
 		{
@@ -83,6 +83,12 @@ func (r *RunPipeline) Color() string {
 	return "orange"
 }
 
+// MaxWaitingTime is how long an execution can wait for the pipeline to finish
+// without any updates from webhooks or polling, before it is considered stuck.
+func (r *RunPipeline) MaxWaitingTime() time.Duration {
+	return 24 * time.Hour
+}
+
 func (r *RunPipeline) ExampleOutput() map[string]any {
 	var example map[string]any
 	if err := json.Unmarshal(exampleOutputRunPipeline, &example); err != nil {
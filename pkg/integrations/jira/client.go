@@ -234,3 +234,40 @@ func (c *Client) CreateIssue(req *CreateIssueRequest) (*CreateIssueResponse, err
 
 	return &response, nil
 }
+
+// SearchIssuesRequest is the request body for searching issues with JQL.
+type SearchIssuesRequest struct {
+	JQL           string   `json:"jql"`
+	Fields        []string `json:"fields,omitempty"`
+	MaxResults    int      `json:"maxResults,omitempty"`
+	NextPageToken string   `json:"nextPageToken,omitempty"`
+}
+
+// SearchIssuesResponse is one page of issues matching a JQL query.
+type SearchIssuesResponse struct {
+	Issues        []Issue `json:"issues"`
+	NextPageToken string  `json:"nextPageToken"`
+	IsLast        bool    `json:"isLast"`
+}
+
+// SearchIssues returns one page of issues matching the JQL query.
+func (c *Client) SearchIssues(req *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+	url := fmt.Sprintf("%s/rest/api/3/search/jql", c.BaseURL)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %v", err)
+	}
+
+	responseBody, err := c.execRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var response SearchIssuesResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("error parsing search response: %v", err)
+	}
+
+	return &response, nil
+}
@@ -79,7 +79,9 @@ func (j *Jira) Components() []core.Component {
 }
 
 func (j *Jira) Triggers() []core.Trigger {
-	return []core.Trigger{}
+	return []core.Trigger{
+		registry.NewPollingTrigger(&OnIssue{}),
+	}
 }
 
 func (j *Jira) Cleanup(ctx core.IntegrationCleanupContext) error {
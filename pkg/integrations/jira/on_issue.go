@@ -0,0 +1,320 @@
+package jira
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	OnIssueCreatedPayloadType = "jira.issue.created"
+	OnIssueUpdatedPayloadType = "jira.issue.updated"
+
+	OnIssueEventCreated = "created"
+	OnIssueEventUpdated = "updated"
+
+	//
+	// JQL only supports minute precision for relative dates,
+	// so every search overlaps with the previous one.
+	// Duplicates are removed by the polling trigger.
+	//
+	onIssueSearchOverlap = 2 * time.Minute
+
+	onIssuePageSize = 100
+	onIssueMaxPages = 10
+
+	jiraTimeFormat = "2006-01-02T15:04:05.000-0700"
+)
+
+var onIssueFields = []string{
+	"summary",
+	"status",
+	"issuetype",
+	"priority",
+	"assignee",
+	"reporter",
+	"labels",
+	"project",
+	"created",
+	"updated",
+}
+
+type OnIssue struct{}
+
+type OnIssueConfiguration struct {
+	Project string   `json:"project" mapstructure:"project"`
+	Events  []string `json:"events" mapstructure:"events"`
+	JQL     string   `json:"jql" mapstructure:"jql"`
+}
+
+func (t *OnIssue) Name() string {
+	return "jira.onIssue"
+}
+
+func (t *OnIssue) Label() string {
+	return "On Issue"
+}
+
+func (t *OnIssue) Description() string {
+	return "Listen to issues being created or updated"
+}
+
+func (t *OnIssue) Documentation() string {
+	return `The On Issue trigger starts a workflow execution when issues are created or updated in a Jira project.
+
+## Use Cases
+
+- **Issue triage**: Route new issues to the right team or channel
+- **Status sync**: Keep external systems in sync with Jira issue changes
+- **Release automation**: Start deployments when issues move to a given status
+
+## Configuration
+
+- **Project**: The Jira project to watch
+- **Events**: Which issue events to listen for (created, updated)
+- **JQL Filter**: Optional JQL expression to narrow down the issues, e.g. ` + "`issuetype = Bug AND priority = High`" + `
+
+## How It Works
+
+Jira webhooks require admin access to the Jira site, so this trigger polls Jira for changes every minute instead.
+The first poll records the issues that already exist, so only issues created or updated after the trigger is set up start executions.
+
+## Event Data
+
+Each event includes:
+- **event**: The event type (created or updated)
+- **issue**: The issue, including its key, summary, status, type, priority, assignee and timestamps`
+}
+
+func (t *OnIssue) Icon() string {
+	return "jira"
+}
+
+func (t *OnIssue) Color() string {
+	return "blue"
+}
+
+func (t *OnIssue) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "project",
+			Label:       "Project",
+			Type:        configuration.FieldTypeIntegrationResource,
+			Required:    true,
+			Description: "The Jira project to watch",
+			Placeholder: "Select a project",
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type: "project",
+				},
+			},
+		},
+		{
+			Name:     "events",
+			Label:    "Events",
+			Type:     configuration.FieldTypeMultiSelect,
+			Required: true,
+			Default:  []string{OnIssueEventCreated},
+			TypeOptions: &configuration.TypeOptions{
+				MultiSelect: &configuration.MultiSelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Created", Value: OnIssueEventCreated},
+						{Label: "Updated", Value: OnIssueEventUpdated},
+					},
+				},
+			},
+		},
+		{
+			Name:        "jql",
+			Label:       "JQL Filter",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Optional JQL expression to filter issues",
+			Placeholder: "issuetype = Bug",
+		},
+	}
+}
+
+func (t *OnIssue) Setup(ctx core.TriggerContext) error {
+	config := OnIssueConfiguration{}
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %v", err)
+	}
+
+	if config.Project == "" {
+		return fmt.Errorf("project is required")
+	}
+
+	if len(config.Events) == 0 {
+		return fmt.Errorf("at least one event is required")
+	}
+
+	for _, event := range config.Events {
+		if event != OnIssueEventCreated && event != OnIssueEventUpdated {
+			return fmt.Errorf("unsupported event: %s", event)
+		}
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %v", err)
+	}
+
+	projects, err := client.ListProjects()
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %v", err)
+	}
+
+	var project *Project
+	for _, p := range projects {
+		if p.Key == config.Project {
+			project = &p
+			break
+		}
+	}
+
+	if project == nil {
+		return fmt.Errorf("project %s not found", config.Project)
+	}
+
+	return ctx.Metadata.Set(NodeMetadata{Project: project})
+}
+
+func (t *OnIssue) PollInterval(configuration any) time.Duration {
+	return core.DefaultPollInterval
+}
+
+// Poll searches for the issues updated since the previous poll.
+// The cursor is the time the previous search started.
+func (t *OnIssue) Poll(ctx core.PollContext) (*core.PollResult, error) {
+	config := OnIssueConfiguration{}
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %v", err)
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %v", err)
+	}
+
+	now := time.Now()
+	since := now.Add(-onIssueSearchOverlap)
+	if ctx.Cursor != "" {
+		cursor, err := time.Parse(time.RFC3339, ctx.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor %q: %v", ctx.Cursor, err)
+		}
+
+		since = cursor.Add(-onIssueSearchOverlap)
+	}
+
+	issues, err := t.searchIssues(client, buildOnIssueJQL(config, now.Sub(since)))
+	if err != nil {
+		return nil, err
+	}
+
+	items := []core.PolledItem{}
+	for _, issue := range issues {
+		event := issueEvent(issue, ctx.Cursor)
+		if !slices.Contains(config.Events, event) {
+			continue
+		}
+
+		payloadType := OnIssueUpdatedPayloadType
+		if event == OnIssueEventCreated {
+			payloadType = OnIssueCreatedPayloadType
+		}
+
+		items = append(items, core.PolledItem{
+			Key:         fmt.Sprintf("%s@%v", issue.ID, issue.Fields["updated"]),
+			PayloadType: payloadType,
+			Payload: map[string]any{
+				"event": event,
+				"issue": issue,
+			},
+		})
+	}
+
+	return &core.PollResult{
+		Cursor: now.Format(time.RFC3339),
+		Items:  items,
+	}, nil
+}
+
+func (t *OnIssue) searchIssues(client *Client, jql string) ([]Issue, error) {
+	issues := []Issue{}
+	nextPageToken := ""
+
+	for range onIssueMaxPages {
+		response, err := client.SearchIssues(&SearchIssuesRequest{
+			JQL:           jql,
+			Fields:        onIssueFields,
+			MaxResults:    onIssuePageSize,
+			NextPageToken: nextPageToken,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error searching issues: %v", err)
+		}
+
+		issues = append(issues, response.Issues...)
+		if response.IsLast || response.NextPageToken == "" {
+			break
+		}
+
+		nextPageToken = response.NextPageToken
+	}
+
+	return issues, nil
+}
+
+// buildOnIssueJQL uses a relative date for the updated filter,
+// since absolute dates in JQL are interpreted in the timezone of the user.
+func buildOnIssueJQL(config OnIssueConfiguration, window time.Duration) string {
+	minutes := int(math.Ceil(window.Minutes()))
+	jql := fmt.Sprintf(`project = "%s" AND updated >= -%dm`, config.Project, minutes)
+
+	filter := strings.TrimSpace(config.JQL)
+	if filter != "" {
+		jql = fmt.Sprintf("%s AND (%s)", jql, filter)
+	}
+
+	return jql + " ORDER BY updated ASC"
+}
+
+// issueEvent considers issues created after the previous poll as created,
+// and everything else as updated.
+func issueEvent(issue Issue, cursor string) string {
+	created, _ := issue.Fields["created"].(string)
+	updated, _ := issue.Fields["updated"].(string)
+	if created != "" && created == updated {
+		return OnIssueEventCreated
+	}
+
+	if cursor == "" {
+		return OnIssueEventUpdated
+	}
+
+	createdAt, err := time.Parse(jiraTimeFormat, created)
+	if err != nil {
+		return OnIssueEventUpdated
+	}
+
+	previousPoll, err := time.Parse(time.RFC3339, cursor)
+	if err != nil {
+		return OnIssueEventUpdated
+	}
+
+	if createdAt.After(previousPoll) {
+		return OnIssueEventCreated
+	}
+
+	return OnIssueEventUpdated
+}
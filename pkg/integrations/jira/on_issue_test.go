@@ -0,0 +1,152 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func jiraIntegrationContext() *contexts.IntegrationContext {
+	return &contexts.IntegrationContext{
+		Configuration: map[string]any{
+			"baseUrl":  "https://test.atlassian.net",
+			"email":    "test@example.com",
+			"apiToken": "test-token",
+		},
+	}
+}
+
+func Test__OnIssue__Setup(t *testing.T) {
+	trigger := &OnIssue{}
+
+	t.Run("missing project -> error", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Integration:   jiraIntegrationContext(),
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"events": []string{"created"}},
+		})
+
+		require.ErrorContains(t, err, "project is required")
+	})
+
+	t.Run("unsupported event -> error", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Integration:   jiraIntegrationContext(),
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"project": "TEST", "events": []string{"deleted"}},
+		})
+
+		require.ErrorContains(t, err, "unsupported event")
+	})
+
+	t.Run("valid configuration -> stores project in metadata", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`[{"id":"10000","key":"TEST","name":"Test Project"}]`)),
+				},
+			},
+		}
+
+		metadataCtx := &contexts.MetadataContext{}
+		err := trigger.Setup(core.TriggerContext{
+			HTTP:          httpContext,
+			Integration:   jiraIntegrationContext(),
+			Metadata:      metadataCtx,
+			Configuration: map[string]any{"project": "TEST", "events": []string{"created", "updated"}},
+		})
+
+		require.NoError(t, err)
+		metadata := metadataCtx.Metadata.(NodeMetadata)
+		assert.Equal(t, "Test Project", metadata.Project.Name)
+	})
+}
+
+func Test__OnIssue__Poll(t *testing.T) {
+	trigger := &OnIssue{}
+	cursorTime := time.Now().Add(-3 * time.Minute)
+	cursor := cursorTime.Format(time.RFC3339)
+	before := cursorTime.Add(-time.Hour).Format(jiraTimeFormat)
+	after := cursorTime.Add(time.Minute).Format(jiraTimeFormat)
+	later := cursorTime.Add(2 * time.Minute).Format(jiraTimeFormat)
+
+	response := func() *http.Response {
+		body, _ := json.Marshal(map[string]any{
+			"isLast": true,
+			"issues": []map[string]any{
+				{"id": "1", "key": "TEST-1", "fields": map[string]any{"created": before, "updated": after}},
+				{"id": "2", "key": "TEST-2", "fields": map[string]any{"created": after, "updated": after}},
+				{"id": "3", "key": "TEST-3", "fields": map[string]any{"created": after, "updated": later}},
+			},
+		})
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(string(body)))}
+	}
+
+	t.Run("created and updated issues", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{Responses: []*http.Response{response()}}
+		result, err := trigger.Poll(core.PollContext{
+			HTTP:          httpContext,
+			Integration:   jiraIntegrationContext(),
+			Configuration: map[string]any{"project": "TEST", "events": []string{"created", "updated"}, "jql": "issuetype = Bug"},
+			Cursor:        cursor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 3)
+		assert.Equal(t, OnIssueUpdatedPayloadType, result.Items[0].PayloadType)
+		assert.Equal(t, OnIssueCreatedPayloadType, result.Items[1].PayloadType)
+		assert.Equal(t, OnIssueCreatedPayloadType, result.Items[2].PayloadType)
+		assert.Equal(t, "1@"+after, result.Items[0].Key)
+
+		require.Len(t, httpContext.Requests, 1)
+		body, err := io.ReadAll(httpContext.Requests[0].Body)
+		require.NoError(t, err)
+
+		request := SearchIssuesRequest{}
+		require.NoError(t, json.Unmarshal(body, &request))
+		assert.Equal(t, `project = "TEST" AND updated >= -6m AND (issuetype = Bug) ORDER BY updated ASC`, request.JQL)
+	})
+
+	t.Run("only created events", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{Responses: []*http.Response{response()}}
+		result, err := trigger.Poll(core.PollContext{
+			HTTP:          httpContext,
+			Integration:   jiraIntegrationContext(),
+			Configuration: map[string]any{"project": "TEST", "events": []string{"created"}},
+			Cursor:        cursor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, map[string]any{"event": "created", "issue": Issue{ID: "2", Key: "TEST-2", Fields: map[string]any{"created": after, "updated": after}}}, result.Items[0].Payload)
+	})
+
+	t.Run("paginates through results", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"isLast":false,"nextPageToken":"next","issues":[{"id":"1","fields":{"created":"a","updated":"b"}}]}`))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"isLast":true,"issues":[{"id":"2","fields":{"created":"a","updated":"b"}}]}`))},
+			},
+		}
+
+		result, err := trigger.Poll(core.PollContext{
+			HTTP:          httpContext,
+			Integration:   jiraIntegrationContext(),
+			Configuration: map[string]any{"project": "TEST", "events": []string{"updated"}},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+		require.Len(t, httpContext.Requests, 2)
+	})
+}
@@ -358,3 +358,34 @@ func (c *Client) ListSubcategories(category string) ([]ChoiceRecord, error) {
 
 	return response.Result, nil
 }
+
+// ListIncidentsCreatedSince returns the incidents created in the last
+// given minutes, oldest first. Relative dates are used in the query,
+// since absolute dates are interpreted in the timezone of the user.
+func (c *Client) ListIncidentsCreatedSince(minutes int, filters []string, limit int) ([]IncidentRecord, error) {
+	query := fmt.Sprintf("sys_created_on>=javascript:gs.minutesAgoStart(%d)", minutes)
+	for _, filter := range filters {
+		query += "^" + filter
+	}
+
+	params := url.Values{}
+	params.Set("sysparm_query", query+"^ORDERBYsys_created_on")
+	params.Set("sysparm_fields", "sys_id,number,short_description,state,urgency,impact,priority,category,subcategory,sys_created_on,sys_updated_on")
+	params.Set("sysparm_limit", fmt.Sprintf("%d", limit))
+	path := "/api/now/table/incident?" + params.Encode()
+	responseBody, err := c.execRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Result []IncidentRecord `json:"result"`
+	}
+
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	return response.Result, nil
+}
@@ -26,3 +26,13 @@ func (c *CreateIncident) ExampleOutput() map[string]any {
 func (c *GetIncident) ExampleOutput() map[string]any {
 	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetIncidentOnce, exampleOutputGetIncidentBytes, &exampleOutputGetIncident)
 }
+
+//go:embed example_data_on_incident.json
+var exampleDataOnIncidentBytes []byte
+
+var exampleDataOnIncidentOnce sync.Once
+var exampleDataOnIncident map[string]any
+
+func (t *OnIncident) ExampleData() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleDataOnIncidentOnce, exampleDataOnIncidentBytes, &exampleDataOnIncident)
+}
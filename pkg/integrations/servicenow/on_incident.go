@@ -0,0 +1,198 @@
+package servicenow
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	//
+	// Every query overlaps with the previous one,
+	// to account for clock differences and minute precision.
+	// Duplicates are removed by the polling trigger.
+	//
+	onIncidentQueryOverlap = 2 * time.Minute
+
+	onIncidentPageSize = 200
+)
+
+type OnIncident struct{}
+
+type OnIncidentConfiguration struct {
+	AssignmentGroup string   `json:"assignmentGroup" mapstructure:"assignmentGroup"`
+	Urgencies       []string `json:"urgencies" mapstructure:"urgencies"`
+}
+
+func (t *OnIncident) Name() string {
+	return "servicenow.onIncident"
+}
+
+func (t *OnIncident) Label() string {
+	return "On Incident"
+}
+
+func (t *OnIncident) Description() string {
+	return "Listen to new incidents"
+}
+
+func (t *OnIncident) Documentation() string {
+	return `The On Incident trigger starts a workflow execution when a new incident is created in ServiceNow.
+
+## Use Cases
+
+- **Incident response**: Start runbooks as soon as incidents are opened
+- **Notifications**: Notify the right team when high urgency incidents are created
+- **Ticket sync**: Mirror incidents in other incident management tools
+
+## Configuration
+
+- **Assignment Group**: Only listen to incidents assigned to this group (optional)
+- **Urgencies**: Only listen to incidents with these urgency levels (optional)
+
+## How It Works
+
+ServiceNow outbound webhooks require business rules configured on the instance, so this trigger polls the Table API for new incidents every minute instead.
+The first poll records the incidents that already exist, so only incidents created after the trigger is set up start executions.
+
+## Event Data
+
+Each event includes the incident fields, such as:
+- **sys_id**: Unique identifier
+- **number**: Human-readable incident number (e.g. INC0010001)
+- **short_description**, **state**, **urgency**, **impact**, **priority**, **category**
+- **sys_created_on**: When the incident was created`
+}
+
+func (t *OnIncident) Icon() string {
+	return "servicenow"
+}
+
+func (t *OnIncident) Color() string {
+	return "gray"
+}
+
+func (t *OnIncident) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "assignmentGroup",
+			Label:       "Assignment Group",
+			Type:        configuration.FieldTypeIntegrationResource,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only listen to incidents assigned to this group",
+			Placeholder: "Select an assignment group",
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type: "assignment_group",
+				},
+			},
+		},
+		{
+			Name:        "urgencies",
+			Label:       "Urgencies",
+			Type:        configuration.FieldTypeMultiSelect,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only listen to incidents with these urgency levels",
+			TypeOptions: &configuration.TypeOptions{
+				MultiSelect: &configuration.MultiSelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "High", Value: "1"},
+						{Label: "Medium", Value: "2"},
+						{Label: "Low", Value: "3"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *OnIncident) Setup(ctx core.TriggerContext) error {
+	config := OnIncidentConfiguration{}
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("error creating client: %w", err)
+	}
+
+	metadata, err := resolveResourceMetadata(client, resourceSpec{
+		AssignmentGroup: config.AssignmentGroup,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Metadata.Set(metadata)
+}
+
+func (t *OnIncident) PollInterval(configuration any) time.Duration {
+	return core.DefaultPollInterval
+}
+
+// Poll lists the incidents created since the previous poll.
+// The cursor is the time the previous query started.
+func (t *OnIncident) Poll(ctx core.PollContext) (*core.PollResult, error) {
+	config := OnIncidentConfiguration{}
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return nil, fmt.Errorf("error creating client: %w", err)
+	}
+
+	now := time.Now()
+	since := now.Add(-onIncidentQueryOverlap)
+	if ctx.Cursor != "" {
+		cursor, err := time.Parse(time.RFC3339, ctx.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor %q: %w", ctx.Cursor, err)
+		}
+
+		since = cursor.Add(-onIncidentQueryOverlap)
+	}
+
+	minutes := int(math.Ceil(now.Sub(since).Minutes()))
+	incidents, err := client.ListIncidentsCreatedSince(minutes, incidentFilters(config), onIncidentPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("error listing incidents: %w", err)
+	}
+
+	items := make([]core.PolledItem, 0, len(incidents))
+	for _, incident := range incidents {
+		items = append(items, core.PolledItem{
+			Key:         incident.SysID,
+			PayloadType: PayloadTypeIncident,
+			Payload:     incident,
+		})
+	}
+
+	return &core.PollResult{
+		Cursor: now.Format(time.RFC3339),
+		Items:  items,
+	}, nil
+}
+
+func incidentFilters(config OnIncidentConfiguration) []string {
+	filters := []string{}
+	if config.AssignmentGroup != "" {
+		filters = append(filters, "assignment_group="+config.AssignmentGroup)
+	}
+
+	if len(config.Urgencies) > 0 {
+		filters = append(filters, "urgencyIN"+strings.Join(config.Urgencies, ","))
+	}
+
+	return filters
+}
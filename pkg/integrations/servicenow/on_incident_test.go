@@ -0,0 +1,118 @@
+package servicenow
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__OnIncident__Setup(t *testing.T) {
+	trigger := &OnIncident{}
+
+	t.Run("no filters -> ok", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{}
+		metadataCtx := &contexts.MetadataContext{}
+
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{},
+			HTTP:          httpContext,
+			Integration:   oauthIntegrationContext(),
+			Metadata:      metadataCtx,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 0)
+		metadata := metadataCtx.Metadata.(NodeMetadata)
+		assert.Equal(t, "https://dev12345.service-now.com", metadata.InstanceURL)
+	})
+
+	t.Run("assignment group is verified and stored in metadata", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"result":{"sys_id":"group-1","name":"Network"}}`)),
+				},
+			},
+		}
+
+		metadataCtx := &contexts.MetadataContext{}
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"assignmentGroup": "group-1"},
+			HTTP:          httpContext,
+			Integration:   oauthIntegrationContext(),
+			Metadata:      metadataCtx,
+		})
+
+		require.NoError(t, err)
+		metadata := metadataCtx.Metadata.(NodeMetadata)
+		require.NotNil(t, metadata.AssignmentGroup)
+		assert.Equal(t, "Network", metadata.AssignmentGroup.Name)
+	})
+}
+
+func Test__OnIncident__Poll(t *testing.T) {
+	trigger := &OnIncident{}
+
+	t.Run("lists incidents created since the cursor", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`{"result":[
+						{"sys_id":"inc-1","number":"INC0010001","urgency":"1"},
+						{"sys_id":"inc-2","number":"INC0010002","urgency":"2"}
+					]}`)),
+				},
+			},
+		}
+
+		cursor := time.Now().Add(-5 * time.Minute).Format(time.RFC3339)
+		result, err := trigger.Poll(core.PollContext{
+			Configuration: map[string]any{
+				"assignmentGroup": "group-1",
+				"urgencies":       []string{"1", "2"},
+			},
+			HTTP:        httpContext,
+			Integration: oauthIntegrationContext(),
+			Cursor:      cursor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, "inc-1", result.Items[0].Key)
+		assert.Equal(t, PayloadTypeIncident, result.Items[0].PayloadType)
+		assert.Equal(t, "INC0010002", result.Items[1].Payload.(IncidentRecord).Number)
+		assert.NotEqual(t, cursor, result.Cursor)
+
+		require.Len(t, httpContext.Requests, 1)
+		query := httpContext.Requests[0].URL.Query().Get("sysparm_query")
+		assert.Equal(t, "sys_created_on>=javascript:gs.minutesAgoStart(8)^assignment_group=group-1^urgencyIN1,2^ORDERBYsys_created_on", query)
+	})
+
+	t.Run("request error -> error", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusTooManyRequests,
+					Body:       io.NopCloser(strings.NewReader(`{"error":"rate limited"}`)),
+				},
+			},
+		}
+
+		_, err := trigger.Poll(core.PollContext{
+			Configuration: map[string]any{},
+			HTTP:          httpContext,
+			Integration:   oauthIntegrationContext(),
+		})
+
+		require.ErrorContains(t, err, "error listing incidents")
+	})
+}
@@ -105,7 +105,9 @@ func (s *ServiceNow) Components() []core.Component {
 }
 
 func (s *ServiceNow) Triggers() []core.Trigger {
-	return []core.Trigger{}
+	return []core.Trigger{
+		registry.NewPollingTrigger(&OnIncident{}),
+	}
 }
 
 func (s *ServiceNow) Cleanup(ctx core.IntegrationCleanupContext) error {
@@ -0,0 +1,171 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+)
+
+// How often the last usage of a token is recorded,
+// to avoid a write on every authenticated request.
+const APITokenLastUsedResolution = time.Minute
+
+// APIToken is a named token of a user or service account.
+// Tokens without scopes have the same permissions as their user.
+// Scopes are RBAC permissions, e.g. canvases:read, and restrict
+// the token to them. Tokens can also be restricted to a single canvas.
+type APIToken struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Name           string
+	TokenHash      string
+	Scopes         datatypes.JSONSlice[string]
+	CanvasID       *uuid.UUID
+	ExpiresAt      *time.Time
+	LastUsedAt     *time.Time
+	CreatedBy      *uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (t *APIToken) TableName() string {
+	return "api_tokens"
+}
+
+func ParseAPITokenScope(scope string) (string, string, error) {
+	resource, action, ok := strings.Cut(scope, ":")
+	resource = strings.TrimSpace(resource)
+	action = strings.TrimSpace(action)
+	if !ok || resource == "" || action == "" {
+		return "", "", fmt.Errorf("invalid scope %q: expected resource:action", scope)
+	}
+
+	return resource, action, nil
+}
+
+func (t *APIToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+func (t *APIToken) IsRestricted() bool {
+	return len(t.Scopes) > 0 || t.CanvasID != nil
+}
+
+// Allows reports whether the token can be used for
+// any of the actions on the resource, in the given canvas.
+// An empty canvas ID means the request is not about a specific canvas.
+func (t *APIToken) Allows(resource, canvasID string, actions ...string) bool {
+	if t.CanvasID != nil && t.CanvasID.String() != canvasID {
+		return false
+	}
+
+	if len(t.Scopes) == 0 {
+		return true
+	}
+
+	for _, action := range actions {
+		if slices.Contains(t.Scopes, resource+":"+action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *APIToken) Delete() error {
+	return database.Conn().Delete(t).Error
+}
+
+func (t *APIToken) TouchLastUsed(now time.Time) error {
+	if t.LastUsedAt != nil && now.Sub(*t.LastUsedAt) < APITokenLastUsedResolution {
+		return nil
+	}
+
+	t.LastUsedAt = &now
+	return database.Conn().
+		Model(t).
+		UpdateColumn("last_used_at", now).
+		Error
+}
+
+func CreateAPIToken(token *APIToken) error {
+	now := time.Now()
+	token.CreatedAt = now
+	token.UpdatedAt = now
+
+	err := database.Conn().Create(token).Error
+	if err != nil && strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+		return ErrNameAlreadyUsed
+	}
+
+	return err
+}
+
+// FindActiveAPITokenByHash only returns tokens of users
+// that were not removed from their organization.
+// Expiration is checked by the caller.
+func FindActiveAPITokenByHash(tokenHash string) (*APIToken, error) {
+	var token APIToken
+
+	err := database.Conn().
+		Joins("JOIN users ON users.id = api_tokens.user_id").
+		Where("users.deleted_at IS NULL").
+		Where("api_tokens.token_hash = ?", tokenHash).
+		First(&token).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func FindAPITokenByID(id string) (*APIToken, error) {
+	var token APIToken
+
+	err := database.Conn().
+		Where("id = ?", id).
+		First(&token).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func FindAPITokenForUser(userID uuid.UUID, id string) (*APIToken, error) {
+	var token APIToken
+
+	err := database.Conn().
+		Where("user_id = ?", userID).
+		Where("id = ?", id).
+		First(&token).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func ListAPITokensForUser(userID uuid.UUID) ([]APIToken, error) {
+	var tokens []APIToken
+
+	err := database.Conn().
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&tokens).
+		Error
+
+	return tokens, err
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPITokenAllows(t *testing.T) {
+	canvasID := uuid.New()
+
+	t.Run("token without restrictions allows everything", func(t *testing.T) {
+		token := &APIToken{}
+		assert.False(t, token.IsRestricted())
+		assert.True(t, token.Allows("secrets", "", "update"))
+		assert.True(t, token.Allows("canvases", canvasID.String(), "delete"))
+	})
+
+	t.Run("scopes restrict resources and actions", func(t *testing.T) {
+		token := &APIToken{Scopes: []string{"canvases:read", "canvases:run"}}
+		assert.True(t, token.IsRestricted())
+		assert.True(t, token.Allows("canvases", "", "read"))
+		assert.True(t, token.Allows("canvases", canvasID.String(), "update", "run"))
+		assert.False(t, token.Allows("canvases", canvasID.String(), "update"))
+		assert.False(t, token.Allows("secrets", "", "read"))
+	})
+
+	t.Run("canvas restriction only allows that canvas", func(t *testing.T) {
+		token := &APIToken{CanvasID: &canvasID}
+		assert.True(t, token.IsRestricted())
+		assert.True(t, token.Allows("canvases", canvasID.String(), "update"))
+		assert.False(t, token.Allows("canvases", uuid.NewString(), "read"))
+		assert.False(t, token.Allows("canvases", "", "read"))
+	})
+}
+
+func TestAPITokenIsExpired(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	assert.False(t, (&APIToken{}).IsExpired(now))
+	assert.True(t, (&APIToken{ExpiresAt: &past}).IsExpired(now))
+	assert.True(t, (&APIToken{ExpiresAt: &now}).IsExpired(now))
+	assert.False(t, (&APIToken{ExpiresAt: &future}).IsExpired(now))
+}
+
+func TestParseAPITokenScope(t *testing.T) {
+	resource, action, err := ParseAPITokenScope(" canvases : read ")
+	require.NoError(t, err)
+	assert.Equal(t, "canvases", resource)
+	assert.Equal(t, "read", action)
+
+	for _, value := range []string{"canvases", "canvases:", ":read", ""} {
+		_, _, err := ParseAPITokenScope(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,143 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const CalendarDateFormat = "2006-01-02"
+
+// Calendar is an organization-level list of blackout dates
+// shared by schedule triggers across canvases,
+// e.g. public holidays or release freeze windows.
+type Calendar struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Description    string
+	Exclusions     datatypes.JSONSlice[CalendarExclusion]
+	CreatedBy      *uuid.UUID
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
+
+type CalendarExclusion struct {
+	Start       string `json:"start"`
+	End         string `json:"end,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (e CalendarExclusion) Validate() error {
+	start, err := time.Parse(CalendarDateFormat, e.Start)
+	if err != nil {
+		return fmt.Errorf("invalid start date %q: expected format YYYY-MM-DD", e.Start)
+	}
+
+	if e.End == "" {
+		return nil
+	}
+
+	end, err := time.Parse(CalendarDateFormat, e.End)
+	if err != nil {
+		return fmt.Errorf("invalid end date %q: expected format YYYY-MM-DD", e.End)
+	}
+
+	if end.Before(start) {
+		return fmt.Errorf("end date %s is before start date %s", e.End, e.Start)
+	}
+
+	return nil
+}
+
+// Includes reports whether the given day (in YYYY-MM-DD format)
+// falls in the exclusion range. Both ends of the range are inclusive.
+func (e CalendarExclusion) Includes(day string) bool {
+	end := e.End
+	if end == "" {
+		end = e.Start
+	}
+
+	return day >= e.Start && day <= end
+}
+
+func (c *Calendar) Excludes(t time.Time) bool {
+	day := t.Format(CalendarDateFormat)
+	for _, exclusion := range c.Exclusions {
+		if exclusion.Includes(day) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ListCalendars(orgID uuid.UUID) ([]Calendar, error) {
+	var calendars []Calendar
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Order("name ASC").
+		Find(&calendars).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return calendars, nil
+}
+
+func FindCalendar(orgID uuid.UUID, id string) (*Calendar, error) {
+	var calendar Calendar
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Where("id = ?", id).
+		First(&calendar).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &calendar, nil
+}
+
+func FindCalendarByName(orgID uuid.UUID, name string) (*Calendar, error) {
+	return FindCalendarByNameInTransaction(database.Conn(), orgID, name)
+}
+
+func FindCalendarByNameInTransaction(tx *gorm.DB, orgID uuid.UUID, name string) (*Calendar, error) {
+	var calendar Calendar
+	err := tx.
+		Where("organization_id = ?", orgID).
+		Where("name = ?", name).
+		First(&calendar).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &calendar, nil
+}
+
+func CreateCalendar(calendar *Calendar) error {
+	now := time.Now()
+	calendar.CreatedAt = &now
+	calendar.UpdatedAt = &now
+	return database.Conn().Create(calendar).Error
+}
+
+func (c *Calendar) Update() error {
+	now := time.Now()
+	c.UpdatedAt = &now
+	return database.Conn().Save(c).Error
+}
+
+func (c *Calendar) Delete() error {
+	return database.Conn().Delete(c).Error
+}
@@ -10,6 +10,12 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+const (
+	CanvasPriorityLow    = "low"
+	CanvasPriorityNormal = "normal"
+	CanvasPriorityHigh   = "high"
+)
+
 type Canvas struct {
 	ID             uuid.UUID
 	OrganizationID uuid.UUID
@@ -17,6 +23,7 @@ type Canvas struct {
 	IsTemplate     bool
 	Name           string
 	Description    string
+	Priority       string `gorm:"default:normal"`
 	CreatedBy      *uuid.UUID
 	CreatedAt      *time.Time
 	UpdatedAt      *time.Time
@@ -93,20 +93,21 @@ func FindCanvasEventInTransaction(tx *gorm.DB, id uuid.UUID) (*CanvasEvent, erro
 }
 
 func ListCanvasEvents(canvasID uuid.UUID, nodeID string, limit int, before *time.Time) ([]CanvasEvent, error) {
-	var events []CanvasEvent
-	query := database.Conn().
-		Where("workflow_id = ?", canvasID).
-		Where("node_id = ?", nodeID)
+	return ListCanvasEventsFiltered(canvasID, nodeID, ListOptions{Limit: limit, Before: before})
+}
 
-	if limit > 0 {
-		query = query.Limit(limit)
-	}
+func CountCanvasEvents(canvasID uuid.UUID, nodeID string) (int64, error) {
+	return CountCanvasEventsFiltered(canvasID, nodeID, ListOptions{})
+}
 
-	if before != nil {
-		query = query.Where("created_at < ?", before)
+func ListCanvasEventsFiltered(canvasID uuid.UUID, nodeID string, options ListOptions) ([]CanvasEvent, error) {
+	query, err := canvasEventsQuery(canvasID, options)
+	if err != nil {
+		return nil, err
 	}
 
-	err := query.Order("created_at DESC").Find(&events).Error
+	var events []CanvasEvent
+	err = options.applyPagination(query.Where("workflow_events.node_id = ?", nodeID), "workflow_events").Find(&events).Error
 	if err != nil {
 		return nil, err
 	}
@@ -114,38 +115,36 @@ func ListCanvasEvents(canvasID uuid.UUID, nodeID string, limit int, before *time
 	return events, nil
 }
 
-func CountCanvasEvents(canvasID uuid.UUID, nodeID string) (int64, error) {
-	var count int64
-
-	err := database.Conn().
-		Model(&CanvasEvent{}).
-		Where("workflow_id = ?", canvasID).
-		Where("node_id = ?", nodeID).
-		Count(&count).
-		Error
-
+func CountCanvasEventsFiltered(canvasID uuid.UUID, nodeID string, options ListOptions) (int64, error) {
+	query, err := canvasEventsQuery(canvasID, options)
 	if err != nil {
 		return 0, err
 	}
 
+	var count int64
+	if err := query.Where("workflow_events.node_id = ?", nodeID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
 	return count, nil
 }
 
 func ListRootCanvasEvents(canvasID uuid.UUID, limit int, before *time.Time) ([]CanvasEvent, error) {
-	var events []CanvasEvent
-	query := database.Conn().
-		Where("workflow_id = ?", canvasID).
-		Where("execution_id IS NULL")
+	return ListRootCanvasEventsFiltered(canvasID, ListOptions{Limit: limit, Before: before})
+}
 
-	if limit > 0 {
-		query = query.Limit(limit)
-	}
+func CountRootCanvasEvents(canvasID uuid.UUID) (int64, error) {
+	return CountRootCanvasEventsFiltered(canvasID, ListOptions{})
+}
 
-	if before != nil {
-		query = query.Where("created_at < ?", before)
+func ListRootCanvasEventsFiltered(canvasID uuid.UUID, options ListOptions) ([]CanvasEvent, error) {
+	query, err := canvasEventsQuery(canvasID, options)
+	if err != nil {
+		return nil, err
 	}
 
-	err := query.Order("created_at DESC").Find(&events).Error
+	var events []CanvasEvent
+	err = options.applyPagination(query.Where("workflow_events.execution_id IS NULL"), "workflow_events").Find(&events).Error
 	if err != nil {
 		return nil, err
 	}
@@ -153,32 +152,37 @@ func ListRootCanvasEvents(canvasID uuid.UUID, limit int, before *time.Time) ([]C
 	return events, nil
 }
 
-func CountRootCanvasEvents(canvasID uuid.UUID) (int64, error) {
-	var count int64
-
-	err := database.Conn().
-		Model(&CanvasEvent{}).
-		Where("workflow_id = ?", canvasID).
-		Where("execution_id IS NULL").
-		Count(&count).
-		Error
-
+func CountRootCanvasEventsFiltered(canvasID uuid.UUID, options ListOptions) (int64, error) {
+	query, err := canvasEventsQuery(canvasID, options)
 	if err != nil {
 		return 0, err
 	}
 
+	var count int64
+	if err := query.Where("workflow_events.execution_id IS NULL").Count(&count).Error; err != nil {
+		return 0, err
+	}
+
 	return count, nil
 }
 
-func ListPendingCanvasEvents() ([]CanvasEvent, error) {
+func canvasEventsQuery(canvasID uuid.UUID, options ListOptions) (*gorm.DB, error) {
+	query := database.Conn().
+		Model(&CanvasEvent{}).
+		Where("workflow_events.workflow_id = ?", canvasID)
+
+	query = options.applyFilters(query, "workflow_events")
+	return applyPayloadFilter(query, "workflow_events.data", options.Payload)
+}
+
+func ListPendingCanvasEvents(shards *CanvasShards, limit int) ([]CanvasEvent, error) {
 	var events []CanvasEvent
-	err := database.Conn().
-		Joins("JOIN workflows ON workflow_events.workflow_id = workflows.id").
+	query := database.Conn().
+		Model(&CanvasEvent{}).
 		Where("workflow_events.state = ?", CanvasEventStatePending).
-		Where("workflows.deleted_at IS NULL").
-		Find(&events).
-		Error
+		Where("workflows.deleted_at IS NULL")
 
+	err := findFairly(query, "workflow_events", "workflow_events.created_at", shards, limit, &events)
 	if err != nil {
 		return nil, err
 	}
@@ -180,18 +180,25 @@ func FindCanvasNodesByIDs(tx *gorm.DB, canvasID uuid.UUID, nodeIDs []string) ([]
 	return nodes, nil
 }
 
-func ListCanvasNodesReady() ([]CanvasNode, error) {
+// ListCanvasNodesReady finds the nodes with items in their queue,
+// ordered by how long their oldest item has been waiting.
+func ListCanvasNodesReady(shards *CanvasShards, limit int) ([]CanvasNode, error) {
 	var nodes []CanvasNode
-	err := database.Conn().
-		Distinct().
-		Joins("JOIN workflow_node_queue_items ON workflow_nodes.workflow_id = workflow_node_queue_items.workflow_id AND workflow_nodes.node_id = workflow_node_queue_items.node_id").
-		Joins("JOIN workflows ON workflow_nodes.workflow_id = workflows.id").
+	query := database.Conn().
+		Model(&CanvasNode{}).
+		Joins(`CROSS JOIN LATERAL (
+			SELECT created_at
+			FROM workflow_node_queue_items
+			WHERE workflow_node_queue_items.workflow_id = workflow_nodes.workflow_id
+			AND workflow_node_queue_items.node_id = workflow_nodes.node_id
+			ORDER BY created_at
+			LIMIT 1
+		) AS queued`).
 		Where("workflow_nodes.state = ?", CanvasNodeStateReady).
 		Where("workflow_nodes.type IN ?", []string{NodeTypeComponent, NodeTypeBlueprint}).
-		Where("workflows.deleted_at IS NULL").
-		Find(&nodes).
-		Error
+		Where("workflows.deleted_at IS NULL")
 
+	err := findFairly(query, "workflow_nodes", "queued.created_at", shards, limit, &nodes)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +221,28 @@ func ListReadyTriggers() ([]CanvasNode, error) {
 	return nodes, nil
 }
 
+// ListTopicSubscribersInTransaction finds the trigger nodes
+// subscribed to a topic, across all the canvases of an organization.
+func ListTopicSubscribersInTransaction(tx *gorm.DB, organizationID uuid.UUID, triggerName, topic string) ([]CanvasNode, error) {
+	var nodes []CanvasNode
+	err := tx.
+		Joins("JOIN workflows ON workflow_nodes.workflow_id = workflows.id").
+		Where("workflows.organization_id = ?", organizationID).
+		Where("workflows.deleted_at IS NULL").
+		Where("workflows.is_template = ?", false).
+		Where("workflow_nodes.type = ?", NodeTypeTrigger).
+		Where("workflow_nodes.ref -> 'trigger' ->> 'name' = ?", triggerName).
+		Where("workflow_nodes.configuration ->> 'topic' = ?", topic).
+		Find(&nodes).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
 func LockCanvasNode(tx *gorm.DB, workflowID uuid.UUID, nodeId string) (*CanvasNode, error) {
 	var node CanvasNode
 
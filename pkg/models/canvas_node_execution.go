@@ -71,6 +71,17 @@ type CanvasNodeExecution struct {
 	ResultMessage string
 	CancelledBy   *uuid.UUID
 
+	//
+	// Activity tracking fields.
+	//
+	// LastActivityAt is updated when the execution starts,
+	// and whenever its metadata changes, e.g. when a webhook for it is received.
+	// MaxWaitingSeconds comes from the component MaxWaitingTime() hint,
+	// and started executions without any activity for longer than it are stuck.
+	//
+	LastActivityAt    *time.Time
+	MaxWaitingSeconds *int
+
 	//
 	// Components can store metadata about each execution here.
 	// This allows them to control the behavior of each execution.
@@ -129,13 +140,13 @@ func CreatePendingChildExecution(tx *gorm.DB, parent *CanvasNodeExecution, child
 	return &execution, nil
 }
 
-func ListPendingNodeExecutions() ([]CanvasNodeExecution, error) {
+func ListPendingNodeExecutions(shards *CanvasShards, limit int) ([]CanvasNodeExecution, error) {
 	var executions []CanvasNodeExecution
 	query := database.Conn().
-		Where("state = ?", CanvasNodeExecutionStatePending).
-		Order("created_at DESC")
+		Model(&CanvasNodeExecution{}).
+		Where("workflow_node_executions.state = ?", CanvasNodeExecutionStatePending)
 
-	err := query.Find(&executions).Error
+	err := findFairly(query, "workflow_node_executions", "workflow_node_executions.created_at", shards, limit, &executions)
 	if err != nil {
 		return nil, err
 	}
@@ -143,27 +154,92 @@ func ListPendingNodeExecutions() ([]CanvasNodeExecution, error) {
 	return executions, nil
 }
 
-func ListNodeExecutions(workflowID uuid.UUID, nodeID string, states []string, results []string, limit int, beforeTime *time.Time) ([]CanvasNodeExecution, error) {
+// CountStuckNodeExecutions counts the started executions of all canvases
+// without any activity for longer than their maximum waiting time.
+func CountStuckNodeExecutions() (int64, error) {
+	var count int64
+	err := stuckNodeExecutionsQuery(database.Conn()).
+		Where("workflow_node_executions.workflow_id IN (?)", database.Conn().Model(&Canvas{}).Select("id")).
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+// ListStuckNodeExecutions lists up to limit stuck executions of all canvases,
+// the ones without activity for the longest first.
+func ListStuckNodeExecutions(limit int) ([]CanvasNodeExecution, error) {
 	var executions []CanvasNodeExecution
-	query := database.Conn().
-		Where("workflow_id = ?", workflowID).
-		Where("node_id = ?", nodeID).
-		Order("created_at DESC").
-		Limit(int(limit))
+	err := stuckNodeExecutionsQuery(database.Conn()).
+		Where("workflow_node_executions.workflow_id IN (?)", database.Conn().Model(&Canvas{}).Select("id")).
+		Order("workflow_node_executions.last_activity_at ASC").
+		Limit(limit).
+		Find(&executions).
+		Error
 
-	if len(states) > 0 {
-		query = query.Where("state IN ?", states)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(results) > 0 {
-		query = query.Where("result IN ?", results)
+	return executions, nil
+}
+
+func ListStuckNodeExecutionsForCanvas(workflowID uuid.UUID) ([]CanvasNodeExecution, error) {
+	var executions []CanvasNodeExecution
+	err := stuckNodeExecutionsQuery(database.Conn()).
+		Where("workflow_node_executions.workflow_id = ?", workflowID).
+		Order("workflow_node_executions.last_activity_at ASC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
 	}
 
-	if beforeTime != nil {
-		query = query.Where("created_at < ?", beforeTime)
+	return executions, nil
+}
+
+// LockStuckNodeExecution locks an execution, if it is still stuck.
+func LockStuckNodeExecution(tx *gorm.DB, id uuid.UUID) (*CanvasNodeExecution, error) {
+	var execution CanvasNodeExecution
+	err := stuckNodeExecutionsQuery(tx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("workflow_node_executions.id = ?", id).
+		First(&execution).
+		Error
+
+	if err != nil {
+		return nil, err
 	}
 
-	err := query.Find(&executions).Error
+	return &execution, nil
+}
+
+func stuckNodeExecutionsQuery(tx *gorm.DB) *gorm.DB {
+	return tx.
+		Model(&CanvasNodeExecution{}).
+		Where("workflow_node_executions.state = ?", CanvasNodeExecutionStateStarted).
+		Where("workflow_node_executions.max_waiting_seconds IS NOT NULL").
+		Where("workflow_node_executions.last_activity_at + make_interval(secs => workflow_node_executions.max_waiting_seconds) < ?", time.Now())
+}
+
+func ListNodeExecutions(workflowID uuid.UUID, nodeID string, states []string, results []string, limit int, beforeTime *time.Time) ([]CanvasNodeExecution, error) {
+	return ListNodeExecutionsFiltered(workflowID, nodeID, ListOptions{
+		States:  states,
+		Results: results,
+		Limit:   limit,
+		Before:  beforeTime,
+	})
+}
+
+func ListNodeExecutionsFiltered(workflowID uuid.UUID, nodeID string, options ListOptions) ([]CanvasNodeExecution, error) {
+	query, err := nodeExecutionsQuery(workflowID, nodeID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var executions []CanvasNodeExecution
+	err = options.applyPagination(query, "workflow_node_executions").Find(&executions).Error
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +247,43 @@ func ListNodeExecutions(workflowID uuid.UUID, nodeID string, states []string, re
 	return executions, nil
 }
 
+func CountNodeExecutionsFiltered(workflowID uuid.UUID, nodeID string, options ListOptions) (int64, error) {
+	query, err := nodeExecutionsQuery(workflowID, nodeID, options)
+	if err != nil {
+		return 0, err
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return 0, err
+	}
+
+	return totalCount, nil
+}
+
+func nodeExecutionsQuery(workflowID uuid.UUID, nodeID string, options ListOptions) (*gorm.DB, error) {
+	query := database.Conn().
+		Model(&CanvasNodeExecution{}).
+		Where("workflow_node_executions.workflow_id = ?", workflowID).
+		Where("workflow_node_executions.node_id = ?", nodeID)
+
+	query = options.applyFilters(query, "workflow_node_executions")
+	if len(options.Payload) == 0 {
+		return query, nil
+	}
+
+	inputEvents, err := applyPayloadFilter(
+		database.Conn().Model(&CanvasEvent{}).Select("id").Where("workflow_id = ?", workflowID),
+		"data",
+		options.Payload,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return query.Where("workflow_node_executions.event_id IN (?)", inputEvents), nil
+}
+
 func ListNodeExecutionsForRootEvents(rootEventIDs []uuid.UUID) ([]CanvasNodeExecution, error) {
 	if len(rootEventIDs) == 0 {
 		return []CanvasNodeExecution{}, nil
@@ -190,25 +303,10 @@ func ListNodeExecutionsForRootEvents(rootEventIDs []uuid.UUID) ([]CanvasNodeExec
 }
 
 func CountNodeExecutions(workflowID uuid.UUID, nodeID string, states []string, results []string) (int64, error) {
-	var totalCount int64
-	countQuery := database.Conn().
-		Model(&CanvasNodeExecution{}).
-		Where("workflow_id = ?", workflowID).
-		Where("node_id = ?", nodeID)
-
-	if len(states) > 0 {
-		countQuery = countQuery.Where("state IN ?", states)
-	}
-
-	if len(results) > 0 {
-		countQuery = countQuery.Where("result IN ?", results)
-	}
-
-	if err := countQuery.Count(&totalCount).Error; err != nil {
-		return 0, err
-	}
-
-	return totalCount, nil
+	return CountNodeExecutionsFiltered(workflowID, nodeID, ListOptions{
+		States:  states,
+		Results: results,
+	})
 }
 
 func CountRunningExecutionsForNode(workflowID uuid.UUID, nodeID string) (int64, error) {
@@ -365,13 +463,31 @@ func (e *CanvasNodeExecution) StartInTransaction(tx *gorm.DB) error {
 
 	//
 	// Update the execution state to started.
+	// The maximum waiting time must be set before starting the execution.
 	//
+	now := time.Now()
+	e.LastActivityAt = &now
 	return tx.Model(e).
 		Update("state", CanvasNodeExecutionStateStarted).
-		Update("updated_at", time.Now()).
+		Update("updated_at", now).
+		Update("last_activity_at", now).
+		Update("max_waiting_seconds", e.MaxWaitingSeconds).
 		Error
 }
 
+// SetMaxWaitingTime records the component MaxWaitingTime() hint for this execution,
+// and is persisted when the execution is started.
+// A zero duration means the execution is never considered stuck.
+func (e *CanvasNodeExecution) SetMaxWaitingTime(maxWaitingTime time.Duration) {
+	if maxWaitingTime <= 0 {
+		e.MaxWaitingSeconds = nil
+		return
+	}
+
+	seconds := int(maxWaitingTime.Seconds())
+	e.MaxWaitingSeconds = &seconds
+}
+
 func (e *CanvasNodeExecution) Pass(outputs map[string][]any) ([]CanvasEvent, error) {
 	var events []CanvasEvent
 	err := database.Conn().Transaction(func(tx *gorm.DB) error {
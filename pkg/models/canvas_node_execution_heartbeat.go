@@ -0,0 +1,111 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+//
+// CanvasNodeExecutionHeartbeat records that a worker is processing a node execution.
+//
+// Executions are started in the same transaction that runs their component,
+// so an execution interrupted by a worker going away is rolled back to pending.
+// Heartbeats are written outside of that transaction, and deleted when processing is done,
+// so a heartbeat only outlives its processing if the worker went away in the middle of it.
+//
+// Attempts counts how many times processing was started without finishing,
+// so executions that keep taking their workers down are eventually failed.
+//
+// There is no foreign key to the execution, since the heartbeat is written
+// while the execution row is locked for update by the worker processing it.
+//
+
+const MaxNodeExecutionAttempts = 3
+
+type CanvasNodeExecutionHeartbeat struct {
+	ExecutionID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Worker      string
+	Attempts    int
+	HeartbeatAt time.Time
+	CreatedAt   *time.Time
+}
+
+func (h *CanvasNodeExecutionHeartbeat) TableName() string {
+	return "workflow_node_execution_heartbeats"
+}
+
+// StartNodeExecutionHeartbeat records that worker started processing an execution,
+// and returns how many times processing it was started without finishing, including this one.
+func StartNodeExecutionHeartbeat(executionID uuid.UUID, worker string) (int, error) {
+	var attempts int
+	err := database.Conn().
+		Raw(`
+			INSERT INTO workflow_node_execution_heartbeats (execution_id, worker, attempts, heartbeat_at, created_at)
+			VALUES (?, ?, 1, now(), now())
+			ON CONFLICT (execution_id) DO UPDATE
+			SET worker = EXCLUDED.worker,
+				attempts = workflow_node_execution_heartbeats.attempts + 1,
+				heartbeat_at = EXCLUDED.heartbeat_at
+			RETURNING attempts
+		`, executionID, worker).
+		Scan(&attempts).
+		Error
+
+	return attempts, err
+}
+
+// FailIfInterruptedTooOften fails the execution if processing it was
+// interrupted MaxNodeExecutionAttempts times or more, and reports whether it did.
+func (e *CanvasNodeExecution) FailIfInterruptedTooOften(tx *gorm.DB, interruptions int) (bool, error) {
+	if interruptions < MaxNodeExecutionAttempts {
+		return false, nil
+	}
+
+	err := e.FailInTransaction(
+		tx,
+		CanvasNodeExecutionResultReasonError,
+		fmt.Sprintf("execution was interrupted %d times", interruptions),
+	)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func RefreshNodeExecutionHeartbeat(executionID uuid.UUID) error {
+	return database.Conn().
+		Model(&CanvasNodeExecutionHeartbeat{}).
+		Where("execution_id = ?", executionID).
+		Update("heartbeat_at", time.Now()).
+		Error
+}
+
+func DeleteNodeExecutionHeartbeat(executionID uuid.UUID) error {
+	return DeleteNodeExecutionHeartbeatInTransaction(database.Conn(), executionID)
+}
+
+func DeleteNodeExecutionHeartbeatInTransaction(tx *gorm.DB, executionID uuid.UUID) error {
+	return tx.
+		Where("execution_id = ?", executionID).
+		Delete(&CanvasNodeExecutionHeartbeat{}).
+		Error
+}
+
+// ListStaleNodeExecutionHeartbeats lists the heartbeats not refreshed since before the given time.
+func ListStaleNodeExecutionHeartbeats(before time.Time, limit int) ([]CanvasNodeExecutionHeartbeat, error) {
+	var heartbeats []CanvasNodeExecutionHeartbeat
+	err := database.Conn().
+		Where("heartbeat_at < ?", before).
+		Order("heartbeat_at ASC").
+		Limit(limit).
+		Find(&heartbeats).
+		Error
+
+	return heartbeats, err
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
 	"gorm.io/gorm"
 )
 
@@ -67,3 +68,20 @@ func FirstNodeExecutionByKVInTransaction(tx *gorm.DB, workflowID uuid.UUID, node
 
 	return &execution, nil
 }
+
+func ListNodeExecutionKVs(workflowID, executionID uuid.UUID) ([]CanvasNodeExecutionKV, error) {
+	var kvs []CanvasNodeExecutionKV
+
+	err := database.Conn().
+		Where("workflow_id = ?", workflowID).
+		Where("execution_id = ?", executionID).
+		Order("created_at ASC").
+		Find(&kvs).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return kvs, nil
+}
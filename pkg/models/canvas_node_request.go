@@ -59,11 +59,12 @@ func LockNodeRequest(tx *gorm.DB, id uuid.UUID) (*CanvasNodeRequest, error) {
 	return &request, nil
 }
 
-func ListNodeRequests() ([]CanvasNodeRequest, error) {
+func ListNodeRequests(shards *CanvasShards) ([]CanvasNodeRequest, error) {
 	var requests []CanvasNodeRequest
 
 	now := time.Now()
-	err := database.Conn().
+	query := shards.apply(database.Conn(), "workflow_node_requests.workflow_id")
+	err := query.
 		Joins("JOIN workflow_nodes ON workflow_node_requests.workflow_id = workflow_nodes.workflow_id AND workflow_node_requests.node_id = workflow_nodes.node_id").
 		Joins("JOIN workflows ON workflow_node_requests.workflow_id = workflows.id").
 		Where("workflow_node_requests.state = ?", NodeExecutionRequestStatePending).
@@ -7,20 +7,36 @@ const (
 	ProviderGoogle = "google"
 
 	DomainTypeOrganization = "org"
+	DomainTypeCanvas       = "canvas"
 
 	DisplayNameOwner  = "Owner"
 	DisplayNameAdmin  = "Admin"
 	DisplayNameViewer = "Viewer"
 
+	DisplayNameCanvasOwner    = "Owner"
+	DisplayNameCanvasEditor   = "Editor"
+	DisplayNameCanvasOperator = "Operator"
+	DisplayNameCanvasViewer   = "Viewer"
+
 	RoleOrgOwner  = "org_owner"
 	RoleOrgAdmin  = "org_admin"
 	RoleOrgViewer = "org_viewer"
 
+	RoleCanvasOwner    = "canvas_owner"
+	RoleCanvasEditor   = "canvas_editor"
+	RoleCanvasOperator = "canvas_operator"
+	RoleCanvasViewer   = "canvas_viewer"
+
 	// Role descriptions
 	DescOrgOwner  = "Complete control over the organization including settings and deletion"
 	DescOrgAdmin  = "Full management access to organization resources including canvases and users"
 	DescOrgViewer = "Read-only access to organization resources"
 
+	DescCanvasOwner    = "Complete control over the canvas, including deletion and who has access to it"
+	DescCanvasEditor   = "Can change the canvas and run it"
+	DescCanvasOperator = "Can run the canvas, approve and cancel its executions"
+	DescCanvasViewer   = "Read-only access to the canvas, its events and executions"
+
 	// Metadata descriptions
 	MetaDescOrgOwner  = "Full control over organization settings, billing, and member management."
 	MetaDescOrgAdmin  = "Can manage canvases, users, groups, and roles within the organization."
@@ -37,7 +53,7 @@ var (
 )
 
 func ValidateDomainType(domainType string) error {
-	if domainType != DomainTypeOrganization {
+	if domainType != DomainTypeOrganization && domainType != DomainTypeCanvas {
 		return fmt.Errorf("invalid domain type %s", domainType)
 	}
 	return nil
@@ -0,0 +1,153 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+//
+// ListOptions narrows down list queries for canvas events and node executions.
+//
+// CreatedAfter and CreatedBefore define a time range, and are taken into account
+// when counting records. Before and Cursor are only used to paginate through results.
+//
+
+type ListOptions struct {
+	NodeIDs       []string
+	States        []string
+	Results       []string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	//
+	// Payload filters records by fields of their payload,
+	// using dot-separated paths as keys, e.g. "data.ref".
+	// For executions, the payload is the data of their input event.
+	//
+	Payload map[string]string
+
+	Ascending bool
+	Before    *time.Time
+	Cursor    *ListCursor
+	Limit     int
+}
+
+//
+// ListCursor points to the last record of a page.
+// Records are ordered by creation time, and by ID for records created at the same time.
+//
+
+type ListCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func (o *ListOptions) applyFilters(query *gorm.DB, table string) *gorm.DB {
+	if len(o.NodeIDs) > 0 {
+		query = query.Where(table+".node_id IN ?", o.NodeIDs)
+	}
+
+	if len(o.States) > 0 {
+		query = query.Where(table+".state IN ?", o.States)
+	}
+
+	if len(o.Results) > 0 {
+		query = query.Where(table+".result IN ?", o.Results)
+	}
+
+	if o.CreatedAfter != nil {
+		query = query.Where(table+".created_at >= ?", o.CreatedAfter)
+	}
+
+	if o.CreatedBefore != nil {
+		query = query.Where(table+".created_at < ?", o.CreatedBefore)
+	}
+
+	return query
+}
+
+func (o *ListOptions) applyPagination(query *gorm.DB, table string) *gorm.DB {
+	if o.Before != nil {
+		query = query.Where(table+".created_at < ?", o.Before)
+	}
+
+	direction := "DESC"
+	comparison := "<"
+	if o.Ascending {
+		direction = "ASC"
+		comparison = ">"
+	}
+
+	if o.Cursor != nil {
+		query = query.Where(
+			"("+table+".created_at, "+table+".id) "+comparison+" (?, ?)",
+			o.Cursor.CreatedAt,
+			o.Cursor.ID,
+		)
+	}
+
+	query = query.Order(table + ".created_at " + direction).Order(table + ".id " + direction)
+	if o.Limit > 0 {
+		query = query.Limit(o.Limit)
+	}
+
+	return query
+}
+
+/*
+ * Payload values are compared as strings, and also as numbers or booleans
+ * when they can be parsed as such, so "replicas=3" matches both "3" and 3.
+ */
+func payloadMatches(path, value string) ([][]byte, error) {
+	candidates := []any{value}
+
+	var typed any
+	if err := json.Unmarshal([]byte(value), &typed); err == nil {
+		switch typed.(type) {
+		case float64, bool:
+			candidates = append(candidates, typed)
+		}
+	}
+
+	keys := strings.Split(path, ".")
+	matches := make([][]byte, 0, len(candidates))
+	for _, candidate := range candidates {
+		var nested any = candidate
+		for i := len(keys) - 1; i >= 0; i-- {
+			nested = map[string]any{keys[i]: nested}
+		}
+
+		match, err := json.Marshal(nested)
+		if err != nil {
+			return nil, err
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
+
+func applyPayloadFilter(query *gorm.DB, column string, payload map[string]string) (*gorm.DB, error) {
+	for path, value := range payload {
+		matches, err := payloadMatches(path, value)
+		if err != nil {
+			return nil, err
+		}
+
+		conditions := make([]string, 0, len(matches))
+		args := make([]any, 0, len(matches))
+		for _, match := range matches {
+			conditions = append(conditions, column+" @> ?::jsonb")
+			args = append(args, match)
+		}
+
+		query = query.Where("("+strings.Join(conditions, " OR ")+")", args...)
+	}
+
+	return query, nil
+}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrganizationQuota overrides the installation-wide quotas for one organization.
+// A nil limit means the installation default applies to it.
+type OrganizationQuota struct {
+	OrganizationID      uuid.UUID `gorm:"type:uuid;primary_key"`
+	MaxCanvases         *int
+	MaxNodesPerCanvas   *int
+	MaxEventsPerMinute  *int
+	MaxExecutionsPerDay *int
+	MaxMemoryRows       *int
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (q *OrganizationQuota) TableName() string {
+	return "organization_quotas"
+}
+
+func FindOrganizationQuotaInTransaction(tx *gorm.DB, organizationID uuid.UUID) (*OrganizationQuota, error) {
+	var quota OrganizationQuota
+
+	err := tx.
+		Where("organization_id = ?", organizationID).
+		First(&quota).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &quota, nil
+}
+
+// Validate checks the limits are not negative.
+// Zero means the quota is not enforced for the organization.
+func (q *OrganizationQuota) Validate() error {
+	limits := map[string]*int{
+		"max_canvases":           q.MaxCanvases,
+		"max_nodes_per_canvas":   q.MaxNodesPerCanvas,
+		"max_events_per_minute":  q.MaxEventsPerMinute,
+		"max_executions_per_day": q.MaxExecutionsPerDay,
+		"max_memory_rows":        q.MaxMemoryRows,
+	}
+
+	for name, limit := range limits {
+		if limit != nil && *limit < 0 {
+			return fmt.Errorf("%s cannot be negative", name)
+		}
+	}
+
+	return nil
+}
+
+func UpsertOrganizationQuota(quota *OrganizationQuota) error {
+	return UpsertOrganizationQuotaInTransaction(database.Conn(), quota)
+}
+
+// UpsertOrganizationQuotaInTransaction replaces all the limits of the organization,
+// so limits not set go back to the installation defaults.
+func UpsertOrganizationQuotaInTransaction(tx *gorm.DB, quota *OrganizationQuota) error {
+	if err := quota.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	quota.CreatedAt = now
+	quota.UpdatedAt = now
+
+	return tx.
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "organization_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"max_canvases",
+					"max_nodes_per_canvas",
+					"max_events_per_minute",
+					"max_executions_per_day",
+					"max_memory_rows",
+					"updated_at",
+				}),
+			},
+		).
+		Create(quota).
+		Error
+}
+
+func CountCanvasesInOrganizationInTransaction(tx *gorm.DB, organizationID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := tx.
+		Model(&Canvas{}).
+		Where("organization_id = ?", organizationID).
+		Where("is_template = ?", false).
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+func MaxNodesPerCanvasInOrganizationInTransaction(tx *gorm.DB, organizationID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := tx.
+		Raw(`
+			SELECT COALESCE(MAX(node_count), 0)
+			FROM (
+				SELECT COUNT(*) AS node_count
+				FROM workflow_nodes
+				JOIN workflows ON workflows.id = workflow_nodes.workflow_id
+				WHERE workflows.organization_id = ?
+				  AND workflows.deleted_at IS NULL
+				  AND workflow_nodes.deleted_at IS NULL
+				  AND workflow_nodes.parent_node_id IS NULL
+				GROUP BY workflow_nodes.workflow_id
+			) AS counts
+		`, organizationID).
+		Scan(&count).
+		Error
+
+	return count, err
+}
+
+func CountCanvasEventsInOrganizationSinceInTransaction(tx *gorm.DB, organizationID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+
+	err := tx.
+		Model(&CanvasEvent{}).
+		Joins("JOIN workflows ON workflows.id = workflow_events.workflow_id").
+		Where("workflows.organization_id = ?", organizationID).
+		Where("workflow_events.created_at >= ?", since).
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+func CountNodeExecutionsInOrganizationSinceInTransaction(tx *gorm.DB, organizationID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+
+	err := tx.
+		Model(&CanvasNodeExecution{}).
+		Joins("JOIN workflows ON workflows.id = workflow_node_executions.workflow_id").
+		Where("workflows.organization_id = ?", organizationID).
+		Where("workflow_node_executions.created_at >= ?", since).
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+func CountCanvasMemoriesInOrganizationInTransaction(tx *gorm.DB, organizationID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := tx.
+		Model(&CanvasMemory{}).
+		Joins("JOIN workflows ON workflows.id = canvas_memories.canvas_id").
+		Where("workflows.organization_id = ?", organizationID).
+		Count(&count).
+		Error
+
+	return count, err
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+func TestUpsertOrganizationQuota(t *testing.T) {
+	require.NoError(t, database.TruncateTables())
+
+	organization, err := CreateOrganization("quota-org", "")
+	require.NoError(t, err)
+
+	limit := func(value int) *int {
+		return &value
+	}
+
+	t.Run("negative limits are rejected", func(t *testing.T) {
+		err := UpsertOrganizationQuota(&OrganizationQuota{
+			OrganizationID: organization.ID,
+			MaxCanvases:    limit(-1),
+		})
+
+		require.ErrorContains(t, err, "max_canvases cannot be negative")
+
+		_, err = FindOrganizationQuotaInTransaction(database.Conn(), organization.ID)
+		require.Error(t, err)
+	})
+
+	t.Run("quota is created", func(t *testing.T) {
+		require.NoError(t, UpsertOrganizationQuota(&OrganizationQuota{
+			OrganizationID:     organization.ID,
+			MaxCanvases:        limit(10),
+			MaxEventsPerMinute: limit(0),
+		}))
+
+		quota, err := FindOrganizationQuotaInTransaction(database.Conn(), organization.ID)
+		require.NoError(t, err)
+		assert.Equal(t, limit(10), quota.MaxCanvases)
+		assert.Equal(t, limit(0), quota.MaxEventsPerMinute)
+		assert.Nil(t, quota.MaxMemoryRows)
+	})
+
+	t.Run("limits are replaced on update", func(t *testing.T) {
+		require.NoError(t, UpsertOrganizationQuota(&OrganizationQuota{
+			OrganizationID: organization.ID,
+			MaxMemoryRows:  limit(500),
+		}))
+
+		quota, err := FindOrganizationQuotaInTransaction(database.Conn(), organization.ID)
+		require.NoError(t, err)
+		assert.Nil(t, quota.MaxCanvases)
+		assert.Nil(t, quota.MaxEventsPerMinute)
+		assert.Equal(t, limit(500), quota.MaxMemoryRows)
+	})
+}
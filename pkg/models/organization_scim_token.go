@@ -0,0 +1,99 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm/clause"
+)
+
+// OrganizationSCIMToken authenticates the SCIM provisioning
+// requests of an identity provider for one organization.
+// Only the hash of the token is stored, and an organization
+// has at most one token; rotating it replaces the previous one.
+type OrganizationSCIMToken struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID
+	TokenHash      string
+	LastUsedAt     *time.Time
+	CreatedBy      *uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (t *OrganizationSCIMToken) TableName() string {
+	return "organization_scim_tokens"
+}
+
+func (t *OrganizationSCIMToken) TouchLastUsed(now time.Time) error {
+	if t.LastUsedAt != nil && now.Sub(*t.LastUsedAt) < APITokenLastUsedResolution {
+		return nil
+	}
+
+	t.LastUsedAt = &now
+	return database.Conn().
+		Model(t).
+		UpdateColumn("last_used_at", now).
+		Error
+}
+
+func FindOrganizationSCIMToken(organizationID string) (*OrganizationSCIMToken, error) {
+	var token OrganizationSCIMToken
+
+	err := database.Conn().
+		Where("organization_id = ?", organizationID).
+		First(&token).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func FindOrganizationSCIMTokenByHash(tokenHash string) (*OrganizationSCIMToken, error) {
+	var token OrganizationSCIMToken
+
+	err := database.Conn().
+		Where("token_hash = ?", tokenHash).
+		First(&token).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func UpsertOrganizationSCIMToken(token *OrganizationSCIMToken) error {
+	now := time.Now()
+	token.CreatedAt = now
+	token.UpdatedAt = now
+	token.LastUsedAt = nil
+
+	return database.Conn().
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "organization_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"token_hash",
+					"last_used_at",
+					"created_by",
+					"created_at",
+					"updated_at",
+				}),
+			},
+		).
+		Create(token).
+		Error
+}
+
+func DeleteOrganizationSCIMToken(organizationID string) error {
+	return database.Conn().
+		Where("organization_id = ?", organizationID).
+		Delete(&OrganizationSCIMToken{}).
+		Error
+}
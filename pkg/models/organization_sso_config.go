@@ -0,0 +1,130 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SSOProtocolOIDC = "oidc"
+	SSOProtocolSAML = "saml"
+
+	DefaultSSOGroupsClaim = "groups"
+)
+
+// SSOGroupMapping maps a group reported by the identity provider
+// to a SuperPlane group of the organization.
+type SSOGroupMapping struct {
+	IdPGroup string `json:"idp_group"`
+	Group    string `json:"group"`
+}
+
+// OrganizationSSOConfig is the single sign-on configuration of an organization.
+// When enforced, members can only use the organization with sessions
+// created through its identity provider.
+type OrganizationSSOConfig struct {
+	ID                         uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationID             uuid.UUID `gorm:"type:uuid;uniqueIndex"`
+	Protocol                   string
+	Enabled                    bool
+	Enforced                   bool
+	OIDCIssuerURL              *string `gorm:"column:oidc_issuer_url"`
+	OIDCClientID               *string `gorm:"column:oidc_client_id"`
+	OIDCClientSecretCiphertext []byte  `gorm:"column:oidc_client_secret_ciphertext"`
+	SAMLIdPMetadata            *string `gorm:"column:saml_idp_metadata"`
+	GroupsClaim                string
+	GroupMappings              datatypes.JSONSlice[SSOGroupMapping]
+	UpdatedBy                  *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt                  time.Time
+	UpdatedAt                  time.Time
+}
+
+func (c *OrganizationSSOConfig) TableName() string {
+	return "organization_sso_configs"
+}
+
+func (c *OrganizationSSOConfig) IsEnforced() bool {
+	return c.Enabled && c.Enforced
+}
+
+// MappedGroups returns the SuperPlane groups mapped
+// from the groups reported by the identity provider.
+func (c *OrganizationSSOConfig) MappedGroups(idpGroups []string) []string {
+	groups := []string{}
+	for _, mapping := range c.GroupMappings {
+		if slices.Contains(idpGroups, mapping.IdPGroup) && !slices.Contains(groups, mapping.Group) {
+			groups = append(groups, mapping.Group)
+		}
+	}
+
+	return groups
+}
+
+// ManagedGroups returns all the SuperPlane groups used in the mappings.
+// Membership in these groups is synchronized on every login.
+func (c *OrganizationSSOConfig) ManagedGroups() []string {
+	groups := []string{}
+	for _, mapping := range c.GroupMappings {
+		if !slices.Contains(groups, mapping.Group) {
+			groups = append(groups, mapping.Group)
+		}
+	}
+
+	return groups
+}
+
+func FindOrganizationSSOConfig(organizationID string) (*OrganizationSSOConfig, error) {
+	return FindOrganizationSSOConfigInTransaction(database.Conn(), organizationID)
+}
+
+func FindOrganizationSSOConfigInTransaction(tx *gorm.DB, organizationID string) (*OrganizationSSOConfig, error) {
+	var config OrganizationSSOConfig
+
+	err := tx.
+		Where("organization_id = ?", organizationID).
+		First(&config).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func UpsertOrganizationSSOConfigInTransaction(tx *gorm.DB, config *OrganizationSSOConfig) error {
+	return tx.
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "organization_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"protocol",
+					"enabled",
+					"enforced",
+					"oidc_issuer_url",
+					"oidc_client_id",
+					"oidc_client_secret_ciphertext",
+					"saml_idp_metadata",
+					"groups_claim",
+					"group_mappings",
+					"updated_by",
+					"updated_at",
+				}),
+			},
+		).
+		Create(config).
+		Error
+}
+
+func DeleteOrganizationSSOConfig(organizationID string) error {
+	return database.Conn().
+		Where("organization_id = ?", organizationID).
+		Delete(&OrganizationSSOConfig{}).
+		Error
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+/*
+ * Pending work is picked up with weighted fair queueing,
+ * so a single canvas with a large fan-out does not starve everything else.
+ *
+ * Inside a canvas, rows are taken in the order they were created.
+ * Each row gets a virtual time: its position in the canvas divided by the canvas weight,
+ * so a high priority canvas gets four turns for every turn of a low priority one.
+ *
+ * Across organizations, rows are interleaved round-robin, taking the row with
+ * the lowest virtual time from each organization on every turn.
+ */
+var CanvasPriorityWeights = map[string]int{
+	CanvasPriorityLow:    1,
+	CanvasPriorityNormal: 2,
+	CanvasPriorityHigh:   4,
+}
+
+/*
+ * CanvasShards restricts the pending work listed to the canvases of the shards
+ * owned by this replica. A canvas belongs to a single shard, picked by hashing its ID.
+ * A nil CanvasShards lists the pending work of every canvas.
+ */
+type CanvasShards struct {
+	Count int
+	Owned []int
+}
+
+func (s *CanvasShards) apply(query *gorm.DB, column string) *gorm.DB {
+	if s == nil {
+		return query
+	}
+
+	if len(s.Owned) == 0 {
+		return query.Where("FALSE")
+	}
+
+	return query.Where(fmt.Sprintf("mod(abs(hashtext(%s::text)::bigint), ?) IN ?", column), s.Count, s.Owned)
+}
+
+func canvasWeightExpression() string {
+	return fmt.Sprintf(
+		"CASE workflows.priority WHEN '%s' THEN %d WHEN '%s' THEN %d ELSE %d END",
+		CanvasPriorityLow,
+		CanvasPriorityWeights[CanvasPriorityLow],
+		CanvasPriorityHigh,
+		CanvasPriorityWeights[CanvasPriorityHigh],
+		CanvasPriorityWeights[CanvasPriorityNormal],
+	)
+}
+
+/*
+ * findFairly scans up to limit rows of table into dest, in a fair order.
+ * query selects the rows of table that are pending, and it is run for each canvas
+ * of the shards, so it can use the columns of workflows, the canvas being looked at.
+ * createdAt is the expression used to order the rows inside a canvas.
+ *
+ * A canvas can't have more than limit rows in the result, so only its oldest
+ * limit rows are read, which an index on (workflow_id, createdAt) answers
+ * without going through all the pending rows of the canvas.
+ */
+func findFairly(query *gorm.DB, table, createdAt string, shards *CanvasShards, limit int, dest any) error {
+	oldest := query.
+		Select(fmt.Sprintf("%s.*, %s AS scheduling_created_at", table, createdAt)).
+		Where(fmt.Sprintf("%s.workflow_id = workflows.id", table)).
+		Order(createdAt).
+		Limit(limit)
+
+	canvases := shards.apply(database.Conn().Table("workflows"), "workflows.id")
+
+	ranked := database.Conn().Raw(fmt.Sprintf(`
+		SELECT
+			oldest.*,
+			workflows.organization_id AS scheduling_organization_id,
+			ROW_NUMBER() OVER (PARTITION BY workflows.id ORDER BY oldest.scheduling_created_at)::float / (%s) AS scheduling_virtual_time
+		FROM (?) AS workflows
+		CROSS JOIN LATERAL (?) AS oldest
+	`, canvasWeightExpression()), canvases, oldest)
+
+	turns := database.Conn().Raw(`
+		SELECT
+			ranked.*,
+			ROW_NUMBER() OVER (PARTITION BY scheduling_organization_id ORDER BY scheduling_virtual_time, scheduling_created_at) AS scheduling_turn
+		FROM (?) AS ranked
+	`, ranked)
+
+	return database.Conn().
+		Raw(`
+			SELECT * FROM (?) AS fair
+			ORDER BY scheduling_turn, scheduling_virtual_time, scheduling_created_at
+			LIMIT ?
+		`, turns, limit).
+		Scan(dest).
+		Error
+}
@@ -37,12 +37,19 @@ func (u *User) GetEmail() string {
 
 func (u *User) Delete() error {
 	now := time.Now()
-	return database.Conn().Unscoped().
-		Model(u).
-		Update("deleted_at", now).
-		Update("updated_at", now).
-		Update("token_hash", nil).
-		Error
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ?", u.ID).Delete(&APIToken{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Unscoped().
+			Model(u).
+			Update("deleted_at", now).
+			Update("updated_at", now).
+			Update("token_hash", nil).
+			Error
+	})
 }
 
 func (u *User) Restore() error {
@@ -62,6 +69,15 @@ func (u *User) UpdateTokenHash(tokenHash string) error {
 	return database.Conn().Save(u).Error
 }
 
+func (u *User) UpdateName(name string) error {
+	u.Name = name
+	u.UpdatedAt = time.Now()
+	return database.Conn().Unscoped().
+		Model(u).
+		Updates(map[string]any{"name": u.Name, "updated_at": u.UpdatedAt}).
+		Error
+}
+
 func CreateUser(orgID, accountID uuid.UUID, email, name string) (*User, error) {
 	return CreateUserInTransaction(database.Conn(), orgID, accountID, email, name)
 }
@@ -321,3 +337,33 @@ func FindAnyUserByEmail(email string) (*User, error) {
 
 	return &user, err
 }
+
+// ListMaybeDeletedHumanUsers returns a page of the human users
+// of an organization, including removed ones, ordered by creation,
+// and the total number of users matching. An empty email matches all users.
+func ListMaybeDeletedHumanUsers(orgID, email string, offset, limit int) ([]User, int64, error) {
+	query := database.Conn().Unscoped().
+		Model(&User{}).
+		Where("organization_id = ?", orgID).
+		Where("type = ?", UserTypeHuman)
+
+	if email != "" {
+		query = query.Where("email = ?", utils.NormalizeEmail(email))
+	}
+
+	var total int64
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var users []User
+	err = query.
+		Order("created_at ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&users).
+		Error
+
+	return users, total, err
+}
@@ -4,6 +4,15 @@ import (
 	"time"
 )
 
+// TokenUseClaim distinguishes tokens SuperPlane mints for its own integrations
+// from workload identity tokens minted for canvas executions, so relying parties
+// can refuse the latter where only integration tokens are expected.
+const (
+	TokenUseClaim       = "token_use"
+	TokenUseIntegration = "integration"
+	TokenUseWorkload    = "workload"
+)
+
 type Provider interface {
 	Sign(subject string, duration time.Duration, audience string, additionalClaims map[string]any) (string, error)
 	PublicJWKs() []PublicJWK
@@ -23,6 +23,129 @@ import (
 // CanvasAPIService CanvasAPI service
 type CanvasAPIService service
 
+type ApiCanvasesAssignCanvasRoleRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	body       *CanvasesAssignCanvasRoleBody
+}
+
+func (r ApiCanvasesAssignCanvasRoleRequest) Body(body CanvasesAssignCanvasRoleBody) ApiCanvasesAssignCanvasRoleRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesAssignCanvasRoleRequest) Execute() (*CanvasesAssignCanvasRoleResponse, *http.Response, error) {
+	return r.ApiService.CanvasesAssignCanvasRoleExecute(r)
+}
+
+/*
+CanvasesAssignCanvasRole Assign canvas role
+
+Gives a canvas role to a user or group, replacing the role they had in the canvas
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesAssignCanvasRoleRequest
+*/
+func (a *CanvasAPIService) CanvasesAssignCanvasRole(ctx context.Context, canvasId string) ApiCanvasesAssignCanvasRoleRequest {
+	return ApiCanvasesAssignCanvasRoleRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesAssignCanvasRoleResponse
+func (a *CanvasAPIService) CanvasesAssignCanvasRoleExecute(r ApiCanvasesAssignCanvasRoleRequest) (*CanvasesAssignCanvasRoleResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesAssignCanvasRoleResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesAssignCanvasRole")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/role-bindings"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesCreateCanvasRequest struct {
 	ctx        context.Context
 	ApiService *CanvasAPIService
@@ -482,27 +605,33 @@ func (a *CanvasAPIService) CanvasesDescribeCanvasExecute(r ApiCanvasesDescribeCa
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiCanvasesListCanvasMemoriesRequest struct {
+type ApiCanvasesDiffCanvasRequest struct {
 	ctx        context.Context
 	ApiService *CanvasAPIService
 	canvasId   string
+	body       *CanvasesDiffCanvasBody
 }
 
-func (r ApiCanvasesListCanvasMemoriesRequest) Execute() (*CanvasesListCanvasMemoriesResponse, *http.Response, error) {
-	return r.ApiService.CanvasesListCanvasMemoriesExecute(r)
+func (r ApiCanvasesDiffCanvasRequest) Body(body CanvasesDiffCanvasBody) ApiCanvasesDiffCanvasRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesDiffCanvasRequest) Execute() (*CanvasesDiffCanvasResponse, *http.Response, error) {
+	return r.ApiService.CanvasesDiffCanvasExecute(r)
 }
 
 /*
-CanvasesListCanvasMemories List canvas memories
+CanvasesDiffCanvas Diff canvas
 
-Returns append-only memory records for a canvas
+Compares a canvas spec against the live version of a canvas, without persisting anything
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param canvasId
-	@return ApiCanvasesListCanvasMemoriesRequest
+	@return ApiCanvasesDiffCanvasRequest
 */
-func (a *CanvasAPIService) CanvasesListCanvasMemories(ctx context.Context, canvasId string) ApiCanvasesListCanvasMemoriesRequest {
-	return ApiCanvasesListCanvasMemoriesRequest{
+func (a *CanvasAPIService) CanvasesDiffCanvas(ctx context.Context, canvasId string) ApiCanvasesDiffCanvasRequest {
+	return ApiCanvasesDiffCanvasRequest{
 		ApiService: a,
 		ctx:        ctx,
 		canvasId:   canvasId,
@@ -511,29 +640,32 @@ func (a *CanvasAPIService) CanvasesListCanvasMemories(ctx context.Context, canva
 
 // Execute executes the request
 //
-//	@return CanvasesListCanvasMemoriesResponse
-func (a *CanvasAPIService) CanvasesListCanvasMemoriesExecute(r ApiCanvasesListCanvasMemoriesRequest) (*CanvasesListCanvasMemoriesResponse, *http.Response, error) {
+//	@return CanvasesDiffCanvasResponse
+func (a *CanvasAPIService) CanvasesDiffCanvasExecute(r ApiCanvasesDiffCanvasRequest) (*CanvasesDiffCanvasResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *CanvasesListCanvasMemoriesResponse
+		localVarReturnValue *CanvasesDiffCanvasResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvasMemories")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesDiffCanvas")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/memory"
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/diff"
 	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -549,6 +681,8 @@ func (a *CanvasAPIService) CanvasesListCanvasMemoriesExecute(r ApiCanvasesListCa
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.body
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -594,61 +728,56 @@ func (a *CanvasAPIService) CanvasesListCanvasMemoriesExecute(r ApiCanvasesListCa
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiCanvasesListCanvasesRequest struct {
-	ctx              context.Context
-	ApiService       *CanvasAPIService
-	includeTemplates *bool
-}
-
-func (r ApiCanvasesListCanvasesRequest) IncludeTemplates(includeTemplates bool) ApiCanvasesListCanvasesRequest {
-	r.includeTemplates = &includeTemplates
-	return r
+type ApiCanvasesListCanvasMemoriesRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
 }
 
-func (r ApiCanvasesListCanvasesRequest) Execute() (*CanvasesListCanvasesResponse, *http.Response, error) {
-	return r.ApiService.CanvasesListCanvasesExecute(r)
+func (r ApiCanvasesListCanvasMemoriesRequest) Execute() (*CanvasesListCanvasMemoriesResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListCanvasMemoriesExecute(r)
 }
 
 /*
-CanvasesListCanvases List canvases
+CanvasesListCanvasMemories List canvas memories
 
-Returns a list of all canvases
+Returns append-only memory records for a canvas
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiCanvasesListCanvasesRequest
+	@param canvasId
+	@return ApiCanvasesListCanvasMemoriesRequest
 */
-func (a *CanvasAPIService) CanvasesListCanvases(ctx context.Context) ApiCanvasesListCanvasesRequest {
-	return ApiCanvasesListCanvasesRequest{
+func (a *CanvasAPIService) CanvasesListCanvasMemories(ctx context.Context, canvasId string) ApiCanvasesListCanvasMemoriesRequest {
+	return ApiCanvasesListCanvasMemoriesRequest{
 		ApiService: a,
 		ctx:        ctx,
+		canvasId:   canvasId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return CanvasesListCanvasesResponse
-func (a *CanvasAPIService) CanvasesListCanvasesExecute(r ApiCanvasesListCanvasesRequest) (*CanvasesListCanvasesResponse, *http.Response, error) {
+//	@return CanvasesListCanvasMemoriesResponse
+func (a *CanvasAPIService) CanvasesListCanvasMemoriesExecute(r ApiCanvasesListCanvasMemoriesRequest) (*CanvasesListCanvasMemoriesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *CanvasesListCanvasesResponse
+		localVarReturnValue *CanvasesListCanvasMemoriesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvases")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvasMemories")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/canvases"
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/memory"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.includeTemplates != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "includeTemplates", r.includeTemplates, "", "")
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -711,33 +840,27 @@ func (a *CanvasAPIService) CanvasesListCanvasesExecute(r ApiCanvasesListCanvases
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiCanvasesSendAiMessageRequest struct {
+type ApiCanvasesListCanvasRoleBindingsRequest struct {
 	ctx        context.Context
 	ApiService *CanvasAPIService
 	canvasId   string
-	body       *CanvasesSendAiMessageBody
-}
-
-func (r ApiCanvasesSendAiMessageRequest) Body(body CanvasesSendAiMessageBody) ApiCanvasesSendAiMessageRequest {
-	r.body = &body
-	return r
 }
 
-func (r ApiCanvasesSendAiMessageRequest) Execute() (*CanvasesSendAiMessageResponse, *http.Response, error) {
-	return r.ApiService.CanvasesSendAiMessageExecute(r)
+func (r ApiCanvasesListCanvasRoleBindingsRequest) Execute() (*CanvasesListCanvasRoleBindingsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListCanvasRoleBindingsExecute(r)
 }
 
 /*
-CanvasesSendAiMessage Generate AI canvas proposal
+CanvasesListCanvasRoleBindings List canvas role bindings
 
-Generates a structured, non-persistent canvas proposal from a natural language prompt
+Returns the users and groups with a role in a canvas
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param canvasId
-	@return ApiCanvasesSendAiMessageRequest
+	@return ApiCanvasesListCanvasRoleBindingsRequest
 */
-func (a *CanvasAPIService) CanvasesSendAiMessage(ctx context.Context, canvasId string) ApiCanvasesSendAiMessageRequest {
-	return ApiCanvasesSendAiMessageRequest{
+func (a *CanvasAPIService) CanvasesListCanvasRoleBindings(ctx context.Context, canvasId string) ApiCanvasesListCanvasRoleBindingsRequest {
+	return ApiCanvasesListCanvasRoleBindingsRequest{
 		ApiService: a,
 		ctx:        ctx,
 		canvasId:   canvasId,
@@ -746,23 +869,630 @@ func (a *CanvasAPIService) CanvasesSendAiMessage(ctx context.Context, canvasId s
 
 // Execute executes the request
 //
-//	@return CanvasesSendAiMessageResponse
-func (a *CanvasAPIService) CanvasesSendAiMessageExecute(r ApiCanvasesSendAiMessageRequest) (*CanvasesSendAiMessageResponse, *http.Response, error) {
+//	@return CanvasesListCanvasRoleBindingsResponse
+func (a *CanvasAPIService) CanvasesListCanvasRoleBindingsExecute(r ApiCanvasesListCanvasRoleBindingsRequest) (*CanvasesListCanvasRoleBindingsResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *CanvasesSendAiMessageResponse
+		localVarReturnValue *CanvasesListCanvasRoleBindingsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesSendAiMessage")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvasRoleBindings")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/ai/messages"
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/role-bindings"
 	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
 
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesListCanvasesRequest struct {
+	ctx              context.Context
+	ApiService       *CanvasAPIService
+	includeTemplates *bool
+}
+
+func (r ApiCanvasesListCanvasesRequest) IncludeTemplates(includeTemplates bool) ApiCanvasesListCanvasesRequest {
+	r.includeTemplates = &includeTemplates
+	return r
+}
+
+func (r ApiCanvasesListCanvasesRequest) Execute() (*CanvasesListCanvasesResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListCanvasesExecute(r)
+}
+
+/*
+CanvasesListCanvases List canvases
+
+Returns a list of all canvases
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiCanvasesListCanvasesRequest
+*/
+func (a *CanvasAPIService) CanvasesListCanvases(ctx context.Context) ApiCanvasesListCanvasesRequest {
+	return ApiCanvasesListCanvasesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListCanvasesResponse
+func (a *CanvasAPIService) CanvasesListCanvasesExecute(r ApiCanvasesListCanvasesRequest) (*CanvasesListCanvasesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListCanvasesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvases")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.includeTemplates != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeTemplates", r.includeTemplates, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesRemoveCanvasRoleRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	userId     *string
+	groupName  *string
+}
+
+func (r ApiCanvasesRemoveCanvasRoleRequest) UserId(userId string) ApiCanvasesRemoveCanvasRoleRequest {
+	r.userId = &userId
+	return r
+}
+
+func (r ApiCanvasesRemoveCanvasRoleRequest) GroupName(groupName string) ApiCanvasesRemoveCanvasRoleRequest {
+	r.groupName = &groupName
+	return r
+}
+
+func (r ApiCanvasesRemoveCanvasRoleRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.CanvasesRemoveCanvasRoleExecute(r)
+}
+
+/*
+CanvasesRemoveCanvasRole Remove canvas role
+
+Removes the canvas role of a user or group
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesRemoveCanvasRoleRequest
+*/
+func (a *CanvasAPIService) CanvasesRemoveCanvasRole(ctx context.Context, canvasId string) ApiCanvasesRemoveCanvasRoleRequest {
+	return ApiCanvasesRemoveCanvasRoleRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *CanvasAPIService) CanvasesRemoveCanvasRoleExecute(r ApiCanvasesRemoveCanvasRoleRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesRemoveCanvasRole")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/role-bindings"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.userId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "userId", r.userId, "", "")
+	}
+	if r.groupName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "groupName", r.groupName, "", "")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesSendAiMessageRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	body       *CanvasesSendAiMessageBody
+}
+
+func (r ApiCanvasesSendAiMessageRequest) Body(body CanvasesSendAiMessageBody) ApiCanvasesSendAiMessageRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesSendAiMessageRequest) Execute() (*CanvasesSendAiMessageResponse, *http.Response, error) {
+	return r.ApiService.CanvasesSendAiMessageExecute(r)
+}
+
+/*
+CanvasesSendAiMessage Generate AI canvas proposal
+
+Generates a structured, non-persistent canvas proposal from a natural language prompt
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesSendAiMessageRequest
+*/
+func (a *CanvasAPIService) CanvasesSendAiMessage(ctx context.Context, canvasId string) ApiCanvasesSendAiMessageRequest {
+	return ApiCanvasesSendAiMessageRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesSendAiMessageResponse
+func (a *CanvasAPIService) CanvasesSendAiMessageExecute(r ApiCanvasesSendAiMessageRequest) (*CanvasesSendAiMessageResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesSendAiMessageResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesSendAiMessage")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/ai/messages"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesSimulateCanvasRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	body       *CanvasesSimulateCanvasRequest
+}
+
+func (r ApiCanvasesSimulateCanvasRequest) Body(body CanvasesSimulateCanvasRequest) ApiCanvasesSimulateCanvasRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesSimulateCanvasRequest) Execute() (*CanvasesSimulateCanvasResponse, *http.Response, error) {
+	return r.ApiService.CanvasesSimulateCanvasExecute(r)
+}
+
+/*
+CanvasesSimulateCanvas Simulate canvas
+
+Runs a trigger payload through a canvas spec, without persisting anything or calling integrations
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiCanvasesSimulateCanvasRequest
+*/
+func (a *CanvasAPIService) CanvasesSimulateCanvas(ctx context.Context) ApiCanvasesSimulateCanvasRequest {
+	return ApiCanvasesSimulateCanvasRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesSimulateCanvasResponse
+func (a *CanvasAPIService) CanvasesSimulateCanvasExecute(r ApiCanvasesSimulateCanvasRequest) (*CanvasesSimulateCanvasResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesSimulateCanvasResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesSimulateCanvas")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/simulate"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesUpdateCanvasPriorityRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	id         string
+	body       *CanvasesUpdateCanvasPriorityBody
+}
+
+func (r ApiCanvasesUpdateCanvasPriorityRequest) Body(body CanvasesUpdateCanvasPriorityBody) ApiCanvasesUpdateCanvasPriorityRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesUpdateCanvasPriorityRequest) Execute() (*CanvasesUpdateCanvasPriorityResponse, *http.Response, error) {
+	return r.ApiService.CanvasesUpdateCanvasPriorityExecute(r)
+}
+
+/*
+CanvasesUpdateCanvasPriority Update canvas priority
+
+Updates the scheduling priority of a canvas relative to the other canvases of its organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiCanvasesUpdateCanvasPriorityRequest
+*/
+func (a *CanvasAPIService) CanvasesUpdateCanvasPriority(ctx context.Context, id string) ApiCanvasesUpdateCanvasPriorityRequest {
+	return ApiCanvasesUpdateCanvasPriorityRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesUpdateCanvasPriorityResponse
+func (a *CanvasAPIService) CanvasesUpdateCanvasPriorityExecute(r ApiCanvasesUpdateCanvasPriorityRequest) (*CanvasesUpdateCanvasPriorityResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPatch
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesUpdateCanvasPriorityResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesUpdateCanvasPriority")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{id}/priority"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
@@ -419,3 +419,130 @@ func (a *CanvasChangeRequestAPIService) CanvasesListCanvasChangeRequestsExecute(
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiCanvasesPublishCanvasChangeRequestRequest struct {
+	ctx             context.Context
+	ApiService      *CanvasChangeRequestAPIService
+	canvasId        string
+	changeRequestId string
+	body            *map[string]interface{}
+}
+
+func (r ApiCanvasesPublishCanvasChangeRequestRequest) Body(body map[string]interface{}) ApiCanvasesPublishCanvasChangeRequestRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesPublishCanvasChangeRequestRequest) Execute() (*CanvasesPublishCanvasChangeRequestResponse, *http.Response, error) {
+	return r.ApiService.CanvasesPublishCanvasChangeRequestExecute(r)
+}
+
+/*
+CanvasesPublishCanvasChangeRequest Publish canvas change request
+
+Publishes an open change request, making its version the live canvas version
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param changeRequestId
+	@return ApiCanvasesPublishCanvasChangeRequestRequest
+*/
+func (a *CanvasChangeRequestAPIService) CanvasesPublishCanvasChangeRequest(ctx context.Context, canvasId string, changeRequestId string) ApiCanvasesPublishCanvasChangeRequestRequest {
+	return ApiCanvasesPublishCanvasChangeRequestRequest{
+		ApiService:      a,
+		ctx:             ctx,
+		canvasId:        canvasId,
+		changeRequestId: changeRequestId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesPublishCanvasChangeRequestResponse
+func (a *CanvasChangeRequestAPIService) CanvasesPublishCanvasChangeRequestExecute(r ApiCanvasesPublishCanvasChangeRequestRequest) (*CanvasesPublishCanvasChangeRequestResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesPublishCanvasChangeRequestResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasChangeRequestAPIService.CanvasesPublishCanvasChangeRequest")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/change-requests/{changeRequestId}/publish"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"changeRequestId"+"}", url.PathEscape(parameterValueToString(r.changeRequestId, "changeRequestId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -17,6 +17,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 	"time"
 )
@@ -25,11 +26,17 @@ import (
 type CanvasEventAPIService service
 
 type ApiCanvasesListCanvasEventsRequest struct {
-	ctx        context.Context
-	ApiService *CanvasEventAPIService
-	canvasId   string
-	limit      *int64
-	before     *time.Time
+	ctx           context.Context
+	ApiService    *CanvasEventAPIService
+	canvasId      string
+	limit         *int64
+	before        *time.Time
+	nodeIds       *[]string
+	createdAfter  *time.Time
+	createdBefore *time.Time
+	order         *string
+	pageToken     *string
+	payload       *string
 }
 
 func (r ApiCanvasesListCanvasEventsRequest) Limit(limit int64) ApiCanvasesListCanvasEventsRequest {
@@ -42,6 +49,36 @@ func (r ApiCanvasesListCanvasEventsRequest) Before(before time.Time) ApiCanvases
 	return r
 }
 
+func (r ApiCanvasesListCanvasEventsRequest) NodeIds(nodeIds []string) ApiCanvasesListCanvasEventsRequest {
+	r.nodeIds = &nodeIds
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) CreatedAfter(createdAfter time.Time) ApiCanvasesListCanvasEventsRequest {
+	r.createdAfter = &createdAfter
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) CreatedBefore(createdBefore time.Time) ApiCanvasesListCanvasEventsRequest {
+	r.createdBefore = &createdBefore
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) Order(order string) ApiCanvasesListCanvasEventsRequest {
+	r.order = &order
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) PageToken(pageToken string) ApiCanvasesListCanvasEventsRequest {
+	r.pageToken = &pageToken
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) Payload(payload string) ApiCanvasesListCanvasEventsRequest {
+	r.payload = &payload
+	return r
+}
+
 func (r ApiCanvasesListCanvasEventsRequest) Execute() (*CanvasesListCanvasEventsResponse, *http.Response, error) {
 	return r.ApiService.CanvasesListCanvasEventsExecute(r)
 }
@@ -92,6 +129,35 @@ func (a *CanvasEventAPIService) CanvasesListCanvasEventsExecute(r ApiCanvasesLis
 	if r.before != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
 	}
+	if r.nodeIds != nil {
+		t := *r.nodeIds
+		if reflect.TypeOf(t).Kind() == reflect.Slice {
+			s := reflect.ValueOf(t)
+			for i := 0; i < s.Len(); i++ {
+				parameterAddToHeaderOrQuery(localVarQueryParams, "nodeIds", s.Index(i).Interface(), "form", "multi")
+			}
+		} else {
+			parameterAddToHeaderOrQuery(localVarQueryParams, "nodeIds", t, "form", "multi")
+		}
+	}
+	if r.createdAfter != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "createdAfter", r.createdAfter, "", "")
+	}
+	if r.createdBefore != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "createdBefore", r.createdBefore, "", "")
+	}
+	if r.order != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "order", r.order, "", "")
+	} else {
+		var defaultValue string = "LIST_ORDER_DESC"
+		r.order = &defaultValue
+	}
+	if r.pageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "pageToken", r.pageToken, "", "")
+	}
+	if r.payload != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "payload", r.payload, "", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
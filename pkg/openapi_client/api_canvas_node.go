@@ -404,12 +404,17 @@ func (a *CanvasNodeAPIService) CanvasesInvokeNodeTriggerActionExecute(r ApiCanva
 }
 
 type ApiCanvasesListNodeEventsRequest struct {
-	ctx        context.Context
-	ApiService *CanvasNodeAPIService
-	canvasId   string
-	nodeId     string
-	limit      *int64
-	before     *time.Time
+	ctx           context.Context
+	ApiService    *CanvasNodeAPIService
+	canvasId      string
+	nodeId        string
+	limit         *int64
+	before        *time.Time
+	createdAfter  *time.Time
+	createdBefore *time.Time
+	order         *string
+	pageToken     *string
+	payload       *string
 }
 
 func (r ApiCanvasesListNodeEventsRequest) Limit(limit int64) ApiCanvasesListNodeEventsRequest {
@@ -422,6 +427,31 @@ func (r ApiCanvasesListNodeEventsRequest) Before(before time.Time) ApiCanvasesLi
 	return r
 }
 
+func (r ApiCanvasesListNodeEventsRequest) CreatedAfter(createdAfter time.Time) ApiCanvasesListNodeEventsRequest {
+	r.createdAfter = &createdAfter
+	return r
+}
+
+func (r ApiCanvasesListNodeEventsRequest) CreatedBefore(createdBefore time.Time) ApiCanvasesListNodeEventsRequest {
+	r.createdBefore = &createdBefore
+	return r
+}
+
+func (r ApiCanvasesListNodeEventsRequest) Order(order string) ApiCanvasesListNodeEventsRequest {
+	r.order = &order
+	return r
+}
+
+func (r ApiCanvasesListNodeEventsRequest) PageToken(pageToken string) ApiCanvasesListNodeEventsRequest {
+	r.pageToken = &pageToken
+	return r
+}
+
+func (r ApiCanvasesListNodeEventsRequest) Payload(payload string) ApiCanvasesListNodeEventsRequest {
+	r.payload = &payload
+	return r
+}
+
 func (r ApiCanvasesListNodeEventsRequest) Execute() (*CanvasesListNodeEventsResponse, *http.Response, error) {
 	return r.ApiService.CanvasesListNodeEventsExecute(r)
 }
@@ -475,6 +505,24 @@ func (a *CanvasNodeAPIService) CanvasesListNodeEventsExecute(r ApiCanvasesListNo
 	if r.before != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
 	}
+	if r.createdAfter != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "createdAfter", r.createdAfter, "", "")
+	}
+	if r.createdBefore != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "createdBefore", r.createdBefore, "", "")
+	}
+	if r.order != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "order", r.order, "", "")
+	} else {
+		var defaultValue string = "LIST_ORDER_DESC"
+		r.order = &defaultValue
+	}
+	if r.pageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "pageToken", r.pageToken, "", "")
+	}
+	if r.payload != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "payload", r.payload, "", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -538,14 +586,19 @@ func (a *CanvasNodeAPIService) CanvasesListNodeEventsExecute(r ApiCanvasesListNo
 }
 
 type ApiCanvasesListNodeExecutionsRequest struct {
-	ctx        context.Context
-	ApiService *CanvasNodeAPIService
-	canvasId   string
-	nodeId     string
-	states     *[]string
-	results    *[]string
-	limit      *int64
-	before     *time.Time
+	ctx           context.Context
+	ApiService    *CanvasNodeAPIService
+	canvasId      string
+	nodeId        string
+	states        *[]string
+	results       *[]string
+	limit         *int64
+	before        *time.Time
+	createdAfter  *time.Time
+	createdBefore *time.Time
+	order         *string
+	pageToken     *string
+	payload       *string
 }
 
 func (r ApiCanvasesListNodeExecutionsRequest) States(states []string) ApiCanvasesListNodeExecutionsRequest {
@@ -568,6 +621,31 @@ func (r ApiCanvasesListNodeExecutionsRequest) Before(before time.Time) ApiCanvas
 	return r
 }
 
+func (r ApiCanvasesListNodeExecutionsRequest) CreatedAfter(createdAfter time.Time) ApiCanvasesListNodeExecutionsRequest {
+	r.createdAfter = &createdAfter
+	return r
+}
+
+func (r ApiCanvasesListNodeExecutionsRequest) CreatedBefore(createdBefore time.Time) ApiCanvasesListNodeExecutionsRequest {
+	r.createdBefore = &createdBefore
+	return r
+}
+
+func (r ApiCanvasesListNodeExecutionsRequest) Order(order string) ApiCanvasesListNodeExecutionsRequest {
+	r.order = &order
+	return r
+}
+
+func (r ApiCanvasesListNodeExecutionsRequest) PageToken(pageToken string) ApiCanvasesListNodeExecutionsRequest {
+	r.pageToken = &pageToken
+	return r
+}
+
+func (r ApiCanvasesListNodeExecutionsRequest) Payload(payload string) ApiCanvasesListNodeExecutionsRequest {
+	r.payload = &payload
+	return r
+}
+
 func (r ApiCanvasesListNodeExecutionsRequest) Execute() (*CanvasesListNodeExecutionsResponse, *http.Response, error) {
 	return r.ApiService.CanvasesListNodeExecutionsExecute(r)
 }
@@ -643,6 +721,24 @@ func (a *CanvasNodeAPIService) CanvasesListNodeExecutionsExecute(r ApiCanvasesLi
 	if r.before != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
 	}
+	if r.createdAfter != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "createdAfter", r.createdAfter, "", "")
+	}
+	if r.createdBefore != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "createdBefore", r.createdBefore, "", "")
+	}
+	if r.order != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "order", r.order, "", "")
+	} else {
+		var defaultValue string = "LIST_ORDER_DESC"
+		r.order = &defaultValue
+	}
+	if r.pageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "pageToken", r.pageToken, "", "")
+	}
+	if r.payload != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "payload", r.payload, "", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -142,6 +142,129 @@ func (a *ServiceAccountsAPIService) ServiceAccountsCreateServiceAccountExecute(r
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiServiceAccountsCreateServiceAccountTokenRequest struct {
+	ctx        context.Context
+	ApiService *ServiceAccountsAPIService
+	id         string
+	body       *ServiceAccountsCreateServiceAccountTokenBody
+}
+
+func (r ApiServiceAccountsCreateServiceAccountTokenRequest) Body(body ServiceAccountsCreateServiceAccountTokenBody) ApiServiceAccountsCreateServiceAccountTokenRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiServiceAccountsCreateServiceAccountTokenRequest) Execute() (*ServiceAccountsCreateServiceAccountTokenResponse, *http.Response, error) {
+	return r.ApiService.ServiceAccountsCreateServiceAccountTokenExecute(r)
+}
+
+/*
+ServiceAccountsCreateServiceAccountToken Create service account API token
+
+Creates a named API token for a service account
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiServiceAccountsCreateServiceAccountTokenRequest
+*/
+func (a *ServiceAccountsAPIService) ServiceAccountsCreateServiceAccountToken(ctx context.Context, id string) ApiServiceAccountsCreateServiceAccountTokenRequest {
+	return ApiServiceAccountsCreateServiceAccountTokenRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return ServiceAccountsCreateServiceAccountTokenResponse
+func (a *ServiceAccountsAPIService) ServiceAccountsCreateServiceAccountTokenExecute(r ApiServiceAccountsCreateServiceAccountTokenRequest) (*ServiceAccountsCreateServiceAccountTokenResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *ServiceAccountsCreateServiceAccountTokenResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ServiceAccountsAPIService.ServiceAccountsCreateServiceAccountToken")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/service-accounts/{id}/tokens"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiServiceAccountsDeleteServiceAccountRequest struct {
 	ctx        context.Context
 	ApiService *ServiceAccountsAPIService
@@ -366,6 +489,118 @@ func (a *ServiceAccountsAPIService) ServiceAccountsDescribeServiceAccountExecute
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiServiceAccountsListServiceAccountTokensRequest struct {
+	ctx        context.Context
+	ApiService *ServiceAccountsAPIService
+	id         string
+}
+
+func (r ApiServiceAccountsListServiceAccountTokensRequest) Execute() (*ServiceAccountsListServiceAccountTokensResponse, *http.Response, error) {
+	return r.ApiService.ServiceAccountsListServiceAccountTokensExecute(r)
+}
+
+/*
+ServiceAccountsListServiceAccountTokens List service account API tokens
+
+Returns the named API tokens of a service account
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiServiceAccountsListServiceAccountTokensRequest
+*/
+func (a *ServiceAccountsAPIService) ServiceAccountsListServiceAccountTokens(ctx context.Context, id string) ApiServiceAccountsListServiceAccountTokensRequest {
+	return ApiServiceAccountsListServiceAccountTokensRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return ServiceAccountsListServiceAccountTokensResponse
+func (a *ServiceAccountsAPIService) ServiceAccountsListServiceAccountTokensExecute(r ApiServiceAccountsListServiceAccountTokensRequest) (*ServiceAccountsListServiceAccountTokensResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *ServiceAccountsListServiceAccountTokensResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ServiceAccountsAPIService.ServiceAccountsListServiceAccountTokens")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/service-accounts/{id}/tokens"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiServiceAccountsListServiceAccountsRequest struct {
 	ctx        context.Context
 	ApiService *ServiceAccountsAPIService
@@ -597,6 +832,122 @@ func (a *ServiceAccountsAPIService) ServiceAccountsRegenerateServiceAccountToken
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiServiceAccountsRevokeServiceAccountTokenRequest struct {
+	ctx        context.Context
+	ApiService *ServiceAccountsAPIService
+	id         string
+	tokenId    string
+}
+
+func (r ApiServiceAccountsRevokeServiceAccountTokenRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.ServiceAccountsRevokeServiceAccountTokenExecute(r)
+}
+
+/*
+ServiceAccountsRevokeServiceAccountToken Revoke service account API token
+
+Revokes a named API token of a service account
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param tokenId
+	@return ApiServiceAccountsRevokeServiceAccountTokenRequest
+*/
+func (a *ServiceAccountsAPIService) ServiceAccountsRevokeServiceAccountToken(ctx context.Context, id string, tokenId string) ApiServiceAccountsRevokeServiceAccountTokenRequest {
+	return ApiServiceAccountsRevokeServiceAccountTokenRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+		tokenId:    tokenId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *ServiceAccountsAPIService) ServiceAccountsRevokeServiceAccountTokenExecute(r ApiServiceAccountsRevokeServiceAccountTokenRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ServiceAccountsAPIService.ServiceAccountsRevokeServiceAccountToken")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/service-accounts/{id}/tokens/{tokenId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"tokenId"+"}", url.PathEscape(parameterValueToString(r.tokenId, "tokenId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiServiceAccountsUpdateServiceAccountRequest struct {
 	ctx        context.Context
 	ApiService *ServiceAccountsAPIService
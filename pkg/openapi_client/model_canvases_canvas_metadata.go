@@ -29,6 +29,7 @@ type CanvasesCanvasMetadata struct {
 	UpdatedAt      *time.Time                 `json:"updatedAt,omitempty"`
 	CreatedBy      *SuperplaneCanvasesUserRef `json:"createdBy,omitempty"`
 	IsTemplate     *bool                      `json:"isTemplate,omitempty"`
+	Priority       *CanvasPriority            `json:"priority,omitempty"`
 }
 
 // NewCanvasesCanvasMetadata instantiates a new CanvasesCanvasMetadata object
@@ -37,6 +38,8 @@ type CanvasesCanvasMetadata struct {
 // will change when the set of required properties is changed
 func NewCanvasesCanvasMetadata() *CanvasesCanvasMetadata {
 	this := CanvasesCanvasMetadata{}
+	var priority CanvasPriority = CANVASPRIORITY_PRIORITY_UNSPECIFIED
+	this.Priority = &priority
 	return &this
 }
 
@@ -45,6 +48,8 @@ func NewCanvasesCanvasMetadata() *CanvasesCanvasMetadata {
 // but it doesn't guarantee that properties required by API are set
 func NewCanvasesCanvasMetadataWithDefaults() *CanvasesCanvasMetadata {
 	this := CanvasesCanvasMetadata{}
+	var priority CanvasPriority = CANVASPRIORITY_PRIORITY_UNSPECIFIED
+	this.Priority = &priority
 	return &this
 }
 
@@ -304,6 +309,38 @@ func (o *CanvasesCanvasMetadata) SetIsTemplate(v bool) {
 	o.IsTemplate = &v
 }
 
+// GetPriority returns the Priority field value if set, zero value otherwise.
+func (o *CanvasesCanvasMetadata) GetPriority() CanvasPriority {
+	if o == nil || IsNil(o.Priority) {
+		var ret CanvasPriority
+		return ret
+	}
+	return *o.Priority
+}
+
+// GetPriorityOk returns a tuple with the Priority field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasMetadata) GetPriorityOk() (*CanvasPriority, bool) {
+	if o == nil || IsNil(o.Priority) {
+		return nil, false
+	}
+	return o.Priority, true
+}
+
+// HasPriority returns a boolean if a field has been set.
+func (o *CanvasesCanvasMetadata) HasPriority() bool {
+	if o != nil && !IsNil(o.Priority) {
+		return true
+	}
+
+	return false
+}
+
+// SetPriority gets a reference to the given CanvasPriority and assigns it to the Priority field.
+func (o *CanvasesCanvasMetadata) SetPriority(v CanvasPriority) {
+	o.Priority = &v
+}
+
 func (o CanvasesCanvasMetadata) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -338,6 +375,9 @@ func (o CanvasesCanvasMetadata) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.IsTemplate) {
 		toSerialize["isTemplate"] = o.IsTemplate
 	}
+	if !IsNil(o.Priority) {
+		toSerialize["priority"] = o.Priority
+	}
 	return toSerialize, nil
 }
 
@@ -39,6 +39,7 @@ type CanvasesCanvasNodeExecution struct {
 	ChildExecutions     []CanvasesCanvasNodeExecution    `json:"childExecutions,omitempty"`
 	RootEvent           *CanvasesCanvasEvent             `json:"rootEvent,omitempty"`
 	CancelledBy         *SuperplaneCanvasesUserRef       `json:"cancelledBy,omitempty"`
+	LastActivityAt      *time.Time                       `json:"lastActivityAt,omitempty"`
 }
 
 // NewCanvasesCanvasNodeExecution instantiates a new CanvasesCanvasNodeExecution object
@@ -646,6 +647,38 @@ func (o *CanvasesCanvasNodeExecution) SetCancelledBy(v SuperplaneCanvasesUserRef
 	o.CancelledBy = &v
 }
 
+// GetLastActivityAt returns the LastActivityAt field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetLastActivityAt() time.Time {
+	if o == nil || IsNil(o.LastActivityAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.LastActivityAt
+}
+
+// GetLastActivityAtOk returns a tuple with the LastActivityAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetLastActivityAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.LastActivityAt) {
+		return nil, false
+	}
+	return o.LastActivityAt, true
+}
+
+// HasLastActivityAt returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasLastActivityAt() bool {
+	if o != nil && !IsNil(o.LastActivityAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastActivityAt gets a reference to the given time.Time and assigns it to the LastActivityAt field.
+func (o *CanvasesCanvasNodeExecution) SetLastActivityAt(v time.Time) {
+	o.LastActivityAt = &v
+}
+
 func (o CanvasesCanvasNodeExecution) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -710,6 +743,9 @@ func (o CanvasesCanvasNodeExecution) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.CancelledBy) {
 		toSerialize["cancelledBy"] = o.CancelledBy
 	}
+	if !IsNil(o.LastActivityAt) {
+		toSerialize["lastActivityAt"] = o.LastActivityAt
+	}
 	return toSerialize, nil
 }
 
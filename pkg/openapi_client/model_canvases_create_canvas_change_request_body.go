@@ -23,6 +23,8 @@ type CanvasesCreateCanvasChangeRequestBody struct {
 	VersionId   *string `json:"versionId,omitempty"`
 	Title       *string `json:"title,omitempty"`
 	Description *string `json:"description,omitempty"`
+	// Leaves the change request open for review, instead of publishing it right away.
+	KeepOpen *bool `json:"keepOpen,omitempty"`
 }
 
 // NewCanvasesCreateCanvasChangeRequestBody instantiates a new CanvasesCreateCanvasChangeRequestBody object
@@ -138,6 +140,38 @@ func (o *CanvasesCreateCanvasChangeRequestBody) SetDescription(v string) {
 	o.Description = &v
 }
 
+// GetKeepOpen returns the KeepOpen field value if set, zero value otherwise.
+func (o *CanvasesCreateCanvasChangeRequestBody) GetKeepOpen() bool {
+	if o == nil || IsNil(o.KeepOpen) {
+		var ret bool
+		return ret
+	}
+	return *o.KeepOpen
+}
+
+// GetKeepOpenOk returns a tuple with the KeepOpen field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCreateCanvasChangeRequestBody) GetKeepOpenOk() (*bool, bool) {
+	if o == nil || IsNil(o.KeepOpen) {
+		return nil, false
+	}
+	return o.KeepOpen, true
+}
+
+// HasKeepOpen returns a boolean if a field has been set.
+func (o *CanvasesCreateCanvasChangeRequestBody) HasKeepOpen() bool {
+	if o != nil && !IsNil(o.KeepOpen) {
+		return true
+	}
+
+	return false
+}
+
+// SetKeepOpen gets a reference to the given bool and assigns it to the KeepOpen field.
+func (o *CanvasesCreateCanvasChangeRequestBody) SetKeepOpen(v bool) {
+	o.KeepOpen = &v
+}
+
 func (o CanvasesCreateCanvasChangeRequestBody) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -157,6 +191,9 @@ func (o CanvasesCreateCanvasChangeRequestBody) ToMap() (map[string]interface{},
 	if !IsNil(o.Description) {
 		toSerialize["description"] = o.Description
 	}
+	if !IsNil(o.KeepOpen) {
+		toSerialize["keepOpen"] = o.KeepOpen
+	}
 	return toSerialize, nil
 }
 
@@ -25,6 +25,7 @@ type CanvasesListNodeEventsResponse struct {
 	TotalCount    *int64                `json:"totalCount,omitempty"`
 	HasNextPage   *bool                 `json:"hasNextPage,omitempty"`
 	LastTimestamp *time.Time            `json:"lastTimestamp,omitempty"`
+	NextPageToken *string               `json:"nextPageToken,omitempty"`
 }
 
 // NewCanvasesListNodeEventsResponse instantiates a new CanvasesListNodeEventsResponse object
@@ -172,6 +173,38 @@ func (o *CanvasesListNodeEventsResponse) SetLastTimestamp(v time.Time) {
 	o.LastTimestamp = &v
 }
 
+// GetNextPageToken returns the NextPageToken field value if set, zero value otherwise.
+func (o *CanvasesListNodeEventsResponse) GetNextPageToken() string {
+	if o == nil || IsNil(o.NextPageToken) {
+		var ret string
+		return ret
+	}
+	return *o.NextPageToken
+}
+
+// GetNextPageTokenOk returns a tuple with the NextPageToken field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesListNodeEventsResponse) GetNextPageTokenOk() (*string, bool) {
+	if o == nil || IsNil(o.NextPageToken) {
+		return nil, false
+	}
+	return o.NextPageToken, true
+}
+
+// HasNextPageToken returns a boolean if a field has been set.
+func (o *CanvasesListNodeEventsResponse) HasNextPageToken() bool {
+	if o != nil && !IsNil(o.NextPageToken) {
+		return true
+	}
+
+	return false
+}
+
+// SetNextPageToken gets a reference to the given string and assigns it to the NextPageToken field.
+func (o *CanvasesListNodeEventsResponse) SetNextPageToken(v string) {
+	o.NextPageToken = &v
+}
+
 func (o CanvasesListNodeEventsResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -194,6 +227,9 @@ func (o CanvasesListNodeEventsResponse) ToMap() (map[string]interface{}, error)
 	if !IsNil(o.LastTimestamp) {
 		toSerialize["lastTimestamp"] = o.LastTimestamp
 	}
+	if !IsNil(o.NextPageToken) {
+		toSerialize["nextPageToken"] = o.NextPageToken
+	}
 	return toSerialize, nil
 }
 
@@ -26,6 +26,52 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ListOrder int32
+
+const (
+	ListOrder_LIST_ORDER_DESC ListOrder = 0
+	ListOrder_LIST_ORDER_ASC  ListOrder = 1
+)
+
+// Enum value maps for ListOrder.
+var (
+	ListOrder_name = map[int32]string{
+		0: "LIST_ORDER_DESC",
+		1: "LIST_ORDER_ASC",
+	}
+	ListOrder_value = map[string]int32{
+		"LIST_ORDER_DESC": 0,
+		"LIST_ORDER_ASC":  1,
+	}
+)
+
+func (x ListOrder) Enum() *ListOrder {
+	p := new(ListOrder)
+	*p = x
+	return p
+}
+
+func (x ListOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[0].Descriptor()
+}
+
+func (ListOrder) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[0]
+}
+
+func (x ListOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListOrder.Descriptor instead.
+func (ListOrder) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{0}
+}
+
 type CanvasAutoLayout_Algorithm int32
 
 const (
@@ -56,11 +102,11 @@ func (x CanvasAutoLayout_Algorithm) String() string {
 }
 
 func (CanvasAutoLayout_Algorithm) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[0].Descriptor()
+	return file_canvases_proto_enumTypes[1].Descriptor()
 }
 
 func (CanvasAutoLayout_Algorithm) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[0]
+	return &file_canvases_proto_enumTypes[1]
 }
 
 func (x CanvasAutoLayout_Algorithm) Number() protoreflect.EnumNumber {
@@ -108,11 +154,11 @@ func (x CanvasAutoLayout_Scope) String() string {
 }
 
 func (CanvasAutoLayout_Scope) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[1].Descriptor()
+	return file_canvases_proto_enumTypes[2].Descriptor()
 }
 
 func (CanvasAutoLayout_Scope) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[1]
+	return &file_canvases_proto_enumTypes[2]
 }
 
 func (x CanvasAutoLayout_Scope) Number() protoreflect.EnumNumber {
@@ -124,6 +170,216 @@ func (CanvasAutoLayout_Scope) EnumDescriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{6, 1}
 }
 
+type CanvasDiff_ChangeType int32
+
+const (
+	CanvasDiff_CHANGE_TYPE_UNSPECIFIED CanvasDiff_ChangeType = 0
+	CanvasDiff_CHANGE_TYPE_ADDED       CanvasDiff_ChangeType = 1
+	CanvasDiff_CHANGE_TYPE_REMOVED     CanvasDiff_ChangeType = 2
+	CanvasDiff_CHANGE_TYPE_CHANGED     CanvasDiff_ChangeType = 3
+)
+
+// Enum value maps for CanvasDiff_ChangeType.
+var (
+	CanvasDiff_ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_ADDED",
+		2: "CHANGE_TYPE_REMOVED",
+		3: "CHANGE_TYPE_CHANGED",
+	}
+	CanvasDiff_ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_ADDED":       1,
+		"CHANGE_TYPE_REMOVED":     2,
+		"CHANGE_TYPE_CHANGED":     3,
+	}
+)
+
+func (x CanvasDiff_ChangeType) Enum() *CanvasDiff_ChangeType {
+	p := new(CanvasDiff_ChangeType)
+	*p = x
+	return p
+}
+
+func (x CanvasDiff_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasDiff_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[3].Descriptor()
+}
+
+func (CanvasDiff_ChangeType) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[3]
+}
+
+func (x CanvasDiff_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasDiff_ChangeType.Descriptor instead.
+func (CanvasDiff_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{29, 0}
+}
+
+type CanvasSimulation_Source int32
+
+const (
+	CanvasSimulation_SOURCE_UNSPECIFIED    CanvasSimulation_Source = 0
+	CanvasSimulation_SOURCE_TRIGGER        CanvasSimulation_Source = 1
+	CanvasSimulation_SOURCE_COMPONENT      CanvasSimulation_Source = 2
+	CanvasSimulation_SOURCE_FIXTURE        CanvasSimulation_Source = 3
+	CanvasSimulation_SOURCE_EXAMPLE_OUTPUT CanvasSimulation_Source = 4
+)
+
+// Enum value maps for CanvasSimulation_Source.
+var (
+	CanvasSimulation_Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "SOURCE_TRIGGER",
+		2: "SOURCE_COMPONENT",
+		3: "SOURCE_FIXTURE",
+		4: "SOURCE_EXAMPLE_OUTPUT",
+	}
+	CanvasSimulation_Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED":    0,
+		"SOURCE_TRIGGER":        1,
+		"SOURCE_COMPONENT":      2,
+		"SOURCE_FIXTURE":        3,
+		"SOURCE_EXAMPLE_OUTPUT": 4,
+	}
+)
+
+func (x CanvasSimulation_Source) Enum() *CanvasSimulation_Source {
+	p := new(CanvasSimulation_Source)
+	*p = x
+	return p
+}
+
+func (x CanvasSimulation_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasSimulation_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[4].Descriptor()
+}
+
+func (CanvasSimulation_Source) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[4]
+}
+
+func (x CanvasSimulation_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasSimulation_Source.Descriptor instead.
+func (CanvasSimulation_Source) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type CanvasSimulation_Result int32
+
+const (
+	CanvasSimulation_RESULT_UNSPECIFIED CanvasSimulation_Result = 0
+	CanvasSimulation_RESULT_PASSED      CanvasSimulation_Result = 1
+	CanvasSimulation_RESULT_FAILED      CanvasSimulation_Result = 2
+)
+
+// Enum value maps for CanvasSimulation_Result.
+var (
+	CanvasSimulation_Result_name = map[int32]string{
+		0: "RESULT_UNSPECIFIED",
+		1: "RESULT_PASSED",
+		2: "RESULT_FAILED",
+	}
+	CanvasSimulation_Result_value = map[string]int32{
+		"RESULT_UNSPECIFIED": 0,
+		"RESULT_PASSED":      1,
+		"RESULT_FAILED":      2,
+	}
+)
+
+func (x CanvasSimulation_Result) Enum() *CanvasSimulation_Result {
+	p := new(CanvasSimulation_Result)
+	*p = x
+	return p
+}
+
+func (x CanvasSimulation_Result) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasSimulation_Result) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[5].Descriptor()
+}
+
+func (CanvasSimulation_Result) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[5]
+}
+
+func (x CanvasSimulation_Result) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasSimulation_Result.Descriptor instead.
+func (CanvasSimulation_Result) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{32, 1}
+}
+
+// When the workers are busy, the work of canvases with a higher priority
+// is picked up more often than the work of other canvases of the same organization.
+type Canvas_Priority int32
+
+const (
+	Canvas_PRIORITY_UNSPECIFIED Canvas_Priority = 0
+	Canvas_PRIORITY_LOW         Canvas_Priority = 1
+	Canvas_PRIORITY_NORMAL      Canvas_Priority = 2
+	Canvas_PRIORITY_HIGH        Canvas_Priority = 3
+)
+
+// Enum value maps for Canvas_Priority.
+var (
+	Canvas_Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "PRIORITY_LOW",
+		2: "PRIORITY_NORMAL",
+		3: "PRIORITY_HIGH",
+	}
+	Canvas_Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"PRIORITY_LOW":         1,
+		"PRIORITY_NORMAL":      2,
+		"PRIORITY_HIGH":        3,
+	}
+)
+
+func (x Canvas_Priority) Enum() *Canvas_Priority {
+	p := new(Canvas_Priority)
+	*p = x
+	return p
+}
+
+func (x Canvas_Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Canvas_Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[6].Descriptor()
+}
+
+func (Canvas_Priority) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[6]
+}
+
+func (x Canvas_Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Canvas_Priority.Descriptor instead.
+func (Canvas_Priority) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{34, 0}
+}
+
 type CanvasChangeRequest_Status int32
 
 const (
@@ -157,11 +413,11 @@ func (x CanvasChangeRequest_Status) String() string {
 }
 
 func (CanvasChangeRequest_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[2].Descriptor()
+	return file_canvases_proto_enumTypes[7].Descriptor()
 }
 
 func (CanvasChangeRequest_Status) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[2]
+	return &file_canvases_proto_enumTypes[7]
 }
 
 func (x CanvasChangeRequest_Status) Number() protoreflect.EnumNumber {
@@ -170,7 +426,7 @@ func (x CanvasChangeRequest_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasChangeRequest_Status.Descriptor instead.
 func (CanvasChangeRequest_Status) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{27, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{37, 0}
 }
 
 type CanvasNodeExecution_State int32
@@ -209,11 +465,11 @@ func (x CanvasNodeExecution_State) String() string {
 }
 
 func (CanvasNodeExecution_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[3].Descriptor()
+	return file_canvases_proto_enumTypes[8].Descriptor()
 }
 
 func (CanvasNodeExecution_State) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[3]
+	return &file_canvases_proto_enumTypes[8]
 }
 
 func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
@@ -222,7 +478,7 @@ func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_State.Descriptor instead.
 func (CanvasNodeExecution_State) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{42, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{55, 0}
 }
 
 type CanvasNodeExecution_Result int32
@@ -261,11 +517,11 @@ func (x CanvasNodeExecution_Result) String() string {
 }
 
 func (CanvasNodeExecution_Result) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[4].Descriptor()
+	return file_canvases_proto_enumTypes[9].Descriptor()
 }
 
 func (CanvasNodeExecution_Result) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[4]
+	return &file_canvases_proto_enumTypes[9]
 }
 
 func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
@@ -274,7 +530,7 @@ func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_Result.Descriptor instead.
 func (CanvasNodeExecution_Result) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{42, 1}
+	return file_canvases_proto_rawDescGZIP(), []int{55, 1}
 }
 
 type CanvasNodeExecution_ResultReason int32
@@ -310,11 +566,11 @@ func (x CanvasNodeExecution_ResultReason) String() string {
 }
 
 func (CanvasNodeExecution_ResultReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[5].Descriptor()
+	return file_canvases_proto_enumTypes[10].Descriptor()
 }
 
 func (CanvasNodeExecution_ResultReason) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[5]
+	return &file_canvases_proto_enumTypes[10]
 }
 
 func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
@@ -323,7 +579,7 @@ func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_ResultReason.Descriptor instead.
 func (CanvasNodeExecution_ResultReason) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{42, 2}
+	return file_canvases_proto_rawDescGZIP(), []int{55, 2}
 }
 
 type ListCanvasesRequest struct {
@@ -1075,11 +1331,13 @@ func (x *UpdateCanvasVersionResponse) GetVersion() *CanvasVersion {
 }
 
 type CreateCanvasChangeRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId    string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	VersionId   string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Leaves the change request open for review, instead of publishing it right away.
+	KeepOpen      bool `protobuf:"varint,5,opt,name=keep_open,json=keepOpen,proto3" json:"keep_open,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1142,6 +1400,13 @@ func (x *CreateCanvasChangeRequestRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateCanvasChangeRequestRequest) GetKeepOpen() bool {
+	if x != nil {
+		return x.KeepOpen
+	}
+	return false
+}
+
 type CreateCanvasChangeRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
@@ -1434,27 +1699,28 @@ func (x *DescribeCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRe
 	return nil
 }
 
-type DeleteCanvasRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PublishCanvasChangeRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ChangeRequestId string                 `protobuf:"bytes,2,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeleteCanvasRequest) Reset() {
-	*x = DeleteCanvasRequest{}
+func (x *PublishCanvasChangeRequestRequest) Reset() {
+	*x = PublishCanvasChangeRequestRequest{}
 	mi := &file_canvases_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCanvasRequest) String() string {
+func (x *PublishCanvasChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCanvasRequest) ProtoMessage() {}
+func (*PublishCanvasChangeRequestRequest) ProtoMessage() {}
 
-func (x *DeleteCanvasRequest) ProtoReflect() protoreflect.Message {
+func (x *PublishCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1466,38 +1732,46 @@ func (x *DeleteCanvasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCanvasRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCanvasRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*PublishCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeleteCanvasRequest) GetId() string {
+func (x *PublishCanvasChangeRequestRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Id
+		return x.CanvasId
 	}
 	return ""
 }
 
-type DeleteCanvasResponse struct {
+func (x *PublishCanvasChangeRequestRequest) GetChangeRequestId() string {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return ""
+}
+
+type PublishCanvasChangeRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCanvasResponse) Reset() {
-	*x = DeleteCanvasResponse{}
+func (x *PublishCanvasChangeRequestResponse) Reset() {
+	*x = PublishCanvasChangeRequestResponse{}
 	mi := &file_canvases_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCanvasResponse) String() string {
+func (x *PublishCanvasChangeRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCanvasResponse) ProtoMessage() {}
+func (*PublishCanvasChangeRequestResponse) ProtoMessage() {}
 
-func (x *DeleteCanvasResponse) ProtoReflect() protoreflect.Message {
+func (x *PublishCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1509,33 +1783,39 @@ func (x *DeleteCanvasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCanvasResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCanvasResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*PublishCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{22}
 }
 
-type UserRef struct {
+func (x *PublishCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+type DeleteCanvasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserRef) Reset() {
-	*x = UserRef{}
+func (x *DeleteCanvasRequest) Reset() {
+	*x = DeleteCanvasRequest{}
 	mi := &file_canvases_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserRef) String() string {
+func (x *DeleteCanvasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRef) ProtoMessage() {}
+func (*DeleteCanvasRequest) ProtoMessage() {}
 
-func (x *UserRef) ProtoReflect() protoreflect.Message {
+func (x *DeleteCanvasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1547,48 +1827,38 @@ func (x *UserRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRef.ProtoReflect.Descriptor instead.
-func (*UserRef) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCanvasRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UserRef) GetId() string {
+func (x *DeleteCanvasRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UserRef) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type Canvas struct {
+type DeleteCanvasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *Canvas_Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec          *Canvas_Spec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status        *Canvas_Status         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Canvas) Reset() {
-	*x = Canvas{}
+func (x *DeleteCanvasResponse) Reset() {
+	*x = DeleteCanvasResponse{}
 	mi := &file_canvases_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Canvas) String() string {
+func (x *DeleteCanvasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Canvas) ProtoMessage() {}
+func (*DeleteCanvasResponse) ProtoMessage() {}
 
-func (x *Canvas) ProtoReflect() protoreflect.Message {
+func (x *DeleteCanvasResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1600,54 +1870,33 @@ func (x *Canvas) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Canvas.ProtoReflect.Descriptor instead.
-func (*Canvas) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCanvasResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Canvas) GetMetadata() *Canvas_Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+type UpdateCanvasPriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Priority      Canvas_Priority        `protobuf:"varint,2,opt,name=priority,proto3,enum=Superplane.Canvases.Canvas_Priority" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Canvas) GetSpec() *Canvas_Spec {
-	if x != nil {
-		return x.Spec
-	}
-	return nil
-}
-
-func (x *Canvas) GetStatus() *Canvas_Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
-}
-
-type CanvasVersion struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Metadata      *CanvasVersion_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec          *Canvas_Spec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CanvasVersion) Reset() {
-	*x = CanvasVersion{}
+func (x *UpdateCanvasPriorityRequest) Reset() {
+	*x = UpdateCanvasPriorityRequest{}
 	mi := &file_canvases_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasVersion) String() string {
+func (x *UpdateCanvasPriorityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasVersion) ProtoMessage() {}
+func (*UpdateCanvasPriorityRequest) ProtoMessage() {}
 
-func (x *CanvasVersion) ProtoReflect() protoreflect.Message {
+func (x *UpdateCanvasPriorityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1659,46 +1908,46 @@ func (x *CanvasVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasVersion.ProtoReflect.Descriptor instead.
-func (*CanvasVersion) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCanvasPriorityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasPriorityRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CanvasVersion) GetMetadata() *CanvasVersion_Metadata {
+func (x *UpdateCanvasPriorityRequest) GetId() string {
 	if x != nil {
-		return x.Metadata
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasVersion) GetSpec() *Canvas_Spec {
+func (x *UpdateCanvasPriorityRequest) GetPriority() Canvas_Priority {
 	if x != nil {
-		return x.Spec
+		return x.Priority
 	}
-	return nil
+	return Canvas_PRIORITY_UNSPECIFIED
 }
 
-type CanvasChangeRequestDiff struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ChangedNodeIds []string               `protobuf:"bytes,1,rep,name=changed_node_ids,json=changedNodeIds,proto3" json:"changed_node_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UpdateCanvasPriorityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Canvas        *Canvas                `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequestDiff) Reset() {
-	*x = CanvasChangeRequestDiff{}
+func (x *UpdateCanvasPriorityResponse) Reset() {
+	*x = UpdateCanvasPriorityResponse{}
 	mi := &file_canvases_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequestDiff) String() string {
+func (x *UpdateCanvasPriorityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasChangeRequestDiff) ProtoMessage() {}
+func (*UpdateCanvasPriorityResponse) ProtoMessage() {}
 
-func (x *CanvasChangeRequestDiff) ProtoReflect() protoreflect.Message {
+func (x *UpdateCanvasPriorityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1710,41 +1959,40 @@ func (x *CanvasChangeRequestDiff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasChangeRequestDiff.ProtoReflect.Descriptor instead.
-func (*CanvasChangeRequestDiff) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCanvasPriorityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasPriorityResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CanvasChangeRequestDiff) GetChangedNodeIds() []string {
+func (x *UpdateCanvasPriorityResponse) GetCanvas() *Canvas {
 	if x != nil {
-		return x.ChangedNodeIds
+		return x.Canvas
 	}
 	return nil
 }
 
-type CanvasChangeRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Metadata      *CanvasChangeRequest_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Version       *CanvasVersion                `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Diff          *CanvasChangeRequestDiff      `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+type DiffCanvasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Canvas        *Canvas                `protobuf:"bytes,2,opt,name=canvas,proto3" json:"canvas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequest) Reset() {
-	*x = CanvasChangeRequest{}
+func (x *DiffCanvasRequest) Reset() {
+	*x = DiffCanvasRequest{}
 	mi := &file_canvases_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequest) String() string {
+func (x *DiffCanvasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasChangeRequest) ProtoMessage() {}
+func (*DiffCanvasRequest) ProtoMessage() {}
 
-func (x *CanvasChangeRequest) ProtoReflect() protoreflect.Message {
+func (x *DiffCanvasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1756,56 +2004,46 @@ func (x *CanvasChangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasChangeRequest.ProtoReflect.Descriptor instead.
-func (*CanvasChangeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiffCanvasRequest.ProtoReflect.Descriptor instead.
+func (*DiffCanvasRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CanvasChangeRequest) GetMetadata() *CanvasChangeRequest_Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *CanvasChangeRequest) GetVersion() *CanvasVersion {
+func (x *DiffCanvasRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Version
+		return x.CanvasId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasChangeRequest) GetDiff() *CanvasChangeRequestDiff {
+func (x *DiffCanvasRequest) GetCanvas() *Canvas {
 	if x != nil {
-		return x.Diff
+		return x.Canvas
 	}
 	return nil
 }
 
-type ListNodeEventsRequest struct {
+type DiffCanvasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	Diff          *CanvasDiff            `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodeEventsRequest) Reset() {
-	*x = ListNodeEventsRequest{}
+func (x *DiffCanvasResponse) Reset() {
+	*x = DiffCanvasResponse{}
 	mi := &file_canvases_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeEventsRequest) String() string {
+func (x *DiffCanvasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeEventsRequest) ProtoMessage() {}
+func (*DiffCanvasResponse) ProtoMessage() {}
 
-func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *DiffCanvasResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1817,63 +2055,40 @@ func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListNodeEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiffCanvasResponse.ProtoReflect.Descriptor instead.
+func (*DiffCanvasResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListNodeEventsRequest) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *ListNodeEventsRequest) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *ListNodeEventsRequest) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListNodeEventsRequest) GetBefore() *timestamp.Timestamp {
+func (x *DiffCanvasResponse) GetDiff() *CanvasDiff {
 	if x != nil {
-		return x.Before
+		return x.Diff
 	}
 	return nil
 }
 
-type ListNodeEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*CanvasEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+type CanvasDiff struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Nodes         []*CanvasDiff_NodeChange `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*CanvasDiff_EdgeChange `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodeEventsResponse) Reset() {
-	*x = ListNodeEventsResponse{}
+func (x *CanvasDiff) Reset() {
+	*x = CanvasDiff{}
 	mi := &file_canvases_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeEventsResponse) String() string {
+func (x *CanvasDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeEventsResponse) ProtoMessage() {}
+func (*CanvasDiff) ProtoMessage() {}
 
-func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *CanvasDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1885,63 +2100,50 @@ func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListNodeEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasDiff.ProtoReflect.Descriptor instead.
+func (*CanvasDiff) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListNodeEventsResponse) GetEvents() []*CanvasEvent {
+func (x *CanvasDiff) GetNodes() []*CanvasDiff_NodeChange {
 	if x != nil {
-		return x.Events
+		return x.Nodes
 	}
 	return nil
 }
 
-func (x *ListNodeEventsResponse) GetTotalCount() uint32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListNodeEventsResponse) GetHasNextPage() bool {
-	if x != nil {
-		return x.HasNextPage
-	}
-	return false
-}
-
-func (x *ListNodeEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+func (x *CanvasDiff) GetEdges() []*CanvasDiff_EdgeChange {
 	if x != nil {
-		return x.LastTimestamp
+		return x.Edges
 	}
 	return nil
 }
 
-type EmitNodeEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
-	Data          *_struct.Struct        `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+type SimulateCanvasRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Canvas        *Canvas                     `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	TriggerNodeId string                      `protobuf:"bytes,2,opt,name=trigger_node_id,json=triggerNodeId,proto3" json:"trigger_node_id,omitempty"`
+	PayloadType   string                      `protobuf:"bytes,3,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"`
+	Payload       *_struct.Struct             `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Fixtures      []*CanvasSimulation_Fixture `protobuf:"bytes,5,rep,name=fixtures,proto3" json:"fixtures,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmitNodeEventRequest) Reset() {
-	*x = EmitNodeEventRequest{}
+func (x *SimulateCanvasRequest) Reset() {
+	*x = SimulateCanvasRequest{}
 	mi := &file_canvases_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmitNodeEventRequest) String() string {
+func (x *SimulateCanvasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmitNodeEventRequest) ProtoMessage() {}
+func (*SimulateCanvasRequest) ProtoMessage() {}
 
-func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
+func (x *SimulateCanvasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1953,60 +2155,67 @@ func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmitNodeEventRequest.ProtoReflect.Descriptor instead.
-func (*EmitNodeEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulateCanvasRequest.ProtoReflect.Descriptor instead.
+func (*SimulateCanvasRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *EmitNodeEventRequest) GetCanvasId() string {
+func (x *SimulateCanvasRequest) GetCanvas() *Canvas {
 	if x != nil {
-		return x.CanvasId
+		return x.Canvas
 	}
-	return ""
+	return nil
 }
 
-func (x *EmitNodeEventRequest) GetNodeId() string {
+func (x *SimulateCanvasRequest) GetTriggerNodeId() string {
 	if x != nil {
-		return x.NodeId
+		return x.TriggerNodeId
 	}
 	return ""
 }
 
-func (x *EmitNodeEventRequest) GetChannel() string {
+func (x *SimulateCanvasRequest) GetPayloadType() string {
 	if x != nil {
-		return x.Channel
+		return x.PayloadType
 	}
 	return ""
 }
 
-func (x *EmitNodeEventRequest) GetData() *_struct.Struct {
+func (x *SimulateCanvasRequest) GetPayload() *_struct.Struct {
 	if x != nil {
-		return x.Data
+		return x.Payload
 	}
 	return nil
 }
 
-type EmitNodeEventResponse struct {
+func (x *SimulateCanvasRequest) GetFixtures() []*CanvasSimulation_Fixture {
+	if x != nil {
+		return x.Fixtures
+	}
+	return nil
+}
+
+type SimulateCanvasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Simulation    *CanvasSimulation      `protobuf:"bytes,1,opt,name=simulation,proto3" json:"simulation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmitNodeEventResponse) Reset() {
-	*x = EmitNodeEventResponse{}
+func (x *SimulateCanvasResponse) Reset() {
+	*x = SimulateCanvasResponse{}
 	mi := &file_canvases_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmitNodeEventResponse) String() string {
+func (x *SimulateCanvasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmitNodeEventResponse) ProtoMessage() {}
+func (*SimulateCanvasResponse) ProtoMessage() {}
 
-func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
+func (x *SimulateCanvasResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2018,42 +2227,39 @@ func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmitNodeEventResponse.ProtoReflect.Descriptor instead.
-func (*EmitNodeEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulateCanvasResponse.ProtoReflect.Descriptor instead.
+func (*SimulateCanvasResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *EmitNodeEventResponse) GetEventId() string {
+func (x *SimulateCanvasResponse) GetSimulation() *CanvasSimulation {
 	if x != nil {
-		return x.EventId
+		return x.Simulation
 	}
-	return ""
+	return nil
 }
 
-type ListNodeQueueItemsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+type CanvasSimulation struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Steps         []*CanvasSimulation_Step `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodeQueueItemsRequest) Reset() {
-	*x = ListNodeQueueItemsRequest{}
+func (x *CanvasSimulation) Reset() {
+	*x = CanvasSimulation{}
 	mi := &file_canvases_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeQueueItemsRequest) String() string {
+func (x *CanvasSimulation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeQueueItemsRequest) ProtoMessage() {}
+func (*CanvasSimulation) ProtoMessage() {}
 
-func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
+func (x *CanvasSimulation) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2065,63 +2271,40 @@ func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeQueueItemsRequest.ProtoReflect.Descriptor instead.
-func (*ListNodeQueueItemsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasSimulation.ProtoReflect.Descriptor instead.
+func (*CanvasSimulation) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListNodeQueueItemsRequest) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *ListNodeQueueItemsRequest) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *ListNodeQueueItemsRequest) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListNodeQueueItemsRequest) GetBefore() *timestamp.Timestamp {
+func (x *CanvasSimulation) GetSteps() []*CanvasSimulation_Step {
 	if x != nil {
-		return x.Before
+		return x.Steps
 	}
 	return nil
 }
 
-type ListNodeQueueItemsResponse struct {
+type UserRef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*CanvasNodeQueueItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodeQueueItemsResponse) Reset() {
-	*x = ListNodeQueueItemsResponse{}
+func (x *UserRef) Reset() {
+	*x = UserRef{}
 	mi := &file_canvases_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeQueueItemsResponse) String() string {
+func (x *UserRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeQueueItemsResponse) ProtoMessage() {}
+func (*UserRef) ProtoMessage() {}
 
-func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *UserRef) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2133,62 +2316,48 @@ func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeQueueItemsResponse.ProtoReflect.Descriptor instead.
-func (*ListNodeQueueItemsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserRef.ProtoReflect.Descriptor instead.
+func (*UserRef) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListNodeQueueItemsResponse) GetItems() []*CanvasNodeQueueItem {
+func (x *UserRef) GetId() string {
 	if x != nil {
-		return x.Items
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListNodeQueueItemsResponse) GetTotalCount() uint32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListNodeQueueItemsResponse) GetHasNextPage() bool {
-	if x != nil {
-		return x.HasNextPage
-	}
-	return false
-}
-
-func (x *ListNodeQueueItemsResponse) GetLastTimestamp() *timestamp.Timestamp {
+func (x *UserRef) GetName() string {
 	if x != nil {
-		return x.LastTimestamp
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type DeleteNodeQueueItemRequest struct {
+type Canvas struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Metadata      *Canvas_Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *Canvas_Spec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *Canvas_Status         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNodeQueueItemRequest) Reset() {
-	*x = DeleteNodeQueueItemRequest{}
+func (x *Canvas) Reset() {
+	*x = Canvas{}
 	mi := &file_canvases_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNodeQueueItemRequest) String() string {
+func (x *Canvas) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNodeQueueItemRequest) ProtoMessage() {}
+func (*Canvas) ProtoMessage() {}
 
-func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
+func (x *Canvas) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2200,52 +2369,54 @@ func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNodeQueueItemRequest.ProtoReflect.Descriptor instead.
-func (*DeleteNodeQueueItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Canvas.ProtoReflect.Descriptor instead.
+func (*Canvas) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DeleteNodeQueueItemRequest) GetCanvasId() string {
+func (x *Canvas) GetMetadata() *Canvas_Metadata {
 	if x != nil {
-		return x.CanvasId
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteNodeQueueItemRequest) GetNodeId() string {
+func (x *Canvas) GetSpec() *Canvas_Spec {
 	if x != nil {
-		return x.NodeId
+		return x.Spec
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteNodeQueueItemRequest) GetItemId() string {
+func (x *Canvas) GetStatus() *Canvas_Status {
 	if x != nil {
-		return x.ItemId
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type DeleteNodeQueueItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type CanvasVersion struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Metadata      *CanvasVersion_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *Canvas_Spec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNodeQueueItemResponse) Reset() {
-	*x = DeleteNodeQueueItemResponse{}
+func (x *CanvasVersion) Reset() {
+	*x = CanvasVersion{}
 	mi := &file_canvases_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNodeQueueItemResponse) String() string {
+func (x *CanvasVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNodeQueueItemResponse) ProtoMessage() {}
+func (*CanvasVersion) ProtoMessage() {}
 
-func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
+func (x *CanvasVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2257,34 +2428,46 @@ func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNodeQueueItemResponse.ProtoReflect.Descriptor instead.
-func (*DeleteNodeQueueItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasVersion.ProtoReflect.Descriptor instead.
+func (*CanvasVersion) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{35}
 }
 
-type UpdateNodePauseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Paused        bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CanvasVersion) GetMetadata() *CanvasVersion_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
-func (x *UpdateNodePauseRequest) Reset() {
-	*x = UpdateNodePauseRequest{}
+func (x *CanvasVersion) GetSpec() *Canvas_Spec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type CanvasChangeRequestDiff struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChangedNodeIds []string               `protobuf:"bytes,1,rep,name=changed_node_ids,json=changedNodeIds,proto3" json:"changed_node_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CanvasChangeRequestDiff) Reset() {
+	*x = CanvasChangeRequestDiff{}
 	mi := &file_canvases_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNodePauseRequest) String() string {
+func (x *CanvasChangeRequestDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNodePauseRequest) ProtoMessage() {}
+func (*CanvasChangeRequestDiff) ProtoMessage() {}
 
-func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
+func (x *CanvasChangeRequestDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2296,53 +2479,41 @@ func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNodePauseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateNodePauseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasChangeRequestDiff.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequestDiff) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *UpdateNodePauseRequest) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *UpdateNodePauseRequest) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *UpdateNodePauseRequest) GetPaused() bool {
+func (x *CanvasChangeRequestDiff) GetChangedNodeIds() []string {
 	if x != nil {
-		return x.Paused
+		return x.ChangedNodeIds
 	}
-	return false
+	return nil
 }
 
-type UpdateNodePauseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *components.Node       `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+type CanvasChangeRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Metadata      *CanvasChangeRequest_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Version       *CanvasVersion                `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Diff          *CanvasChangeRequestDiff      `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateNodePauseResponse) Reset() {
-	*x = UpdateNodePauseResponse{}
+func (x *CanvasChangeRequest) Reset() {
+	*x = CanvasChangeRequest{}
 	mi := &file_canvases_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNodePauseResponse) String() string {
+func (x *CanvasChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNodePauseResponse) ProtoMessage() {}
+func (*CanvasChangeRequest) ProtoMessage() {}
 
-func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
+func (x *CanvasChangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2354,44 +2525,63 @@ func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNodePauseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateNodePauseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasChangeRequest.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UpdateNodePauseResponse) GetNode() *components.Node {
+func (x *CanvasChangeRequest) GetMetadata() *CanvasChangeRequest_Metadata {
 	if x != nil {
-		return x.Node
+		return x.Metadata
 	}
 	return nil
 }
 
-type ListNodeExecutionsRequest struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	CanvasId      string                       `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                       `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	States        []CanvasNodeExecution_State  `protobuf:"varint,3,rep,packed,name=states,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"states,omitempty"`
-	Results       []CanvasNodeExecution_Result `protobuf:"varint,4,rep,packed,name=results,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"results,omitempty"`
-	Limit         uint32                       `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp         `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+func (x *CanvasChangeRequest) GetVersion() *CanvasVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequest) GetDiff() *CanvasChangeRequestDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type ListNodeEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	CreatedAfter  *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Order         ListOrder              `protobuf:"varint,7,opt,name=order,proto3,enum=Superplane.Canvases.ListOrder" json:"order,omitempty"`
+	PageToken     string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filters by fields of the event payload, using dot-separated paths as keys.
+	// Over HTTP, use one query parameter per field, e.g. ?payload.data.ref=refs/heads/main.
+	Payload       map[string]string `protobuf:"bytes,9,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodeExecutionsRequest) Reset() {
-	*x = ListNodeExecutionsRequest{}
+func (x *ListNodeEventsRequest) Reset() {
+	*x = ListNodeEventsRequest{}
 	mi := &file_canvases_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeExecutionsRequest) String() string {
+func (x *ListNodeEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeExecutionsRequest) ProtoMessage() {}
+func (*ListNodeEventsRequest) ProtoMessage() {}
 
-func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2403,77 +2593,99 @@ func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListNodeExecutionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListNodeEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeEventsRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListNodeExecutionsRequest) GetCanvasId() string {
+func (x *ListNodeEventsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ListNodeExecutionsRequest) GetNodeId() string {
+func (x *ListNodeEventsRequest) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *ListNodeExecutionsRequest) GetStates() []CanvasNodeExecution_State {
+func (x *ListNodeEventsRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.States
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNodeEventsRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
 	}
 	return nil
 }
 
-func (x *ListNodeExecutionsRequest) GetResults() []CanvasNodeExecution_Result {
+func (x *ListNodeEventsRequest) GetCreatedAfter() *timestamp.Timestamp {
 	if x != nil {
-		return x.Results
+		return x.CreatedAfter
 	}
 	return nil
 }
 
-func (x *ListNodeExecutionsRequest) GetLimit() uint32 {
+func (x *ListNodeEventsRequest) GetCreatedBefore() *timestamp.Timestamp {
 	if x != nil {
-		return x.Limit
+		return x.CreatedBefore
 	}
-	return 0
+	return nil
 }
 
-func (x *ListNodeExecutionsRequest) GetBefore() *timestamp.Timestamp {
+func (x *ListNodeEventsRequest) GetOrder() ListOrder {
 	if x != nil {
-		return x.Before
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_DESC
+}
+
+func (x *ListNodeEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNodeEventsRequest) GetPayload() map[string]string {
+	if x != nil {
+		return x.Payload
 	}
 	return nil
 }
 
-type ListNodeExecutionsResponse struct {
+type ListNodeEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	Events        []*CanvasEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
 	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodeExecutionsResponse) Reset() {
-	*x = ListNodeExecutionsResponse{}
+func (x *ListNodeEventsResponse) Reset() {
+	*x = ListNodeEventsResponse{}
 	mi := &file_canvases_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeExecutionsResponse) String() string {
+func (x *ListNodeEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeExecutionsResponse) ProtoMessage() {}
+func (*ListNodeEventsResponse) ProtoMessage() {}
 
-func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2485,61 +2697,70 @@ func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListNodeExecutionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListNodeEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeEventsResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListNodeExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+func (x *ListNodeEventsResponse) GetEvents() []*CanvasEvent {
 	if x != nil {
-		return x.Executions
+		return x.Events
 	}
 	return nil
 }
 
-func (x *ListNodeExecutionsResponse) GetTotalCount() uint32 {
+func (x *ListNodeEventsResponse) GetTotalCount() uint32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *ListNodeExecutionsResponse) GetHasNextPage() bool {
+func (x *ListNodeEventsResponse) GetHasNextPage() bool {
 	if x != nil {
 		return x.HasNextPage
 	}
 	return false
 }
 
-func (x *ListNodeExecutionsResponse) GetLastTimestamp() *timestamp.Timestamp {
+func (x *ListNodeEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
 	if x != nil {
 		return x.LastTimestamp
 	}
 	return nil
 }
 
-type ListChildExecutionsRequest struct {
+func (x *ListNodeEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type EmitNodeEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChildExecutionsRequest) Reset() {
-	*x = ListChildExecutionsRequest{}
+func (x *EmitNodeEventRequest) Reset() {
+	*x = EmitNodeEventRequest{}
 	mi := &file_canvases_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChildExecutionsRequest) String() string {
+func (x *EmitNodeEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChildExecutionsRequest) ProtoMessage() {}
+func (*EmitNodeEventRequest) ProtoMessage() {}
 
-func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
+func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2551,46 +2772,60 @@ func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmitNodeEventRequest.ProtoReflect.Descriptor instead.
+func (*EmitNodeEventRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListChildExecutionsRequest) GetCanvasId() string {
+func (x *EmitNodeEventRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ListChildExecutionsRequest) GetExecutionId() string {
+func (x *EmitNodeEventRequest) GetNodeId() string {
 	if x != nil {
-		return x.ExecutionId
+		return x.NodeId
 	}
 	return ""
 }
 
-type ListChildExecutionsResponse struct {
+func (x *EmitNodeEventRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *EmitNodeEventRequest) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type EmitNodeEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChildExecutionsResponse) Reset() {
-	*x = ListChildExecutionsResponse{}
+func (x *EmitNodeEventResponse) Reset() {
+	*x = EmitNodeEventResponse{}
 	mi := &file_canvases_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChildExecutionsResponse) String() string {
+func (x *EmitNodeEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChildExecutionsResponse) ProtoMessage() {}
+func (*EmitNodeEventResponse) ProtoMessage() {}
 
-func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
+func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2602,56 +2837,42 @@ func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmitNodeEventResponse.ProtoReflect.Descriptor instead.
+func (*EmitNodeEventResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+func (x *EmitNodeEventResponse) GetEventId() string {
 	if x != nil {
-		return x.Executions
+		return x.EventId
 	}
-	return nil
+	return ""
 }
 
-type CanvasNodeExecution struct {
-	state               protoimpl.MessageState           `protogen:"open.v1"`
-	Id                  string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId            string                           `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId              string                           `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ParentExecutionId   string                           `protobuf:"bytes,4,opt,name=parent_execution_id,json=parentExecutionId,proto3" json:"parent_execution_id,omitempty"`
-	PreviousExecutionId string                           `protobuf:"bytes,5,opt,name=previous_execution_id,json=previousExecutionId,proto3" json:"previous_execution_id,omitempty"`
-	State               CanvasNodeExecution_State        `protobuf:"varint,6,opt,name=state,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"state,omitempty"`
-	Result              CanvasNodeExecution_Result       `protobuf:"varint,7,opt,name=result,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"result,omitempty"`
-	ResultReason        CanvasNodeExecution_ResultReason `protobuf:"varint,8,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Canvases.CanvasNodeExecution_ResultReason" json:"result_reason,omitempty"`
-	ResultMessage       string                           `protobuf:"bytes,9,opt,name=result_message,json=resultMessage,proto3" json:"result_message,omitempty"`
-	Input               *_struct.Struct                  `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
-	Outputs             *_struct.Struct                  `protobuf:"bytes,11,opt,name=outputs,proto3" json:"outputs,omitempty"`
-	CreatedAt           *timestamp.Timestamp             `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt           *timestamp.Timestamp             `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Metadata            *_struct.Struct                  `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Configuration       *_struct.Struct                  `protobuf:"bytes,15,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	ChildExecutions     []*CanvasNodeExecution           `protobuf:"bytes,16,rep,name=child_executions,json=childExecutions,proto3" json:"child_executions,omitempty"`
-	RootEvent           *CanvasEvent                     `protobuf:"bytes,17,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
-	CancelledBy         *UserRef                         `protobuf:"bytes,18,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type ListNodeQueueItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecution) Reset() {
-	*x = CanvasNodeExecution{}
+func (x *ListNodeQueueItemsRequest) Reset() {
+	*x = ListNodeQueueItemsRequest{}
 	mi := &file_canvases_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecution) String() string {
+func (x *ListNodeQueueItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeExecution) ProtoMessage() {}
+func (*ListNodeQueueItemsRequest) ProtoMessage() {}
 
-func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
+func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2663,245 +2884,130 @@ func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
-func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListNodeQueueItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeQueueItemsRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CanvasNodeExecution) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasNodeExecution) GetCanvasId() string {
+func (x *ListNodeQueueItemsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasNodeExecution) GetNodeId() string {
+func (x *ListNodeQueueItemsRequest) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *CanvasNodeExecution) GetParentExecutionId() string {
+func (x *ListNodeQueueItemsRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.ParentExecutionId
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *CanvasNodeExecution) GetPreviousExecutionId() string {
+func (x *ListNodeQueueItemsRequest) GetBefore() *timestamp.Timestamp {
 	if x != nil {
-		return x.PreviousExecutionId
+		return x.Before
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecution) GetState() CanvasNodeExecution_State {
-	if x != nil {
-		return x.State
-	}
-	return CanvasNodeExecution_STATE_UNKNOWN
+type ListNodeQueueItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CanvasNodeQueueItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecution) GetResult() CanvasNodeExecution_Result {
-	if x != nil {
-		return x.Result
-	}
-	return CanvasNodeExecution_RESULT_UNKNOWN
+func (x *ListNodeQueueItemsResponse) Reset() {
+	*x = ListNodeQueueItemsResponse{}
+	mi := &file_canvases_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecution) GetResultReason() CanvasNodeExecution_ResultReason {
-	if x != nil {
-		return x.ResultReason
-	}
-	return CanvasNodeExecution_RESULT_REASON_OK
+func (x *ListNodeQueueItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CanvasNodeExecution) GetResultMessage() string {
-	if x != nil {
-		return x.ResultMessage
-	}
-	return ""
-}
+func (*ListNodeQueueItemsResponse) ProtoMessage() {}
 
-func (x *CanvasNodeExecution) GetInput() *_struct.Struct {
+func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[43]
 	if x != nil {
-		return x.Input
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CanvasNodeExecution) GetOutputs() *_struct.Struct {
-	if x != nil {
-		return x.Outputs
-	}
-	return nil
+// Deprecated: Use ListNodeQueueItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeQueueItemsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CanvasNodeExecution) GetCreatedAt() *timestamp.Timestamp {
+func (x *ListNodeQueueItemsResponse) GetItems() []*CanvasNodeQueueItem {
 	if x != nil {
-		return x.CreatedAt
+		return x.Items
 	}
 	return nil
 }
 
-func (x *CanvasNodeExecution) GetUpdatedAt() *timestamp.Timestamp {
+func (x *ListNodeQueueItemsResponse) GetTotalCount() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-func (x *CanvasNodeExecution) GetMetadata() *_struct.Struct {
+func (x *ListNodeQueueItemsResponse) GetHasNextPage() bool {
 	if x != nil {
-		return x.Metadata
+		return x.HasNextPage
 	}
-	return nil
+	return false
 }
 
-func (x *CanvasNodeExecution) GetConfiguration() *_struct.Struct {
+func (x *ListNodeQueueItemsResponse) GetLastTimestamp() *timestamp.Timestamp {
 	if x != nil {
-		return x.Configuration
+		return x.LastTimestamp
 	}
 	return nil
 }
 
-func (x *CanvasNodeExecution) GetChildExecutions() []*CanvasNodeExecution {
-	if x != nil {
-		return x.ChildExecutions
-	}
-	return nil
+type DeleteNodeQueueItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecution) GetRootEvent() *CanvasEvent {
-	if x != nil {
-		return x.RootEvent
-	}
-	return nil
+func (x *DeleteNodeQueueItemRequest) Reset() {
+	*x = DeleteNodeQueueItemRequest{}
+	mi := &file_canvases_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecution) GetCancelledBy() *UserRef {
-	if x != nil {
-		return x.CancelledBy
-	}
-	return nil
+func (x *DeleteNodeQueueItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CanvasNodeQueueItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Input         *_struct.Struct        `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
-	RootEvent     *CanvasEvent           `protobuf:"bytes,5,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CanvasNodeQueueItem) Reset() {
-	*x = CanvasNodeQueueItem{}
-	mi := &file_canvases_proto_msgTypes[43]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CanvasNodeQueueItem) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CanvasNodeQueueItem) ProtoMessage() {}
-
-func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[43]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
-func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *CanvasNodeQueueItem) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasNodeQueueItem) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *CanvasNodeQueueItem) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *CanvasNodeQueueItem) GetInput() *_struct.Struct {
-	if x != nil {
-		return x.Input
-	}
-	return nil
-}
-
-func (x *CanvasNodeQueueItem) GetRootEvent() *CanvasEvent {
-	if x != nil {
-		return x.RootEvent
-	}
-	return nil
-}
-
-func (x *CanvasNodeQueueItem) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-type InvokeNodeExecutionActionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
-	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *InvokeNodeExecutionActionRequest) Reset() {
-	*x = InvokeNodeExecutionActionRequest{}
-	mi := &file_canvases_proto_msgTypes[44]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *InvokeNodeExecutionActionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
+func (*DeleteNodeQueueItemRequest) ProtoMessage() {}
 
-func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2913,59 +3019,52 @@ func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
-func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteNodeQueueItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNodeQueueItemRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
+func (x *DeleteNodeQueueItemRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetExecutionId() string {
+func (x *DeleteNodeQueueItemRequest) GetNodeId() string {
 	if x != nil {
-		return x.ExecutionId
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetActionName() string {
+func (x *DeleteNodeQueueItemRequest) GetItemId() string {
 	if x != nil {
-		return x.ActionName
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetParameters() *_struct.Struct {
-	if x != nil {
-		return x.Parameters
-	}
-	return nil
-}
-
-type InvokeNodeExecutionActionResponse struct {
+type DeleteNodeQueueItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeExecutionActionResponse) Reset() {
-	*x = InvokeNodeExecutionActionResponse{}
+func (x *DeleteNodeQueueItemResponse) Reset() {
+	*x = DeleteNodeQueueItemResponse{}
 	mi := &file_canvases_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeExecutionActionResponse) String() string {
+func (x *DeleteNodeQueueItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
+func (*DeleteNodeQueueItemResponse) ProtoMessage() {}
 
-func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2977,35 +3076,34 @@ func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
-func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteNodeQueueItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNodeQueueItemResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{45}
 }
 
-type InvokeNodeTriggerActionRequest struct {
+type UpdateNodePauseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
 	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
-	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	Paused        bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeTriggerActionRequest) Reset() {
-	*x = InvokeNodeTriggerActionRequest{}
+func (x *UpdateNodePauseRequest) Reset() {
+	*x = UpdateNodePauseRequest{}
 	mi := &file_canvases_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeTriggerActionRequest) String() string {
+func (x *UpdateNodePauseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
+func (*UpdateNodePauseRequest) ProtoMessage() {}
 
-func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3017,60 +3115,53 @@ func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
-func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateNodePauseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNodePauseRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
+func (x *UpdateNodePauseRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetNodeId() string {
+func (x *UpdateNodePauseRequest) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetActionName() string {
-	if x != nil {
-		return x.ActionName
-	}
-	return ""
-}
-
-func (x *InvokeNodeTriggerActionRequest) GetParameters() *_struct.Struct {
+func (x *UpdateNodePauseRequest) GetPaused() bool {
 	if x != nil {
-		return x.Parameters
+		return x.Paused
 	}
-	return nil
+	return false
 }
 
-type InvokeNodeTriggerActionResponse struct {
+type UpdateNodePauseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *_struct.Struct        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Node          *components.Node       `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeTriggerActionResponse) Reset() {
-	*x = InvokeNodeTriggerActionResponse{}
+func (x *UpdateNodePauseResponse) Reset() {
+	*x = UpdateNodePauseResponse{}
 	mi := &file_canvases_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeTriggerActionResponse) String() string {
+func (x *UpdateNodePauseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
+func (*UpdateNodePauseResponse) ProtoMessage() {}
 
-func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3082,41 +3173,51 @@ func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
-func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateNodePauseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNodePauseResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
+func (x *UpdateNodePauseResponse) GetNode() *components.Node {
 	if x != nil {
-		return x.Result
+		return x.Node
 	}
 	return nil
 }
 
-type ListCanvasEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+type ListNodeExecutionsRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	CanvasId      string                       `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                       `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	States        []CanvasNodeExecution_State  `protobuf:"varint,3,rep,packed,name=states,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"states,omitempty"`
+	Results       []CanvasNodeExecution_Result `protobuf:"varint,4,rep,packed,name=results,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"results,omitempty"`
+	Limit         uint32                       `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp         `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	CreatedAfter  *timestamp.Timestamp         `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamp.Timestamp         `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Order         ListOrder                    `protobuf:"varint,9,opt,name=order,proto3,enum=Superplane.Canvases.ListOrder" json:"order,omitempty"`
+	PageToken     string                       `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filters by fields of the input payload, using dot-separated paths as keys.
+	// Over HTTP, use one query parameter per field, e.g. ?payload.data.ref=refs/heads/main.
+	Payload       map[string]string `protobuf:"bytes,11,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasEventsRequest) Reset() {
-	*x = ListCanvasEventsRequest{}
+func (x *ListNodeExecutionsRequest) Reset() {
+	*x = ListNodeExecutionsRequest{}
 	mi := &file_canvases_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasEventsRequest) String() string {
+func (x *ListNodeExecutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasEventsRequest) ProtoMessage() {}
+func (*ListNodeExecutionsRequest) ProtoMessage() {}
 
-func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3128,57 +3229,1356 @@ func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListNodeExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeExecutionsRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListCanvasEventsRequest) GetCanvasId() string {
+func (x *ListNodeExecutionsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ListCanvasEventsRequest) GetLimit() uint32 {
+func (x *ListNodeExecutionsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListNodeExecutionsRequest) GetStates() []CanvasNodeExecution_State {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListNodeExecutionsRequest) GetResults() []CanvasNodeExecution_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListNodeExecutionsRequest) GetLimit() uint32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *ListCanvasEventsRequest) GetBefore() *timestamp.Timestamp {
+func (x *ListNodeExecutionsRequest) GetBefore() *timestamp.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-type ListCanvasEventsResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Events        []*CanvasEventWithExecutions `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	TotalCount    uint32                       `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasNextPage   bool                         `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	LastTimestamp *timestamp.Timestamp         `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+func (x *ListNodeExecutionsRequest) GetCreatedAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListNodeExecutionsRequest) GetCreatedBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListNodeExecutionsRequest) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_DESC
+}
+
+func (x *ListNodeExecutionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNodeExecutionsRequest) GetPayload() map[string]string {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ListNodeExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasEventsResponse) Reset() {
-	*x = ListCanvasEventsResponse{}
+func (x *ListNodeExecutionsResponse) Reset() {
+	*x = ListNodeExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodeExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeExecutionsResponse) ProtoMessage() {}
+
+func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListNodeExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *ListNodeExecutionsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListNodeExecutionsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListNodeExecutionsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+func (x *ListNodeExecutionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DescribeNodeExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeNodeExecutionRequest) Reset() {
+	*x = DescribeNodeExecutionRequest{}
+	mi := &file_canvases_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeNodeExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeNodeExecutionRequest) ProtoMessage() {}
+
+func (x *DescribeNodeExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeNodeExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeNodeExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DescribeNodeExecutionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DescribeNodeExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type DescribeNodeExecutionResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Execution     *CanvasNodeExecution     `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	Kvs           []*CanvasNodeExecutionKV `protobuf:"bytes,2,rep,name=kvs,proto3" json:"kvs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeNodeExecutionResponse) Reset() {
+	*x = DescribeNodeExecutionResponse{}
+	mi := &file_canvases_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeNodeExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeNodeExecutionResponse) ProtoMessage() {}
+
+func (x *DescribeNodeExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeNodeExecutionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeNodeExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DescribeNodeExecutionResponse) GetExecution() *CanvasNodeExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *DescribeNodeExecutionResponse) GetKvs() []*CanvasNodeExecutionKV {
+	if x != nil {
+		return x.Kvs
+	}
+	return nil
+}
+
+type CanvasNodeExecutionKV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionKV) Reset() {
+	*x = CanvasNodeExecutionKV{}
+	mi := &file_canvases_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionKV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionKV) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionKV) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionKV.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionKV) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CanvasNodeExecutionKV) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionKV) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ListChildExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildExecutionsRequest) Reset() {
+	*x = ListChildExecutionsRequest{}
+	mi := &file_canvases_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildExecutionsRequest) ProtoMessage() {}
+
+func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListChildExecutionsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListChildExecutionsRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type ListChildExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildExecutionsResponse) Reset() {
+	*x = ListChildExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildExecutionsResponse) ProtoMessage() {}
+
+func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type CanvasNodeExecution struct {
+	state               protoimpl.MessageState           `protogen:"open.v1"`
+	Id                  string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId            string                           `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId              string                           `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ParentExecutionId   string                           `protobuf:"bytes,4,opt,name=parent_execution_id,json=parentExecutionId,proto3" json:"parent_execution_id,omitempty"`
+	PreviousExecutionId string                           `protobuf:"bytes,5,opt,name=previous_execution_id,json=previousExecutionId,proto3" json:"previous_execution_id,omitempty"`
+	State               CanvasNodeExecution_State        `protobuf:"varint,6,opt,name=state,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"state,omitempty"`
+	Result              CanvasNodeExecution_Result       `protobuf:"varint,7,opt,name=result,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"result,omitempty"`
+	ResultReason        CanvasNodeExecution_ResultReason `protobuf:"varint,8,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Canvases.CanvasNodeExecution_ResultReason" json:"result_reason,omitempty"`
+	ResultMessage       string                           `protobuf:"bytes,9,opt,name=result_message,json=resultMessage,proto3" json:"result_message,omitempty"`
+	Input               *_struct.Struct                  `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
+	Outputs             *_struct.Struct                  `protobuf:"bytes,11,opt,name=outputs,proto3" json:"outputs,omitempty"`
+	CreatedAt           *timestamp.Timestamp             `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamp.Timestamp             `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Metadata            *_struct.Struct                  `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Configuration       *_struct.Struct                  `protobuf:"bytes,15,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	ChildExecutions     []*CanvasNodeExecution           `protobuf:"bytes,16,rep,name=child_executions,json=childExecutions,proto3" json:"child_executions,omitempty"`
+	RootEvent           *CanvasEvent                     `protobuf:"bytes,17,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
+	CancelledBy         *UserRef                         `protobuf:"bytes,18,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	LastActivityAt      *timestamp.Timestamp             `protobuf:"bytes,19,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecution) Reset() {
+	*x = CanvasNodeExecution{}
+	mi := &file_canvases_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecution) ProtoMessage() {}
+
+func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CanvasNodeExecution) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetParentExecutionId() string {
+	if x != nil {
+		return x.ParentExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetPreviousExecutionId() string {
+	if x != nil {
+		return x.PreviousExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetState() CanvasNodeExecution_State {
+	if x != nil {
+		return x.State
+	}
+	return CanvasNodeExecution_STATE_UNKNOWN
+}
+
+func (x *CanvasNodeExecution) GetResult() CanvasNodeExecution_Result {
+	if x != nil {
+		return x.Result
+	}
+	return CanvasNodeExecution_RESULT_UNKNOWN
+}
+
+func (x *CanvasNodeExecution) GetResultReason() CanvasNodeExecution_ResultReason {
+	if x != nil {
+		return x.ResultReason
+	}
+	return CanvasNodeExecution_RESULT_REASON_OK
+}
+
+func (x *CanvasNodeExecution) GetResultMessage() string {
+	if x != nil {
+		return x.ResultMessage
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetInput() *_struct.Struct {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetOutputs() *_struct.Struct {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetMetadata() *_struct.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetChildExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.ChildExecutions
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetRootEvent() *CanvasEvent {
+	if x != nil {
+		return x.RootEvent
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetCancelledBy() *UserRef {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetLastActivityAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return nil
+}
+
+type CanvasNodeQueueItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Input         *_struct.Struct        `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	RootEvent     *CanvasEvent           `protobuf:"bytes,5,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeQueueItem) Reset() {
+	*x = CanvasNodeQueueItem{}
+	mi := &file_canvases_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeQueueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeQueueItem) ProtoMessage() {}
+
+func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
+func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CanvasNodeQueueItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItem) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItem) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItem) GetInput() *_struct.Struct {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CanvasNodeQueueItem) GetRootEvent() *CanvasEvent {
+	if x != nil {
+		return x.RootEvent
+	}
+	return nil
+}
+
+func (x *CanvasNodeQueueItem) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type InvokeNodeExecutionActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeExecutionActionRequest) Reset() {
+	*x = InvokeNodeExecutionActionRequest{}
+	mi := &file_canvases_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeExecutionActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
+
+func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
+func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetActionName() string {
+	if x != nil {
+		return x.ActionName
+	}
+	return ""
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetParameters() *_struct.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type InvokeNodeExecutionActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeExecutionActionResponse) Reset() {
+	*x = InvokeNodeExecutionActionResponse{}
+	mi := &file_canvases_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeExecutionActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
+
+func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
+func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{58}
+}
+
+type InvokeNodeTriggerActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeTriggerActionRequest) Reset() {
+	*x = InvokeNodeTriggerActionRequest{}
+	mi := &file_canvases_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeTriggerActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
+
+func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
+func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetActionName() string {
+	if x != nil {
+		return x.ActionName
+	}
+	return ""
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetParameters() *_struct.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type InvokeNodeTriggerActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *_struct.Struct        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeTriggerActionResponse) Reset() {
+	*x = InvokeNodeTriggerActionResponse{}
+	mi := &file_canvases_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeTriggerActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
+
+func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
+func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListCanvasEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	NodeIds       []string               `protobuf:"bytes,4,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	CreatedAfter  *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Order         ListOrder              `protobuf:"varint,7,opt,name=order,proto3,enum=Superplane.Canvases.ListOrder" json:"order,omitempty"`
+	PageToken     string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filters by fields of the event payload, using dot-separated paths as keys.
+	// Over HTTP, use one query parameter per field, e.g. ?payload.data.ref=refs/heads/main.
+	Payload       map[string]string `protobuf:"bytes,9,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasEventsRequest) Reset() {
+	*x = ListCanvasEventsRequest{}
+	mi := &file_canvases_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasEventsRequest) ProtoMessage() {}
+
+func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListCanvasEventsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListCanvasEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCanvasEventsRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsRequest) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsRequest) GetCreatedAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsRequest) GetCreatedBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsRequest) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_DESC
+}
+
+func (x *ListCanvasEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCanvasEventsRequest) GetPayload() map[string]string {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ListCanvasEventsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Events        []*CanvasEventWithExecutions `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    uint32                       `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                         `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp         `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	NextPageToken string                       `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasEventsResponse) Reset() {
+	*x = ListCanvasEventsResponse{}
+	mi := &file_canvases_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasEventsResponse) ProtoMessage() {}
+
+func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListCanvasEventsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListCanvasEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CanvasMemory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Values        *_struct.Value         `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasMemory) Reset() {
+	*x = CanvasMemory{}
+	mi := &file_canvases_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasMemory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasMemory) ProtoMessage() {}
+
+func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasMemory.ProtoReflect.Descriptor instead.
+func (*CanvasMemory) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CanvasMemory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasMemory) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CanvasMemory) GetValues() *_struct.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ListCanvasMemoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasMemoriesRequest) Reset() {
+	*x = ListCanvasMemoriesRequest{}
+	mi := &file_canvases_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasMemoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasMemoriesRequest) ProtoMessage() {}
+
+func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasMemoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasMemoriesRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListCanvasMemoriesRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type ListCanvasMemoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CanvasMemory        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasMemoriesResponse) Reset() {
+	*x = ListCanvasMemoriesResponse{}
+	mi := &file_canvases_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasMemoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasMemoriesResponse) ProtoMessage() {}
+
+func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasMemoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasMemoriesResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListCanvasMemoriesResponse) GetItems() []*CanvasMemory {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteCanvasMemoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	MemoryId      string                 `protobuf:"bytes,2,opt,name=memory_id,json=memoryId,proto3" json:"memory_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasMemoryRequest) Reset() {
+	*x = DeleteCanvasMemoryRequest{}
+	mi := &file_canvases_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasMemoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasMemoryRequest) ProtoMessage() {}
+
+func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasMemoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteCanvasMemoryRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DeleteCanvasMemoryRequest) GetMemoryId() string {
+	if x != nil {
+		return x.MemoryId
+	}
+	return ""
+}
+
+type DeleteCanvasMemoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasMemoryResponse) Reset() {
+	*x = DeleteCanvasMemoryResponse{}
+	mi := &file_canvases_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasMemoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasMemoryResponse) ProtoMessage() {}
+
+func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasMemoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{67}
+}
+
+// A canvas role is bound to either a user or a group of the organization.
+// Roles are canvas_viewer, canvas_operator, canvas_editor and canvas_owner,
+// and each one includes the permissions of the previous ones.
+// Once a canvas has role bindings, only the users with a role in it
+// and the organization owners and admins can access it.
+type CanvasRoleBinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	GroupName     string                 `protobuf:"bytes,4,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasRoleBinding) Reset() {
+	*x = CanvasRoleBinding{}
+	mi := &file_canvases_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasEventsResponse) String() string {
+func (x *CanvasRoleBinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasEventsResponse) ProtoMessage() {}
+func (*CanvasRoleBinding) ProtoMessage() {}
 
-func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[49]
+func (x *CanvasRoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3189,63 +4589,61 @@ func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use CanvasRoleBinding.ProtoReflect.Descriptor instead.
+func (*CanvasRoleBinding) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
+func (x *CanvasRoleBinding) GetRole() string {
 	if x != nil {
-		return x.Events
+		return x.Role
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCanvasEventsResponse) GetTotalCount() uint32 {
+func (x *CanvasRoleBinding) GetUserId() string {
 	if x != nil {
-		return x.TotalCount
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCanvasEventsResponse) GetHasNextPage() bool {
+func (x *CanvasRoleBinding) GetUserEmail() string {
 	if x != nil {
-		return x.HasNextPage
+		return x.UserEmail
 	}
-	return false
+	return ""
 }
 
-func (x *ListCanvasEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+func (x *CanvasRoleBinding) GetGroupName() string {
 	if x != nil {
-		return x.LastTimestamp
+		return x.GroupName
 	}
-	return nil
+	return ""
 }
 
-type CanvasMemory struct {
+type ListCanvasRoleBindingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Values        *_struct.Value         `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasMemory) Reset() {
-	*x = CanvasMemory{}
-	mi := &file_canvases_proto_msgTypes[50]
+func (x *ListCanvasRoleBindingsRequest) Reset() {
+	*x = ListCanvasRoleBindingsRequest{}
+	mi := &file_canvases_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasMemory) String() string {
+func (x *ListCanvasRoleBindingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasMemory) ProtoMessage() {}
+func (*ListCanvasRoleBindingsRequest) ProtoMessage() {}
 
-func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[50]
+func (x *ListCanvasRoleBindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3256,54 +4654,87 @@ func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasMemory.ProtoReflect.Descriptor instead.
-func (*CanvasMemory) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ListCanvasRoleBindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasRoleBindingsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *CanvasMemory) GetId() string {
+func (x *ListCanvasRoleBindingsRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Id
+		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasMemory) GetNamespace() string {
+type ListCanvasRoleBindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bindings      []*CanvasRoleBinding   `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasRoleBindingsResponse) Reset() {
+	*x = ListCanvasRoleBindingsResponse{}
+	mi := &file_canvases_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasRoleBindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasRoleBindingsResponse) ProtoMessage() {}
+
+func (x *ListCanvasRoleBindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[70]
 	if x != nil {
-		return x.Namespace
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CanvasMemory) GetValues() *_struct.Value {
+// Deprecated: Use ListCanvasRoleBindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasRoleBindingsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListCanvasRoleBindingsResponse) GetBindings() []*CanvasRoleBinding {
 	if x != nil {
-		return x.Values
+		return x.Bindings
 	}
 	return nil
 }
 
-type ListCanvasMemoriesRequest struct {
+type AssignCanvasRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,4,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasMemoriesRequest) Reset() {
-	*x = ListCanvasMemoriesRequest{}
-	mi := &file_canvases_proto_msgTypes[51]
+func (x *AssignCanvasRoleRequest) Reset() {
+	*x = AssignCanvasRoleRequest{}
+	mi := &file_canvases_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasMemoriesRequest) String() string {
+func (x *AssignCanvasRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasMemoriesRequest) ProtoMessage() {}
+func (*AssignCanvasRoleRequest) ProtoMessage() {}
 
-func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[51]
+func (x *AssignCanvasRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3314,40 +4745,61 @@ func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasMemoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCanvasMemoriesRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use AssignCanvasRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignCanvasRoleRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListCanvasMemoriesRequest) GetCanvasId() string {
+func (x *AssignCanvasRoleRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-type ListCanvasMemoriesResponse struct {
+func (x *AssignCanvasRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AssignCanvasRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignCanvasRoleRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type AssignCanvasRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*CanvasMemory        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Binding       *CanvasRoleBinding     `protobuf:"bytes,1,opt,name=binding,proto3" json:"binding,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasMemoriesResponse) Reset() {
-	*x = ListCanvasMemoriesResponse{}
-	mi := &file_canvases_proto_msgTypes[52]
+func (x *AssignCanvasRoleResponse) Reset() {
+	*x = AssignCanvasRoleResponse{}
+	mi := &file_canvases_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasMemoriesResponse) String() string {
+func (x *AssignCanvasRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasMemoriesResponse) ProtoMessage() {}
+func (*AssignCanvasRoleResponse) ProtoMessage() {}
 
-func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[52]
+func (x *AssignCanvasRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3358,41 +4810,42 @@ func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasMemoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCanvasMemoriesResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use AssignCanvasRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignCanvasRoleResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListCanvasMemoriesResponse) GetItems() []*CanvasMemory {
+func (x *AssignCanvasRoleResponse) GetBinding() *CanvasRoleBinding {
 	if x != nil {
-		return x.Items
+		return x.Binding
 	}
 	return nil
 }
 
-type DeleteCanvasMemoryRequest struct {
+type RemoveCanvasRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	MemoryId      string                 `protobuf:"bytes,2,opt,name=memory_id,json=memoryId,proto3" json:"memory_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCanvasMemoryRequest) Reset() {
-	*x = DeleteCanvasMemoryRequest{}
-	mi := &file_canvases_proto_msgTypes[53]
+func (x *RemoveCanvasRoleRequest) Reset() {
+	*x = RemoveCanvasRoleRequest{}
+	mi := &file_canvases_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCanvasMemoryRequest) String() string {
+func (x *RemoveCanvasRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCanvasMemoryRequest) ProtoMessage() {}
+func (*RemoveCanvasRoleRequest) ProtoMessage() {}
 
-func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[53]
+func (x *RemoveCanvasRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3403,46 +4856,53 @@ func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCanvasMemoryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCanvasMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use RemoveCanvasRoleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCanvasRoleRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *DeleteCanvasMemoryRequest) GetCanvasId() string {
+func (x *RemoveCanvasRoleRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *DeleteCanvasMemoryRequest) GetMemoryId() string {
+func (x *RemoveCanvasRoleRequest) GetUserId() string {
 	if x != nil {
-		return x.MemoryId
+		return x.UserId
 	}
 	return ""
 }
 
-type DeleteCanvasMemoryResponse struct {
+func (x *RemoveCanvasRoleRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type RemoveCanvasRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCanvasMemoryResponse) Reset() {
-	*x = DeleteCanvasMemoryResponse{}
-	mi := &file_canvases_proto_msgTypes[54]
+func (x *RemoveCanvasRoleResponse) Reset() {
+	*x = RemoveCanvasRoleResponse{}
+	mi := &file_canvases_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCanvasMemoryResponse) String() string {
+func (x *RemoveCanvasRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCanvasMemoryResponse) ProtoMessage() {}
+func (*RemoveCanvasRoleResponse) ProtoMessage() {}
 
-func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[54]
+func (x *RemoveCanvasRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3453,9 +4913,9 @@ func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCanvasMemoryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCanvasMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use RemoveCanvasRoleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCanvasRoleResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{74}
 }
 
 type CanvasEvent struct {
@@ -3473,7 +4933,7 @@ type CanvasEvent struct {
 
 func (x *CanvasEvent) Reset() {
 	*x = CanvasEvent{}
-	mi := &file_canvases_proto_msgTypes[55]
+	mi := &file_canvases_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3485,7 +4945,7 @@ func (x *CanvasEvent) String() string {
 func (*CanvasEvent) ProtoMessage() {}
 
 func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[55]
+	mi := &file_canvases_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3498,7 +4958,7 @@ func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
 func (*CanvasEvent) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{55}
+	return file_canvases_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *CanvasEvent) GetId() string {
@@ -3566,7 +5026,7 @@ type CanvasEventWithExecutions struct {
 
 func (x *CanvasEventWithExecutions) Reset() {
 	*x = CanvasEventWithExecutions{}
-	mi := &file_canvases_proto_msgTypes[56]
+	mi := &file_canvases_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3578,7 +5038,7 @@ func (x *CanvasEventWithExecutions) String() string {
 func (*CanvasEventWithExecutions) ProtoMessage() {}
 
 func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[56]
+	mi := &file_canvases_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3591,7 +5051,7 @@ func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
 func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{56}
+	return file_canvases_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *CanvasEventWithExecutions) GetId() string {
@@ -3660,7 +5120,7 @@ type ListEventExecutionsRequest struct {
 
 func (x *ListEventExecutionsRequest) Reset() {
 	*x = ListEventExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[57]
+	mi := &file_canvases_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3672,7 +5132,7 @@ func (x *ListEventExecutionsRequest) String() string {
 func (*ListEventExecutionsRequest) ProtoMessage() {}
 
 func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[57]
+	mi := &file_canvases_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3685,7 +5145,7 @@ func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{57}
+	return file_canvases_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ListEventExecutionsRequest) GetCanvasId() string {
@@ -3711,7 +5171,7 @@ type ListEventExecutionsResponse struct {
 
 func (x *ListEventExecutionsResponse) Reset() {
 	*x = ListEventExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[58]
+	mi := &file_canvases_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3723,7 +5183,7 @@ func (x *ListEventExecutionsResponse) String() string {
 func (*ListEventExecutionsResponse) ProtoMessage() {}
 
 func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[58]
+	mi := &file_canvases_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3736,7 +5196,7 @@ func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{58}
+	return file_canvases_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -3756,7 +5216,7 @@ type CancelExecutionRequest struct {
 
 func (x *CancelExecutionRequest) Reset() {
 	*x = CancelExecutionRequest{}
-	mi := &file_canvases_proto_msgTypes[59]
+	mi := &file_canvases_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3768,7 +5228,7 @@ func (x *CancelExecutionRequest) String() string {
 func (*CancelExecutionRequest) ProtoMessage() {}
 
 func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[59]
+	mi := &file_canvases_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3781,7 +5241,7 @@ func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
 func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{59}
+	return file_canvases_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *CancelExecutionRequest) GetCanvasId() string {
@@ -3806,7 +5266,7 @@ type CancelExecutionResponse struct {
 
 func (x *CancelExecutionResponse) Reset() {
 	*x = CancelExecutionResponse{}
-	mi := &file_canvases_proto_msgTypes[60]
+	mi := &file_canvases_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3818,7 +5278,7 @@ func (x *CancelExecutionResponse) String() string {
 func (*CancelExecutionResponse) ProtoMessage() {}
 
 func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[60]
+	mi := &file_canvases_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,7 +5291,7 @@ func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
 func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{60}
+	return file_canvases_proto_rawDescGZIP(), []int{80}
 }
 
 type ResolveExecutionErrorsRequest struct {
@@ -3844,7 +5304,7 @@ type ResolveExecutionErrorsRequest struct {
 
 func (x *ResolveExecutionErrorsRequest) Reset() {
 	*x = ResolveExecutionErrorsRequest{}
-	mi := &file_canvases_proto_msgTypes[61]
+	mi := &file_canvases_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3856,7 +5316,7 @@ func (x *ResolveExecutionErrorsRequest) String() string {
 func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
 
 func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[61]
+	mi := &file_canvases_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3869,7 +5329,7 @@ func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
 func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{61}
+	return file_canvases_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
@@ -3894,7 +5354,7 @@ type ResolveExecutionErrorsResponse struct {
 
 func (x *ResolveExecutionErrorsResponse) Reset() {
 	*x = ResolveExecutionErrorsResponse{}
-	mi := &file_canvases_proto_msgTypes[62]
+	mi := &file_canvases_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3906,7 +5366,7 @@ func (x *ResolveExecutionErrorsResponse) String() string {
 func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
 
 func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[62]
+	mi := &file_canvases_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3919,7 +5379,95 @@ func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
 func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{62}
+	return file_canvases_proto_rawDescGZIP(), []int{82}
+}
+
+type ListStuckExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckExecutionsRequest) Reset() {
+	*x = ListStuckExecutionsRequest{}
+	mi := &file_canvases_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckExecutionsRequest) ProtoMessage() {}
+
+func (x *ListStuckExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStuckExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListStuckExecutionsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type ListStuckExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckExecutionsResponse) Reset() {
+	*x = ListStuckExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckExecutionsResponse) ProtoMessage() {}
+
+func (x *ListStuckExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStuckExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListStuckExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
 }
 
 type CanvasAiNodeContext struct {
@@ -3934,7 +5482,7 @@ type CanvasAiNodeContext struct {
 
 func (x *CanvasAiNodeContext) Reset() {
 	*x = CanvasAiNodeContext{}
-	mi := &file_canvases_proto_msgTypes[63]
+	mi := &file_canvases_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3946,7 +5494,7 @@ func (x *CanvasAiNodeContext) String() string {
 func (*CanvasAiNodeContext) ProtoMessage() {}
 
 func (x *CanvasAiNodeContext) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[63]
+	mi := &file_canvases_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3959,7 +5507,7 @@ func (x *CanvasAiNodeContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasAiNodeContext.ProtoReflect.Descriptor instead.
 func (*CanvasAiNodeContext) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{63}
+	return file_canvases_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *CanvasAiNodeContext) GetId() string {
@@ -4001,7 +5549,7 @@ type CanvasAiBlockContext struct {
 
 func (x *CanvasAiBlockContext) Reset() {
 	*x = CanvasAiBlockContext{}
-	mi := &file_canvases_proto_msgTypes[64]
+	mi := &file_canvases_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4013,7 +5561,7 @@ func (x *CanvasAiBlockContext) String() string {
 func (*CanvasAiBlockContext) ProtoMessage() {}
 
 func (x *CanvasAiBlockContext) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[64]
+	mi := &file_canvases_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4026,7 +5574,7 @@ func (x *CanvasAiBlockContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasAiBlockContext.ProtoReflect.Descriptor instead.
 func (*CanvasAiBlockContext) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{64}
+	return file_canvases_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *CanvasAiBlockContext) GetName() string {
@@ -4060,7 +5608,7 @@ type CanvasAiContext struct {
 
 func (x *CanvasAiContext) Reset() {
 	*x = CanvasAiContext{}
-	mi := &file_canvases_proto_msgTypes[65]
+	mi := &file_canvases_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4072,7 +5620,7 @@ func (x *CanvasAiContext) String() string {
 func (*CanvasAiContext) ProtoMessage() {}
 
 func (x *CanvasAiContext) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[65]
+	mi := &file_canvases_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4085,7 +5633,7 @@ func (x *CanvasAiContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasAiContext.ProtoReflect.Descriptor instead.
 func (*CanvasAiContext) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{65}
+	return file_canvases_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *CanvasAiContext) GetNodes() []*CanvasAiNodeContext {
@@ -4113,7 +5661,7 @@ type SendAiMessageRequest struct {
 
 func (x *SendAiMessageRequest) Reset() {
 	*x = SendAiMessageRequest{}
-	mi := &file_canvases_proto_msgTypes[66]
+	mi := &file_canvases_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4125,7 +5673,7 @@ func (x *SendAiMessageRequest) String() string {
 func (*SendAiMessageRequest) ProtoMessage() {}
 
 func (x *SendAiMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[66]
+	mi := &file_canvases_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4138,7 +5686,7 @@ func (x *SendAiMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendAiMessageRequest.ProtoReflect.Descriptor instead.
 func (*SendAiMessageRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{66}
+	return file_canvases_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *SendAiMessageRequest) GetCanvasId() string {
@@ -4172,7 +5720,7 @@ type SendAiMessageResponse struct {
 
 func (x *SendAiMessageResponse) Reset() {
 	*x = SendAiMessageResponse{}
-	mi := &file_canvases_proto_msgTypes[67]
+	mi := &file_canvases_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4184,7 +5732,7 @@ func (x *SendAiMessageResponse) String() string {
 func (*SendAiMessageResponse) ProtoMessage() {}
 
 func (x *SendAiMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[67]
+	mi := &file_canvases_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4197,7 +5745,7 @@ func (x *SendAiMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendAiMessageResponse.ProtoReflect.Descriptor instead.
 func (*SendAiMessageResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{67}
+	return file_canvases_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *SendAiMessageResponse) GetAssistantMessage() string {
@@ -4226,7 +5774,7 @@ type CanvasNodeEventMessage struct {
 
 func (x *CanvasNodeEventMessage) Reset() {
 	*x = CanvasNodeEventMessage{}
-	mi := &file_canvases_proto_msgTypes[68]
+	mi := &file_canvases_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4238,7 +5786,7 @@ func (x *CanvasNodeEventMessage) String() string {
 func (*CanvasNodeEventMessage) ProtoMessage() {}
 
 func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[68]
+	mi := &file_canvases_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4251,7 +5799,7 @@ func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{68}
+	return file_canvases_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *CanvasNodeEventMessage) GetId() string {
@@ -4294,7 +5842,7 @@ type CanvasNodeExecutionMessage struct {
 
 func (x *CanvasNodeExecutionMessage) Reset() {
 	*x = CanvasNodeExecutionMessage{}
-	mi := &file_canvases_proto_msgTypes[69]
+	mi := &file_canvases_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4306,7 +5854,7 @@ func (x *CanvasNodeExecutionMessage) String() string {
 func (*CanvasNodeExecutionMessage) ProtoMessage() {}
 
 func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[69]
+	mi := &file_canvases_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4319,7 +5867,7 @@ func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{69}
+	return file_canvases_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *CanvasNodeExecutionMessage) GetId() string {
@@ -4362,7 +5910,7 @@ type CanvasNodeQueueItemMessage struct {
 
 func (x *CanvasNodeQueueItemMessage) Reset() {
 	*x = CanvasNodeQueueItemMessage{}
-	mi := &file_canvases_proto_msgTypes[70]
+	mi := &file_canvases_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4374,7 +5922,7 @@ func (x *CanvasNodeQueueItemMessage) String() string {
 func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
 
 func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[70]
+	mi := &file_canvases_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4387,7 +5935,7 @@ func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{70}
+	return file_canvases_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *CanvasNodeQueueItemMessage) GetId() string {
@@ -4429,7 +5977,7 @@ type CanvasMessage struct {
 
 func (x *CanvasMessage) Reset() {
 	*x = CanvasMessage{}
-	mi := &file_canvases_proto_msgTypes[71]
+	mi := &file_canvases_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4441,7 +5989,7 @@ func (x *CanvasMessage) String() string {
 func (*CanvasMessage) ProtoMessage() {}
 
 func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[71]
+	mi := &file_canvases_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4454,7 +6002,7 @@ func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasMessage.ProtoReflect.Descriptor instead.
 func (*CanvasMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{71}
+	return file_canvases_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *CanvasMessage) GetId() string {
@@ -4489,7 +6037,7 @@ type CanvasVersionMessage struct {
 
 func (x *CanvasVersionMessage) Reset() {
 	*x = CanvasVersionMessage{}
-	mi := &file_canvases_proto_msgTypes[72]
+	mi := &file_canvases_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4501,7 +6049,7 @@ func (x *CanvasVersionMessage) String() string {
 func (*CanvasVersionMessage) ProtoMessage() {}
 
 func (x *CanvasVersionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[72]
+	mi := &file_canvases_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4514,7 +6062,7 @@ func (x *CanvasVersionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasVersionMessage.ProtoReflect.Descriptor instead.
 func (*CanvasVersionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{72}
+	return file_canvases_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *CanvasVersionMessage) GetCanvasId() string {
@@ -4538,6 +6086,294 @@ func (x *CanvasVersionMessage) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+type CanvasDiff_NodeChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                 `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Type          CanvasDiff_ChangeType  `protobuf:"varint,3,opt,name=type,proto3,enum=Superplane.Canvases.CanvasDiff_ChangeType" json:"type,omitempty"`
+	Fields        []string               `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasDiff_NodeChange) Reset() {
+	*x = CanvasDiff_NodeChange{}
+	mi := &file_canvases_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasDiff_NodeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasDiff_NodeChange) ProtoMessage() {}
+
+func (x *CanvasDiff_NodeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasDiff_NodeChange.ProtoReflect.Descriptor instead.
+func (*CanvasDiff_NodeChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *CanvasDiff_NodeChange) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasDiff_NodeChange) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CanvasDiff_NodeChange) GetType() CanvasDiff_ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return CanvasDiff_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *CanvasDiff_NodeChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type CanvasDiff_EdgeChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edge          *components.Edge       `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	Type          CanvasDiff_ChangeType  `protobuf:"varint,2,opt,name=type,proto3,enum=Superplane.Canvases.CanvasDiff_ChangeType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasDiff_EdgeChange) Reset() {
+	*x = CanvasDiff_EdgeChange{}
+	mi := &file_canvases_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasDiff_EdgeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasDiff_EdgeChange) ProtoMessage() {}
+
+func (x *CanvasDiff_EdgeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasDiff_EdgeChange.ProtoReflect.Descriptor instead.
+func (*CanvasDiff_EdgeChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *CanvasDiff_EdgeChange) GetEdge() *components.Edge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *CanvasDiff_EdgeChange) GetType() CanvasDiff_ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return CanvasDiff_CHANGE_TYPE_UNSPECIFIED
+}
+
+type CanvasSimulation_Fixture struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasSimulation_Fixture) Reset() {
+	*x = CanvasSimulation_Fixture{}
+	mi := &file_canvases_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasSimulation_Fixture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasSimulation_Fixture) ProtoMessage() {}
+
+func (x *CanvasSimulation_Fixture) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasSimulation_Fixture.ProtoReflect.Descriptor instead.
+func (*CanvasSimulation_Fixture) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *CanvasSimulation_Fixture) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasSimulation_Fixture) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CanvasSimulation_Fixture) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CanvasSimulation_Step struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	NodeId        string                  `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                  `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	SourceNodeId  string                  `protobuf:"bytes,3,opt,name=source_node_id,json=sourceNodeId,proto3" json:"source_node_id,omitempty"`
+	Source        CanvasSimulation_Source `protobuf:"varint,4,opt,name=source,proto3,enum=Superplane.Canvases.CanvasSimulation_Source" json:"source,omitempty"`
+	Result        CanvasSimulation_Result `protobuf:"varint,5,opt,name=result,proto3,enum=Superplane.Canvases.CanvasSimulation_Result" json:"result,omitempty"`
+	Channel       string                  `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	Configuration *_struct.Struct         `protobuf:"bytes,7,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Outputs       []*_struct.Struct       `protobuf:"bytes,8,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Error         string                  `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasSimulation_Step) Reset() {
+	*x = CanvasSimulation_Step{}
+	mi := &file_canvases_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasSimulation_Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasSimulation_Step) ProtoMessage() {}
+
+func (x *CanvasSimulation_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasSimulation_Step.ProtoReflect.Descriptor instead.
+func (*CanvasSimulation_Step) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{32, 1}
+}
+
+func (x *CanvasSimulation_Step) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasSimulation_Step) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CanvasSimulation_Step) GetSourceNodeId() string {
+	if x != nil {
+		return x.SourceNodeId
+	}
+	return ""
+}
+
+func (x *CanvasSimulation_Step) GetSource() CanvasSimulation_Source {
+	if x != nil {
+		return x.Source
+	}
+	return CanvasSimulation_SOURCE_UNSPECIFIED
+}
+
+func (x *CanvasSimulation_Step) GetResult() CanvasSimulation_Result {
+	if x != nil {
+		return x.Result
+	}
+	return CanvasSimulation_RESULT_UNSPECIFIED
+}
+
+func (x *CanvasSimulation_Step) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CanvasSimulation_Step) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *CanvasSimulation_Step) GetOutputs() []*_struct.Struct {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *CanvasSimulation_Step) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type Canvas_Metadata struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -4548,13 +6384,14 @@ type Canvas_Metadata struct {
 	UpdatedAt      *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy      *UserRef               `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	IsTemplate     bool                   `protobuf:"varint,8,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"`
+	Priority       Canvas_Priority        `protobuf:"varint,9,opt,name=priority,proto3,enum=Superplane.Canvases.Canvas_Priority" json:"priority,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Canvas_Metadata) Reset() {
 	*x = Canvas_Metadata{}
-	mi := &file_canvases_proto_msgTypes[73]
+	mi := &file_canvases_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4566,7 +6403,7 @@ func (x *Canvas_Metadata) String() string {
 func (*Canvas_Metadata) ProtoMessage() {}
 
 func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[73]
+	mi := &file_canvases_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4579,7 +6416,7 @@ func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Canvas_Metadata.ProtoReflect.Descriptor instead.
 func (*Canvas_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{24, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *Canvas_Metadata) GetId() string {
@@ -4638,6 +6475,13 @@ func (x *Canvas_Metadata) GetIsTemplate() bool {
 	return false
 }
 
+func (x *Canvas_Metadata) GetPriority() Canvas_Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Canvas_PRIORITY_UNSPECIFIED
+}
+
 type Canvas_Spec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Nodes         []*components.Node     `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
@@ -4648,7 +6492,7 @@ type Canvas_Spec struct {
 
 func (x *Canvas_Spec) Reset() {
 	*x = Canvas_Spec{}
-	mi := &file_canvases_proto_msgTypes[74]
+	mi := &file_canvases_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4660,7 +6504,7 @@ func (x *Canvas_Spec) String() string {
 func (*Canvas_Spec) ProtoMessage() {}
 
 func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[74]
+	mi := &file_canvases_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4673,7 +6517,7 @@ func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Canvas_Spec.ProtoReflect.Descriptor instead.
 func (*Canvas_Spec) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{24, 1}
+	return file_canvases_proto_rawDescGZIP(), []int{34, 1}
 }
 
 func (x *Canvas_Spec) GetNodes() []*components.Node {
@@ -4701,7 +6545,7 @@ type Canvas_Status struct {
 
 func (x *Canvas_Status) Reset() {
 	*x = Canvas_Status{}
-	mi := &file_canvases_proto_msgTypes[75]
+	mi := &file_canvases_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4713,7 +6557,7 @@ func (x *Canvas_Status) String() string {
 func (*Canvas_Status) ProtoMessage() {}
 
 func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[75]
+	mi := &file_canvases_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4726,7 +6570,7 @@ func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Canvas_Status.ProtoReflect.Descriptor instead.
 func (*Canvas_Status) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{24, 2}
+	return file_canvases_proto_rawDescGZIP(), []int{34, 2}
 }
 
 func (x *Canvas_Status) GetLastExecutions() []*CanvasNodeExecution {
@@ -4765,7 +6609,7 @@ type CanvasVersion_Metadata struct {
 
 func (x *CanvasVersion_Metadata) Reset() {
 	*x = CanvasVersion_Metadata{}
-	mi := &file_canvases_proto_msgTypes[76]
+	mi := &file_canvases_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4777,7 +6621,7 @@ func (x *CanvasVersion_Metadata) String() string {
 func (*CanvasVersion_Metadata) ProtoMessage() {}
 
 func (x *CanvasVersion_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[76]
+	mi := &file_canvases_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4790,7 +6634,7 @@ func (x *CanvasVersion_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasVersion_Metadata.ProtoReflect.Descriptor instead.
 func (*CanvasVersion_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{25, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{35, 0}
 }
 
 func (x *CanvasVersion_Metadata) GetId() string {
@@ -4860,7 +6704,7 @@ type CanvasChangeRequest_Metadata struct {
 
 func (x *CanvasChangeRequest_Metadata) Reset() {
 	*x = CanvasChangeRequest_Metadata{}
-	mi := &file_canvases_proto_msgTypes[77]
+	mi := &file_canvases_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4872,7 +6716,7 @@ func (x *CanvasChangeRequest_Metadata) String() string {
 func (*CanvasChangeRequest_Metadata) ProtoMessage() {}
 
 func (x *CanvasChangeRequest_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[77]
+	mi := &file_canvases_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4885,7 +6729,7 @@ func (x *CanvasChangeRequest_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasChangeRequest_Metadata.ProtoReflect.Descriptor instead.
 func (*CanvasChangeRequest_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{27, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{37, 0}
 }
 
 func (x *CanvasChangeRequest_Metadata) GetId() string {
@@ -5015,13 +6859,14 @@ const file_canvases_proto_rawDesc = "" +
 	"\vauto_layout\x18\x04 \x01(\v2%.Superplane.Canvases.CanvasAutoLayoutR\n" +
 	"autoLayout\"[\n" +
 	"\x1bUpdateCanvasVersionResponse\x12<\n" +
-	"\aversion\x18\x01 \x01(\v2\".Superplane.Canvases.CanvasVersionR\aversion\"\x96\x01\n" +
+	"\aversion\x18\x01 \x01(\v2\".Superplane.Canvases.CanvasVersionR\aversion\"\xb3\x01\n" +
 	" CreateCanvasChangeRequestRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
 	"\n" +
 	"version_id\x18\x02 \x01(\tR\tversionId\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"t\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tkeep_open\x18\x05 \x01(\bR\bkeepOpen\"t\n" +
 	"!CreateCanvasChangeRequestResponse\x12O\n" +
 	"\x0echange_request\x18\x01 \x01(\v2(.Superplane.Canvases.CanvasChangeRequestR\rchangeRequest\"\xe0\x01\n" +
 	"\x1fListCanvasChangeRequestsRequest\x12\x1b\n" +
@@ -5041,17 +6886,88 @@ const file_canvases_proto_rawDesc = "" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12*\n" +
 	"\x11change_request_id\x18\x02 \x01(\tR\x0fchangeRequestId\"v\n" +
 	"#DescribeCanvasChangeRequestResponse\x12O\n" +
+	"\x0echange_request\x18\x01 \x01(\v2(.Superplane.Canvases.CanvasChangeRequestR\rchangeRequest\"l\n" +
+	"!PublishCanvasChangeRequestRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12*\n" +
+	"\x11change_request_id\x18\x02 \x01(\tR\x0fchangeRequestId\"u\n" +
+	"\"PublishCanvasChangeRequestResponse\x12O\n" +
 	"\x0echange_request\x18\x01 \x01(\v2(.Superplane.Canvases.CanvasChangeRequestR\rchangeRequest\"%\n" +
 	"\x13DeleteCanvasRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
-	"\x14DeleteCanvasResponse\"-\n" +
+	"\x14DeleteCanvasResponse\"o\n" +
+	"\x1bUpdateCanvasPriorityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12@\n" +
+	"\bpriority\x18\x02 \x01(\x0e2$.Superplane.Canvases.Canvas.PriorityR\bpriority\"S\n" +
+	"\x1cUpdateCanvasPriorityResponse\x123\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"e\n" +
+	"\x11DiffCanvasRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x123\n" +
+	"\x06canvas\x18\x02 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"I\n" +
+	"\x12DiffCanvasResponse\x123\n" +
+	"\x04diff\x18\x01 \x01(\v2\x1f.Superplane.Canvases.CanvasDiffR\x04diff\"\xa0\x04\n" +
+	"\n" +
+	"CanvasDiff\x12@\n" +
+	"\x05nodes\x18\x01 \x03(\v2*.Superplane.Canvases.CanvasDiff.NodeChangeR\x05nodes\x12@\n" +
+	"\x05edges\x18\x02 \x03(\v2*.Superplane.Canvases.CanvasDiff.EdgeChangeR\x05edges\x1a\x9a\x01\n" +
+	"\n" +
+	"NodeChange\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12>\n" +
+	"\x04type\x18\x03 \x01(\x0e2*.Superplane.Canvases.CanvasDiff.ChangeTypeR\x04type\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\x1a}\n" +
+	"\n" +
+	"EdgeChange\x12/\n" +
+	"\x04edge\x18\x01 \x01(\v2\x1b.Superplane.Components.EdgeR\x04edge\x12>\n" +
+	"\x04type\x18\x02 \x01(\x0e2*.Superplane.Canvases.CanvasDiff.ChangeTypeR\x04type\"r\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CHANGE_TYPE_ADDED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_TYPE_REMOVED\x10\x02\x12\x17\n" +
+	"\x13CHANGE_TYPE_CHANGED\x10\x03\"\x95\x02\n" +
+	"\x15SimulateCanvasRequest\x123\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x12&\n" +
+	"\x0ftrigger_node_id\x18\x02 \x01(\tR\rtriggerNodeId\x12!\n" +
+	"\fpayload_type\x18\x03 \x01(\tR\vpayloadType\x121\n" +
+	"\apayload\x18\x04 \x01(\v2\x17.google.protobuf.StructR\apayload\x12I\n" +
+	"\bfixtures\x18\x05 \x03(\v2-.Superplane.Canvases.CanvasSimulation.FixtureR\bfixtures\"_\n" +
+	"\x16SimulateCanvasResponse\x12E\n" +
+	"\n" +
+	"simulation\x18\x01 \x01(\v2%.Superplane.Canvases.CanvasSimulationR\n" +
+	"simulation\"\x95\x06\n" +
+	"\x10CanvasSimulation\x12@\n" +
+	"\x05steps\x18\x01 \x03(\v2*.Superplane.Canvases.CanvasSimulation.StepR\x05steps\x1ai\n" +
+	"\aFixture\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04data\x1a\x90\x03\n" +
+	"\x04Step\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12$\n" +
+	"\x0esource_node_id\x18\x03 \x01(\tR\fsourceNodeId\x12D\n" +
+	"\x06source\x18\x04 \x01(\x0e2,.Superplane.Canvases.CanvasSimulation.SourceR\x06source\x12D\n" +
+	"\x06result\x18\x05 \x01(\x0e2,.Superplane.Canvases.CanvasSimulation.ResultR\x06result\x12\x18\n" +
+	"\achannel\x18\x06 \x01(\tR\achannel\x12=\n" +
+	"\rconfiguration\x18\a \x01(\v2\x17.google.protobuf.StructR\rconfiguration\x121\n" +
+	"\aoutputs\x18\b \x03(\v2\x17.google.protobuf.StructR\aoutputs\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"y\n" +
+	"\x06Source\x12\x16\n" +
+	"\x12SOURCE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSOURCE_TRIGGER\x10\x01\x12\x14\n" +
+	"\x10SOURCE_COMPONENT\x10\x02\x12\x12\n" +
+	"\x0eSOURCE_FIXTURE\x10\x03\x12\x19\n" +
+	"\x15SOURCE_EXAMPLE_OUTPUT\x10\x04\"F\n" +
+	"\x06Result\x12\x16\n" +
+	"\x12RESULT_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rRESULT_PASSED\x10\x01\x12\x11\n" +
+	"\rRESULT_FAILED\x10\x02\"-\n" +
 	"\aUserRef\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\xef\x06\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x91\b\n" +
 	"\x06Canvas\x12@\n" +
 	"\bmetadata\x18\x01 \x01(\v2$.Superplane.Canvases.Canvas.MetadataR\bmetadata\x124\n" +
 	"\x04spec\x18\x02 \x01(\v2 .Superplane.Canvases.Canvas.SpecR\x04spec\x12:\n" +
-	"\x06status\x18\x03 \x01(\v2\".Superplane.Canvases.Canvas.StatusR\x06status\x1a\xcd\x02\n" +
+	"\x06status\x18\x03 \x01(\v2\".Superplane.Canvases.Canvas.StatusR\x06status\x1a\x8f\x03\n" +
 	"\bMetadata\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -5064,7 +6980,8 @@ const file_canvases_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\a \x01(\v2\x1c.Superplane.Canvases.UserRefR\tcreatedBy\x12\x1f\n" +
 	"\vis_template\x18\b \x01(\bR\n" +
-	"isTemplate\x1al\n" +
+	"isTemplate\x12@\n" +
+	"\bpriority\x18\t \x01(\x0e2$.Superplane.Canvases.Canvas.PriorityR\bpriority\x1al\n" +
 	"\x04Spec\x121\n" +
 	"\x05nodes\x18\x01 \x03(\v2\x1b.Superplane.Components.NodeR\x05nodes\x121\n" +
 	"\x05edges\x18\x02 \x03(\v2\x1b.Superplane.Components.EdgeR\x05edges\x1a\xf2\x01\n" +
@@ -5072,7 +6989,12 @@ const file_canvases_proto_rawDesc = "" +
 	"\x0flast_executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\x0elastExecutions\x12R\n" +
 	"\x10next_queue_items\x18\x02 \x03(\v2(.Superplane.Canvases.CanvasNodeQueueItemR\x0enextQueueItems\x12A\n" +
 	"\vlast_events\x18\x03 \x03(\v2 .Superplane.Canvases.CanvasEventR\n" +
-	"lastEvents\"\xd4\x03\n" +
+	"lastEvents\"^\n" +
+	"\bPriority\x12\x18\n" +
+	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fPRIORITY_LOW\x10\x01\x12\x13\n" +
+	"\x0fPRIORITY_NORMAL\x10\x02\x12\x11\n" +
+	"\rPRIORITY_HIGH\x10\x03\"\xd4\x03\n" +
 	"\rCanvasVersion\x12G\n" +
 	"\bmetadata\x18\x01 \x01(\v2+.Superplane.Canvases.CanvasVersion.MetadataR\bmetadata\x124\n" +
 	"\x04spec\x18\x02 \x01(\v2 .Superplane.Canvases.Canvas.SpecR\x04spec\x1a\xc3\x02\n" +
@@ -5110,18 +7032,28 @@ const file_canvases_proto_rawDesc = "" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vSTATUS_OPEN\x10\x01\x12\x14\n" +
-	"\x10STATUS_PUBLISHED\x10\x02\"\x97\x01\n" +
+	"\x10STATUS_PUBLISHED\x10\x02\"\xff\x03\n" +
 	"\x15ListNodeEventsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\rR\x05limit\x122\n" +
-	"\x06before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\xda\x01\n" +
+	"\x06before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12?\n" +
+	"\rcreated_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x124\n" +
+	"\x05order\x18\a \x01(\x0e2\x1e.Superplane.Canvases.ListOrderR\x05order\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12Q\n" +
+	"\apayload\x18\t \x03(\v27.Superplane.Canvases.ListNodeEventsRequest.PayloadEntryR\apayload\x1a:\n" +
+	"\fPayloadEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x82\x02\n" +
 	"\x16ListNodeEventsResponse\x128\n" +
 	"\x06events\x18\x01 \x03(\v2 .Superplane.Canvases.CanvasEventR\x06events\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\rR\n" +
 	"totalCount\x12\"\n" +
 	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
-	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"\x93\x01\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\x93\x01\n" +
 	"\x14EmitNodeEventRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x18\n" +
@@ -5150,14 +7082,24 @@ const file_canvases_proto_rawDesc = "" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x16\n" +
 	"\x06paused\x18\x03 \x01(\bR\x06paused\"J\n" +
 	"\x17UpdateNodePauseResponse\x12/\n" +
-	"\x04node\x18\x01 \x01(\v2\x1b.Superplane.Components.NodeR\x04node\"\xae\x02\n" +
+	"\x04node\x18\x01 \x01(\v2\x1b.Superplane.Components.NodeR\x04node\"\x9a\x05\n" +
 	"\x19ListNodeExecutionsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12F\n" +
 	"\x06states\x18\x03 \x03(\x0e2..Superplane.Canvases.CanvasNodeExecution.StateR\x06states\x12I\n" +
 	"\aresults\x18\x04 \x03(\x0e2/.Superplane.Canvases.CanvasNodeExecution.ResultR\aresults\x12\x14\n" +
 	"\x05limit\x18\x05 \x01(\rR\x05limit\x122\n" +
-	"\x06before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\xee\x01\n" +
+	"\x06before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12?\n" +
+	"\rcreated_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x124\n" +
+	"\x05order\x18\t \x01(\x0e2\x1e.Superplane.Canvases.ListOrderR\x05order\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\x12U\n" +
+	"\apayload\x18\v \x03(\v2;.Superplane.Canvases.ListNodeExecutionsRequest.PayloadEntryR\apayload\x1a:\n" +
+	"\fPayloadEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x02\n" +
 	"\x1aListNodeExecutionsResponse\x12H\n" +
 	"\n" +
 	"executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\n" +
@@ -5165,14 +7107,24 @@ const file_canvases_proto_rawDesc = "" +
 	"\vtotal_count\x18\x02 \x01(\rR\n" +
 	"totalCount\x12\"\n" +
 	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
-	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"\\\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"^\n" +
+	"\x1cDescribeNodeExecutionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"\xa5\x01\n" +
+	"\x1dDescribeNodeExecutionResponse\x12F\n" +
+	"\texecution\x18\x01 \x01(\v2(.Superplane.Canvases.CanvasNodeExecutionR\texecution\x12<\n" +
+	"\x03kvs\x18\x02 \x03(\v2*.Superplane.Canvases.CanvasNodeExecutionKVR\x03kvs\"?\n" +
+	"\x15CanvasNodeExecutionKV\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\\\n" +
 	"\x1aListChildExecutionsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"g\n" +
 	"\x1bListChildExecutionsResponse\x12H\n" +
 	"\n" +
 	"executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\n" +
-	"executions\"\x85\n" +
+	"executions\"\xcb\n" +
 	"\n" +
 	"\x13CanvasNodeExecution\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
@@ -5196,7 +7148,8 @@ const file_canvases_proto_rawDesc = "" +
 	"\x10child_executions\x18\x10 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\x0fchildExecutions\x12?\n" +
 	"\n" +
 	"root_event\x18\x11 \x01(\v2 .Superplane.Canvases.CanvasEventR\trootEvent\x12?\n" +
-	"\fcancelled_by\x18\x12 \x01(\v2\x1c.Superplane.Canvases.UserRefR\vcancelledBy\"T\n" +
+	"\fcancelled_by\x18\x12 \x01(\v2\x1c.Superplane.Canvases.UserRefR\vcancelledBy\x12D\n" +
+	"\x10last_activity_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastActivityAt\"T\n" +
 	"\x05State\x12\x11\n" +
 	"\rSTATE_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rSTATE_PENDING\x10\x01\x12\x11\n" +
@@ -5238,17 +7191,28 @@ const file_canvases_proto_rawDesc = "" +
 	"parameters\x18\x04 \x01(\v2\x17.google.protobuf.StructR\n" +
 	"parameters\"R\n" +
 	"\x1fInvokeNodeTriggerActionResponse\x12/\n" +
-	"\x06result\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x06result\"\x80\x01\n" +
+	"\x06result\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x06result\"\x85\x04\n" +
 	"\x17ListCanvasEventsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\rR\x05limit\x122\n" +
-	"\x06before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\xea\x01\n" +
+	"\x06before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x19\n" +
+	"\bnode_ids\x18\x04 \x03(\tR\anodeIds\x12?\n" +
+	"\rcreated_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x124\n" +
+	"\x05order\x18\a \x01(\x0e2\x1e.Superplane.Canvases.ListOrderR\x05order\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12S\n" +
+	"\apayload\x18\t \x03(\v29.Superplane.Canvases.ListCanvasEventsRequest.PayloadEntryR\apayload\x1a:\n" +
+	"\fPayloadEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x92\x02\n" +
 	"\x18ListCanvasEventsResponse\x12F\n" +
 	"\x06events\x18\x01 \x03(\v2..Superplane.Canvases.CanvasEventWithExecutionsR\x06events\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\rR\n" +
 	"totalCount\x12\"\n" +
 	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
-	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"l\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"l\n" +
 	"\fCanvasMemory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12.\n" +
@@ -5260,7 +7224,32 @@ const file_canvases_proto_rawDesc = "" +
 	"\x19DeleteCanvasMemoryRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1b\n" +
 	"\tmemory_id\x18\x02 \x01(\tR\bmemoryId\"\x1c\n" +
-	"\x1aDeleteCanvasMemoryResponse\"\xf6\x01\n" +
+	"\x1aDeleteCanvasMemoryResponse\"~\n" +
+	"\x11CanvasRoleBinding\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x03 \x01(\tR\tuserEmail\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x04 \x01(\tR\tgroupName\"<\n" +
+	"\x1dListCanvasRoleBindingsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"d\n" +
+	"\x1eListCanvasRoleBindingsResponse\x12B\n" +
+	"\bbindings\x18\x01 \x03(\v2&.Superplane.Canvases.CanvasRoleBindingR\bbindings\"\x82\x01\n" +
+	"\x17AssignCanvasRoleRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x04 \x01(\tR\tgroupName\"\\\n" +
+	"\x18AssignCanvasRoleResponse\x12@\n" +
+	"\abinding\x18\x01 \x01(\v2&.Superplane.Canvases.CanvasRoleBindingR\abinding\"n\n" +
+	"\x17RemoveCanvasRoleRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x03 \x01(\tR\tgroupName\"\x1a\n" +
+	"\x18RemoveCanvasRoleResponse\"\xf6\x01\n" +
 	"\vCanvasEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -5298,7 +7287,13 @@ const file_canvases_proto_rawDesc = "" +
 	"\x1dResolveExecutionErrorsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12#\n" +
 	"\rexecution_ids\x18\x02 \x03(\tR\fexecutionIds\" \n" +
-	"\x1eResolveExecutionErrorsResponse\"c\n" +
+	"\x1eResolveExecutionErrorsResponse\"9\n" +
+	"\x1aListStuckExecutionsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"g\n" +
+	"\x1bListStuckExecutionsResponse\x12H\n" +
+	"\n" +
+	"executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\n" +
+	"executions\"c\n" +
 	"\x13CanvasAiNodeContext\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -5343,7 +7338,10 @@ const file_canvases_proto_rawDesc = "" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
 	"\n" +
 	"version_id\x18\x02 \x01(\tR\tversionId\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xae9\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp*4\n" +
+	"\tListOrder\x12\x13\n" +
+	"\x0fLIST_ORDER_DESC\x10\x00\x12\x12\n" +
+	"\x0eLIST_ORDER_ASC\x10\x012\x9aN\n" +
 	"\bCanvases\x12\xb7\x01\n" +
 	"\fListCanvases\x12(.Superplane.Canvases.ListCanvasesRequest\x1a).Superplane.Canvases.ListCanvasesResponse\"R\x92A7\n" +
 	"\x06Canvas\x12\rList canvases\x1a\x1eReturns a list of all canvases\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/canvases\x12\xb0\x01\n" +
@@ -5364,9 +7362,18 @@ const file_canvases_proto_rawDesc = "" +
 	"\x18ListCanvasChangeRequests\x124.Superplane.Canvases.ListCanvasChangeRequestsRequest\x1a5.Superplane.Canvases.ListCanvasChangeRequestsResponse\"\x8d\x01\x92AV\n" +
 	"\x13CanvasChangeRequest\x12\x1bList canvas change requests\x1a\"Lists change requests for a canvas\x82\xd3\xe4\x93\x02.\x12,/api/v1/canvases/{canvas_id}/change-requests\x12\xbc\x02\n" +
 	"\x1bDescribeCanvasChangeRequest\x127.Superplane.Canvases.DescribeCanvasChangeRequestRequest\x1a8.Superplane.Canvases.DescribeCanvasChangeRequestResponse\"\xa9\x01\x92A^\n" +
-	"\x13CanvasChangeRequest\x12\x1eDescribe canvas change request\x1a'Returns one canvas change request by ID\x82\xd3\xe4\x93\x02B\x12@/api/v1/canvases/{canvas_id}/change-requests/{change_request_id}\x12\xb8\x01\n" +
+	"\x13CanvasChangeRequest\x12\x1eDescribe canvas change request\x1a'Returns one canvas change request by ID\x82\xd3\xe4\x93\x02B\x12@/api/v1/canvases/{canvas_id}/change-requests/{change_request_id}\x12\xe9\x02\n" +
+	"\x1aPublishCanvasChangeRequest\x126.Superplane.Canvases.PublishCanvasChangeRequestRequest\x1a7.Superplane.Canvases.PublishCanvasChangeRequestResponse\"\xd9\x01\x92A\x82\x01\n" +
+	"\x13CanvasChangeRequest\x12\x1dPublish canvas change request\x1aLPublishes an open change request, making its version the live canvas version\x82\xd3\xe4\x93\x02M:\x01*\"H/api/v1/canvases/{canvas_id}/change-requests/{change_request_id}/publish\x12\xfe\x01\n" +
+	"\n" +
+	"DiffCanvas\x12&.Superplane.Canvases.DiffCanvasRequest\x1a'.Superplane.Canvases.DiffCanvasResponse\"\x9e\x01\x92Ao\n" +
+	"\x06Canvas\x12\vDiff canvas\x1aXCompares a canvas spec against the live version of a canvas, without persisting anything\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/canvases/{canvas_id}/diff\x12\x8f\x02\n" +
+	"\x0eSimulateCanvas\x12*.Superplane.Canvases.SimulateCanvasRequest\x1a+.Superplane.Canvases.SimulateCanvasResponse\"\xa3\x01\x92A|\n" +
+	"\x06Canvas\x12\x0fSimulate canvas\x1aaRuns a trigger payload through a canvas spec, without persisting anything or calling integrations\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/canvases/simulate\x12\xb8\x01\n" +
 	"\fDeleteCanvas\x12(.Superplane.Canvases.DeleteCanvasRequest\x1a).Superplane.Canvases.DeleteCanvasResponse\"S\x92A3\n" +
-	"\x06Canvas\x12\rDelete canvas\x1a\x1aDeletes an existing canvas\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/canvases/{id}\x12\x8a\x02\n" +
+	"\x06Canvas\x12\rDelete canvas\x1a\x1aDeletes an existing canvas\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/canvases/{id}\x12\xab\x02\n" +
+	"\x14UpdateCanvasPriority\x120.Superplane.Canvases.UpdateCanvasPriorityRequest\x1a1.Superplane.Canvases.UpdateCanvasPriorityResponse\"\xad\x01\x92A\x80\x01\n" +
+	"\x06Canvas\x12\x16Update canvas priority\x1a^Updates the scheduling priority of a canvas relative to the other canvases of its organization\x82\xd3\xe4\x93\x02#:\x01*2\x1e/api/v1/canvases/{id}/priority\x12\x8a\x02\n" +
 	"\x12ListNodeQueueItems\x12..Superplane.Canvases.ListNodeQueueItemsRequest\x1a/.Superplane.Canvases.ListNodeQueueItemsResponse\"\x92\x01\x92AU\n" +
 	"\n" +
 	"CanvasNode\x12\x1cList items in a node's queue\x1a)Returns a list of items in a node's queue\x82\xd3\xe4\x93\x024\x122/api/v1/canvases/{canvas_id}/nodes/{node_id}/queue\x12\x9a\x02\n" +
@@ -5389,19 +7396,29 @@ const file_canvases_proto_rawDesc = "" +
 	"\x13CanvasNodeExecution\x12\x17Invoke execution action\x1a2Invokes a custom action on a canvas node execution\x82\xd3\xe4\x93\x02Q:\x01*\"L/api/v1/canvases/{canvas_id}/executions/{execution_id}/actions/{action_name}\x12\xaf\x02\n" +
 	"\x17InvokeNodeTriggerAction\x123.Superplane.Canvases.InvokeNodeTriggerActionRequest\x1a4.Superplane.Canvases.InvokeNodeTriggerActionResponse\"\xa8\x01\x92AU\n" +
 	"\n" +
-	"CanvasNode\x12\x15Invoke trigger action\x1a0Invokes a custom action on a canvas node trigger\x82\xd3\xe4\x93\x02J:\x01*\"E/api/v1/canvases/{canvas_id}/triggers/{node_id}/actions/{action_name}\x12\xad\x02\n" +
+	"CanvasNode\x12\x15Invoke trigger action\x1a0Invokes a custom action on a canvas node trigger\x82\xd3\xe4\x93\x02J:\x01*\"E/api/v1/canvases/{canvas_id}/triggers/{node_id}/actions/{action_name}\x12\xba\x02\n" +
+	"\x15DescribeNodeExecution\x121.Superplane.Canvases.DescribeNodeExecutionRequest\x1a2.Superplane.Canvases.DescribeNodeExecutionResponse\"\xb9\x01\x92Ax\n" +
+	"\x13CanvasNodeExecution\x12\x12Describe execution\x1aMReturns a canvas node execution with its child executions and key-value pairs\x82\xd3\xe4\x93\x028\x126/api/v1/canvases/{canvas_id}/executions/{execution_id}\x12\xad\x02\n" +
 	"\x13ListChildExecutions\x12/.Superplane.Canvases.ListChildExecutionsRequest\x1a0.Superplane.Canvases.ListChildExecutionsResponse\"\xb2\x01\x92Ae\n" +
 	"\x13CanvasNodeExecution\x12&List child executions for an execution\x1a&List child executions for an execution\x82\xd3\xe4\x93\x02D:\x01*\"?/api/v1/canvases/{canvas_id}/executions/{execution_id}/children\x12\x8a\x02\n" +
 	"\x0fCancelExecution\x12+.Superplane.Canvases.CancelExecutionRequest\x1a,.Superplane.Canvases.CancelExecutionResponse\"\x9b\x01\x92AP\n" +
 	"\x13CanvasNodeExecution\x12\x10Cancel execution\x1a'Cancels a running canvas node execution\x82\xd3\xe4\x93\x02B:\x01*2=/api/v1/canvases/{canvas_id}/executions/{execution_id}/cancel\x12\xa0\x02\n" +
 	"\x16ResolveExecutionErrors\x122.Superplane.Canvases.ResolveExecutionErrorsRequest\x1a3.Superplane.Canvases.ResolveExecutionErrorsResponse\"\x9c\x01\x92A_\n" +
-	"\x13CanvasNodeExecution\x12\x18Resolve execution errors\x1a.Marks canvas node execution errors as resolved\x82\xd3\xe4\x93\x024:\x01*2//api/v1/canvases/{canvas_id}/executions/resolve\x12\x86\x02\n" +
+	"\x13CanvasNodeExecution\x12\x18Resolve execution errors\x1a.Marks canvas node execution errors as resolved\x82\xd3\xe4\x93\x024:\x01*2//api/v1/canvases/{canvas_id}/executions/resolve\x12\xdd\x02\n" +
+	"\x13ListStuckExecutions\x12/.Superplane.Canvases.ListStuckExecutionsRequest\x1a0.Superplane.Canvases.ListStuckExecutionsResponse\"\xe2\x01\x92A\xa9\x01\n" +
+	"\x13CanvasNodeExecution\x12\x15List stuck executions\x1a{Returns the started executions of a canvas without any activity for longer than the maximum waiting time of their component\x82\xd3\xe4\x93\x02/\x12-/api/v1/canvases/{canvas_id}/stuck-executions\x12\x86\x02\n" +
 	"\x10ListCanvasEvents\x12,.Superplane.Canvases.ListCanvasEventsRequest\x1a-.Superplane.Canvases.ListCanvasEventsResponse\"\x94\x01\x92Af\n" +
 	"\vCanvasEvent\x12\x12List canvas events\x1aCReturns a list of root events that triggered executions in a canvas\x82\xd3\xe4\x93\x02%\x12#/api/v1/canvases/{canvas_id}/events\x12\xf4\x01\n" +
 	"\x12ListCanvasMemories\x12..Superplane.Canvases.ListCanvasMemoriesRequest\x1a/.Superplane.Canvases.ListCanvasMemoriesResponse\"}\x92AO\n" +
 	"\x06Canvas\x12\x14List canvas memories\x1a/Returns append-only memory records for a canvas\x82\xd3\xe4\x93\x02%\x12#/api/v1/canvases/{canvas_id}/memory\x12\x85\x02\n" +
 	"\x12DeleteCanvasMemory\x12..Superplane.Canvases.DeleteCanvasMemoryRequest\x1a/.Superplane.Canvases.DeleteCanvasMemoryResponse\"\x8d\x01\x92AS\n" +
-	"\x06Canvas\x12\x1aDelete canvas memory entry\x1a-Deletes one memory record by ID from a canvas\x82\xd3\xe4\x93\x021*//api/v1/canvases/{canvas_id}/memory/{memory_id}\x12\xa4\x02\n" +
+	"\x06Canvas\x12\x1aDelete canvas memory entry\x1a-Deletes one memory record by ID from a canvas\x82\xd3\xe4\x93\x021*//api/v1/canvases/{canvas_id}/memory/{memory_id}\x12\x92\x02\n" +
+	"\x16ListCanvasRoleBindings\x122.Superplane.Canvases.ListCanvasRoleBindingsRequest\x1a3.Superplane.Canvases.ListCanvasRoleBindingsResponse\"\x8e\x01\x92AY\n" +
+	"\x06Canvas\x12\x19List canvas role bindings\x1a4Returns the users and groups with a role in a canvas\x82\xd3\xe4\x93\x02,\x12*/api/v1/canvases/{canvas_id}/role-bindings\x12\x99\x02\n" +
+	"\x10AssignCanvasRole\x12,.Superplane.Canvases.AssignCanvasRoleRequest\x1a-.Superplane.Canvases.AssignCanvasRoleResponse\"\xa7\x01\x92Ao\n" +
+	"\x06Canvas\x12\x12Assign canvas role\x1aQGives a canvas role to a user or group, replacing the role they had in the canvas\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/canvases/{canvas_id}/role-bindings\x12\xee\x01\n" +
+	"\x10RemoveCanvasRole\x12,.Superplane.Canvases.RemoveCanvasRoleRequest\x1a-.Superplane.Canvases.RemoveCanvasRoleResponse\"}\x92AH\n" +
+	"\x06Canvas\x12\x12Remove canvas role\x1a*Removes the canvas role of a user or group\x82\xd3\xe4\x93\x02,**/api/v1/canvases/{canvas_id}/role-bindings\x12\xa4\x02\n" +
 	"\x13ListEventExecutions\x12/.Superplane.Canvases.ListEventExecutionsRequest\x1a0.Superplane.Canvases.ListEventExecutionsResponse\"\xa9\x01\x92Ae\n" +
 	"\vCanvasEvent\x12\x15List event executions\x1a?Returns a list of all node executions triggered by a root event\x82\xd3\xe4\x93\x02;\x129/api/v1/canvases/{canvas_id}/events/{event_id}/executions\x12\x9b\x02\n" +
 	"\rSendAiMessage\x12).Superplane.Canvases.SendAiMessageRequest\x1a*.Superplane.Canvases.SendAiMessageResponse\"\xb2\x01\x92A|\n" +
@@ -5421,255 +7438,346 @@ func file_canvases_proto_rawDescGZIP() []byte {
 	return file_canvases_proto_rawDescData
 }
 
-var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
+var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 107)
 var file_canvases_proto_goTypes = []any{
-	(CanvasAutoLayout_Algorithm)(0),             // 0: Superplane.Canvases.CanvasAutoLayout.Algorithm
-	(CanvasAutoLayout_Scope)(0),                 // 1: Superplane.Canvases.CanvasAutoLayout.Scope
-	(CanvasChangeRequest_Status)(0),             // 2: Superplane.Canvases.CanvasChangeRequest.Status
-	(CanvasNodeExecution_State)(0),              // 3: Superplane.Canvases.CanvasNodeExecution.State
-	(CanvasNodeExecution_Result)(0),             // 4: Superplane.Canvases.CanvasNodeExecution.Result
-	(CanvasNodeExecution_ResultReason)(0),       // 5: Superplane.Canvases.CanvasNodeExecution.ResultReason
-	(*ListCanvasesRequest)(nil),                 // 6: Superplane.Canvases.ListCanvasesRequest
-	(*ListCanvasesResponse)(nil),                // 7: Superplane.Canvases.ListCanvasesResponse
-	(*DescribeCanvasRequest)(nil),               // 8: Superplane.Canvases.DescribeCanvasRequest
-	(*DescribeCanvasResponse)(nil),              // 9: Superplane.Canvases.DescribeCanvasResponse
-	(*CreateCanvasRequest)(nil),                 // 10: Superplane.Canvases.CreateCanvasRequest
-	(*CreateCanvasResponse)(nil),                // 11: Superplane.Canvases.CreateCanvasResponse
-	(*CanvasAutoLayout)(nil),                    // 12: Superplane.Canvases.CanvasAutoLayout
-	(*CreateCanvasVersionRequest)(nil),          // 13: Superplane.Canvases.CreateCanvasVersionRequest
-	(*CreateCanvasVersionResponse)(nil),         // 14: Superplane.Canvases.CreateCanvasVersionResponse
-	(*ListCanvasVersionsRequest)(nil),           // 15: Superplane.Canvases.ListCanvasVersionsRequest
-	(*ListCanvasVersionsResponse)(nil),          // 16: Superplane.Canvases.ListCanvasVersionsResponse
-	(*DescribeCanvasVersionRequest)(nil),        // 17: Superplane.Canvases.DescribeCanvasVersionRequest
-	(*DescribeCanvasVersionResponse)(nil),       // 18: Superplane.Canvases.DescribeCanvasVersionResponse
-	(*UpdateCanvasVersionRequest)(nil),          // 19: Superplane.Canvases.UpdateCanvasVersionRequest
-	(*UpdateCanvasVersionResponse)(nil),         // 20: Superplane.Canvases.UpdateCanvasVersionResponse
-	(*CreateCanvasChangeRequestRequest)(nil),    // 21: Superplane.Canvases.CreateCanvasChangeRequestRequest
-	(*CreateCanvasChangeRequestResponse)(nil),   // 22: Superplane.Canvases.CreateCanvasChangeRequestResponse
-	(*ListCanvasChangeRequestsRequest)(nil),     // 23: Superplane.Canvases.ListCanvasChangeRequestsRequest
-	(*ListCanvasChangeRequestsResponse)(nil),    // 24: Superplane.Canvases.ListCanvasChangeRequestsResponse
-	(*DescribeCanvasChangeRequestRequest)(nil),  // 25: Superplane.Canvases.DescribeCanvasChangeRequestRequest
-	(*DescribeCanvasChangeRequestResponse)(nil), // 26: Superplane.Canvases.DescribeCanvasChangeRequestResponse
-	(*DeleteCanvasRequest)(nil),                 // 27: Superplane.Canvases.DeleteCanvasRequest
-	(*DeleteCanvasResponse)(nil),                // 28: Superplane.Canvases.DeleteCanvasResponse
-	(*UserRef)(nil),                             // 29: Superplane.Canvases.UserRef
-	(*Canvas)(nil),                              // 30: Superplane.Canvases.Canvas
-	(*CanvasVersion)(nil),                       // 31: Superplane.Canvases.CanvasVersion
-	(*CanvasChangeRequestDiff)(nil),             // 32: Superplane.Canvases.CanvasChangeRequestDiff
-	(*CanvasChangeRequest)(nil),                 // 33: Superplane.Canvases.CanvasChangeRequest
-	(*ListNodeEventsRequest)(nil),               // 34: Superplane.Canvases.ListNodeEventsRequest
-	(*ListNodeEventsResponse)(nil),              // 35: Superplane.Canvases.ListNodeEventsResponse
-	(*EmitNodeEventRequest)(nil),                // 36: Superplane.Canvases.EmitNodeEventRequest
-	(*EmitNodeEventResponse)(nil),               // 37: Superplane.Canvases.EmitNodeEventResponse
-	(*ListNodeQueueItemsRequest)(nil),           // 38: Superplane.Canvases.ListNodeQueueItemsRequest
-	(*ListNodeQueueItemsResponse)(nil),          // 39: Superplane.Canvases.ListNodeQueueItemsResponse
-	(*DeleteNodeQueueItemRequest)(nil),          // 40: Superplane.Canvases.DeleteNodeQueueItemRequest
-	(*DeleteNodeQueueItemResponse)(nil),         // 41: Superplane.Canvases.DeleteNodeQueueItemResponse
-	(*UpdateNodePauseRequest)(nil),              // 42: Superplane.Canvases.UpdateNodePauseRequest
-	(*UpdateNodePauseResponse)(nil),             // 43: Superplane.Canvases.UpdateNodePauseResponse
-	(*ListNodeExecutionsRequest)(nil),           // 44: Superplane.Canvases.ListNodeExecutionsRequest
-	(*ListNodeExecutionsResponse)(nil),          // 45: Superplane.Canvases.ListNodeExecutionsResponse
-	(*ListChildExecutionsRequest)(nil),          // 46: Superplane.Canvases.ListChildExecutionsRequest
-	(*ListChildExecutionsResponse)(nil),         // 47: Superplane.Canvases.ListChildExecutionsResponse
-	(*CanvasNodeExecution)(nil),                 // 48: Superplane.Canvases.CanvasNodeExecution
-	(*CanvasNodeQueueItem)(nil),                 // 49: Superplane.Canvases.CanvasNodeQueueItem
-	(*InvokeNodeExecutionActionRequest)(nil),    // 50: Superplane.Canvases.InvokeNodeExecutionActionRequest
-	(*InvokeNodeExecutionActionResponse)(nil),   // 51: Superplane.Canvases.InvokeNodeExecutionActionResponse
-	(*InvokeNodeTriggerActionRequest)(nil),      // 52: Superplane.Canvases.InvokeNodeTriggerActionRequest
-	(*InvokeNodeTriggerActionResponse)(nil),     // 53: Superplane.Canvases.InvokeNodeTriggerActionResponse
-	(*ListCanvasEventsRequest)(nil),             // 54: Superplane.Canvases.ListCanvasEventsRequest
-	(*ListCanvasEventsResponse)(nil),            // 55: Superplane.Canvases.ListCanvasEventsResponse
-	(*CanvasMemory)(nil),                        // 56: Superplane.Canvases.CanvasMemory
-	(*ListCanvasMemoriesRequest)(nil),           // 57: Superplane.Canvases.ListCanvasMemoriesRequest
-	(*ListCanvasMemoriesResponse)(nil),          // 58: Superplane.Canvases.ListCanvasMemoriesResponse
-	(*DeleteCanvasMemoryRequest)(nil),           // 59: Superplane.Canvases.DeleteCanvasMemoryRequest
-	(*DeleteCanvasMemoryResponse)(nil),          // 60: Superplane.Canvases.DeleteCanvasMemoryResponse
-	(*CanvasEvent)(nil),                         // 61: Superplane.Canvases.CanvasEvent
-	(*CanvasEventWithExecutions)(nil),           // 62: Superplane.Canvases.CanvasEventWithExecutions
-	(*ListEventExecutionsRequest)(nil),          // 63: Superplane.Canvases.ListEventExecutionsRequest
-	(*ListEventExecutionsResponse)(nil),         // 64: Superplane.Canvases.ListEventExecutionsResponse
-	(*CancelExecutionRequest)(nil),              // 65: Superplane.Canvases.CancelExecutionRequest
-	(*CancelExecutionResponse)(nil),             // 66: Superplane.Canvases.CancelExecutionResponse
-	(*ResolveExecutionErrorsRequest)(nil),       // 67: Superplane.Canvases.ResolveExecutionErrorsRequest
-	(*ResolveExecutionErrorsResponse)(nil),      // 68: Superplane.Canvases.ResolveExecutionErrorsResponse
-	(*CanvasAiNodeContext)(nil),                 // 69: Superplane.Canvases.CanvasAiNodeContext
-	(*CanvasAiBlockContext)(nil),                // 70: Superplane.Canvases.CanvasAiBlockContext
-	(*CanvasAiContext)(nil),                     // 71: Superplane.Canvases.CanvasAiContext
-	(*SendAiMessageRequest)(nil),                // 72: Superplane.Canvases.SendAiMessageRequest
-	(*SendAiMessageResponse)(nil),               // 73: Superplane.Canvases.SendAiMessageResponse
-	(*CanvasNodeEventMessage)(nil),              // 74: Superplane.Canvases.CanvasNodeEventMessage
-	(*CanvasNodeExecutionMessage)(nil),          // 75: Superplane.Canvases.CanvasNodeExecutionMessage
-	(*CanvasNodeQueueItemMessage)(nil),          // 76: Superplane.Canvases.CanvasNodeQueueItemMessage
-	(*CanvasMessage)(nil),                       // 77: Superplane.Canvases.CanvasMessage
-	(*CanvasVersionMessage)(nil),                // 78: Superplane.Canvases.CanvasVersionMessage
-	(*Canvas_Metadata)(nil),                     // 79: Superplane.Canvases.Canvas.Metadata
-	(*Canvas_Spec)(nil),                         // 80: Superplane.Canvases.Canvas.Spec
-	(*Canvas_Status)(nil),                       // 81: Superplane.Canvases.Canvas.Status
-	(*CanvasVersion_Metadata)(nil),              // 82: Superplane.Canvases.CanvasVersion.Metadata
-	(*CanvasChangeRequest_Metadata)(nil),        // 83: Superplane.Canvases.CanvasChangeRequest.Metadata
-	(*timestamp.Timestamp)(nil),                 // 84: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                      // 85: google.protobuf.Struct
-	(*components.Node)(nil),                     // 86: Superplane.Components.Node
-	(*_struct.Value)(nil),                       // 87: google.protobuf.Value
-	(*components.Edge)(nil),                     // 88: Superplane.Components.Edge
+	(ListOrder)(0),                              // 0: Superplane.Canvases.ListOrder
+	(CanvasAutoLayout_Algorithm)(0),             // 1: Superplane.Canvases.CanvasAutoLayout.Algorithm
+	(CanvasAutoLayout_Scope)(0),                 // 2: Superplane.Canvases.CanvasAutoLayout.Scope
+	(CanvasDiff_ChangeType)(0),                  // 3: Superplane.Canvases.CanvasDiff.ChangeType
+	(CanvasSimulation_Source)(0),                // 4: Superplane.Canvases.CanvasSimulation.Source
+	(CanvasSimulation_Result)(0),                // 5: Superplane.Canvases.CanvasSimulation.Result
+	(Canvas_Priority)(0),                        // 6: Superplane.Canvases.Canvas.Priority
+	(CanvasChangeRequest_Status)(0),             // 7: Superplane.Canvases.CanvasChangeRequest.Status
+	(CanvasNodeExecution_State)(0),              // 8: Superplane.Canvases.CanvasNodeExecution.State
+	(CanvasNodeExecution_Result)(0),             // 9: Superplane.Canvases.CanvasNodeExecution.Result
+	(CanvasNodeExecution_ResultReason)(0),       // 10: Superplane.Canvases.CanvasNodeExecution.ResultReason
+	(*ListCanvasesRequest)(nil),                 // 11: Superplane.Canvases.ListCanvasesRequest
+	(*ListCanvasesResponse)(nil),                // 12: Superplane.Canvases.ListCanvasesResponse
+	(*DescribeCanvasRequest)(nil),               // 13: Superplane.Canvases.DescribeCanvasRequest
+	(*DescribeCanvasResponse)(nil),              // 14: Superplane.Canvases.DescribeCanvasResponse
+	(*CreateCanvasRequest)(nil),                 // 15: Superplane.Canvases.CreateCanvasRequest
+	(*CreateCanvasResponse)(nil),                // 16: Superplane.Canvases.CreateCanvasResponse
+	(*CanvasAutoLayout)(nil),                    // 17: Superplane.Canvases.CanvasAutoLayout
+	(*CreateCanvasVersionRequest)(nil),          // 18: Superplane.Canvases.CreateCanvasVersionRequest
+	(*CreateCanvasVersionResponse)(nil),         // 19: Superplane.Canvases.CreateCanvasVersionResponse
+	(*ListCanvasVersionsRequest)(nil),           // 20: Superplane.Canvases.ListCanvasVersionsRequest
+	(*ListCanvasVersionsResponse)(nil),          // 21: Superplane.Canvases.ListCanvasVersionsResponse
+	(*DescribeCanvasVersionRequest)(nil),        // 22: Superplane.Canvases.DescribeCanvasVersionRequest
+	(*DescribeCanvasVersionResponse)(nil),       // 23: Superplane.Canvases.DescribeCanvasVersionResponse
+	(*UpdateCanvasVersionRequest)(nil),          // 24: Superplane.Canvases.UpdateCanvasVersionRequest
+	(*UpdateCanvasVersionResponse)(nil),         // 25: Superplane.Canvases.UpdateCanvasVersionResponse
+	(*CreateCanvasChangeRequestRequest)(nil),    // 26: Superplane.Canvases.CreateCanvasChangeRequestRequest
+	(*CreateCanvasChangeRequestResponse)(nil),   // 27: Superplane.Canvases.CreateCanvasChangeRequestResponse
+	(*ListCanvasChangeRequestsRequest)(nil),     // 28: Superplane.Canvases.ListCanvasChangeRequestsRequest
+	(*ListCanvasChangeRequestsResponse)(nil),    // 29: Superplane.Canvases.ListCanvasChangeRequestsResponse
+	(*DescribeCanvasChangeRequestRequest)(nil),  // 30: Superplane.Canvases.DescribeCanvasChangeRequestRequest
+	(*DescribeCanvasChangeRequestResponse)(nil), // 31: Superplane.Canvases.DescribeCanvasChangeRequestResponse
+	(*PublishCanvasChangeRequestRequest)(nil),   // 32: Superplane.Canvases.PublishCanvasChangeRequestRequest
+	(*PublishCanvasChangeRequestResponse)(nil),  // 33: Superplane.Canvases.PublishCanvasChangeRequestResponse
+	(*DeleteCanvasRequest)(nil),                 // 34: Superplane.Canvases.DeleteCanvasRequest
+	(*DeleteCanvasResponse)(nil),                // 35: Superplane.Canvases.DeleteCanvasResponse
+	(*UpdateCanvasPriorityRequest)(nil),         // 36: Superplane.Canvases.UpdateCanvasPriorityRequest
+	(*UpdateCanvasPriorityResponse)(nil),        // 37: Superplane.Canvases.UpdateCanvasPriorityResponse
+	(*DiffCanvasRequest)(nil),                   // 38: Superplane.Canvases.DiffCanvasRequest
+	(*DiffCanvasResponse)(nil),                  // 39: Superplane.Canvases.DiffCanvasResponse
+	(*CanvasDiff)(nil),                          // 40: Superplane.Canvases.CanvasDiff
+	(*SimulateCanvasRequest)(nil),               // 41: Superplane.Canvases.SimulateCanvasRequest
+	(*SimulateCanvasResponse)(nil),              // 42: Superplane.Canvases.SimulateCanvasResponse
+	(*CanvasSimulation)(nil),                    // 43: Superplane.Canvases.CanvasSimulation
+	(*UserRef)(nil),                             // 44: Superplane.Canvases.UserRef
+	(*Canvas)(nil),                              // 45: Superplane.Canvases.Canvas
+	(*CanvasVersion)(nil),                       // 46: Superplane.Canvases.CanvasVersion
+	(*CanvasChangeRequestDiff)(nil),             // 47: Superplane.Canvases.CanvasChangeRequestDiff
+	(*CanvasChangeRequest)(nil),                 // 48: Superplane.Canvases.CanvasChangeRequest
+	(*ListNodeEventsRequest)(nil),               // 49: Superplane.Canvases.ListNodeEventsRequest
+	(*ListNodeEventsResponse)(nil),              // 50: Superplane.Canvases.ListNodeEventsResponse
+	(*EmitNodeEventRequest)(nil),                // 51: Superplane.Canvases.EmitNodeEventRequest
+	(*EmitNodeEventResponse)(nil),               // 52: Superplane.Canvases.EmitNodeEventResponse
+	(*ListNodeQueueItemsRequest)(nil),           // 53: Superplane.Canvases.ListNodeQueueItemsRequest
+	(*ListNodeQueueItemsResponse)(nil),          // 54: Superplane.Canvases.ListNodeQueueItemsResponse
+	(*DeleteNodeQueueItemRequest)(nil),          // 55: Superplane.Canvases.DeleteNodeQueueItemRequest
+	(*DeleteNodeQueueItemResponse)(nil),         // 56: Superplane.Canvases.DeleteNodeQueueItemResponse
+	(*UpdateNodePauseRequest)(nil),              // 57: Superplane.Canvases.UpdateNodePauseRequest
+	(*UpdateNodePauseResponse)(nil),             // 58: Superplane.Canvases.UpdateNodePauseResponse
+	(*ListNodeExecutionsRequest)(nil),           // 59: Superplane.Canvases.ListNodeExecutionsRequest
+	(*ListNodeExecutionsResponse)(nil),          // 60: Superplane.Canvases.ListNodeExecutionsResponse
+	(*DescribeNodeExecutionRequest)(nil),        // 61: Superplane.Canvases.DescribeNodeExecutionRequest
+	(*DescribeNodeExecutionResponse)(nil),       // 62: Superplane.Canvases.DescribeNodeExecutionResponse
+	(*CanvasNodeExecutionKV)(nil),               // 63: Superplane.Canvases.CanvasNodeExecutionKV
+	(*ListChildExecutionsRequest)(nil),          // 64: Superplane.Canvases.ListChildExecutionsRequest
+	(*ListChildExecutionsResponse)(nil),         // 65: Superplane.Canvases.ListChildExecutionsResponse
+	(*CanvasNodeExecution)(nil),                 // 66: Superplane.Canvases.CanvasNodeExecution
+	(*CanvasNodeQueueItem)(nil),                 // 67: Superplane.Canvases.CanvasNodeQueueItem
+	(*InvokeNodeExecutionActionRequest)(nil),    // 68: Superplane.Canvases.InvokeNodeExecutionActionRequest
+	(*InvokeNodeExecutionActionResponse)(nil),   // 69: Superplane.Canvases.InvokeNodeExecutionActionResponse
+	(*InvokeNodeTriggerActionRequest)(nil),      // 70: Superplane.Canvases.InvokeNodeTriggerActionRequest
+	(*InvokeNodeTriggerActionResponse)(nil),     // 71: Superplane.Canvases.InvokeNodeTriggerActionResponse
+	(*ListCanvasEventsRequest)(nil),             // 72: Superplane.Canvases.ListCanvasEventsRequest
+	(*ListCanvasEventsResponse)(nil),            // 73: Superplane.Canvases.ListCanvasEventsResponse
+	(*CanvasMemory)(nil),                        // 74: Superplane.Canvases.CanvasMemory
+	(*ListCanvasMemoriesRequest)(nil),           // 75: Superplane.Canvases.ListCanvasMemoriesRequest
+	(*ListCanvasMemoriesResponse)(nil),          // 76: Superplane.Canvases.ListCanvasMemoriesResponse
+	(*DeleteCanvasMemoryRequest)(nil),           // 77: Superplane.Canvases.DeleteCanvasMemoryRequest
+	(*DeleteCanvasMemoryResponse)(nil),          // 78: Superplane.Canvases.DeleteCanvasMemoryResponse
+	(*CanvasRoleBinding)(nil),                   // 79: Superplane.Canvases.CanvasRoleBinding
+	(*ListCanvasRoleBindingsRequest)(nil),       // 80: Superplane.Canvases.ListCanvasRoleBindingsRequest
+	(*ListCanvasRoleBindingsResponse)(nil),      // 81: Superplane.Canvases.ListCanvasRoleBindingsResponse
+	(*AssignCanvasRoleRequest)(nil),             // 82: Superplane.Canvases.AssignCanvasRoleRequest
+	(*AssignCanvasRoleResponse)(nil),            // 83: Superplane.Canvases.AssignCanvasRoleResponse
+	(*RemoveCanvasRoleRequest)(nil),             // 84: Superplane.Canvases.RemoveCanvasRoleRequest
+	(*RemoveCanvasRoleResponse)(nil),            // 85: Superplane.Canvases.RemoveCanvasRoleResponse
+	(*CanvasEvent)(nil),                         // 86: Superplane.Canvases.CanvasEvent
+	(*CanvasEventWithExecutions)(nil),           // 87: Superplane.Canvases.CanvasEventWithExecutions
+	(*ListEventExecutionsRequest)(nil),          // 88: Superplane.Canvases.ListEventExecutionsRequest
+	(*ListEventExecutionsResponse)(nil),         // 89: Superplane.Canvases.ListEventExecutionsResponse
+	(*CancelExecutionRequest)(nil),              // 90: Superplane.Canvases.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),             // 91: Superplane.Canvases.CancelExecutionResponse
+	(*ResolveExecutionErrorsRequest)(nil),       // 92: Superplane.Canvases.ResolveExecutionErrorsRequest
+	(*ResolveExecutionErrorsResponse)(nil),      // 93: Superplane.Canvases.ResolveExecutionErrorsResponse
+	(*ListStuckExecutionsRequest)(nil),          // 94: Superplane.Canvases.ListStuckExecutionsRequest
+	(*ListStuckExecutionsResponse)(nil),         // 95: Superplane.Canvases.ListStuckExecutionsResponse
+	(*CanvasAiNodeContext)(nil),                 // 96: Superplane.Canvases.CanvasAiNodeContext
+	(*CanvasAiBlockContext)(nil),                // 97: Superplane.Canvases.CanvasAiBlockContext
+	(*CanvasAiContext)(nil),                     // 98: Superplane.Canvases.CanvasAiContext
+	(*SendAiMessageRequest)(nil),                // 99: Superplane.Canvases.SendAiMessageRequest
+	(*SendAiMessageResponse)(nil),               // 100: Superplane.Canvases.SendAiMessageResponse
+	(*CanvasNodeEventMessage)(nil),              // 101: Superplane.Canvases.CanvasNodeEventMessage
+	(*CanvasNodeExecutionMessage)(nil),          // 102: Superplane.Canvases.CanvasNodeExecutionMessage
+	(*CanvasNodeQueueItemMessage)(nil),          // 103: Superplane.Canvases.CanvasNodeQueueItemMessage
+	(*CanvasMessage)(nil),                       // 104: Superplane.Canvases.CanvasMessage
+	(*CanvasVersionMessage)(nil),                // 105: Superplane.Canvases.CanvasVersionMessage
+	(*CanvasDiff_NodeChange)(nil),               // 106: Superplane.Canvases.CanvasDiff.NodeChange
+	(*CanvasDiff_EdgeChange)(nil),               // 107: Superplane.Canvases.CanvasDiff.EdgeChange
+	(*CanvasSimulation_Fixture)(nil),            // 108: Superplane.Canvases.CanvasSimulation.Fixture
+	(*CanvasSimulation_Step)(nil),               // 109: Superplane.Canvases.CanvasSimulation.Step
+	(*Canvas_Metadata)(nil),                     // 110: Superplane.Canvases.Canvas.Metadata
+	(*Canvas_Spec)(nil),                         // 111: Superplane.Canvases.Canvas.Spec
+	(*Canvas_Status)(nil),                       // 112: Superplane.Canvases.Canvas.Status
+	(*CanvasVersion_Metadata)(nil),              // 113: Superplane.Canvases.CanvasVersion.Metadata
+	(*CanvasChangeRequest_Metadata)(nil),        // 114: Superplane.Canvases.CanvasChangeRequest.Metadata
+	nil,                                         // 115: Superplane.Canvases.ListNodeEventsRequest.PayloadEntry
+	nil,                                         // 116: Superplane.Canvases.ListNodeExecutionsRequest.PayloadEntry
+	nil,                                         // 117: Superplane.Canvases.ListCanvasEventsRequest.PayloadEntry
+	(*timestamp.Timestamp)(nil),                 // 118: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                      // 119: google.protobuf.Struct
+	(*components.Node)(nil),                     // 120: Superplane.Components.Node
+	(*_struct.Value)(nil),                       // 121: google.protobuf.Value
+	(*components.Edge)(nil),                     // 122: Superplane.Components.Edge
 }
 var file_canvases_proto_depIdxs = []int32{
-	30,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
-	30,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	30,  // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	30,  // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	0,   // 4: Superplane.Canvases.CanvasAutoLayout.algorithm:type_name -> Superplane.Canvases.CanvasAutoLayout.Algorithm
-	1,   // 5: Superplane.Canvases.CanvasAutoLayout.scope:type_name -> Superplane.Canvases.CanvasAutoLayout.Scope
-	31,  // 6: Superplane.Canvases.CreateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	84,  // 7: Superplane.Canvases.ListCanvasVersionsRequest.before:type_name -> google.protobuf.Timestamp
-	31,  // 8: Superplane.Canvases.ListCanvasVersionsResponse.versions:type_name -> Superplane.Canvases.CanvasVersion
-	84,  // 9: Superplane.Canvases.ListCanvasVersionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	31,  // 10: Superplane.Canvases.DescribeCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	30,  // 11: Superplane.Canvases.UpdateCanvasVersionRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	12,  // 12: Superplane.Canvases.UpdateCanvasVersionRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
-	31,  // 13: Superplane.Canvases.UpdateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	33,  // 14: Superplane.Canvases.CreateCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	84,  // 15: Superplane.Canvases.ListCanvasChangeRequestsRequest.before:type_name -> google.protobuf.Timestamp
-	33,  // 16: Superplane.Canvases.ListCanvasChangeRequestsResponse.change_requests:type_name -> Superplane.Canvases.CanvasChangeRequest
-	84,  // 17: Superplane.Canvases.ListCanvasChangeRequestsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	33,  // 18: Superplane.Canvases.DescribeCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	79,  // 19: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
-	80,  // 20: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	81,  // 21: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
-	82,  // 22: Superplane.Canvases.CanvasVersion.metadata:type_name -> Superplane.Canvases.CanvasVersion.Metadata
-	80,  // 23: Superplane.Canvases.CanvasVersion.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	83,  // 24: Superplane.Canvases.CanvasChangeRequest.metadata:type_name -> Superplane.Canvases.CanvasChangeRequest.Metadata
-	31,  // 25: Superplane.Canvases.CanvasChangeRequest.version:type_name -> Superplane.Canvases.CanvasVersion
-	32,  // 26: Superplane.Canvases.CanvasChangeRequest.diff:type_name -> Superplane.Canvases.CanvasChangeRequestDiff
-	84,  // 27: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
-	61,  // 28: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
-	84,  // 29: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	85,  // 30: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
-	84,  // 31: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
-	49,  // 32: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	84,  // 33: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	86,  // 34: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
-	3,   // 35: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	4,   // 36: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	84,  // 37: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
-	48,  // 38: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	84,  // 39: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	48,  // 40: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	3,   // 41: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	4,   // 42: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	5,   // 43: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
-	85,  // 44: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
-	85,  // 45: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
-	84,  // 46: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
-	84,  // 47: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
-	85,  // 48: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
-	85,  // 49: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
-	48,  // 50: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	61,  // 51: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	29,  // 52: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
-	85,  // 53: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
-	61,  // 54: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	84,  // 55: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
-	85,  // 56: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
-	85,  // 57: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
-	85,  // 58: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
-	84,  // 59: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
-	62,  // 60: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
-	84,  // 61: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	87,  // 62: Superplane.Canvases.CanvasMemory.values:type_name -> google.protobuf.Value
-	56,  // 63: Superplane.Canvases.ListCanvasMemoriesResponse.items:type_name -> Superplane.Canvases.CanvasMemory
-	85,  // 64: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
-	84,  // 65: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
-	85,  // 66: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
-	84,  // 67: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
-	48,  // 68: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	48,  // 69: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	69,  // 70: Superplane.Canvases.CanvasAiContext.nodes:type_name -> Superplane.Canvases.CanvasAiNodeContext
-	70,  // 71: Superplane.Canvases.CanvasAiContext.available_blocks:type_name -> Superplane.Canvases.CanvasAiBlockContext
-	71,  // 72: Superplane.Canvases.SendAiMessageRequest.canvas_context:type_name -> Superplane.Canvases.CanvasAiContext
-	85,  // 73: Superplane.Canvases.SendAiMessageResponse.operations:type_name -> google.protobuf.Struct
-	84,  // 74: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
-	84,  // 75: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	84,  // 76: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
-	84,  // 77: Superplane.Canvases.CanvasMessage.timestamp:type_name -> google.protobuf.Timestamp
-	84,  // 78: Superplane.Canvases.CanvasVersionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	84,  // 79: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	84,  // 80: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	29,  // 81: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
-	86,  // 82: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
-	88,  // 83: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
-	48,  // 84: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	49,  // 85: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	61,  // 86: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
-	29,  // 87: Superplane.Canvases.CanvasVersion.Metadata.owner:type_name -> Superplane.Canvases.UserRef
-	84,  // 88: Superplane.Canvases.CanvasVersion.Metadata.published_at:type_name -> google.protobuf.Timestamp
-	84,  // 89: Superplane.Canvases.CanvasVersion.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	84,  // 90: Superplane.Canvases.CanvasVersion.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	29,  // 91: Superplane.Canvases.CanvasChangeRequest.Metadata.owner:type_name -> Superplane.Canvases.UserRef
-	2,   // 92: Superplane.Canvases.CanvasChangeRequest.Metadata.status:type_name -> Superplane.Canvases.CanvasChangeRequest.Status
-	84,  // 93: Superplane.Canvases.CanvasChangeRequest.Metadata.published_at:type_name -> google.protobuf.Timestamp
-	84,  // 94: Superplane.Canvases.CanvasChangeRequest.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	84,  // 95: Superplane.Canvases.CanvasChangeRequest.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	6,   // 96: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
-	10,  // 97: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
-	8,   // 98: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
-	13,  // 99: Superplane.Canvases.Canvases.CreateCanvasVersion:input_type -> Superplane.Canvases.CreateCanvasVersionRequest
-	15,  // 100: Superplane.Canvases.Canvases.ListCanvasVersions:input_type -> Superplane.Canvases.ListCanvasVersionsRequest
-	17,  // 101: Superplane.Canvases.Canvases.DescribeCanvasVersion:input_type -> Superplane.Canvases.DescribeCanvasVersionRequest
-	19,  // 102: Superplane.Canvases.Canvases.UpdateCanvasVersion:input_type -> Superplane.Canvases.UpdateCanvasVersionRequest
-	21,  // 103: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:input_type -> Superplane.Canvases.CreateCanvasChangeRequestRequest
-	23,  // 104: Superplane.Canvases.Canvases.ListCanvasChangeRequests:input_type -> Superplane.Canvases.ListCanvasChangeRequestsRequest
-	25,  // 105: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:input_type -> Superplane.Canvases.DescribeCanvasChangeRequestRequest
-	27,  // 106: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
-	38,  // 107: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
-	40,  // 108: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
-	42,  // 109: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
-	44,  // 110: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
-	34,  // 111: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
-	36,  // 112: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
-	50,  // 113: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
-	52,  // 114: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
-	46,  // 115: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
-	65,  // 116: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
-	67,  // 117: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
-	54,  // 118: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
-	57,  // 119: Superplane.Canvases.Canvases.ListCanvasMemories:input_type -> Superplane.Canvases.ListCanvasMemoriesRequest
-	59,  // 120: Superplane.Canvases.Canvases.DeleteCanvasMemory:input_type -> Superplane.Canvases.DeleteCanvasMemoryRequest
-	63,  // 121: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
-	72,  // 122: Superplane.Canvases.Canvases.SendAiMessage:input_type -> Superplane.Canvases.SendAiMessageRequest
-	7,   // 123: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
-	11,  // 124: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
-	9,   // 125: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
-	14,  // 126: Superplane.Canvases.Canvases.CreateCanvasVersion:output_type -> Superplane.Canvases.CreateCanvasVersionResponse
-	16,  // 127: Superplane.Canvases.Canvases.ListCanvasVersions:output_type -> Superplane.Canvases.ListCanvasVersionsResponse
-	18,  // 128: Superplane.Canvases.Canvases.DescribeCanvasVersion:output_type -> Superplane.Canvases.DescribeCanvasVersionResponse
-	20,  // 129: Superplane.Canvases.Canvases.UpdateCanvasVersion:output_type -> Superplane.Canvases.UpdateCanvasVersionResponse
-	22,  // 130: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:output_type -> Superplane.Canvases.CreateCanvasChangeRequestResponse
-	24,  // 131: Superplane.Canvases.Canvases.ListCanvasChangeRequests:output_type -> Superplane.Canvases.ListCanvasChangeRequestsResponse
-	26,  // 132: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:output_type -> Superplane.Canvases.DescribeCanvasChangeRequestResponse
-	28,  // 133: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
-	39,  // 134: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
-	41,  // 135: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
-	43,  // 136: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
-	45,  // 137: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
-	35,  // 138: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
-	37,  // 139: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
-	51,  // 140: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
-	53,  // 141: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
-	47,  // 142: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
-	66,  // 143: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
-	68,  // 144: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
-	55,  // 145: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
-	58,  // 146: Superplane.Canvases.Canvases.ListCanvasMemories:output_type -> Superplane.Canvases.ListCanvasMemoriesResponse
-	60,  // 147: Superplane.Canvases.Canvases.DeleteCanvasMemory:output_type -> Superplane.Canvases.DeleteCanvasMemoryResponse
-	64,  // 148: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
-	73,  // 149: Superplane.Canvases.Canvases.SendAiMessage:output_type -> Superplane.Canvases.SendAiMessageResponse
-	123, // [123:150] is the sub-list for method output_type
-	96,  // [96:123] is the sub-list for method input_type
-	96,  // [96:96] is the sub-list for extension type_name
-	96,  // [96:96] is the sub-list for extension extendee
-	0,   // [0:96] is the sub-list for field type_name
+	45,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
+	45,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	45,  // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	45,  // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	1,   // 4: Superplane.Canvases.CanvasAutoLayout.algorithm:type_name -> Superplane.Canvases.CanvasAutoLayout.Algorithm
+	2,   // 5: Superplane.Canvases.CanvasAutoLayout.scope:type_name -> Superplane.Canvases.CanvasAutoLayout.Scope
+	46,  // 6: Superplane.Canvases.CreateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	118, // 7: Superplane.Canvases.ListCanvasVersionsRequest.before:type_name -> google.protobuf.Timestamp
+	46,  // 8: Superplane.Canvases.ListCanvasVersionsResponse.versions:type_name -> Superplane.Canvases.CanvasVersion
+	118, // 9: Superplane.Canvases.ListCanvasVersionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	46,  // 10: Superplane.Canvases.DescribeCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	45,  // 11: Superplane.Canvases.UpdateCanvasVersionRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 12: Superplane.Canvases.UpdateCanvasVersionRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
+	46,  // 13: Superplane.Canvases.UpdateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	48,  // 14: Superplane.Canvases.CreateCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	118, // 15: Superplane.Canvases.ListCanvasChangeRequestsRequest.before:type_name -> google.protobuf.Timestamp
+	48,  // 16: Superplane.Canvases.ListCanvasChangeRequestsResponse.change_requests:type_name -> Superplane.Canvases.CanvasChangeRequest
+	118, // 17: Superplane.Canvases.ListCanvasChangeRequestsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	48,  // 18: Superplane.Canvases.DescribeCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	48,  // 19: Superplane.Canvases.PublishCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	6,   // 20: Superplane.Canvases.UpdateCanvasPriorityRequest.priority:type_name -> Superplane.Canvases.Canvas.Priority
+	45,  // 21: Superplane.Canvases.UpdateCanvasPriorityResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	45,  // 22: Superplane.Canvases.DiffCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	40,  // 23: Superplane.Canvases.DiffCanvasResponse.diff:type_name -> Superplane.Canvases.CanvasDiff
+	106, // 24: Superplane.Canvases.CanvasDiff.nodes:type_name -> Superplane.Canvases.CanvasDiff.NodeChange
+	107, // 25: Superplane.Canvases.CanvasDiff.edges:type_name -> Superplane.Canvases.CanvasDiff.EdgeChange
+	45,  // 26: Superplane.Canvases.SimulateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	119, // 27: Superplane.Canvases.SimulateCanvasRequest.payload:type_name -> google.protobuf.Struct
+	108, // 28: Superplane.Canvases.SimulateCanvasRequest.fixtures:type_name -> Superplane.Canvases.CanvasSimulation.Fixture
+	43,  // 29: Superplane.Canvases.SimulateCanvasResponse.simulation:type_name -> Superplane.Canvases.CanvasSimulation
+	109, // 30: Superplane.Canvases.CanvasSimulation.steps:type_name -> Superplane.Canvases.CanvasSimulation.Step
+	110, // 31: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
+	111, // 32: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	112, // 33: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
+	113, // 34: Superplane.Canvases.CanvasVersion.metadata:type_name -> Superplane.Canvases.CanvasVersion.Metadata
+	111, // 35: Superplane.Canvases.CanvasVersion.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	114, // 36: Superplane.Canvases.CanvasChangeRequest.metadata:type_name -> Superplane.Canvases.CanvasChangeRequest.Metadata
+	46,  // 37: Superplane.Canvases.CanvasChangeRequest.version:type_name -> Superplane.Canvases.CanvasVersion
+	47,  // 38: Superplane.Canvases.CanvasChangeRequest.diff:type_name -> Superplane.Canvases.CanvasChangeRequestDiff
+	118, // 39: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
+	118, // 40: Superplane.Canvases.ListNodeEventsRequest.created_after:type_name -> google.protobuf.Timestamp
+	118, // 41: Superplane.Canvases.ListNodeEventsRequest.created_before:type_name -> google.protobuf.Timestamp
+	0,   // 42: Superplane.Canvases.ListNodeEventsRequest.order:type_name -> Superplane.Canvases.ListOrder
+	115, // 43: Superplane.Canvases.ListNodeEventsRequest.payload:type_name -> Superplane.Canvases.ListNodeEventsRequest.PayloadEntry
+	86,  // 44: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
+	118, // 45: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	119, // 46: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
+	118, // 47: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
+	67,  // 48: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	118, // 49: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	120, // 50: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
+	8,   // 51: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	9,   // 52: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	118, // 53: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
+	118, // 54: Superplane.Canvases.ListNodeExecutionsRequest.created_after:type_name -> google.protobuf.Timestamp
+	118, // 55: Superplane.Canvases.ListNodeExecutionsRequest.created_before:type_name -> google.protobuf.Timestamp
+	0,   // 56: Superplane.Canvases.ListNodeExecutionsRequest.order:type_name -> Superplane.Canvases.ListOrder
+	116, // 57: Superplane.Canvases.ListNodeExecutionsRequest.payload:type_name -> Superplane.Canvases.ListNodeExecutionsRequest.PayloadEntry
+	66,  // 58: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	118, // 59: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 60: Superplane.Canvases.DescribeNodeExecutionResponse.execution:type_name -> Superplane.Canvases.CanvasNodeExecution
+	63,  // 61: Superplane.Canvases.DescribeNodeExecutionResponse.kvs:type_name -> Superplane.Canvases.CanvasNodeExecutionKV
+	66,  // 62: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	8,   // 63: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	9,   // 64: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	10,  // 65: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
+	119, // 66: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
+	119, // 67: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
+	118, // 68: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
+	118, // 69: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
+	119, // 70: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
+	119, // 71: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
+	66,  // 72: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	86,  // 73: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	44,  // 74: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
+	118, // 75: Superplane.Canvases.CanvasNodeExecution.last_activity_at:type_name -> google.protobuf.Timestamp
+	119, // 76: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
+	86,  // 77: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	118, // 78: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
+	119, // 79: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
+	119, // 80: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
+	119, // 81: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
+	118, // 82: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
+	118, // 83: Superplane.Canvases.ListCanvasEventsRequest.created_after:type_name -> google.protobuf.Timestamp
+	118, // 84: Superplane.Canvases.ListCanvasEventsRequest.created_before:type_name -> google.protobuf.Timestamp
+	0,   // 85: Superplane.Canvases.ListCanvasEventsRequest.order:type_name -> Superplane.Canvases.ListOrder
+	117, // 86: Superplane.Canvases.ListCanvasEventsRequest.payload:type_name -> Superplane.Canvases.ListCanvasEventsRequest.PayloadEntry
+	87,  // 87: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
+	118, // 88: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	121, // 89: Superplane.Canvases.CanvasMemory.values:type_name -> google.protobuf.Value
+	74,  // 90: Superplane.Canvases.ListCanvasMemoriesResponse.items:type_name -> Superplane.Canvases.CanvasMemory
+	79,  // 91: Superplane.Canvases.ListCanvasRoleBindingsResponse.bindings:type_name -> Superplane.Canvases.CanvasRoleBinding
+	79,  // 92: Superplane.Canvases.AssignCanvasRoleResponse.binding:type_name -> Superplane.Canvases.CanvasRoleBinding
+	119, // 93: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
+	118, // 94: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
+	119, // 95: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
+	118, // 96: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 97: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	66,  // 98: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	66,  // 99: Superplane.Canvases.ListStuckExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	96,  // 100: Superplane.Canvases.CanvasAiContext.nodes:type_name -> Superplane.Canvases.CanvasAiNodeContext
+	97,  // 101: Superplane.Canvases.CanvasAiContext.available_blocks:type_name -> Superplane.Canvases.CanvasAiBlockContext
+	98,  // 102: Superplane.Canvases.SendAiMessageRequest.canvas_context:type_name -> Superplane.Canvases.CanvasAiContext
+	119, // 103: Superplane.Canvases.SendAiMessageResponse.operations:type_name -> google.protobuf.Struct
+	118, // 104: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
+	118, // 105: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	118, // 106: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
+	118, // 107: Superplane.Canvases.CanvasMessage.timestamp:type_name -> google.protobuf.Timestamp
+	118, // 108: Superplane.Canvases.CanvasVersionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	3,   // 109: Superplane.Canvases.CanvasDiff.NodeChange.type:type_name -> Superplane.Canvases.CanvasDiff.ChangeType
+	122, // 110: Superplane.Canvases.CanvasDiff.EdgeChange.edge:type_name -> Superplane.Components.Edge
+	3,   // 111: Superplane.Canvases.CanvasDiff.EdgeChange.type:type_name -> Superplane.Canvases.CanvasDiff.ChangeType
+	119, // 112: Superplane.Canvases.CanvasSimulation.Fixture.data:type_name -> google.protobuf.Struct
+	4,   // 113: Superplane.Canvases.CanvasSimulation.Step.source:type_name -> Superplane.Canvases.CanvasSimulation.Source
+	5,   // 114: Superplane.Canvases.CanvasSimulation.Step.result:type_name -> Superplane.Canvases.CanvasSimulation.Result
+	119, // 115: Superplane.Canvases.CanvasSimulation.Step.configuration:type_name -> google.protobuf.Struct
+	119, // 116: Superplane.Canvases.CanvasSimulation.Step.outputs:type_name -> google.protobuf.Struct
+	118, // 117: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	118, // 118: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	44,  // 119: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
+	6,   // 120: Superplane.Canvases.Canvas.Metadata.priority:type_name -> Superplane.Canvases.Canvas.Priority
+	120, // 121: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
+	122, // 122: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
+	66,  // 123: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	67,  // 124: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	86,  // 125: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
+	44,  // 126: Superplane.Canvases.CanvasVersion.Metadata.owner:type_name -> Superplane.Canvases.UserRef
+	118, // 127: Superplane.Canvases.CanvasVersion.Metadata.published_at:type_name -> google.protobuf.Timestamp
+	118, // 128: Superplane.Canvases.CanvasVersion.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	118, // 129: Superplane.Canvases.CanvasVersion.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	44,  // 130: Superplane.Canvases.CanvasChangeRequest.Metadata.owner:type_name -> Superplane.Canvases.UserRef
+	7,   // 131: Superplane.Canvases.CanvasChangeRequest.Metadata.status:type_name -> Superplane.Canvases.CanvasChangeRequest.Status
+	118, // 132: Superplane.Canvases.CanvasChangeRequest.Metadata.published_at:type_name -> google.protobuf.Timestamp
+	118, // 133: Superplane.Canvases.CanvasChangeRequest.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	118, // 134: Superplane.Canvases.CanvasChangeRequest.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	11,  // 135: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
+	15,  // 136: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
+	13,  // 137: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
+	18,  // 138: Superplane.Canvases.Canvases.CreateCanvasVersion:input_type -> Superplane.Canvases.CreateCanvasVersionRequest
+	20,  // 139: Superplane.Canvases.Canvases.ListCanvasVersions:input_type -> Superplane.Canvases.ListCanvasVersionsRequest
+	22,  // 140: Superplane.Canvases.Canvases.DescribeCanvasVersion:input_type -> Superplane.Canvases.DescribeCanvasVersionRequest
+	24,  // 141: Superplane.Canvases.Canvases.UpdateCanvasVersion:input_type -> Superplane.Canvases.UpdateCanvasVersionRequest
+	26,  // 142: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:input_type -> Superplane.Canvases.CreateCanvasChangeRequestRequest
+	28,  // 143: Superplane.Canvases.Canvases.ListCanvasChangeRequests:input_type -> Superplane.Canvases.ListCanvasChangeRequestsRequest
+	30,  // 144: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:input_type -> Superplane.Canvases.DescribeCanvasChangeRequestRequest
+	32,  // 145: Superplane.Canvases.Canvases.PublishCanvasChangeRequest:input_type -> Superplane.Canvases.PublishCanvasChangeRequestRequest
+	38,  // 146: Superplane.Canvases.Canvases.DiffCanvas:input_type -> Superplane.Canvases.DiffCanvasRequest
+	41,  // 147: Superplane.Canvases.Canvases.SimulateCanvas:input_type -> Superplane.Canvases.SimulateCanvasRequest
+	34,  // 148: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
+	36,  // 149: Superplane.Canvases.Canvases.UpdateCanvasPriority:input_type -> Superplane.Canvases.UpdateCanvasPriorityRequest
+	53,  // 150: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
+	55,  // 151: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
+	57,  // 152: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
+	59,  // 153: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
+	49,  // 154: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
+	51,  // 155: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
+	68,  // 156: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
+	70,  // 157: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
+	61,  // 158: Superplane.Canvases.Canvases.DescribeNodeExecution:input_type -> Superplane.Canvases.DescribeNodeExecutionRequest
+	64,  // 159: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
+	90,  // 160: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
+	92,  // 161: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
+	94,  // 162: Superplane.Canvases.Canvases.ListStuckExecutions:input_type -> Superplane.Canvases.ListStuckExecutionsRequest
+	72,  // 163: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
+	75,  // 164: Superplane.Canvases.Canvases.ListCanvasMemories:input_type -> Superplane.Canvases.ListCanvasMemoriesRequest
+	77,  // 165: Superplane.Canvases.Canvases.DeleteCanvasMemory:input_type -> Superplane.Canvases.DeleteCanvasMemoryRequest
+	80,  // 166: Superplane.Canvases.Canvases.ListCanvasRoleBindings:input_type -> Superplane.Canvases.ListCanvasRoleBindingsRequest
+	82,  // 167: Superplane.Canvases.Canvases.AssignCanvasRole:input_type -> Superplane.Canvases.AssignCanvasRoleRequest
+	84,  // 168: Superplane.Canvases.Canvases.RemoveCanvasRole:input_type -> Superplane.Canvases.RemoveCanvasRoleRequest
+	88,  // 169: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
+	99,  // 170: Superplane.Canvases.Canvases.SendAiMessage:input_type -> Superplane.Canvases.SendAiMessageRequest
+	12,  // 171: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
+	16,  // 172: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
+	14,  // 173: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
+	19,  // 174: Superplane.Canvases.Canvases.CreateCanvasVersion:output_type -> Superplane.Canvases.CreateCanvasVersionResponse
+	21,  // 175: Superplane.Canvases.Canvases.ListCanvasVersions:output_type -> Superplane.Canvases.ListCanvasVersionsResponse
+	23,  // 176: Superplane.Canvases.Canvases.DescribeCanvasVersion:output_type -> Superplane.Canvases.DescribeCanvasVersionResponse
+	25,  // 177: Superplane.Canvases.Canvases.UpdateCanvasVersion:output_type -> Superplane.Canvases.UpdateCanvasVersionResponse
+	27,  // 178: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:output_type -> Superplane.Canvases.CreateCanvasChangeRequestResponse
+	29,  // 179: Superplane.Canvases.Canvases.ListCanvasChangeRequests:output_type -> Superplane.Canvases.ListCanvasChangeRequestsResponse
+	31,  // 180: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:output_type -> Superplane.Canvases.DescribeCanvasChangeRequestResponse
+	33,  // 181: Superplane.Canvases.Canvases.PublishCanvasChangeRequest:output_type -> Superplane.Canvases.PublishCanvasChangeRequestResponse
+	39,  // 182: Superplane.Canvases.Canvases.DiffCanvas:output_type -> Superplane.Canvases.DiffCanvasResponse
+	42,  // 183: Superplane.Canvases.Canvases.SimulateCanvas:output_type -> Superplane.Canvases.SimulateCanvasResponse
+	35,  // 184: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
+	37,  // 185: Superplane.Canvases.Canvases.UpdateCanvasPriority:output_type -> Superplane.Canvases.UpdateCanvasPriorityResponse
+	54,  // 186: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
+	56,  // 187: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
+	58,  // 188: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
+	60,  // 189: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
+	50,  // 190: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
+	52,  // 191: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
+	69,  // 192: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
+	71,  // 193: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
+	62,  // 194: Superplane.Canvases.Canvases.DescribeNodeExecution:output_type -> Superplane.Canvases.DescribeNodeExecutionResponse
+	65,  // 195: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
+	91,  // 196: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
+	93,  // 197: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
+	95,  // 198: Superplane.Canvases.Canvases.ListStuckExecutions:output_type -> Superplane.Canvases.ListStuckExecutionsResponse
+	73,  // 199: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
+	76,  // 200: Superplane.Canvases.Canvases.ListCanvasMemories:output_type -> Superplane.Canvases.ListCanvasMemoriesResponse
+	78,  // 201: Superplane.Canvases.Canvases.DeleteCanvasMemory:output_type -> Superplane.Canvases.DeleteCanvasMemoryResponse
+	81,  // 202: Superplane.Canvases.Canvases.ListCanvasRoleBindings:output_type -> Superplane.Canvases.ListCanvasRoleBindingsResponse
+	83,  // 203: Superplane.Canvases.Canvases.AssignCanvasRole:output_type -> Superplane.Canvases.AssignCanvasRoleResponse
+	85,  // 204: Superplane.Canvases.Canvases.RemoveCanvasRole:output_type -> Superplane.Canvases.RemoveCanvasRoleResponse
+	89,  // 205: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
+	100, // 206: Superplane.Canvases.Canvases.SendAiMessage:output_type -> Superplane.Canvases.SendAiMessageResponse
+	171, // [171:207] is the sub-list for method output_type
+	135, // [135:171] is the sub-list for method input_type
+	135, // [135:135] is the sub-list for extension type_name
+	135, // [135:135] is the sub-list for extension extendee
+	0,   // [0:135] is the sub-list for field type_name
 }
 
 func init() { file_canvases_proto_init() }
@@ -5682,8 +7790,8 @@ func file_canvases_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_canvases_proto_rawDesc), len(file_canvases_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   78,
+			NumEnums:      11,
+			NumMessages:   107,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,7 +29,11 @@ const (
 	Canvases_CreateCanvasChangeRequest_FullMethodName   = "/Superplane.Canvases.Canvases/CreateCanvasChangeRequest"
 	Canvases_ListCanvasChangeRequests_FullMethodName    = "/Superplane.Canvases.Canvases/ListCanvasChangeRequests"
 	Canvases_DescribeCanvasChangeRequest_FullMethodName = "/Superplane.Canvases.Canvases/DescribeCanvasChangeRequest"
+	Canvases_PublishCanvasChangeRequest_FullMethodName  = "/Superplane.Canvases.Canvases/PublishCanvasChangeRequest"
+	Canvases_DiffCanvas_FullMethodName                  = "/Superplane.Canvases.Canvases/DiffCanvas"
+	Canvases_SimulateCanvas_FullMethodName              = "/Superplane.Canvases.Canvases/SimulateCanvas"
 	Canvases_DeleteCanvas_FullMethodName                = "/Superplane.Canvases.Canvases/DeleteCanvas"
+	Canvases_UpdateCanvasPriority_FullMethodName        = "/Superplane.Canvases.Canvases/UpdateCanvasPriority"
 	Canvases_ListNodeQueueItems_FullMethodName          = "/Superplane.Canvases.Canvases/ListNodeQueueItems"
 	Canvases_DeleteNodeQueueItem_FullMethodName         = "/Superplane.Canvases.Canvases/DeleteNodeQueueItem"
 	Canvases_UpdateNodePause_FullMethodName             = "/Superplane.Canvases.Canvases/UpdateNodePause"
@@ -38,12 +42,17 @@ const (
 	Canvases_EmitNodeEvent_FullMethodName               = "/Superplane.Canvases.Canvases/EmitNodeEvent"
 	Canvases_InvokeNodeExecutionAction_FullMethodName   = "/Superplane.Canvases.Canvases/InvokeNodeExecutionAction"
 	Canvases_InvokeNodeTriggerAction_FullMethodName     = "/Superplane.Canvases.Canvases/InvokeNodeTriggerAction"
+	Canvases_DescribeNodeExecution_FullMethodName       = "/Superplane.Canvases.Canvases/DescribeNodeExecution"
 	Canvases_ListChildExecutions_FullMethodName         = "/Superplane.Canvases.Canvases/ListChildExecutions"
 	Canvases_CancelExecution_FullMethodName             = "/Superplane.Canvases.Canvases/CancelExecution"
 	Canvases_ResolveExecutionErrors_FullMethodName      = "/Superplane.Canvases.Canvases/ResolveExecutionErrors"
+	Canvases_ListStuckExecutions_FullMethodName         = "/Superplane.Canvases.Canvases/ListStuckExecutions"
 	Canvases_ListCanvasEvents_FullMethodName            = "/Superplane.Canvases.Canvases/ListCanvasEvents"
 	Canvases_ListCanvasMemories_FullMethodName          = "/Superplane.Canvases.Canvases/ListCanvasMemories"
 	Canvases_DeleteCanvasMemory_FullMethodName          = "/Superplane.Canvases.Canvases/DeleteCanvasMemory"
+	Canvases_ListCanvasRoleBindings_FullMethodName      = "/Superplane.Canvases.Canvases/ListCanvasRoleBindings"
+	Canvases_AssignCanvasRole_FullMethodName            = "/Superplane.Canvases.Canvases/AssignCanvasRole"
+	Canvases_RemoveCanvasRole_FullMethodName            = "/Superplane.Canvases.Canvases/RemoveCanvasRole"
 	Canvases_ListEventExecutions_FullMethodName         = "/Superplane.Canvases.Canvases/ListEventExecutions"
 	Canvases_SendAiMessage_FullMethodName               = "/Superplane.Canvases.Canvases/SendAiMessage"
 )
@@ -62,7 +71,11 @@ type CanvasesClient interface {
 	CreateCanvasChangeRequest(ctx context.Context, in *CreateCanvasChangeRequestRequest, opts ...grpc.CallOption) (*CreateCanvasChangeRequestResponse, error)
 	ListCanvasChangeRequests(ctx context.Context, in *ListCanvasChangeRequestsRequest, opts ...grpc.CallOption) (*ListCanvasChangeRequestsResponse, error)
 	DescribeCanvasChangeRequest(ctx context.Context, in *DescribeCanvasChangeRequestRequest, opts ...grpc.CallOption) (*DescribeCanvasChangeRequestResponse, error)
+	PublishCanvasChangeRequest(ctx context.Context, in *PublishCanvasChangeRequestRequest, opts ...grpc.CallOption) (*PublishCanvasChangeRequestResponse, error)
+	DiffCanvas(ctx context.Context, in *DiffCanvasRequest, opts ...grpc.CallOption) (*DiffCanvasResponse, error)
+	SimulateCanvas(ctx context.Context, in *SimulateCanvasRequest, opts ...grpc.CallOption) (*SimulateCanvasResponse, error)
 	DeleteCanvas(ctx context.Context, in *DeleteCanvasRequest, opts ...grpc.CallOption) (*DeleteCanvasResponse, error)
+	UpdateCanvasPriority(ctx context.Context, in *UpdateCanvasPriorityRequest, opts ...grpc.CallOption) (*UpdateCanvasPriorityResponse, error)
 	ListNodeQueueItems(ctx context.Context, in *ListNodeQueueItemsRequest, opts ...grpc.CallOption) (*ListNodeQueueItemsResponse, error)
 	DeleteNodeQueueItem(ctx context.Context, in *DeleteNodeQueueItemRequest, opts ...grpc.CallOption) (*DeleteNodeQueueItemResponse, error)
 	UpdateNodePause(ctx context.Context, in *UpdateNodePauseRequest, opts ...grpc.CallOption) (*UpdateNodePauseResponse, error)
@@ -71,12 +84,17 @@ type CanvasesClient interface {
 	EmitNodeEvent(ctx context.Context, in *EmitNodeEventRequest, opts ...grpc.CallOption) (*EmitNodeEventResponse, error)
 	InvokeNodeExecutionAction(ctx context.Context, in *InvokeNodeExecutionActionRequest, opts ...grpc.CallOption) (*InvokeNodeExecutionActionResponse, error)
 	InvokeNodeTriggerAction(ctx context.Context, in *InvokeNodeTriggerActionRequest, opts ...grpc.CallOption) (*InvokeNodeTriggerActionResponse, error)
+	DescribeNodeExecution(ctx context.Context, in *DescribeNodeExecutionRequest, opts ...grpc.CallOption) (*DescribeNodeExecutionResponse, error)
 	ListChildExecutions(ctx context.Context, in *ListChildExecutionsRequest, opts ...grpc.CallOption) (*ListChildExecutionsResponse, error)
 	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error)
 	ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error)
+	ListStuckExecutions(ctx context.Context, in *ListStuckExecutionsRequest, opts ...grpc.CallOption) (*ListStuckExecutionsResponse, error)
 	ListCanvasEvents(ctx context.Context, in *ListCanvasEventsRequest, opts ...grpc.CallOption) (*ListCanvasEventsResponse, error)
 	ListCanvasMemories(ctx context.Context, in *ListCanvasMemoriesRequest, opts ...grpc.CallOption) (*ListCanvasMemoriesResponse, error)
 	DeleteCanvasMemory(ctx context.Context, in *DeleteCanvasMemoryRequest, opts ...grpc.CallOption) (*DeleteCanvasMemoryResponse, error)
+	ListCanvasRoleBindings(ctx context.Context, in *ListCanvasRoleBindingsRequest, opts ...grpc.CallOption) (*ListCanvasRoleBindingsResponse, error)
+	AssignCanvasRole(ctx context.Context, in *AssignCanvasRoleRequest, opts ...grpc.CallOption) (*AssignCanvasRoleResponse, error)
+	RemoveCanvasRole(ctx context.Context, in *RemoveCanvasRoleRequest, opts ...grpc.CallOption) (*RemoveCanvasRoleResponse, error)
 	ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error)
 	SendAiMessage(ctx context.Context, in *SendAiMessageRequest, opts ...grpc.CallOption) (*SendAiMessageResponse, error)
 }
@@ -189,6 +207,36 @@ func (c *canvasesClient) DescribeCanvasChangeRequest(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *canvasesClient) PublishCanvasChangeRequest(ctx context.Context, in *PublishCanvasChangeRequestRequest, opts ...grpc.CallOption) (*PublishCanvasChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishCanvasChangeRequestResponse)
+	err := c.cc.Invoke(ctx, Canvases_PublishCanvasChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DiffCanvas(ctx context.Context, in *DiffCanvasRequest, opts ...grpc.CallOption) (*DiffCanvasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffCanvasResponse)
+	err := c.cc.Invoke(ctx, Canvases_DiffCanvas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) SimulateCanvas(ctx context.Context, in *SimulateCanvasRequest, opts ...grpc.CallOption) (*SimulateCanvasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateCanvasResponse)
+	err := c.cc.Invoke(ctx, Canvases_SimulateCanvas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) DeleteCanvas(ctx context.Context, in *DeleteCanvasRequest, opts ...grpc.CallOption) (*DeleteCanvasResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteCanvasResponse)
@@ -199,6 +247,16 @@ func (c *canvasesClient) DeleteCanvas(ctx context.Context, in *DeleteCanvasReque
 	return out, nil
 }
 
+func (c *canvasesClient) UpdateCanvasPriority(ctx context.Context, in *UpdateCanvasPriorityRequest, opts ...grpc.CallOption) (*UpdateCanvasPriorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasPriorityResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListNodeQueueItems(ctx context.Context, in *ListNodeQueueItemsRequest, opts ...grpc.CallOption) (*ListNodeQueueItemsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListNodeQueueItemsResponse)
@@ -279,6 +337,16 @@ func (c *canvasesClient) InvokeNodeTriggerAction(ctx context.Context, in *Invoke
 	return out, nil
 }
 
+func (c *canvasesClient) DescribeNodeExecution(ctx context.Context, in *DescribeNodeExecutionRequest, opts ...grpc.CallOption) (*DescribeNodeExecutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeNodeExecutionResponse)
+	err := c.cc.Invoke(ctx, Canvases_DescribeNodeExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListChildExecutions(ctx context.Context, in *ListChildExecutionsRequest, opts ...grpc.CallOption) (*ListChildExecutionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListChildExecutionsResponse)
@@ -309,6 +377,16 @@ func (c *canvasesClient) ResolveExecutionErrors(ctx context.Context, in *Resolve
 	return out, nil
 }
 
+func (c *canvasesClient) ListStuckExecutions(ctx context.Context, in *ListStuckExecutionsRequest, opts ...grpc.CallOption) (*ListStuckExecutionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStuckExecutionsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListStuckExecutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListCanvasEvents(ctx context.Context, in *ListCanvasEventsRequest, opts ...grpc.CallOption) (*ListCanvasEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListCanvasEventsResponse)
@@ -339,6 +417,36 @@ func (c *canvasesClient) DeleteCanvasMemory(ctx context.Context, in *DeleteCanva
 	return out, nil
 }
 
+func (c *canvasesClient) ListCanvasRoleBindings(ctx context.Context, in *ListCanvasRoleBindingsRequest, opts ...grpc.CallOption) (*ListCanvasRoleBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCanvasRoleBindingsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListCanvasRoleBindings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) AssignCanvasRole(ctx context.Context, in *AssignCanvasRoleRequest, opts ...grpc.CallOption) (*AssignCanvasRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignCanvasRoleResponse)
+	err := c.cc.Invoke(ctx, Canvases_AssignCanvasRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) RemoveCanvasRole(ctx context.Context, in *RemoveCanvasRoleRequest, opts ...grpc.CallOption) (*RemoveCanvasRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCanvasRoleResponse)
+	err := c.cc.Invoke(ctx, Canvases_RemoveCanvasRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListEventExecutionsResponse)
@@ -373,7 +481,11 @@ type CanvasesServer interface {
 	CreateCanvasChangeRequest(context.Context, *CreateCanvasChangeRequestRequest) (*CreateCanvasChangeRequestResponse, error)
 	ListCanvasChangeRequests(context.Context, *ListCanvasChangeRequestsRequest) (*ListCanvasChangeRequestsResponse, error)
 	DescribeCanvasChangeRequest(context.Context, *DescribeCanvasChangeRequestRequest) (*DescribeCanvasChangeRequestResponse, error)
+	PublishCanvasChangeRequest(context.Context, *PublishCanvasChangeRequestRequest) (*PublishCanvasChangeRequestResponse, error)
+	DiffCanvas(context.Context, *DiffCanvasRequest) (*DiffCanvasResponse, error)
+	SimulateCanvas(context.Context, *SimulateCanvasRequest) (*SimulateCanvasResponse, error)
 	DeleteCanvas(context.Context, *DeleteCanvasRequest) (*DeleteCanvasResponse, error)
+	UpdateCanvasPriority(context.Context, *UpdateCanvasPriorityRequest) (*UpdateCanvasPriorityResponse, error)
 	ListNodeQueueItems(context.Context, *ListNodeQueueItemsRequest) (*ListNodeQueueItemsResponse, error)
 	DeleteNodeQueueItem(context.Context, *DeleteNodeQueueItemRequest) (*DeleteNodeQueueItemResponse, error)
 	UpdateNodePause(context.Context, *UpdateNodePauseRequest) (*UpdateNodePauseResponse, error)
@@ -382,12 +494,17 @@ type CanvasesServer interface {
 	EmitNodeEvent(context.Context, *EmitNodeEventRequest) (*EmitNodeEventResponse, error)
 	InvokeNodeExecutionAction(context.Context, *InvokeNodeExecutionActionRequest) (*InvokeNodeExecutionActionResponse, error)
 	InvokeNodeTriggerAction(context.Context, *InvokeNodeTriggerActionRequest) (*InvokeNodeTriggerActionResponse, error)
+	DescribeNodeExecution(context.Context, *DescribeNodeExecutionRequest) (*DescribeNodeExecutionResponse, error)
 	ListChildExecutions(context.Context, *ListChildExecutionsRequest) (*ListChildExecutionsResponse, error)
 	CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error)
 	ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error)
+	ListStuckExecutions(context.Context, *ListStuckExecutionsRequest) (*ListStuckExecutionsResponse, error)
 	ListCanvasEvents(context.Context, *ListCanvasEventsRequest) (*ListCanvasEventsResponse, error)
 	ListCanvasMemories(context.Context, *ListCanvasMemoriesRequest) (*ListCanvasMemoriesResponse, error)
 	DeleteCanvasMemory(context.Context, *DeleteCanvasMemoryRequest) (*DeleteCanvasMemoryResponse, error)
+	ListCanvasRoleBindings(context.Context, *ListCanvasRoleBindingsRequest) (*ListCanvasRoleBindingsResponse, error)
+	AssignCanvasRole(context.Context, *AssignCanvasRoleRequest) (*AssignCanvasRoleResponse, error)
+	RemoveCanvasRole(context.Context, *RemoveCanvasRoleRequest) (*RemoveCanvasRoleResponse, error)
 	ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error)
 	SendAiMessage(context.Context, *SendAiMessageRequest) (*SendAiMessageResponse, error)
 }
@@ -429,9 +546,21 @@ func (UnimplementedCanvasesServer) ListCanvasChangeRequests(context.Context, *Li
 func (UnimplementedCanvasesServer) DescribeCanvasChangeRequest(context.Context, *DescribeCanvasChangeRequestRequest) (*DescribeCanvasChangeRequestResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DescribeCanvasChangeRequest not implemented")
 }
+func (UnimplementedCanvasesServer) PublishCanvasChangeRequest(context.Context, *PublishCanvasChangeRequestRequest) (*PublishCanvasChangeRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishCanvasChangeRequest not implemented")
+}
+func (UnimplementedCanvasesServer) DiffCanvas(context.Context, *DiffCanvasRequest) (*DiffCanvasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffCanvas not implemented")
+}
+func (UnimplementedCanvasesServer) SimulateCanvas(context.Context, *SimulateCanvasRequest) (*SimulateCanvasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateCanvas not implemented")
+}
 func (UnimplementedCanvasesServer) DeleteCanvas(context.Context, *DeleteCanvasRequest) (*DeleteCanvasResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCanvas not implemented")
 }
+func (UnimplementedCanvasesServer) UpdateCanvasPriority(context.Context, *UpdateCanvasPriorityRequest) (*UpdateCanvasPriorityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasPriority not implemented")
+}
 func (UnimplementedCanvasesServer) ListNodeQueueItems(context.Context, *ListNodeQueueItemsRequest) (*ListNodeQueueItemsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListNodeQueueItems not implemented")
 }
@@ -456,6 +585,9 @@ func (UnimplementedCanvasesServer) InvokeNodeExecutionAction(context.Context, *I
 func (UnimplementedCanvasesServer) InvokeNodeTriggerAction(context.Context, *InvokeNodeTriggerActionRequest) (*InvokeNodeTriggerActionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InvokeNodeTriggerAction not implemented")
 }
+func (UnimplementedCanvasesServer) DescribeNodeExecution(context.Context, *DescribeNodeExecutionRequest) (*DescribeNodeExecutionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeNodeExecution not implemented")
+}
 func (UnimplementedCanvasesServer) ListChildExecutions(context.Context, *ListChildExecutionsRequest) (*ListChildExecutionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListChildExecutions not implemented")
 }
@@ -465,6 +597,9 @@ func (UnimplementedCanvasesServer) CancelExecution(context.Context, *CancelExecu
 func (UnimplementedCanvasesServer) ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResolveExecutionErrors not implemented")
 }
+func (UnimplementedCanvasesServer) ListStuckExecutions(context.Context, *ListStuckExecutionsRequest) (*ListStuckExecutionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListStuckExecutions not implemented")
+}
 func (UnimplementedCanvasesServer) ListCanvasEvents(context.Context, *ListCanvasEventsRequest) (*ListCanvasEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListCanvasEvents not implemented")
 }
@@ -474,6 +609,15 @@ func (UnimplementedCanvasesServer) ListCanvasMemories(context.Context, *ListCanv
 func (UnimplementedCanvasesServer) DeleteCanvasMemory(context.Context, *DeleteCanvasMemoryRequest) (*DeleteCanvasMemoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCanvasMemory not implemented")
 }
+func (UnimplementedCanvasesServer) ListCanvasRoleBindings(context.Context, *ListCanvasRoleBindingsRequest) (*ListCanvasRoleBindingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCanvasRoleBindings not implemented")
+}
+func (UnimplementedCanvasesServer) AssignCanvasRole(context.Context, *AssignCanvasRoleRequest) (*AssignCanvasRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignCanvasRole not implemented")
+}
+func (UnimplementedCanvasesServer) RemoveCanvasRole(context.Context, *RemoveCanvasRoleRequest) (*RemoveCanvasRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveCanvasRole not implemented")
+}
 func (UnimplementedCanvasesServer) ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEventExecutions not implemented")
 }
@@ -680,6 +824,60 @@ func _Canvases_DescribeCanvasChangeRequest_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_PublishCanvasChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishCanvasChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).PublishCanvasChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_PublishCanvasChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).PublishCanvasChangeRequest(ctx, req.(*PublishCanvasChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DiffCanvas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCanvasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DiffCanvas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DiffCanvas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DiffCanvas(ctx, req.(*DiffCanvasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_SimulateCanvas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateCanvasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).SimulateCanvas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_SimulateCanvas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).SimulateCanvas(ctx, req.(*SimulateCanvasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_DeleteCanvas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCanvasRequest)
 	if err := dec(in); err != nil {
@@ -698,6 +896,24 @@ func _Canvases_DeleteCanvas_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_UpdateCanvasPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasPriority(ctx, req.(*UpdateCanvasPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListNodeQueueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListNodeQueueItemsRequest)
 	if err := dec(in); err != nil {
@@ -842,6 +1058,24 @@ func _Canvases_InvokeNodeTriggerAction_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_DescribeNodeExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNodeExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DescribeNodeExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DescribeNodeExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DescribeNodeExecution(ctx, req.(*DescribeNodeExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListChildExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListChildExecutionsRequest)
 	if err := dec(in); err != nil {
@@ -896,6 +1130,24 @@ func _Canvases_ResolveExecutionErrors_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_ListStuckExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStuckExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListStuckExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListStuckExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListStuckExecutions(ctx, req.(*ListStuckExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListCanvasEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCanvasEventsRequest)
 	if err := dec(in); err != nil {
@@ -950,6 +1202,60 @@ func _Canvases_DeleteCanvasMemory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_ListCanvasRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanvasRoleBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListCanvasRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListCanvasRoleBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListCanvasRoleBindings(ctx, req.(*ListCanvasRoleBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_AssignCanvasRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCanvasRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).AssignCanvasRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_AssignCanvasRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).AssignCanvasRole(ctx, req.(*AssignCanvasRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_RemoveCanvasRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCanvasRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RemoveCanvasRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RemoveCanvasRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RemoveCanvasRole(ctx, req.(*RemoveCanvasRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListEventExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEventExecutionsRequest)
 	if err := dec(in); err != nil {
@@ -1033,10 +1339,26 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeCanvasChangeRequest",
 			Handler:    _Canvases_DescribeCanvasChangeRequest_Handler,
 		},
+		{
+			MethodName: "PublishCanvasChangeRequest",
+			Handler:    _Canvases_PublishCanvasChangeRequest_Handler,
+		},
+		{
+			MethodName: "DiffCanvas",
+			Handler:    _Canvases_DiffCanvas_Handler,
+		},
+		{
+			MethodName: "SimulateCanvas",
+			Handler:    _Canvases_SimulateCanvas_Handler,
+		},
 		{
 			MethodName: "DeleteCanvas",
 			Handler:    _Canvases_DeleteCanvas_Handler,
 		},
+		{
+			MethodName: "UpdateCanvasPriority",
+			Handler:    _Canvases_UpdateCanvasPriority_Handler,
+		},
 		{
 			MethodName: "ListNodeQueueItems",
 			Handler:    _Canvases_ListNodeQueueItems_Handler,
@@ -1069,6 +1391,10 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InvokeNodeTriggerAction",
 			Handler:    _Canvases_InvokeNodeTriggerAction_Handler,
 		},
+		{
+			MethodName: "DescribeNodeExecution",
+			Handler:    _Canvases_DescribeNodeExecution_Handler,
+		},
 		{
 			MethodName: "ListChildExecutions",
 			Handler:    _Canvases_ListChildExecutions_Handler,
@@ -1081,6 +1407,10 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveExecutionErrors",
 			Handler:    _Canvases_ResolveExecutionErrors_Handler,
 		},
+		{
+			MethodName: "ListStuckExecutions",
+			Handler:    _Canvases_ListStuckExecutions_Handler,
+		},
 		{
 			MethodName: "ListCanvasEvents",
 			Handler:    _Canvases_ListCanvasEvents_Handler,
@@ -1093,6 +1423,18 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCanvasMemory",
 			Handler:    _Canvases_DeleteCanvasMemory_Handler,
 		},
+		{
+			MethodName: "ListCanvasRoleBindings",
+			Handler:    _Canvases_ListCanvasRoleBindings_Handler,
+		},
+		{
+			MethodName: "AssignCanvasRole",
+			Handler:    _Canvases_AssignCanvasRole_Handler,
+		},
+		{
+			MethodName: "RemoveCanvasRole",
+			Handler:    _Canvases_RemoveCanvasRole_Handler,
+		},
 		{
 			MethodName: "ListEventExecutions",
 			Handler:    _Canvases_ListEventExecutions_Handler,
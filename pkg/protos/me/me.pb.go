@@ -145,6 +145,344 @@ func (x *RegenerateTokenResponse) GetToken() string {
 	return ""
 }
 
+// Scopes are permissions, as resource:action, e.g. canvases:read.
+// A token without scopes has all the permissions of its user.
+type ApiToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,4,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiToken) Reset() {
+	*x = ApiToken{}
+	mi := &file_me_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiToken) ProtoMessage() {}
+
+func (x *ApiToken) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiToken.ProtoReflect.Descriptor instead.
+func (*ApiToken) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApiToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiToken) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ApiToken) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiToken) GetLastUsedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ApiToken) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*ApiToken            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokensResponse) Reset() {
+	*x = ListTokensResponse{}
+	mi := &file_me_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensResponse) ProtoMessage() {}
+
+func (x *ListTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListTokensResponse) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTokensResponse) GetTokens() []*ApiToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type CreateTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,3,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenRequest) Reset() {
+	*x = CreateTokenRequest{}
+	mi := &file_me_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenRequest) ProtoMessage() {}
+
+func (x *CreateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateTokenRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CreateTokenRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiToken      *ApiToken              `protobuf:"bytes,1,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenResponse) Reset() {
+	*x = CreateTokenResponse{}
+	mi := &file_me_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenResponse) ProtoMessage() {}
+
+func (x *CreateTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateTokenResponse) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTokenResponse) GetApiToken() *ApiToken {
+	if x != nil {
+		return x.ApiToken
+	}
+	return nil
+}
+
+func (x *CreateTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenRequest) Reset() {
+	*x = RevokeTokenRequest{}
+	mi := &file_me_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenRequest) ProtoMessage() {}
+
+func (x *RevokeTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevokeTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenResponse) Reset() {
+	*x = RevokeTokenResponse{}
+	mi := &file_me_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenResponse) ProtoMessage() {}
+
+func (x *RevokeTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{7}
+}
+
 var File_me_proto protoreflect.FileDescriptor
 
 const file_me_proto_rawDesc = "" +
@@ -158,13 +496,45 @@ const file_me_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
 	"\thas_token\x18\x05 \x01(\bR\bhasToken\"/\n" +
 	"\x17RegenerateTokenResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token2\xd2\x02\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x97\x02\n" +
+	"\bApiToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1b\n" +
+	"\tcanvas_id\x18\x04 \x01(\tR\bcanvasId\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"E\n" +
+	"\x12ListTokensResponse\x12/\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x17.Superplane.Me.ApiTokenR\x06tokens\"\x98\x01\n" +
+	"\x12CreateTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x1b\n" +
+	"\tcanvas_id\x18\x03 \x01(\tR\bcanvasId\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"a\n" +
+	"\x13CreateTokenResponse\x124\n" +
+	"\tapi_token\x18\x01 \x01(\v2\x17.Superplane.Me.ApiTokenR\bapiToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"$\n" +
+	"\x12RevokeTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13RevokeTokenResponse2\xae\a\n" +
 	"\x02Me\x12\x88\x01\n" +
 	"\x02Me\x12\x16.google.protobuf.Empty\x1a\x13.Superplane.Me.User\"U\x92A@\n" +
 	"\x02Me\x12\x10Get current user\x1a(Returns the currently authenticated user\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/api/v1/me\x12\xc0\x01\n" +
 	"\x0fRegenerateToken\x12\x16.google.protobuf.Empty\x1a&.Superplane.Me.RegenerateTokenResponse\"m\x92AR\n" +
-	"\x02Me\x12\x14Regenerate API token\x1a6Regenerates the currently authencated user's API token\x82\xd3\xe4\x93\x02\x12\"\x10/api/v1/me/tokenB\x9e\x01\x92Ai\x12?\n" +
+	"\x02Me\x12\x14Regenerate API token\x1a6Regenerates the currently authencated user's API token\x82\xd3\xe4\x93\x02\x12\"\x10/api/v1/me/token\x12\xbc\x01\n" +
+	"\n" +
+	"ListTokens\x12\x16.google.protobuf.Empty\x1a!.Superplane.Me.ListTokensResponse\"s\x92AW\n" +
+	"\x02Me\x12\x0fList API tokens\x1a@Returns the named API tokens of the currently authenticated user\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/me/tokens\x12\xcb\x01\n" +
+	"\vCreateToken\x12!.Superplane.Me.CreateTokenRequest\x1a\".Superplane.Me.CreateTokenResponse\"u\x92AV\n" +
+	"\x02Me\x12\x10Create API token\x1a>Creates a named API token for the currently authenticated user\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/me/tokens\x12\xcc\x01\n" +
+	"\vRevokeToken\x12!.Superplane.Me.RevokeTokenRequest\x1a\".Superplane.Me.RevokeTokenResponse\"v\x92AU\n" +
+	"\x02Me\x12\x10Revoke API token\x1a=Revokes a named API token of the currently authenticated user\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/me/tokens/{id}B\x9e\x01\x92Ai\x12?\n" +
 	"\x11Superplane Me API\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ0github.com/superplanehq/superplane/pkg/protos/meb\x06proto3"
 
@@ -180,24 +550,42 @@ func file_me_proto_rawDescGZIP() []byte {
 	return file_me_proto_rawDescData
 }
 
-var file_me_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_me_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_me_proto_goTypes = []any{
 	(*User)(nil),                    // 0: Superplane.Me.User
 	(*RegenerateTokenResponse)(nil), // 1: Superplane.Me.RegenerateTokenResponse
-	(*timestamp.Timestamp)(nil),     // 2: google.protobuf.Timestamp
-	(*empty.Empty)(nil),             // 3: google.protobuf.Empty
+	(*ApiToken)(nil),                // 2: Superplane.Me.ApiToken
+	(*ListTokensResponse)(nil),      // 3: Superplane.Me.ListTokensResponse
+	(*CreateTokenRequest)(nil),      // 4: Superplane.Me.CreateTokenRequest
+	(*CreateTokenResponse)(nil),     // 5: Superplane.Me.CreateTokenResponse
+	(*RevokeTokenRequest)(nil),      // 6: Superplane.Me.RevokeTokenRequest
+	(*RevokeTokenResponse)(nil),     // 7: Superplane.Me.RevokeTokenResponse
+	(*timestamp.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+	(*empty.Empty)(nil),             // 9: google.protobuf.Empty
 }
 var file_me_proto_depIdxs = []int32{
-	2, // 0: Superplane.Me.User.created_at:type_name -> google.protobuf.Timestamp
-	3, // 1: Superplane.Me.Me.Me:input_type -> google.protobuf.Empty
-	3, // 2: Superplane.Me.Me.RegenerateToken:input_type -> google.protobuf.Empty
-	0, // 3: Superplane.Me.Me.Me:output_type -> Superplane.Me.User
-	1, // 4: Superplane.Me.Me.RegenerateToken:output_type -> Superplane.Me.RegenerateTokenResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: Superplane.Me.User.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 1: Superplane.Me.ApiToken.expires_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: Superplane.Me.ApiToken.last_used_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: Superplane.Me.ApiToken.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: Superplane.Me.ListTokensResponse.tokens:type_name -> Superplane.Me.ApiToken
+	8,  // 5: Superplane.Me.CreateTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 6: Superplane.Me.CreateTokenResponse.api_token:type_name -> Superplane.Me.ApiToken
+	9,  // 7: Superplane.Me.Me.Me:input_type -> google.protobuf.Empty
+	9,  // 8: Superplane.Me.Me.RegenerateToken:input_type -> google.protobuf.Empty
+	9,  // 9: Superplane.Me.Me.ListTokens:input_type -> google.protobuf.Empty
+	4,  // 10: Superplane.Me.Me.CreateToken:input_type -> Superplane.Me.CreateTokenRequest
+	6,  // 11: Superplane.Me.Me.RevokeToken:input_type -> Superplane.Me.RevokeTokenRequest
+	0,  // 12: Superplane.Me.Me.Me:output_type -> Superplane.Me.User
+	1,  // 13: Superplane.Me.Me.RegenerateToken:output_type -> Superplane.Me.RegenerateTokenResponse
+	3,  // 14: Superplane.Me.Me.ListTokens:output_type -> Superplane.Me.ListTokensResponse
+	5,  // 15: Superplane.Me.Me.CreateToken:output_type -> Superplane.Me.CreateTokenResponse
+	7,  // 16: Superplane.Me.Me.RevokeToken:output_type -> Superplane.Me.RevokeTokenResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_me_proto_init() }
@@ -211,7 +599,7 @@ func file_me_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_me_proto_rawDesc), len(file_me_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,16 +22,31 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Me_Me_FullMethodName              = "/Superplane.Me.Me/Me"
 	Me_RegenerateToken_FullMethodName = "/Superplane.Me.Me/RegenerateToken"
+	Me_ListTokens_FullMethodName      = "/Superplane.Me.Me/ListTokens"
+	Me_CreateToken_FullMethodName     = "/Superplane.Me.Me/CreateToken"
+	Me_RevokeToken_FullMethodName     = "/Superplane.Me.Me/RevokeToken"
 )
 
 // MeClient is the client API for Me service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MeClient interface {
+	//
 	// Endpoint for getting the currently authenticated user.
+	//
 	Me(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*User, error)
+	//
 	// Endpoint for regenerating the currently authenticated user's API token.
+	//
 	RegenerateToken(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RegenerateTokenResponse, error)
+	//
+	// Endpoints for managing the named API tokens of the currently authenticated user.
+	// Unlike the token above, a user can have many of them,
+	// and they can expire and be restricted to some permissions or a canvas.
+	//
+	ListTokens(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTokensResponse, error)
+	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
 }
 
 type meClient struct {
@@ -62,14 +77,56 @@ func (c *meClient) RegenerateToken(ctx context.Context, in *empty.Empty, opts ..
 	return out, nil
 }
 
+func (c *meClient) ListTokens(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTokensResponse)
+	err := c.cc.Invoke(ctx, Me_ListTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meClient) CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTokenResponse)
+	err := c.cc.Invoke(ctx, Me_CreateToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTokenResponse)
+	err := c.cc.Invoke(ctx, Me_RevokeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MeServer is the server API for Me service.
 // All implementations should embed UnimplementedMeServer
 // for forward compatibility.
 type MeServer interface {
+	//
 	// Endpoint for getting the currently authenticated user.
+	//
 	Me(context.Context, *empty.Empty) (*User, error)
+	//
 	// Endpoint for regenerating the currently authenticated user's API token.
+	//
 	RegenerateToken(context.Context, *empty.Empty) (*RegenerateTokenResponse, error)
+	//
+	// Endpoints for managing the named API tokens of the currently authenticated user.
+	// Unlike the token above, a user can have many of them,
+	// and they can expire and be restricted to some permissions or a canvas.
+	//
+	ListTokens(context.Context, *empty.Empty) (*ListTokensResponse, error)
+	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error)
 }
 
 // UnimplementedMeServer should be embedded to have
@@ -85,6 +142,15 @@ func (UnimplementedMeServer) Me(context.Context, *empty.Empty) (*User, error) {
 func (UnimplementedMeServer) RegenerateToken(context.Context, *empty.Empty) (*RegenerateTokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegenerateToken not implemented")
 }
+func (UnimplementedMeServer) ListTokens(context.Context, *empty.Empty) (*ListTokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTokens not implemented")
+}
+func (UnimplementedMeServer) CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateToken not implemented")
+}
+func (UnimplementedMeServer) RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeToken not implemented")
+}
 func (UnimplementedMeServer) testEmbeddedByValue() {}
 
 // UnsafeMeServer may be embedded to opt out of forward compatibility for this service.
@@ -141,6 +207,60 @@ func _Me_RegenerateToken_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Me_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Me_ListTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeServer).ListTokens(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Me_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeServer).CreateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Me_CreateToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeServer).CreateToken(ctx, req.(*CreateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Me_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Me_RevokeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Me_ServiceDesc is the grpc.ServiceDesc for Me service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -156,6 +276,18 @@ var Me_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegenerateToken",
 			Handler:    _Me_RegenerateToken_Handler,
 		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _Me_ListTokens_Handler,
+		},
+		{
+			MethodName: "CreateToken",
+			Handler:    _Me_CreateToken_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _Me_RevokeToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "me.proto",
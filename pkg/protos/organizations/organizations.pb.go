@@ -653,6 +653,274 @@ func (x *AgentSettings) GetOpenaiKey() *AgentOpenAIKey {
 	return nil
 }
 
+type SSOGroupMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdpGroup      string                 `protobuf:"bytes,1,opt,name=idp_group,json=idpGroup,proto3" json:"idp_group,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSOGroupMapping) Reset() {
+	*x = SSOGroupMapping{}
+	mi := &file_organizations_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOGroupMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOGroupMapping) ProtoMessage() {}
+
+func (x *SSOGroupMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOGroupMapping.ProtoReflect.Descriptor instead.
+func (*SSOGroupMapping) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SSOGroupMapping) GetIdpGroup() string {
+	if x != nil {
+		return x.IdpGroup
+	}
+	return ""
+}
+
+func (x *SSOGroupMapping) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type SSOConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Protocol       string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Enabled        bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enforced       bool                   `protobuf:"varint,4,opt,name=enforced,proto3" json:"enforced,omitempty"`
+	Oidc           *SSOConfig_OIDC        `protobuf:"bytes,5,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	Saml           *SSOConfig_SAML        `protobuf:"bytes,6,opt,name=saml,proto3" json:"saml,omitempty"`
+	GroupsClaim    string                 `protobuf:"bytes,7,opt,name=groups_claim,json=groupsClaim,proto3" json:"groups_claim,omitempty"`
+	GroupMappings  []*SSOGroupMapping     `protobuf:"bytes,8,rep,name=group_mappings,json=groupMappings,proto3" json:"group_mappings,omitempty"`
+	LoginUrl       string                 `protobuf:"bytes,9,opt,name=login_url,json=loginUrl,proto3" json:"login_url,omitempty"`
+	CallbackUrl    string                 `protobuf:"bytes,10,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	UpdatedAt      *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SSOConfig) Reset() {
+	*x = SSOConfig{}
+	mi := &file_organizations_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOConfig) ProtoMessage() {}
+
+func (x *SSOConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOConfig.ProtoReflect.Descriptor instead.
+func (*SSOConfig) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SSOConfig) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SSOConfig) GetEnforced() bool {
+	if x != nil {
+		return x.Enforced
+	}
+	return false
+}
+
+func (x *SSOConfig) GetOidc() *SSOConfig_OIDC {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetSaml() *SSOConfig_SAML {
+	if x != nil {
+		return x.Saml
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetGroupsClaim() string {
+	if x != nil {
+		return x.GroupsClaim
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetGroupMappings() []*SSOGroupMapping {
+	if x != nil {
+		return x.GroupMappings
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type SCIMConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Enabled         bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	BaseUrl         string                 `protobuf:"bytes,3,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	TokenCreatedAt  *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=token_created_at,json=tokenCreatedAt,proto3" json:"token_created_at,omitempty"`
+	TokenLastUsedAt *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=token_last_used_at,json=tokenLastUsedAt,proto3" json:"token_last_used_at,omitempty"`
+	TokenCreatedBy  string                 `protobuf:"bytes,6,opt,name=token_created_by,json=tokenCreatedBy,proto3" json:"token_created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SCIMConfig) Reset() {
+	*x = SCIMConfig{}
+	mi := &file_organizations_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SCIMConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCIMConfig) ProtoMessage() {}
+
+func (x *SCIMConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCIMConfig.ProtoReflect.Descriptor instead.
+func (*SCIMConfig) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SCIMConfig) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SCIMConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SCIMConfig) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *SCIMConfig) GetTokenCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.TokenCreatedAt
+	}
+	return nil
+}
+
+func (x *SCIMConfig) GetTokenLastUsedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.TokenLastUsedAt
+	}
+	return nil
+}
+
+func (x *SCIMConfig) GetTokenCreatedBy() string {
+	if x != nil {
+		return x.TokenCreatedBy
+	}
+	return ""
+}
+
 type CreateInvitationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -663,7 +931,7 @@ type CreateInvitationRequest struct {
 
 func (x *CreateInvitationRequest) Reset() {
 	*x = CreateInvitationRequest{}
-	mi := &file_organizations_proto_msgTypes[11]
+	mi := &file_organizations_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +943,7 @@ func (x *CreateInvitationRequest) String() string {
 func (*CreateInvitationRequest) ProtoMessage() {}
 
 func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[11]
+	mi := &file_organizations_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +956,7 @@ func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
 func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{11}
+	return file_organizations_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateInvitationRequest) GetId() string {
@@ -714,7 +982,7 @@ type CreateInvitationResponse struct {
 
 func (x *CreateInvitationResponse) Reset() {
 	*x = CreateInvitationResponse{}
-	mi := &file_organizations_proto_msgTypes[12]
+	mi := &file_organizations_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +994,7 @@ func (x *CreateInvitationResponse) String() string {
 func (*CreateInvitationResponse) ProtoMessage() {}
 
 func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[12]
+	mi := &file_organizations_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +1007,7 @@ func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInvitationResponse.ProtoReflect.Descriptor instead.
 func (*CreateInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{12}
+	return file_organizations_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateInvitationResponse) GetInvitation() *Invitation {
@@ -758,7 +1026,7 @@ type ListInvitationsRequest struct {
 
 func (x *ListInvitationsRequest) Reset() {
 	*x = ListInvitationsRequest{}
-	mi := &file_organizations_proto_msgTypes[13]
+	mi := &file_organizations_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -770,7 +1038,7 @@ func (x *ListInvitationsRequest) String() string {
 func (*ListInvitationsRequest) ProtoMessage() {}
 
 func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[13]
+	mi := &file_organizations_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,7 +1051,7 @@ func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
 func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{13}
+	return file_organizations_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListInvitationsRequest) GetId() string {
@@ -802,7 +1070,7 @@ type ListInvitationsResponse struct {
 
 func (x *ListInvitationsResponse) Reset() {
 	*x = ListInvitationsResponse{}
-	mi := &file_organizations_proto_msgTypes[14]
+	mi := &file_organizations_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +1082,7 @@ func (x *ListInvitationsResponse) String() string {
 func (*ListInvitationsResponse) ProtoMessage() {}
 
 func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[14]
+	mi := &file_organizations_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1095,7 @@ func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
 func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{14}
+	return file_organizations_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
@@ -847,7 +1115,7 @@ type RemoveInvitationRequest struct {
 
 func (x *RemoveInvitationRequest) Reset() {
 	*x = RemoveInvitationRequest{}
-	mi := &file_organizations_proto_msgTypes[15]
+	mi := &file_organizations_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +1127,7 @@ func (x *RemoveInvitationRequest) String() string {
 func (*RemoveInvitationRequest) ProtoMessage() {}
 
 func (x *RemoveInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[15]
+	mi := &file_organizations_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +1140,7 @@ func (x *RemoveInvitationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveInvitationRequest.ProtoReflect.Descriptor instead.
 func (*RemoveInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{15}
+	return file_organizations_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RemoveInvitationRequest) GetId() string {
@@ -897,7 +1165,7 @@ type RemoveInvitationResponse struct {
 
 func (x *RemoveInvitationResponse) Reset() {
 	*x = RemoveInvitationResponse{}
-	mi := &file_organizations_proto_msgTypes[16]
+	mi := &file_organizations_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +1177,7 @@ func (x *RemoveInvitationResponse) String() string {
 func (*RemoveInvitationResponse) ProtoMessage() {}
 
 func (x *RemoveInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[16]
+	mi := &file_organizations_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1190,7 @@ func (x *RemoveInvitationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveInvitationResponse.ProtoReflect.Descriptor instead.
 func (*RemoveInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{16}
+	return file_organizations_proto_rawDescGZIP(), []int{19}
 }
 
 type GetInviteLinkRequest struct {
@@ -934,7 +1202,7 @@ type GetInviteLinkRequest struct {
 
 func (x *GetInviteLinkRequest) Reset() {
 	*x = GetInviteLinkRequest{}
-	mi := &file_organizations_proto_msgTypes[17]
+	mi := &file_organizations_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -946,7 +1214,7 @@ func (x *GetInviteLinkRequest) String() string {
 func (*GetInviteLinkRequest) ProtoMessage() {}
 
 func (x *GetInviteLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[17]
+	mi := &file_organizations_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1227,7 @@ func (x *GetInviteLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInviteLinkRequest.ProtoReflect.Descriptor instead.
 func (*GetInviteLinkRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{17}
+	return file_organizations_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetInviteLinkRequest) GetId() string {
@@ -978,7 +1246,7 @@ type GetInviteLinkResponse struct {
 
 func (x *GetInviteLinkResponse) Reset() {
 	*x = GetInviteLinkResponse{}
-	mi := &file_organizations_proto_msgTypes[18]
+	mi := &file_organizations_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +1258,7 @@ func (x *GetInviteLinkResponse) String() string {
 func (*GetInviteLinkResponse) ProtoMessage() {}
 
 func (x *GetInviteLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[18]
+	mi := &file_organizations_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1271,7 @@ func (x *GetInviteLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInviteLinkResponse.ProtoReflect.Descriptor instead.
 func (*GetInviteLinkResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{18}
+	return file_organizations_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetInviteLinkResponse) GetInviteLink() *InviteLink {
@@ -1023,7 +1291,7 @@ type UpdateInviteLinkRequest struct {
 
 func (x *UpdateInviteLinkRequest) Reset() {
 	*x = UpdateInviteLinkRequest{}
-	mi := &file_organizations_proto_msgTypes[19]
+	mi := &file_organizations_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1035,7 +1303,7 @@ func (x *UpdateInviteLinkRequest) String() string {
 func (*UpdateInviteLinkRequest) ProtoMessage() {}
 
 func (x *UpdateInviteLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[19]
+	mi := &file_organizations_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,7 +1316,7 @@ func (x *UpdateInviteLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInviteLinkRequest.ProtoReflect.Descriptor instead.
 func (*UpdateInviteLinkRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{19}
+	return file_organizations_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateInviteLinkRequest) GetId() string {
@@ -1074,7 +1342,7 @@ type UpdateInviteLinkResponse struct {
 
 func (x *UpdateInviteLinkResponse) Reset() {
 	*x = UpdateInviteLinkResponse{}
-	mi := &file_organizations_proto_msgTypes[20]
+	mi := &file_organizations_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1354,7 @@ func (x *UpdateInviteLinkResponse) String() string {
 func (*UpdateInviteLinkResponse) ProtoMessage() {}
 
 func (x *UpdateInviteLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[20]
+	mi := &file_organizations_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1367,7 @@ func (x *UpdateInviteLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInviteLinkResponse.ProtoReflect.Descriptor instead.
 func (*UpdateInviteLinkResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{20}
+	return file_organizations_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateInviteLinkResponse) GetInviteLink() *InviteLink {
@@ -1118,7 +1386,7 @@ type ResetInviteLinkRequest struct {
 
 func (x *ResetInviteLinkRequest) Reset() {
 	*x = ResetInviteLinkRequest{}
-	mi := &file_organizations_proto_msgTypes[21]
+	mi := &file_organizations_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1130,7 +1398,7 @@ func (x *ResetInviteLinkRequest) String() string {
 func (*ResetInviteLinkRequest) ProtoMessage() {}
 
 func (x *ResetInviteLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[21]
+	mi := &file_organizations_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +1411,7 @@ func (x *ResetInviteLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetInviteLinkRequest.ProtoReflect.Descriptor instead.
 func (*ResetInviteLinkRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{21}
+	return file_organizations_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ResetInviteLinkRequest) GetId() string {
@@ -1162,7 +1430,7 @@ type ResetInviteLinkResponse struct {
 
 func (x *ResetInviteLinkResponse) Reset() {
 	*x = ResetInviteLinkResponse{}
-	mi := &file_organizations_proto_msgTypes[22]
+	mi := &file_organizations_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1174,7 +1442,7 @@ func (x *ResetInviteLinkResponse) String() string {
 func (*ResetInviteLinkResponse) ProtoMessage() {}
 
 func (x *ResetInviteLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[22]
+	mi := &file_organizations_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,7 +1455,7 @@ func (x *ResetInviteLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetInviteLinkResponse.ProtoReflect.Descriptor instead.
 func (*ResetInviteLinkResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{22}
+	return file_organizations_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ResetInviteLinkResponse) GetInviteLink() *InviteLink {
@@ -1206,7 +1474,7 @@ type GetAgentSettingsRequest struct {
 
 func (x *GetAgentSettingsRequest) Reset() {
 	*x = GetAgentSettingsRequest{}
-	mi := &file_organizations_proto_msgTypes[23]
+	mi := &file_organizations_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1218,7 +1486,7 @@ func (x *GetAgentSettingsRequest) String() string {
 func (*GetAgentSettingsRequest) ProtoMessage() {}
 
 func (x *GetAgentSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[23]
+	mi := &file_organizations_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +1499,7 @@ func (x *GetAgentSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentSettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetAgentSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{23}
+	return file_organizations_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetAgentSettingsRequest) GetId() string {
@@ -1250,7 +1518,7 @@ type GetAgentSettingsResponse struct {
 
 func (x *GetAgentSettingsResponse) Reset() {
 	*x = GetAgentSettingsResponse{}
-	mi := &file_organizations_proto_msgTypes[24]
+	mi := &file_organizations_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1262,7 +1530,7 @@ func (x *GetAgentSettingsResponse) String() string {
 func (*GetAgentSettingsResponse) ProtoMessage() {}
 
 func (x *GetAgentSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[24]
+	mi := &file_organizations_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1275,7 +1543,7 @@ func (x *GetAgentSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentSettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetAgentSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{24}
+	return file_organizations_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetAgentSettingsResponse) GetAgentSettings() *AgentSettings {
@@ -1295,7 +1563,7 @@ type UpdateAgentSettingsRequest struct {
 
 func (x *UpdateAgentSettingsRequest) Reset() {
 	*x = UpdateAgentSettingsRequest{}
-	mi := &file_organizations_proto_msgTypes[25]
+	mi := &file_organizations_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1307,7 +1575,7 @@ func (x *UpdateAgentSettingsRequest) String() string {
 func (*UpdateAgentSettingsRequest) ProtoMessage() {}
 
 func (x *UpdateAgentSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[25]
+	mi := &file_organizations_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,7 +1588,7 @@ func (x *UpdateAgentSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentSettingsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAgentSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{25}
+	return file_organizations_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateAgentSettingsRequest) GetId() string {
@@ -1346,7 +1614,7 @@ type UpdateAgentSettingsResponse struct {
 
 func (x *UpdateAgentSettingsResponse) Reset() {
 	*x = UpdateAgentSettingsResponse{}
-	mi := &file_organizations_proto_msgTypes[26]
+	mi := &file_organizations_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +1626,7 @@ func (x *UpdateAgentSettingsResponse) String() string {
 func (*UpdateAgentSettingsResponse) ProtoMessage() {}
 
 func (x *UpdateAgentSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[26]
+	mi := &file_organizations_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +1639,7 @@ func (x *UpdateAgentSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentSettingsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAgentSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{26}
+	return file_organizations_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateAgentSettingsResponse) GetAgentSettings() *AgentSettings {
@@ -1392,7 +1660,7 @@ type SetAgentOpenAIKeyRequest struct {
 
 func (x *SetAgentOpenAIKeyRequest) Reset() {
 	*x = SetAgentOpenAIKeyRequest{}
-	mi := &file_organizations_proto_msgTypes[27]
+	mi := &file_organizations_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1404,7 +1672,7 @@ func (x *SetAgentOpenAIKeyRequest) String() string {
 func (*SetAgentOpenAIKeyRequest) ProtoMessage() {}
 
 func (x *SetAgentOpenAIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[27]
+	mi := &file_organizations_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1417,7 +1685,7 @@ func (x *SetAgentOpenAIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAgentOpenAIKeyRequest.ProtoReflect.Descriptor instead.
 func (*SetAgentOpenAIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{27}
+	return file_organizations_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SetAgentOpenAIKeyRequest) GetId() string {
@@ -1450,7 +1718,7 @@ type SetAgentOpenAIKeyResponse struct {
 
 func (x *SetAgentOpenAIKeyResponse) Reset() {
 	*x = SetAgentOpenAIKeyResponse{}
-	mi := &file_organizations_proto_msgTypes[28]
+	mi := &file_organizations_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1462,7 +1730,7 @@ func (x *SetAgentOpenAIKeyResponse) String() string {
 func (*SetAgentOpenAIKeyResponse) ProtoMessage() {}
 
 func (x *SetAgentOpenAIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[28]
+	mi := &file_organizations_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,7 +1743,7 @@ func (x *SetAgentOpenAIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAgentOpenAIKeyResponse.ProtoReflect.Descriptor instead.
 func (*SetAgentOpenAIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{28}
+	return file_organizations_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SetAgentOpenAIKeyResponse) GetAgentSettings() *AgentSettings {
@@ -1494,7 +1762,7 @@ type DeleteAgentOpenAIKeyRequest struct {
 
 func (x *DeleteAgentOpenAIKeyRequest) Reset() {
 	*x = DeleteAgentOpenAIKeyRequest{}
-	mi := &file_organizations_proto_msgTypes[29]
+	mi := &file_organizations_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1506,7 +1774,7 @@ func (x *DeleteAgentOpenAIKeyRequest) String() string {
 func (*DeleteAgentOpenAIKeyRequest) ProtoMessage() {}
 
 func (x *DeleteAgentOpenAIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[29]
+	mi := &file_organizations_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,7 +1787,7 @@ func (x *DeleteAgentOpenAIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentOpenAIKeyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAgentOpenAIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{29}
+	return file_organizations_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DeleteAgentOpenAIKeyRequest) GetId() string {
@@ -1538,7 +1806,7 @@ type DeleteAgentOpenAIKeyResponse struct {
 
 func (x *DeleteAgentOpenAIKeyResponse) Reset() {
 	*x = DeleteAgentOpenAIKeyResponse{}
-	mi := &file_organizations_proto_msgTypes[30]
+	mi := &file_organizations_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1550,7 +1818,7 @@ func (x *DeleteAgentOpenAIKeyResponse) String() string {
 func (*DeleteAgentOpenAIKeyResponse) ProtoMessage() {}
 
 func (x *DeleteAgentOpenAIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[30]
+	mi := &file_organizations_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1563,7 +1831,7 @@ func (x *DeleteAgentOpenAIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentOpenAIKeyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAgentOpenAIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{30}
+	return file_organizations_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DeleteAgentOpenAIKeyResponse) GetAgentSettings() *AgentSettings {
@@ -1573,29 +1841,28 @@ func (x *DeleteAgentOpenAIKeyResponse) GetAgentSettings() *AgentSettings {
 	return nil
 }
 
-type RemoveUserRequest struct {
+type GetSSOConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveUserRequest) Reset() {
-	*x = RemoveUserRequest{}
-	mi := &file_organizations_proto_msgTypes[31]
+func (x *GetSSOConfigRequest) Reset() {
+	*x = GetSSOConfigRequest{}
+	mi := &file_organizations_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveUserRequest) String() string {
+func (x *GetSSOConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveUserRequest) ProtoMessage() {}
+func (*GetSSOConfigRequest) ProtoMessage() {}
 
-func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[31]
+func (x *GetSSOConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,46 +1873,40 @@ func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
-func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetSSOConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSSOConfigRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RemoveUserRequest) GetId() string {
+func (x *GetSSOConfigRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *RemoveUserRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type RemoveUserResponse struct {
+type GetSSOConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	SsoConfig     *SSOConfig             `protobuf:"bytes,1,opt,name=sso_config,json=ssoConfig,proto3" json:"sso_config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveUserResponse) Reset() {
-	*x = RemoveUserResponse{}
-	mi := &file_organizations_proto_msgTypes[32]
+func (x *GetSSOConfigResponse) Reset() {
+	*x = GetSSOConfigResponse{}
+	mi := &file_organizations_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveUserResponse) String() string {
+func (x *GetSSOConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveUserResponse) ProtoMessage() {}
+func (*GetSSOConfigResponse) ProtoMessage() {}
 
-func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[32]
+func (x *GetSSOConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1656,33 +1917,41 @@ func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveUserResponse.ProtoReflect.Descriptor instead.
-func (*RemoveUserResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetSSOConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetSSOConfigResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{35}
 }
 
-type ListIntegrationsRequest struct {
+func (x *GetSSOConfigResponse) GetSsoConfig() *SSOConfig {
+	if x != nil {
+		return x.SsoConfig
+	}
+	return nil
+}
+
+type UpdateSSOConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SsoConfig     *SSOConfig             `protobuf:"bytes,2,opt,name=sso_config,json=ssoConfig,proto3" json:"sso_config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIntegrationsRequest) Reset() {
-	*x = ListIntegrationsRequest{}
-	mi := &file_organizations_proto_msgTypes[33]
+func (x *UpdateSSOConfigRequest) Reset() {
+	*x = UpdateSSOConfigRequest{}
+	mi := &file_organizations_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIntegrationsRequest) String() string {
+func (x *UpdateSSOConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegrationsRequest) ProtoMessage() {}
+func (*UpdateSSOConfigRequest) ProtoMessage() {}
 
-func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[33]
+func (x *UpdateSSOConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1693,40 +1962,47 @@ func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegrationsRequest.ProtoReflect.Descriptor instead.
-func (*ListIntegrationsRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use UpdateSSOConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSSOConfigRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListIntegrationsRequest) GetId() string {
+func (x *UpdateSSOConfigRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListIntegrationsResponse struct {
+func (x *UpdateSSOConfigRequest) GetSsoConfig() *SSOConfig {
+	if x != nil {
+		return x.SsoConfig
+	}
+	return nil
+}
+
+type UpdateSSOConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Integrations  []*Integration         `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
+	SsoConfig     *SSOConfig             `protobuf:"bytes,1,opt,name=sso_config,json=ssoConfig,proto3" json:"sso_config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIntegrationsResponse) Reset() {
-	*x = ListIntegrationsResponse{}
-	mi := &file_organizations_proto_msgTypes[34]
+func (x *UpdateSSOConfigResponse) Reset() {
+	*x = UpdateSSOConfigResponse{}
+	mi := &file_organizations_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIntegrationsResponse) String() string {
+func (x *UpdateSSOConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegrationsResponse) ProtoMessage() {}
+func (*UpdateSSOConfigResponse) ProtoMessage() {}
 
-func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[34]
+func (x *UpdateSSOConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1737,43 +2013,40 @@ func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegrationsResponse.ProtoReflect.Descriptor instead.
-func (*ListIntegrationsResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UpdateSSOConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSSOConfigResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListIntegrationsResponse) GetIntegrations() []*Integration {
+func (x *UpdateSSOConfigResponse) GetSsoConfig() *SSOConfig {
 	if x != nil {
-		return x.Integrations
+		return x.SsoConfig
 	}
 	return nil
 }
 
-type CreateIntegrationRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IntegrationName string                 `protobuf:"bytes,3,opt,name=integration_name,json=integrationName,proto3" json:"integration_name,omitempty"`
-	Configuration   *_struct.Struct        `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DeleteSSOConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIntegrationRequest) Reset() {
-	*x = CreateIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[35]
+func (x *DeleteSSOConfigRequest) Reset() {
+	*x = DeleteSSOConfigRequest{}
+	mi := &file_organizations_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIntegrationRequest) String() string {
+func (x *DeleteSSOConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIntegrationRequest) ProtoMessage() {}
+func (*DeleteSSOConfigRequest) ProtoMessage() {}
 
-func (x *CreateIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[35]
+func (x *DeleteSSOConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1784,61 +2057,39 @@ func (x *CreateIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*CreateIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DeleteSSOConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSSOConfigRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreateIntegrationRequest) GetId() string {
+func (x *DeleteSSOConfigRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateIntegrationRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateIntegrationRequest) GetIntegrationName() string {
-	if x != nil {
-		return x.IntegrationName
-	}
-	return ""
-}
-
-func (x *CreateIntegrationRequest) GetConfiguration() *_struct.Struct {
-	if x != nil {
-		return x.Configuration
-	}
-	return nil
-}
-
-type CreateIntegrationResponse struct {
+type DeleteSSOConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIntegrationResponse) Reset() {
-	*x = CreateIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[36]
+func (x *DeleteSSOConfigResponse) Reset() {
+	*x = DeleteSSOConfigResponse{}
+	mi := &file_organizations_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIntegrationResponse) String() string {
+func (x *DeleteSSOConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIntegrationResponse) ProtoMessage() {}
+func (*DeleteSSOConfigResponse) ProtoMessage() {}
 
-func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[36]
+func (x *DeleteSSOConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1849,41 +2100,33 @@ func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIntegrationResponse.ProtoReflect.Descriptor instead.
-func (*CreateIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *CreateIntegrationResponse) GetIntegration() *Integration {
-	if x != nil {
-		return x.Integration
-	}
-	return nil
+// Deprecated: Use DeleteSSOConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSSOConfigResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{39}
 }
 
-type DescribeIntegrationRequest struct {
+type GetSCIMConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeIntegrationRequest) Reset() {
-	*x = DescribeIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[37]
+func (x *GetSCIMConfigRequest) Reset() {
+	*x = GetSCIMConfigRequest{}
+	mi := &file_organizations_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeIntegrationRequest) String() string {
+func (x *GetSCIMConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeIntegrationRequest) ProtoMessage() {}
+func (*GetSCIMConfigRequest) ProtoMessage() {}
 
-func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[37]
+func (x *GetSCIMConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,47 +2137,40 @@ func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*DescribeIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetSCIMConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSCIMConfigRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DescribeIntegrationRequest) GetId() string {
+func (x *GetSCIMConfigRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DescribeIntegrationRequest) GetIntegrationId() string {
-	if x != nil {
-		return x.IntegrationId
-	}
-	return ""
-}
-
-type DescribeIntegrationResponse struct {
+type GetSCIMConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	ScimConfig    *SCIMConfig            `protobuf:"bytes,1,opt,name=scim_config,json=scimConfig,proto3" json:"scim_config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeIntegrationResponse) Reset() {
-	*x = DescribeIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[38]
+func (x *GetSCIMConfigResponse) Reset() {
+	*x = GetSCIMConfigResponse{}
+	mi := &file_organizations_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeIntegrationResponse) String() string {
+func (x *GetSCIMConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeIntegrationResponse) ProtoMessage() {}
+func (*GetSCIMConfigResponse) ProtoMessage() {}
 
-func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[38]
+func (x *GetSCIMConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1945,42 +2181,40 @@ func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeIntegrationResponse.ProtoReflect.Descriptor instead.
-func (*DescribeIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetSCIMConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetSCIMConfigResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DescribeIntegrationResponse) GetIntegration() *Integration {
+func (x *GetSCIMConfigResponse) GetScimConfig() *SCIMConfig {
 	if x != nil {
-		return x.Integration
+		return x.ScimConfig
 	}
 	return nil
 }
 
-type ListIntegrationResourcesRequest struct {
+type RegenerateSCIMTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
-	Parameters    map[string]string      `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIntegrationResourcesRequest) Reset() {
-	*x = ListIntegrationResourcesRequest{}
-	mi := &file_organizations_proto_msgTypes[39]
+func (x *RegenerateSCIMTokenRequest) Reset() {
+	*x = RegenerateSCIMTokenRequest{}
+	mi := &file_organizations_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIntegrationResourcesRequest) String() string {
+func (x *RegenerateSCIMTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegrationResourcesRequest) ProtoMessage() {}
+func (*RegenerateSCIMTokenRequest) ProtoMessage() {}
 
-func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[39]
+func (x *RegenerateSCIMTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1991,54 +2225,92 @@ func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegrationResourcesRequest.ProtoReflect.Descriptor instead.
-func (*ListIntegrationResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RegenerateSCIMTokenRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateSCIMTokenRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListIntegrationResourcesRequest) GetId() string {
+func (x *RegenerateSCIMTokenRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ListIntegrationResourcesRequest) GetIntegrationId() string {
+type RegenerateSCIMTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScimConfig    *SCIMConfig            `protobuf:"bytes,1,opt,name=scim_config,json=scimConfig,proto3" json:"scim_config,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateSCIMTokenResponse) Reset() {
+	*x = RegenerateSCIMTokenResponse{}
+	mi := &file_organizations_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateSCIMTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateSCIMTokenResponse) ProtoMessage() {}
+
+func (x *RegenerateSCIMTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[43]
 	if x != nil {
-		return x.IntegrationId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListIntegrationResourcesRequest) GetParameters() map[string]string {
+// Deprecated: Use RegenerateSCIMTokenResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateSCIMTokenResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RegenerateSCIMTokenResponse) GetScimConfig() *SCIMConfig {
 	if x != nil {
-		return x.Parameters
+		return x.ScimConfig
 	}
 	return nil
 }
 
-type ListIntegrationResourcesResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Resources     []*IntegrationResourceRef `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+func (x *RegenerateSCIMTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DeleteSCIMTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIntegrationResourcesResponse) Reset() {
-	*x = ListIntegrationResourcesResponse{}
-	mi := &file_organizations_proto_msgTypes[40]
+func (x *DeleteSCIMTokenRequest) Reset() {
+	*x = DeleteSCIMTokenRequest{}
+	mi := &file_organizations_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIntegrationResourcesResponse) String() string {
+func (x *DeleteSCIMTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegrationResourcesResponse) ProtoMessage() {}
+func (*DeleteSCIMTokenRequest) ProtoMessage() {}
 
-func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[40]
+func (x *DeleteSCIMTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2049,42 +2321,39 @@ func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegrationResourcesResponse.ProtoReflect.Descriptor instead.
-func (*ListIntegrationResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use DeleteSCIMTokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSCIMTokenRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListIntegrationResourcesResponse) GetResources() []*IntegrationResourceRef {
+func (x *DeleteSCIMTokenRequest) GetId() string {
 	if x != nil {
-		return x.Resources
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type IntegrationResourceRef struct {
+type DeleteSCIMTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IntegrationResourceRef) Reset() {
-	*x = IntegrationResourceRef{}
-	mi := &file_organizations_proto_msgTypes[41]
+func (x *DeleteSCIMTokenResponse) Reset() {
+	*x = DeleteSCIMTokenResponse{}
+	mi := &file_organizations_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IntegrationResourceRef) String() string {
+func (x *DeleteSCIMTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntegrationResourceRef) ProtoMessage() {}
+func (*DeleteSCIMTokenResponse) ProtoMessage() {}
 
-func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[41]
+func (x *DeleteSCIMTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2095,57 +2364,94 @@ func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntegrationResourceRef.ProtoReflect.Descriptor instead.
-func (*IntegrationResourceRef) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DeleteSCIMTokenResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSCIMTokenResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *IntegrationResourceRef) GetType() string {
+// A limit of zero means the quota is not enforced.
+type QuotaUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         string                 `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	Used          int64                  `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	mi := &file_organizations_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[46]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *IntegrationResourceRef) GetName() string {
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *QuotaUsage) GetQuota() string {
 	if x != nil {
-		return x.Name
+		return x.Quota
 	}
 	return ""
 }
 
-func (x *IntegrationResourceRef) GetId() string {
+func (x *QuotaUsage) GetUsed() int64 {
 	if x != nil {
-		return x.Id
+		return x.Used
 	}
-	return ""
+	return 0
 }
 
-type UpdateIntegrationRequest struct {
+func (x *QuotaUsage) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
-	Configuration *_struct.Struct        `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateIntegrationRequest) Reset() {
-	*x = UpdateIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[42]
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_organizations_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateIntegrationRequest) String() string {
+func (x *GetUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateIntegrationRequest) ProtoMessage() {}
+func (*GetUsageRequest) ProtoMessage() {}
 
-func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[42]
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2156,61 +2462,1118 @@ func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UpdateIntegrationRequest) GetId() string {
+func (x *GetUsageRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateIntegrationRequest) GetIntegrationId() string {
+type GetUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotas        []*QuotaUsage          `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_organizations_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[48]
 	if x != nil {
-		return x.IntegrationId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateIntegrationRequest) GetConfiguration() *_struct.Struct {
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetUsageResponse) GetQuotas() []*QuotaUsage {
 	if x != nil {
-		return x.Configuration
+		return x.Quotas
 	}
 	return nil
 }
 
-func (x *UpdateIntegrationRequest) GetName() string {
+type RemoveUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserRequest) Reset() {
+	*x = RemoveUserRequest{}
+	mi := &file_organizations_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserRequest) ProtoMessage() {}
+
+func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[49]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RemoveUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type UpdateIntegrationResponse struct {
+func (x *RemoveUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateIntegrationResponse) Reset() {
-	*x = UpdateIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[43]
+func (x *RemoveUserResponse) Reset() {
+	*x = RemoveUserResponse{}
+	mi := &file_organizations_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserResponse) ProtoMessage() {}
+
+func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUserResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{50}
+}
+
+type ListIntegrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationsRequest) Reset() {
+	*x = ListIntegrationsRequest{}
+	mi := &file_organizations_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationsRequest) ProtoMessage() {}
+
+func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListIntegrationsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListIntegrationsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListIntegrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integrations  []*Integration         `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationsResponse) Reset() {
+	*x = ListIntegrationsResponse{}
+	mi := &file_organizations_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationsResponse) ProtoMessage() {}
+
+func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListIntegrationsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListIntegrationsResponse) GetIntegrations() []*Integration {
+	if x != nil {
+		return x.Integrations
+	}
+	return nil
+}
+
+type CreateIntegrationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IntegrationName string                 `protobuf:"bytes,3,opt,name=integration_name,json=integrationName,proto3" json:"integration_name,omitempty"`
+	Configuration   *_struct.Struct        `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateIntegrationRequest) Reset() {
+	*x = CreateIntegrationRequest{}
+	mi := &file_organizations_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIntegrationRequest) ProtoMessage() {}
+
+func (x *CreateIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*CreateIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateIntegrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateIntegrationRequest) GetIntegrationName() string {
+	if x != nil {
+		return x.IntegrationName
+	}
+	return ""
+}
+
+func (x *CreateIntegrationRequest) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type CreateIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIntegrationResponse) Reset() {
+	*x = CreateIntegrationResponse{}
+	mi := &file_organizations_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIntegrationResponse) ProtoMessage() {}
+
+func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*CreateIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateIntegrationResponse) GetIntegration() *Integration {
+	if x != nil {
+		return x.Integration
+	}
+	return nil
+}
+
+type DescribeIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeIntegrationRequest) Reset() {
+	*x = DescribeIntegrationRequest{}
+	mi := &file_organizations_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeIntegrationRequest) ProtoMessage() {}
+
+func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*DescribeIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DescribeIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DescribeIntegrationRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+type DescribeIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeIntegrationResponse) Reset() {
+	*x = DescribeIntegrationResponse{}
+	mi := &file_organizations_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeIntegrationResponse) ProtoMessage() {}
+
+func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*DescribeIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DescribeIntegrationResponse) GetIntegration() *Integration {
+	if x != nil {
+		return x.Integration
+	}
+	return nil
+}
+
+type ListIntegrationResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	Parameters    map[string]string      `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationResourcesRequest) Reset() {
+	*x = ListIntegrationResourcesRequest{}
+	mi := &file_organizations_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationResourcesRequest) ProtoMessage() {}
+
+func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListIntegrationResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListIntegrationResourcesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListIntegrationResourcesRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+func (x *ListIntegrationResourcesRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type ListIntegrationResourcesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Resources     []*IntegrationResourceRef `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationResourcesResponse) Reset() {
+	*x = ListIntegrationResourcesResponse{}
+	mi := &file_organizations_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationResourcesResponse) ProtoMessage() {}
+
+func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListIntegrationResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListIntegrationResourcesResponse) GetResources() []*IntegrationResourceRef {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type IntegrationResourceRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationResourceRef) Reset() {
+	*x = IntegrationResourceRef{}
+	mi := &file_organizations_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationResourceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationResourceRef) ProtoMessage() {}
+
+func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationResourceRef.ProtoReflect.Descriptor instead.
+func (*IntegrationResourceRef) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *IntegrationResourceRef) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *IntegrationResourceRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IntegrationResourceRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	Configuration *_struct.Struct        `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIntegrationRequest) Reset() {
+	*x = UpdateIntegrationRequest{}
+	mi := &file_organizations_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIntegrationRequest) ProtoMessage() {}
+
+func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIntegrationRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+func (x *UpdateIntegrationRequest) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *UpdateIntegrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIntegrationResponse) Reset() {
+	*x = UpdateIntegrationResponse{}
+	mi := &file_organizations_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIntegrationResponse) ProtoMessage() {}
+
+func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateIntegrationResponse) GetIntegration() *Integration {
+	if x != nil {
+		return x.Integration
+	}
+	return nil
+}
+
+type DeleteIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIntegrationRequest) Reset() {
+	*x = DeleteIntegrationRequest{}
+	mi := &file_organizations_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIntegrationRequest) ProtoMessage() {}
+
+func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteIntegrationRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+type DeleteIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIntegrationResponse) Reset() {
+	*x = DeleteIntegrationResponse{}
+	mi := &file_organizations_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIntegrationResponse) ProtoMessage() {}
+
+func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{63}
+}
+
+type Integration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Integration_Metadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *Integration_Spec      `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *Integration_Status    `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Integration) Reset() {
+	*x = Integration{}
+	mi := &file_organizations_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Integration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Integration) ProtoMessage() {}
+
+func (x *Integration) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Integration.ProtoReflect.Descriptor instead.
+func (*Integration) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Integration) GetMetadata() *Integration_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Integration) GetSpec() *Integration_Spec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Integration) GetStatus() *Integration_Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type BrowserAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	FormFields    map[string]string      `protobuf:"bytes,3,rep,name=form_fields,json=formFields,proto3" json:"form_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowserAction) Reset() {
+	*x = BrowserAction{}
+	mi := &file_organizations_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowserAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowserAction) ProtoMessage() {}
+
+func (x *BrowserAction) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowserAction.ProtoReflect.Descriptor instead.
+func (*BrowserAction) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *BrowserAction) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BrowserAction) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *BrowserAction) GetFormFields() map[string]string {
+	if x != nil {
+		return x.FormFields
+	}
+	return nil
+}
+
+func (x *BrowserAction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Event messages for organization lifecycle events
+type OrganizationCreated struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationCreated) Reset() {
+	*x = OrganizationCreated{}
+	mi := &file_organizations_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationCreated) ProtoMessage() {}
+
+func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationCreated.ProtoReflect.Descriptor instead.
+func (*OrganizationCreated) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *OrganizationCreated) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationCreated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type OrganizationUpdated struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationUpdated) Reset() {
+	*x = OrganizationUpdated{}
+	mi := &file_organizations_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationUpdated) ProtoMessage() {}
+
+func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationUpdated.ProtoReflect.Descriptor instead.
+func (*OrganizationUpdated) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *OrganizationUpdated) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationUpdated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type OrganizationDeleted struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationDeleted) Reset() {
+	*x = OrganizationDeleted{}
+	mi := &file_organizations_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationDeleted) ProtoMessage() {}
+
+func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationDeleted.ProtoReflect.Descriptor instead.
+func (*OrganizationDeleted) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *OrganizationDeleted) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationDeleted) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type InvitationCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvitationCreated) Reset() {
+	*x = InvitationCreated{}
+	mi := &file_organizations_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateIntegrationResponse) String() string {
+func (x *InvitationCreated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateIntegrationResponse) ProtoMessage() {}
+func (*InvitationCreated) ProtoMessage() {}
 
-func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[43]
+func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2221,41 +3584,48 @@ func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateIntegrationResponse.ProtoReflect.Descriptor instead.
-func (*UpdateIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use InvitationCreated.ProtoReflect.Descriptor instead.
+func (*InvitationCreated) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateIntegrationResponse) GetIntegration() *Integration {
+func (x *InvitationCreated) GetInvitationId() string {
 	if x != nil {
-		return x.Integration
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *InvitationCreated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
 	}
 	return nil
 }
 
-type DeleteIntegrationRequest struct {
+type Calendar struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	Metadata      *Calendar_Metadata     `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *Calendar_Spec         `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIntegrationRequest) Reset() {
-	*x = DeleteIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[44]
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_organizations_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIntegrationRequest) String() string {
+func (x *Calendar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIntegrationRequest) ProtoMessage() {}
+func (*Calendar) ProtoMessage() {}
 
-func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[44]
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,46 +3636,47 @@ func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeleteIntegrationRequest) GetId() string {
+func (x *Calendar) GetMetadata() *Calendar_Metadata {
 	if x != nil {
-		return x.Id
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteIntegrationRequest) GetIntegrationId() string {
+func (x *Calendar) GetSpec() *Calendar_Spec {
 	if x != nil {
-		return x.IntegrationId
+		return x.Spec
 	}
-	return ""
+	return nil
 }
 
-type DeleteIntegrationResponse struct {
+type ListCalendarsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIntegrationResponse) Reset() {
-	*x = DeleteIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[45]
+func (x *ListCalendarsRequest) Reset() {
+	*x = ListCalendarsRequest{}
+	mi := &file_organizations_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIntegrationResponse) String() string {
+func (x *ListCalendarsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIntegrationResponse) ProtoMessage() {}
+func (*ListCalendarsRequest) ProtoMessage() {}
 
-func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[45]
+func (x *ListCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,35 +3687,40 @@ func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIntegrationResponse.ProtoReflect.Descriptor instead.
-func (*DeleteIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ListCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{71}
 }
 
-type Integration struct {
+func (x *ListCalendarsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCalendarsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *Integration_Metadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec          *Integration_Spec      `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status        *Integration_Status    `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Calendars     []*Calendar            `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Integration) Reset() {
-	*x = Integration{}
-	mi := &file_organizations_proto_msgTypes[46]
+func (x *ListCalendarsResponse) Reset() {
+	*x = ListCalendarsResponse{}
+	mi := &file_organizations_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Integration) String() string {
+func (x *ListCalendarsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Integration) ProtoMessage() {}
+func (*ListCalendarsResponse) ProtoMessage() {}
 
-func (x *Integration) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[46]
+func (x *ListCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2355,57 +3731,41 @@ func (x *Integration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Integration.ProtoReflect.Descriptor instead.
-func (*Integration) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *Integration) GetMetadata() *Integration_Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *Integration) GetSpec() *Integration_Spec {
-	if x != nil {
-		return x.Spec
-	}
-	return nil
+// Deprecated: Use ListCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *Integration) GetStatus() *Integration_Status {
+func (x *ListCalendarsResponse) GetCalendars() []*Calendar {
 	if x != nil {
-		return x.Status
+		return x.Calendars
 	}
 	return nil
 }
 
-type BrowserAction struct {
+type CreateCalendarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	FormFields    map[string]string      `protobuf:"bytes,3,rep,name=form_fields,json=formFields,proto3" json:"form_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Calendar      *Calendar              `protobuf:"bytes,2,opt,name=calendar,proto3" json:"calendar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BrowserAction) Reset() {
-	*x = BrowserAction{}
-	mi := &file_organizations_proto_msgTypes[47]
+func (x *CreateCalendarRequest) Reset() {
+	*x = CreateCalendarRequest{}
+	mi := &file_organizations_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BrowserAction) String() string {
+func (x *CreateCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrowserAction) ProtoMessage() {}
+func (*CreateCalendarRequest) ProtoMessage() {}
 
-func (x *BrowserAction) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[47]
+func (x *CreateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2416,63 +3776,47 @@ func (x *BrowserAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrowserAction.ProtoReflect.Descriptor instead.
-func (*BrowserAction) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *BrowserAction) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+// Deprecated: Use CreateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *BrowserAction) GetMethod() string {
+func (x *CreateCalendarRequest) GetId() string {
 	if x != nil {
-		return x.Method
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BrowserAction) GetFormFields() map[string]string {
+func (x *CreateCalendarRequest) GetCalendar() *Calendar {
 	if x != nil {
-		return x.FormFields
+		return x.Calendar
 	}
 	return nil
 }
 
-func (x *BrowserAction) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-// Event messages for organization lifecycle events
-type OrganizationCreated struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CreateCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrganizationCreated) Reset() {
-	*x = OrganizationCreated{}
-	mi := &file_organizations_proto_msgTypes[48]
+func (x *CreateCalendarResponse) Reset() {
+	*x = CreateCalendarResponse{}
+	mi := &file_organizations_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrganizationCreated) String() string {
+func (x *CreateCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationCreated) ProtoMessage() {}
+func (*CreateCalendarResponse) ProtoMessage() {}
 
-func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[48]
+func (x *CreateCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2483,48 +3827,42 @@ func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationCreated.ProtoReflect.Descriptor instead.
-func (*OrganizationCreated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *OrganizationCreated) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+// Deprecated: Use CreateCalendarResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *OrganizationCreated) GetTimestamp() *timestamp.Timestamp {
+func (x *CreateCalendarResponse) GetCalendar() *Calendar {
 	if x != nil {
-		return x.Timestamp
+		return x.Calendar
 	}
 	return nil
 }
 
-type OrganizationUpdated struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UpdateCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CalendarId    string                 `protobuf:"bytes,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Calendar      *Calendar              `protobuf:"bytes,3,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrganizationUpdated) Reset() {
-	*x = OrganizationUpdated{}
-	mi := &file_organizations_proto_msgTypes[49]
+func (x *UpdateCalendarRequest) Reset() {
+	*x = UpdateCalendarRequest{}
+	mi := &file_organizations_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrganizationUpdated) String() string {
+func (x *UpdateCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationUpdated) ProtoMessage() {}
+func (*UpdateCalendarRequest) ProtoMessage() {}
 
-func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[49]
+func (x *UpdateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2535,48 +3873,54 @@ func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationUpdated.ProtoReflect.Descriptor instead.
-func (*OrganizationUpdated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use UpdateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *OrganizationUpdated) GetOrganizationId() string {
+func (x *UpdateCalendarRequest) GetId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *OrganizationUpdated) GetTimestamp() *timestamp.Timestamp {
+func (x *UpdateCalendarRequest) GetCalendarId() string {
 	if x != nil {
-		return x.Timestamp
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *UpdateCalendarRequest) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
 	}
 	return nil
 }
 
-type OrganizationDeleted struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UpdateCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrganizationDeleted) Reset() {
-	*x = OrganizationDeleted{}
-	mi := &file_organizations_proto_msgTypes[50]
+func (x *UpdateCalendarResponse) Reset() {
+	*x = UpdateCalendarResponse{}
+	mi := &file_organizations_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrganizationDeleted) String() string {
+func (x *UpdateCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationDeleted) ProtoMessage() {}
+func (*UpdateCalendarResponse) ProtoMessage() {}
 
-func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[50]
+func (x *UpdateCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2587,48 +3931,41 @@ func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationDeleted.ProtoReflect.Descriptor instead.
-func (*OrganizationDeleted) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *OrganizationDeleted) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+// Deprecated: Use UpdateCalendarResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *OrganizationDeleted) GetTimestamp() *timestamp.Timestamp {
+func (x *UpdateCalendarResponse) GetCalendar() *Calendar {
 	if x != nil {
-		return x.Timestamp
+		return x.Calendar
 	}
 	return nil
 }
 
-type InvitationCreated struct {
+type DeleteCalendarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CalendarId    string                 `protobuf:"bytes,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvitationCreated) Reset() {
-	*x = InvitationCreated{}
-	mi := &file_organizations_proto_msgTypes[51]
+func (x *DeleteCalendarRequest) Reset() {
+	*x = DeleteCalendarRequest{}
+	mi := &file_organizations_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvitationCreated) String() string {
+func (x *DeleteCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvitationCreated) ProtoMessage() {}
+func (*DeleteCalendarRequest) ProtoMessage() {}
 
-func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[51]
+func (x *DeleteCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2639,23 +3976,59 @@ func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvitationCreated.ProtoReflect.Descriptor instead.
-func (*InvitationCreated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use DeleteCalendarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *InvitationCreated) GetInvitationId() string {
+func (x *DeleteCalendarRequest) GetId() string {
 	if x != nil {
-		return x.InvitationId
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCalendarRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
 	}
 	return ""
 }
 
-func (x *InvitationCreated) GetTimestamp() *timestamp.Timestamp {
+type DeleteCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarResponse) Reset() {
+	*x = DeleteCalendarResponse{}
+	mi := &file_organizations_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarResponse) ProtoMessage() {}
+
+func (x *DeleteCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[78]
 	if x != nil {
-		return x.Timestamp
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{78}
 }
 
 type Organization_Metadata struct {
@@ -2672,7 +4045,7 @@ type Organization_Metadata struct {
 
 func (x *Organization_Metadata) Reset() {
 	*x = Organization_Metadata{}
-	mi := &file_organizations_proto_msgTypes[52]
+	mi := &file_organizations_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2684,7 +4057,7 @@ func (x *Organization_Metadata) String() string {
 func (*Organization_Metadata) ProtoMessage() {}
 
 func (x *Organization_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[52]
+	mi := &file_organizations_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,6 +4115,146 @@ func (x *Organization_Metadata) GetCanvasSandboxModeEnabled() bool {
 	return false
 }
 
+type SSOConfig_OIDC struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	IssuerUrl string                 `protobuf:"bytes,1,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
+	ClientId  string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	//
+	// Only used when updating the configuration.
+	// If empty, the current secret is kept.
+	//
+	ClientSecret           string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	ClientSecretConfigured bool   `protobuf:"varint,4,opt,name=client_secret_configured,json=clientSecretConfigured,proto3" json:"client_secret_configured,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SSOConfig_OIDC) Reset() {
+	*x = SSOConfig_OIDC{}
+	mi := &file_organizations_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOConfig_OIDC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOConfig_OIDC) ProtoMessage() {}
+
+func (x *SSOConfig_OIDC) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOConfig_OIDC.ProtoReflect.Descriptor instead.
+func (*SSOConfig_OIDC) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *SSOConfig_OIDC) GetIssuerUrl() string {
+	if x != nil {
+		return x.IssuerUrl
+	}
+	return ""
+}
+
+func (x *SSOConfig_OIDC) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SSOConfig_OIDC) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *SSOConfig_OIDC) GetClientSecretConfigured() bool {
+	if x != nil {
+		return x.ClientSecretConfigured
+	}
+	return false
+}
+
+type SSOConfig_SAML struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdpMetadata   string                 `protobuf:"bytes,1,opt,name=idp_metadata,json=idpMetadata,proto3" json:"idp_metadata,omitempty"`
+	IdpEntityId   string                 `protobuf:"bytes,2,opt,name=idp_entity_id,json=idpEntityId,proto3" json:"idp_entity_id,omitempty"`
+	SpEntityId    string                 `protobuf:"bytes,3,opt,name=sp_entity_id,json=spEntityId,proto3" json:"sp_entity_id,omitempty"`
+	SpMetadataUrl string                 `protobuf:"bytes,4,opt,name=sp_metadata_url,json=spMetadataUrl,proto3" json:"sp_metadata_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSOConfig_SAML) Reset() {
+	*x = SSOConfig_SAML{}
+	mi := &file_organizations_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOConfig_SAML) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOConfig_SAML) ProtoMessage() {}
+
+func (x *SSOConfig_SAML) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOConfig_SAML.ProtoReflect.Descriptor instead.
+func (*SSOConfig_SAML) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *SSOConfig_SAML) GetIdpMetadata() string {
+	if x != nil {
+		return x.IdpMetadata
+	}
+	return ""
+}
+
+func (x *SSOConfig_SAML) GetIdpEntityId() string {
+	if x != nil {
+		return x.IdpEntityId
+	}
+	return ""
+}
+
+func (x *SSOConfig_SAML) GetSpEntityId() string {
+	if x != nil {
+		return x.SpEntityId
+	}
+	return ""
+}
+
+func (x *SSOConfig_SAML) GetSpMetadataUrl() string {
+	if x != nil {
+		return x.SpMetadataUrl
+	}
+	return ""
+}
+
 type Integration_Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2754,7 +4267,7 @@ type Integration_Metadata struct {
 
 func (x *Integration_Metadata) Reset() {
 	*x = Integration_Metadata{}
-	mi := &file_organizations_proto_msgTypes[54]
+	mi := &file_organizations_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2766,7 +4279,7 @@ func (x *Integration_Metadata) String() string {
 func (*Integration_Metadata) ProtoMessage() {}
 
 func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[54]
+	mi := &file_organizations_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,7 +4292,7 @@ func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Metadata.ProtoReflect.Descriptor instead.
 func (*Integration_Metadata) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 0}
+	return file_organizations_proto_rawDescGZIP(), []int{64, 0}
 }
 
 func (x *Integration_Metadata) GetId() string {
@@ -2820,7 +4333,7 @@ type Integration_Spec struct {
 
 func (x *Integration_Spec) Reset() {
 	*x = Integration_Spec{}
-	mi := &file_organizations_proto_msgTypes[55]
+	mi := &file_organizations_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2832,7 +4345,7 @@ func (x *Integration_Spec) String() string {
 func (*Integration_Spec) ProtoMessage() {}
 
 func (x *Integration_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[55]
+	mi := &file_organizations_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2845,7 +4358,7 @@ func (x *Integration_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Spec.ProtoReflect.Descriptor instead.
 func (*Integration_Spec) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 1}
+	return file_organizations_proto_rawDescGZIP(), []int{64, 1}
 }
 
 func (x *Integration_Spec) GetIntegrationName() string {
@@ -2875,7 +4388,7 @@ type Integration_Status struct {
 
 func (x *Integration_Status) Reset() {
 	*x = Integration_Status{}
-	mi := &file_organizations_proto_msgTypes[56]
+	mi := &file_organizations_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2887,7 +4400,7 @@ func (x *Integration_Status) String() string {
 func (*Integration_Status) ProtoMessage() {}
 
 func (x *Integration_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[56]
+	mi := &file_organizations_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2900,7 +4413,7 @@ func (x *Integration_Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Status.ProtoReflect.Descriptor instead.
 func (*Integration_Status) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 2}
+	return file_organizations_proto_rawDescGZIP(), []int{64, 2}
 }
 
 func (x *Integration_Status) GetState() string {
@@ -2950,7 +4463,7 @@ type Integration_NodeRef struct {
 
 func (x *Integration_NodeRef) Reset() {
 	*x = Integration_NodeRef{}
-	mi := &file_organizations_proto_msgTypes[57]
+	mi := &file_organizations_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2962,7 +4475,7 @@ func (x *Integration_NodeRef) String() string {
 func (*Integration_NodeRef) ProtoMessage() {}
 
 func (x *Integration_NodeRef) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[57]
+	mi := &file_organizations_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2975,7 +4488,7 @@ func (x *Integration_NodeRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_NodeRef.ProtoReflect.Descriptor instead.
 func (*Integration_NodeRef) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 3}
+	return file_organizations_proto_rawDescGZIP(), []int{64, 3}
 }
 
 func (x *Integration_NodeRef) GetCanvasId() string {
@@ -3006,6 +4519,186 @@ func (x *Integration_NodeRef) GetNodeName() string {
 	return ""
 }
 
+type Calendar_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calendar_Metadata) Reset() {
+	*x = Calendar_Metadata{}
+	mi := &file_organizations_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar_Metadata) ProtoMessage() {}
+
+func (x *Calendar_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar_Metadata.ProtoReflect.Descriptor instead.
+func (*Calendar_Metadata) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *Calendar_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Calendar_Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Calendar_Metadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Calendar_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Calendar_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Calendar_Exclusion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calendar_Exclusion) Reset() {
+	*x = Calendar_Exclusion{}
+	mi := &file_organizations_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar_Exclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar_Exclusion) ProtoMessage() {}
+
+func (x *Calendar_Exclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar_Exclusion.ProtoReflect.Descriptor instead.
+func (*Calendar_Exclusion) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{70, 1}
+}
+
+func (x *Calendar_Exclusion) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Calendar_Exclusion) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *Calendar_Exclusion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Calendar_Spec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exclusions    []*Calendar_Exclusion  `protobuf:"bytes,1,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calendar_Spec) Reset() {
+	*x = Calendar_Spec{}
+	mi := &file_organizations_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar_Spec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar_Spec) ProtoMessage() {}
+
+func (x *Calendar_Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar_Spec.ProtoReflect.Descriptor instead.
+func (*Calendar_Spec) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{70, 2}
+}
+
+func (x *Calendar_Spec) GetExclusions() []*Calendar_Exclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
 var File_organizations_proto protoreflect.FileDescriptor
 
 const file_organizations_proto_rawDesc = "" +
@@ -3070,7 +4763,46 @@ const file_organizations_proto_rawDesc = "" +
 	"\x12agent_mode_enabled\x18\x02 \x01(\bR\x10agentModeEnabled\x120\n" +
 	"\x14agent_mode_effective\x18\x03 \x01(\bR\x12agentModeEffective\x12G\n" +
 	"\n" +
-	"openai_key\x18\x04 \x01(\v2(.Superplane.Organizations.AgentOpenAIKeyR\topenaiKey\"?\n" +
+	"openai_key\x18\x04 \x01(\v2(.Superplane.Organizations.AgentOpenAIKeyR\topenaiKey\"D\n" +
+	"\x0fSSOGroupMapping\x12\x1b\n" +
+	"\tidp_group\x18\x01 \x01(\tR\bidpGroup\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\"\xcf\x06\n" +
+	"\tSSOConfig\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1a\n" +
+	"\benforced\x18\x04 \x01(\bR\benforced\x12<\n" +
+	"\x04oidc\x18\x05 \x01(\v2(.Superplane.Organizations.SSOConfig.OIDCR\x04oidc\x12<\n" +
+	"\x04saml\x18\x06 \x01(\v2(.Superplane.Organizations.SSOConfig.SAMLR\x04saml\x12!\n" +
+	"\fgroups_claim\x18\a \x01(\tR\vgroupsClaim\x12P\n" +
+	"\x0egroup_mappings\x18\b \x03(\v2).Superplane.Organizations.SSOGroupMappingR\rgroupMappings\x12\x1b\n" +
+	"\tlogin_url\x18\t \x01(\tR\bloginUrl\x12!\n" +
+	"\fcallback_url\x18\n" +
+	" \x01(\tR\vcallbackUrl\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\x1a\xa1\x01\n" +
+	"\x04OIDC\x12\x1d\n" +
+	"\n" +
+	"issuer_url\x18\x01 \x01(\tR\tissuerUrl\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x128\n" +
+	"\x18client_secret_configured\x18\x04 \x01(\bR\x16clientSecretConfigured\x1a\x97\x01\n" +
+	"\x04SAML\x12!\n" +
+	"\fidp_metadata\x18\x01 \x01(\tR\vidpMetadata\x12\"\n" +
+	"\ridp_entity_id\x18\x02 \x01(\tR\vidpEntityId\x12 \n" +
+	"\fsp_entity_id\x18\x03 \x01(\tR\n" +
+	"spEntityId\x12&\n" +
+	"\x0fsp_metadata_url\x18\x04 \x01(\tR\rspMetadataUrl\"\xa3\x02\n" +
+	"\n" +
+	"SCIMConfig\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x19\n" +
+	"\bbase_url\x18\x03 \x01(\tR\abaseUrl\x12D\n" +
+	"\x10token_created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0etokenCreatedAt\x12G\n" +
+	"\x12token_last_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ftokenLastUsedAt\x12(\n" +
+	"\x10token_created_by\x18\x06 \x01(\tR\x0etokenCreatedBy\"?\n" +
 	"\x17CreateInvitationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\"`\n" +
@@ -3120,7 +4852,45 @@ const file_organizations_proto_rawDesc = "" +
 	"\x1bDeleteAgentOpenAIKeyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"n\n" +
 	"\x1cDeleteAgentOpenAIKeyResponse\x12N\n" +
-	"\x0eagent_settings\x18\x01 \x01(\v2'.Superplane.Organizations.AgentSettingsR\ragentSettings\"<\n" +
+	"\x0eagent_settings\x18\x01 \x01(\v2'.Superplane.Organizations.AgentSettingsR\ragentSettings\"%\n" +
+	"\x13GetSSOConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x14GetSSOConfigResponse\x12B\n" +
+	"\n" +
+	"sso_config\x18\x01 \x01(\v2#.Superplane.Organizations.SSOConfigR\tssoConfig\"l\n" +
+	"\x16UpdateSSOConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12B\n" +
+	"\n" +
+	"sso_config\x18\x02 \x01(\v2#.Superplane.Organizations.SSOConfigR\tssoConfig\"]\n" +
+	"\x17UpdateSSOConfigResponse\x12B\n" +
+	"\n" +
+	"sso_config\x18\x01 \x01(\v2#.Superplane.Organizations.SSOConfigR\tssoConfig\"(\n" +
+	"\x16DeleteSSOConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DeleteSSOConfigResponse\"&\n" +
+	"\x14GetSCIMConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"^\n" +
+	"\x15GetSCIMConfigResponse\x12E\n" +
+	"\vscim_config\x18\x01 \x01(\v2$.Superplane.Organizations.SCIMConfigR\n" +
+	"scimConfig\",\n" +
+	"\x1aRegenerateSCIMTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"z\n" +
+	"\x1bRegenerateSCIMTokenResponse\x12E\n" +
+	"\vscim_config\x18\x01 \x01(\v2$.Superplane.Organizations.SCIMConfigR\n" +
+	"scimConfig\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"(\n" +
+	"\x16DeleteSCIMTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DeleteSCIMTokenResponse\"L\n" +
+	"\n" +
+	"QuotaUsage\x12\x14\n" +
+	"\x05quota\x18\x01 \x01(\tR\x05quota\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\x03R\x04used\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\"!\n" +
+	"\x0fGetUsageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x10GetUsageResponse\x12<\n" +
+	"\x06quotas\x18\x01 \x03(\v2$.Superplane.Organizations.QuotaUsageR\x06quotas\"<\n" +
 	"\x11RemoveUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x14\n" +
@@ -3213,7 +4983,47 @@ const file_organizations_proto_rawDesc = "" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"r\n" +
 	"\x11InvitationCreated\x12#\n" +
 	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x96/\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x86\x04\n" +
+	"\bCalendar\x12G\n" +
+	"\bmetadata\x18\x01 \x01(\v2+.Superplane.Organizations.Calendar.MetadataR\bmetadata\x12;\n" +
+	"\x04spec\x18\x02 \x01(\v2'.Superplane.Organizations.Calendar.SpecR\x04spec\x1a\xc6\x01\n" +
+	"\bMetadata\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aU\n" +
+	"\tExclusion\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x1aT\n" +
+	"\x04Spec\x12L\n" +
+	"\n" +
+	"exclusions\x18\x01 \x03(\v2,.Superplane.Organizations.Calendar.ExclusionR\n" +
+	"exclusions\"&\n" +
+	"\x14ListCalendarsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
+	"\x15ListCalendarsResponse\x12@\n" +
+	"\tcalendars\x18\x01 \x03(\v2\".Superplane.Organizations.CalendarR\tcalendars\"g\n" +
+	"\x15CreateCalendarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12>\n" +
+	"\bcalendar\x18\x02 \x01(\v2\".Superplane.Organizations.CalendarR\bcalendar\"X\n" +
+	"\x16CreateCalendarResponse\x12>\n" +
+	"\bcalendar\x18\x01 \x01(\v2\".Superplane.Organizations.CalendarR\bcalendar\"\x88\x01\n" +
+	"\x15UpdateCalendarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcalendar_id\x18\x02 \x01(\tR\n" +
+	"calendarId\x12>\n" +
+	"\bcalendar\x18\x03 \x01(\v2\".Superplane.Organizations.CalendarR\bcalendar\"X\n" +
+	"\x16UpdateCalendarResponse\x12>\n" +
+	"\bcalendar\x18\x01 \x01(\v2\".Superplane.Organizations.CalendarR\bcalendar\"H\n" +
+	"\x15DeleteCalendarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcalendar_id\x18\x02 \x01(\tR\n" +
+	"calendarId\"\x18\n" +
+	"\x16DeleteCalendarResponse2\x98H\n" +
 	"\rOrganizations\x12\xa7\x02\n" +
 	"\x14DescribeOrganization\x125.Superplane.Organizations.DescribeOrganizationRequest\x1a6.Superplane.Organizations.DescribeOrganizationResponse\"\x9f\x01\x92Az\n" +
 	"\fOrganization\x12\x18Get organization details\x1aPReturns the details of a specific organization (can be referenced by ID or name)\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/organizations/{id}\x12\x96\x02\n" +
@@ -3243,7 +5053,21 @@ const file_organizations_proto_rawDesc = "" +
 	"\x11SetAgentOpenAIKey\x122.Superplane.Organizations.SetAgentOpenAIKeyRequest\x1a3.Superplane.Organizations.SetAgentOpenAIKeyResponse\"\xbe\x01\x92A|\n" +
 	"\fOrganization\x127Create or update organization OpenAI key for Agent Mode\x1a3Sets the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x029:\x01*\x1a4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xbc\x02\n" +
 	"\x14DeleteAgentOpenAIKey\x125.Superplane.Organizations.DeleteAgentOpenAIKeyRequest\x1a6.Superplane.Organizations.DeleteAgentOpenAIKeyResponse\"\xb4\x01\x92Au\n" +
-	"\fOrganization\x12-Delete organization OpenAI key for Agent Mode\x1a6Deletes the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x026*4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xea\x01\n" +
+	"\fOrganization\x12-Delete organization OpenAI key for Agent Mode\x1a6Deletes the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x026*4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xa1\x02\n" +
+	"\fGetSSOConfig\x12-.Superplane.Organizations.GetSSOConfigRequest\x1a..Superplane.Organizations.GetSSOConfigResponse\"\xb1\x01\x92A\x87\x01\n" +
+	"\fOrganization\x12-Get organization single sign-on configuration\x1aHReturns the OIDC or SAML single sign-on configuration of an organization\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/organizations/{id}/sso\x12\xd2\x02\n" +
+	"\x0fUpdateSSOConfig\x120.Superplane.Organizations.UpdateSSOConfigRequest\x1a1.Superplane.Organizations.UpdateSSOConfigResponse\"\xd9\x01\x92A\xac\x01\n" +
+	"\fOrganization\x12:Create or update organization single sign-on configuration\x1a`Configures the OIDC or SAML identity provider, enforcement and group mappings of an organization\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/organizations/{id}/sso\x12\x9f\x02\n" +
+	"\x0fDeleteSSOConfig\x120.Superplane.Organizations.DeleteSSOConfigRequest\x1a1.Superplane.Organizations.DeleteSSOConfigResponse\"\xa6\x01\x92A}\n" +
+	"\fOrganization\x120Delete organization single sign-on configuration\x1a;Removes the single sign-on configuration of an organization\x82\xd3\xe4\x93\x02 *\x1e/api/v1/organizations/{id}/sso\x12\xb4\x02\n" +
+	"\rGetSCIMConfig\x12..Superplane.Organizations.GetSCIMConfigRequest\x1a/.Superplane.Organizations.GetSCIMConfigResponse\"\xc1\x01\x92A\x96\x01\n" +
+	"\fOrganization\x120Get organization SCIM provisioning configuration\x1aTReturns the SCIM endpoint of an organization and the state of its provisioning token\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/organizations/{id}/scim\x12\xc6\x02\n" +
+	"\x13RegenerateSCIMToken\x124.Superplane.Organizations.RegenerateSCIMTokenRequest\x1a5.Superplane.Organizations.RegenerateSCIMTokenResponse\"\xc1\x01\x92A\x8d\x01\n" +
+	"\fOrganization\x12\"Regenerate organization SCIM token\x1aYEnables SCIM provisioning for an organization with a new token, revoking the previous one\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/organizations/{id}/scim/token\x12\x87\x02\n" +
+	"\x0fDeleteSCIMToken\x120.Superplane.Organizations.DeleteSCIMTokenRequest\x1a1.Superplane.Organizations.DeleteSCIMTokenResponse\"\x8e\x01\x92A^\n" +
+	"\fOrganization\x12\x1eDelete organization SCIM token\x1a.Disables SCIM provisioning for an organization\x82\xd3\xe4\x93\x02'*%/api/v1/organizations/{id}/scim/token\x12\xf8\x01\n" +
+	"\bGetUsage\x12).Superplane.Organizations.GetUsageRequest\x1a*.Superplane.Organizations.GetUsageResponse\"\x94\x01\x92Ai\n" +
+	"\fOrganization\x12\x16Get organization usage\x1aAReturns the usage of an organization and the limits of its quotas\x82\xd3\xe4\x93\x02\"\x12 /api/v1/organizations/{id}/usage\x12\xea\x01\n" +
 	"\x10AcceptInviteLink\x12$.Superplane.Organizations.InviteLink\x1a\x17.google.protobuf.Struct\"\x96\x01\x92Ah\n" +
 	"\fOrganization\x12\x15Accept an invite link\x1aAAccepts an organization invite link for the authenticated account\x82\xd3\xe4\x93\x02%\"#/api/v1/invite-links/{token}/accept\x12\x95\x02\n" +
 	"\x10ListIntegrations\x121.Superplane.Organizations.ListIntegrationsRequest\x1a2.Superplane.Organizations.ListIntegrationsResponse\"\x99\x01\x92Ag\n" +
@@ -3257,7 +5081,15 @@ const file_organizations_proto_rawDesc = "" +
 	"\x11UpdateIntegration\x122.Superplane.Organizations.UpdateIntegrationRequest\x1a3.Superplane.Organizations.UpdateIntegrationResponse\"\xa3\x01\x92A]\n" +
 	"\fOrganization\x12\x12Update integration\x1a9Updates the configuration for an organization integration\x82\xd3\xe4\x93\x02=:\x01*28/api/v1/organizations/{id}/integrations/{integration_id}\x12\x9e\x02\n" +
 	"\x11DeleteIntegration\x122.Superplane.Organizations.DeleteIntegrationRequest\x1a3.Superplane.Organizations.DeleteIntegrationResponse\"\x9f\x01\x92A\\\n" +
-	"\fOrganization\x12\x1fDelete organization integration\x1a+Deletes an integration from an organization\x82\xd3\xe4\x93\x02:*8/api/v1/organizations/{id}/integrations/{integration_id}B\xf0\x01\x92A\xaf\x01\x12\x84\x01\n" +
+	"\fOrganization\x12\x1fDelete organization integration\x1a+Deletes an integration from an organization\x82\xd3\xe4\x93\x02:*8/api/v1/organizations/{id}/integrations/{integration_id}\x12\xa8\x02\n" +
+	"\rListCalendars\x12..Superplane.Organizations.ListCalendarsRequest\x1a/.Superplane.Organizations.ListCalendarsResponse\"\xb5\x01\x92A\x85\x01\n" +
+	"\fOrganization\x12!List calendars in an organization\x1aRReturns the exclusion calendars that schedule triggers in the organization can use\x82\xd3\xe4\x93\x02&\x12$/api/v1/organizations/{id}/calendars\x12\xa0\x02\n" +
+	"\x0eCreateCalendar\x12/.Superplane.Organizations.CreateCalendarRequest\x1a0.Superplane.Organizations.CreateCalendarResponse\"\xaa\x01\x92Ax\n" +
+	"\fOrganization\x12\x11Create a calendar\x1aUCreates an exclusion calendar that can be shared by schedule triggers across canvases\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/organizations/{id}/calendars\x12\x91\x02\n" +
+	"\x0eUpdateCalendar\x12/.Superplane.Organizations.UpdateCalendarRequest\x1a0.Superplane.Organizations.UpdateCalendarResponse\"\x9b\x01\x92A[\n" +
+	"\fOrganization\x12\x11Update a calendar\x1a8Updates the description and excluded dates of a calendar\x82\xd3\xe4\x93\x027:\x01*22/api/v1/organizations/{id}/calendars/{calendar_id}\x12\xfd\x01\n" +
+	"\x0eDeleteCalendar\x12/.Superplane.Organizations.DeleteCalendarRequest\x1a0.Superplane.Organizations.DeleteCalendarResponse\"\x87\x01\x92AJ\n" +
+	"\fOrganization\x12\x11Delete a calendar\x1a'Deletes a calendar from an organization\x82\xd3\xe4\x93\x024*2/api/v1/organizations/{id}/calendars/{calendar_id}B\xf0\x01\x92A\xaf\x01\x12\x84\x01\n" +
 	"\x1cSuperplane Organizations API\x128API for managing organizations in the Superplane service\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ;github.com/superplanehq/superplane/pkg/protos/organizationsb\x06proto3"
 
@@ -3273,7 +5105,7 @@ func file_organizations_proto_rawDescGZIP() []byte {
 	return file_organizations_proto_rawDescData
 }
 
-var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
 var file_organizations_proto_goTypes = []any{
 	(*Organization)(nil),                     // 0: Superplane.Organizations.Organization
 	(*DescribeOrganizationRequest)(nil),      // 1: Superplane.Organizations.DescribeOrganizationRequest
@@ -3286,148 +5118,224 @@ var file_organizations_proto_goTypes = []any{
 	(*InviteLink)(nil),                       // 8: Superplane.Organizations.InviteLink
 	(*AgentOpenAIKey)(nil),                   // 9: Superplane.Organizations.AgentOpenAIKey
 	(*AgentSettings)(nil),                    // 10: Superplane.Organizations.AgentSettings
-	(*CreateInvitationRequest)(nil),          // 11: Superplane.Organizations.CreateInvitationRequest
-	(*CreateInvitationResponse)(nil),         // 12: Superplane.Organizations.CreateInvitationResponse
-	(*ListInvitationsRequest)(nil),           // 13: Superplane.Organizations.ListInvitationsRequest
-	(*ListInvitationsResponse)(nil),          // 14: Superplane.Organizations.ListInvitationsResponse
-	(*RemoveInvitationRequest)(nil),          // 15: Superplane.Organizations.RemoveInvitationRequest
-	(*RemoveInvitationResponse)(nil),         // 16: Superplane.Organizations.RemoveInvitationResponse
-	(*GetInviteLinkRequest)(nil),             // 17: Superplane.Organizations.GetInviteLinkRequest
-	(*GetInviteLinkResponse)(nil),            // 18: Superplane.Organizations.GetInviteLinkResponse
-	(*UpdateInviteLinkRequest)(nil),          // 19: Superplane.Organizations.UpdateInviteLinkRequest
-	(*UpdateInviteLinkResponse)(nil),         // 20: Superplane.Organizations.UpdateInviteLinkResponse
-	(*ResetInviteLinkRequest)(nil),           // 21: Superplane.Organizations.ResetInviteLinkRequest
-	(*ResetInviteLinkResponse)(nil),          // 22: Superplane.Organizations.ResetInviteLinkResponse
-	(*GetAgentSettingsRequest)(nil),          // 23: Superplane.Organizations.GetAgentSettingsRequest
-	(*GetAgentSettingsResponse)(nil),         // 24: Superplane.Organizations.GetAgentSettingsResponse
-	(*UpdateAgentSettingsRequest)(nil),       // 25: Superplane.Organizations.UpdateAgentSettingsRequest
-	(*UpdateAgentSettingsResponse)(nil),      // 26: Superplane.Organizations.UpdateAgentSettingsResponse
-	(*SetAgentOpenAIKeyRequest)(nil),         // 27: Superplane.Organizations.SetAgentOpenAIKeyRequest
-	(*SetAgentOpenAIKeyResponse)(nil),        // 28: Superplane.Organizations.SetAgentOpenAIKeyResponse
-	(*DeleteAgentOpenAIKeyRequest)(nil),      // 29: Superplane.Organizations.DeleteAgentOpenAIKeyRequest
-	(*DeleteAgentOpenAIKeyResponse)(nil),     // 30: Superplane.Organizations.DeleteAgentOpenAIKeyResponse
-	(*RemoveUserRequest)(nil),                // 31: Superplane.Organizations.RemoveUserRequest
-	(*RemoveUserResponse)(nil),               // 32: Superplane.Organizations.RemoveUserResponse
-	(*ListIntegrationsRequest)(nil),          // 33: Superplane.Organizations.ListIntegrationsRequest
-	(*ListIntegrationsResponse)(nil),         // 34: Superplane.Organizations.ListIntegrationsResponse
-	(*CreateIntegrationRequest)(nil),         // 35: Superplane.Organizations.CreateIntegrationRequest
-	(*CreateIntegrationResponse)(nil),        // 36: Superplane.Organizations.CreateIntegrationResponse
-	(*DescribeIntegrationRequest)(nil),       // 37: Superplane.Organizations.DescribeIntegrationRequest
-	(*DescribeIntegrationResponse)(nil),      // 38: Superplane.Organizations.DescribeIntegrationResponse
-	(*ListIntegrationResourcesRequest)(nil),  // 39: Superplane.Organizations.ListIntegrationResourcesRequest
-	(*ListIntegrationResourcesResponse)(nil), // 40: Superplane.Organizations.ListIntegrationResourcesResponse
-	(*IntegrationResourceRef)(nil),           // 41: Superplane.Organizations.IntegrationResourceRef
-	(*UpdateIntegrationRequest)(nil),         // 42: Superplane.Organizations.UpdateIntegrationRequest
-	(*UpdateIntegrationResponse)(nil),        // 43: Superplane.Organizations.UpdateIntegrationResponse
-	(*DeleteIntegrationRequest)(nil),         // 44: Superplane.Organizations.DeleteIntegrationRequest
-	(*DeleteIntegrationResponse)(nil),        // 45: Superplane.Organizations.DeleteIntegrationResponse
-	(*Integration)(nil),                      // 46: Superplane.Organizations.Integration
-	(*BrowserAction)(nil),                    // 47: Superplane.Organizations.BrowserAction
-	(*OrganizationCreated)(nil),              // 48: Superplane.Organizations.OrganizationCreated
-	(*OrganizationUpdated)(nil),              // 49: Superplane.Organizations.OrganizationUpdated
-	(*OrganizationDeleted)(nil),              // 50: Superplane.Organizations.OrganizationDeleted
-	(*InvitationCreated)(nil),                // 51: Superplane.Organizations.InvitationCreated
-	(*Organization_Metadata)(nil),            // 52: Superplane.Organizations.Organization.Metadata
-	nil,                                      // 53: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	(*Integration_Metadata)(nil),             // 54: Superplane.Organizations.Integration.Metadata
-	(*Integration_Spec)(nil),                 // 55: Superplane.Organizations.Integration.Spec
-	(*Integration_Status)(nil),               // 56: Superplane.Organizations.Integration.Status
-	(*Integration_NodeRef)(nil),              // 57: Superplane.Organizations.Integration.NodeRef
-	nil,                                      // 58: Superplane.Organizations.BrowserAction.FormFieldsEntry
-	(*timestamp.Timestamp)(nil),              // 59: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                   // 60: google.protobuf.Struct
+	(*SSOGroupMapping)(nil),                  // 11: Superplane.Organizations.SSOGroupMapping
+	(*SSOConfig)(nil),                        // 12: Superplane.Organizations.SSOConfig
+	(*SCIMConfig)(nil),                       // 13: Superplane.Organizations.SCIMConfig
+	(*CreateInvitationRequest)(nil),          // 14: Superplane.Organizations.CreateInvitationRequest
+	(*CreateInvitationResponse)(nil),         // 15: Superplane.Organizations.CreateInvitationResponse
+	(*ListInvitationsRequest)(nil),           // 16: Superplane.Organizations.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),          // 17: Superplane.Organizations.ListInvitationsResponse
+	(*RemoveInvitationRequest)(nil),          // 18: Superplane.Organizations.RemoveInvitationRequest
+	(*RemoveInvitationResponse)(nil),         // 19: Superplane.Organizations.RemoveInvitationResponse
+	(*GetInviteLinkRequest)(nil),             // 20: Superplane.Organizations.GetInviteLinkRequest
+	(*GetInviteLinkResponse)(nil),            // 21: Superplane.Organizations.GetInviteLinkResponse
+	(*UpdateInviteLinkRequest)(nil),          // 22: Superplane.Organizations.UpdateInviteLinkRequest
+	(*UpdateInviteLinkResponse)(nil),         // 23: Superplane.Organizations.UpdateInviteLinkResponse
+	(*ResetInviteLinkRequest)(nil),           // 24: Superplane.Organizations.ResetInviteLinkRequest
+	(*ResetInviteLinkResponse)(nil),          // 25: Superplane.Organizations.ResetInviteLinkResponse
+	(*GetAgentSettingsRequest)(nil),          // 26: Superplane.Organizations.GetAgentSettingsRequest
+	(*GetAgentSettingsResponse)(nil),         // 27: Superplane.Organizations.GetAgentSettingsResponse
+	(*UpdateAgentSettingsRequest)(nil),       // 28: Superplane.Organizations.UpdateAgentSettingsRequest
+	(*UpdateAgentSettingsResponse)(nil),      // 29: Superplane.Organizations.UpdateAgentSettingsResponse
+	(*SetAgentOpenAIKeyRequest)(nil),         // 30: Superplane.Organizations.SetAgentOpenAIKeyRequest
+	(*SetAgentOpenAIKeyResponse)(nil),        // 31: Superplane.Organizations.SetAgentOpenAIKeyResponse
+	(*DeleteAgentOpenAIKeyRequest)(nil),      // 32: Superplane.Organizations.DeleteAgentOpenAIKeyRequest
+	(*DeleteAgentOpenAIKeyResponse)(nil),     // 33: Superplane.Organizations.DeleteAgentOpenAIKeyResponse
+	(*GetSSOConfigRequest)(nil),              // 34: Superplane.Organizations.GetSSOConfigRequest
+	(*GetSSOConfigResponse)(nil),             // 35: Superplane.Organizations.GetSSOConfigResponse
+	(*UpdateSSOConfigRequest)(nil),           // 36: Superplane.Organizations.UpdateSSOConfigRequest
+	(*UpdateSSOConfigResponse)(nil),          // 37: Superplane.Organizations.UpdateSSOConfigResponse
+	(*DeleteSSOConfigRequest)(nil),           // 38: Superplane.Organizations.DeleteSSOConfigRequest
+	(*DeleteSSOConfigResponse)(nil),          // 39: Superplane.Organizations.DeleteSSOConfigResponse
+	(*GetSCIMConfigRequest)(nil),             // 40: Superplane.Organizations.GetSCIMConfigRequest
+	(*GetSCIMConfigResponse)(nil),            // 41: Superplane.Organizations.GetSCIMConfigResponse
+	(*RegenerateSCIMTokenRequest)(nil),       // 42: Superplane.Organizations.RegenerateSCIMTokenRequest
+	(*RegenerateSCIMTokenResponse)(nil),      // 43: Superplane.Organizations.RegenerateSCIMTokenResponse
+	(*DeleteSCIMTokenRequest)(nil),           // 44: Superplane.Organizations.DeleteSCIMTokenRequest
+	(*DeleteSCIMTokenResponse)(nil),          // 45: Superplane.Organizations.DeleteSCIMTokenResponse
+	(*QuotaUsage)(nil),                       // 46: Superplane.Organizations.QuotaUsage
+	(*GetUsageRequest)(nil),                  // 47: Superplane.Organizations.GetUsageRequest
+	(*GetUsageResponse)(nil),                 // 48: Superplane.Organizations.GetUsageResponse
+	(*RemoveUserRequest)(nil),                // 49: Superplane.Organizations.RemoveUserRequest
+	(*RemoveUserResponse)(nil),               // 50: Superplane.Organizations.RemoveUserResponse
+	(*ListIntegrationsRequest)(nil),          // 51: Superplane.Organizations.ListIntegrationsRequest
+	(*ListIntegrationsResponse)(nil),         // 52: Superplane.Organizations.ListIntegrationsResponse
+	(*CreateIntegrationRequest)(nil),         // 53: Superplane.Organizations.CreateIntegrationRequest
+	(*CreateIntegrationResponse)(nil),        // 54: Superplane.Organizations.CreateIntegrationResponse
+	(*DescribeIntegrationRequest)(nil),       // 55: Superplane.Organizations.DescribeIntegrationRequest
+	(*DescribeIntegrationResponse)(nil),      // 56: Superplane.Organizations.DescribeIntegrationResponse
+	(*ListIntegrationResourcesRequest)(nil),  // 57: Superplane.Organizations.ListIntegrationResourcesRequest
+	(*ListIntegrationResourcesResponse)(nil), // 58: Superplane.Organizations.ListIntegrationResourcesResponse
+	(*IntegrationResourceRef)(nil),           // 59: Superplane.Organizations.IntegrationResourceRef
+	(*UpdateIntegrationRequest)(nil),         // 60: Superplane.Organizations.UpdateIntegrationRequest
+	(*UpdateIntegrationResponse)(nil),        // 61: Superplane.Organizations.UpdateIntegrationResponse
+	(*DeleteIntegrationRequest)(nil),         // 62: Superplane.Organizations.DeleteIntegrationRequest
+	(*DeleteIntegrationResponse)(nil),        // 63: Superplane.Organizations.DeleteIntegrationResponse
+	(*Integration)(nil),                      // 64: Superplane.Organizations.Integration
+	(*BrowserAction)(nil),                    // 65: Superplane.Organizations.BrowserAction
+	(*OrganizationCreated)(nil),              // 66: Superplane.Organizations.OrganizationCreated
+	(*OrganizationUpdated)(nil),              // 67: Superplane.Organizations.OrganizationUpdated
+	(*OrganizationDeleted)(nil),              // 68: Superplane.Organizations.OrganizationDeleted
+	(*InvitationCreated)(nil),                // 69: Superplane.Organizations.InvitationCreated
+	(*Calendar)(nil),                         // 70: Superplane.Organizations.Calendar
+	(*ListCalendarsRequest)(nil),             // 71: Superplane.Organizations.ListCalendarsRequest
+	(*ListCalendarsResponse)(nil),            // 72: Superplane.Organizations.ListCalendarsResponse
+	(*CreateCalendarRequest)(nil),            // 73: Superplane.Organizations.CreateCalendarRequest
+	(*CreateCalendarResponse)(nil),           // 74: Superplane.Organizations.CreateCalendarResponse
+	(*UpdateCalendarRequest)(nil),            // 75: Superplane.Organizations.UpdateCalendarRequest
+	(*UpdateCalendarResponse)(nil),           // 76: Superplane.Organizations.UpdateCalendarResponse
+	(*DeleteCalendarRequest)(nil),            // 77: Superplane.Organizations.DeleteCalendarRequest
+	(*DeleteCalendarResponse)(nil),           // 78: Superplane.Organizations.DeleteCalendarResponse
+	(*Organization_Metadata)(nil),            // 79: Superplane.Organizations.Organization.Metadata
+	(*SSOConfig_OIDC)(nil),                   // 80: Superplane.Organizations.SSOConfig.OIDC
+	(*SSOConfig_SAML)(nil),                   // 81: Superplane.Organizations.SSOConfig.SAML
+	nil,                                      // 82: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	(*Integration_Metadata)(nil),             // 83: Superplane.Organizations.Integration.Metadata
+	(*Integration_Spec)(nil),                 // 84: Superplane.Organizations.Integration.Spec
+	(*Integration_Status)(nil),               // 85: Superplane.Organizations.Integration.Status
+	(*Integration_NodeRef)(nil),              // 86: Superplane.Organizations.Integration.NodeRef
+	nil,                                      // 87: Superplane.Organizations.BrowserAction.FormFieldsEntry
+	(*Calendar_Metadata)(nil),                // 88: Superplane.Organizations.Calendar.Metadata
+	(*Calendar_Exclusion)(nil),               // 89: Superplane.Organizations.Calendar.Exclusion
+	(*Calendar_Spec)(nil),                    // 90: Superplane.Organizations.Calendar.Spec
+	(*timestamp.Timestamp)(nil),              // 91: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                   // 92: google.protobuf.Struct
 }
 var file_organizations_proto_depIdxs = []int32{
-	52, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
+	79, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
 	0,  // 1: Superplane.Organizations.DescribeOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
 	0,  // 2: Superplane.Organizations.UpdateOrganizationRequest.organization:type_name -> Superplane.Organizations.Organization
 	0,  // 3: Superplane.Organizations.UpdateOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
-	59, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
-	59, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
-	59, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
-	59, // 7: Superplane.Organizations.AgentOpenAIKey.validated_at:type_name -> google.protobuf.Timestamp
-	59, // 8: Superplane.Organizations.AgentOpenAIKey.updated_at:type_name -> google.protobuf.Timestamp
+	91, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	91, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
+	91, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
+	91, // 7: Superplane.Organizations.AgentOpenAIKey.validated_at:type_name -> google.protobuf.Timestamp
+	91, // 8: Superplane.Organizations.AgentOpenAIKey.updated_at:type_name -> google.protobuf.Timestamp
 	9,  // 9: Superplane.Organizations.AgentSettings.openai_key:type_name -> Superplane.Organizations.AgentOpenAIKey
-	7,  // 10: Superplane.Organizations.CreateInvitationResponse.invitation:type_name -> Superplane.Organizations.Invitation
-	7,  // 11: Superplane.Organizations.ListInvitationsResponse.invitations:type_name -> Superplane.Organizations.Invitation
-	8,  // 12: Superplane.Organizations.GetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	8,  // 13: Superplane.Organizations.UpdateInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	8,  // 14: Superplane.Organizations.ResetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	10, // 15: Superplane.Organizations.GetAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	10, // 16: Superplane.Organizations.UpdateAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	10, // 17: Superplane.Organizations.SetAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	10, // 18: Superplane.Organizations.DeleteAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	46, // 19: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
-	60, // 20: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	46, // 21: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	46, // 22: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	53, // 23: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	41, // 24: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
-	60, // 25: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	46, // 26: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	54, // 27: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
-	55, // 28: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
-	56, // 29: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
-	58, // 30: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
-	59, // 31: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 32: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 33: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 34: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 35: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	59, // 36: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	59, // 37: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	59, // 38: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	60, // 39: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
-	60, // 40: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
-	47, // 41: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
-	57, // 42: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
-	1,  // 43: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
-	3,  // 44: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
-	5,  // 45: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
-	31, // 46: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
-	11, // 47: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
-	13, // 48: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
-	15, // 49: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
-	17, // 50: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
-	19, // 51: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
-	21, // 52: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
-	23, // 53: Superplane.Organizations.Organizations.GetAgentSettings:input_type -> Superplane.Organizations.GetAgentSettingsRequest
-	25, // 54: Superplane.Organizations.Organizations.UpdateAgentSettings:input_type -> Superplane.Organizations.UpdateAgentSettingsRequest
-	27, // 55: Superplane.Organizations.Organizations.SetAgentOpenAIKey:input_type -> Superplane.Organizations.SetAgentOpenAIKeyRequest
-	29, // 56: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:input_type -> Superplane.Organizations.DeleteAgentOpenAIKeyRequest
-	8,  // 57: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
-	33, // 58: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
-	37, // 59: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
-	39, // 60: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
-	35, // 61: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
-	42, // 62: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
-	44, // 63: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
-	2,  // 64: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
-	4,  // 65: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
-	6,  // 66: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
-	32, // 67: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
-	12, // 68: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
-	14, // 69: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
-	16, // 70: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
-	18, // 71: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
-	20, // 72: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
-	22, // 73: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
-	24, // 74: Superplane.Organizations.Organizations.GetAgentSettings:output_type -> Superplane.Organizations.GetAgentSettingsResponse
-	26, // 75: Superplane.Organizations.Organizations.UpdateAgentSettings:output_type -> Superplane.Organizations.UpdateAgentSettingsResponse
-	28, // 76: Superplane.Organizations.Organizations.SetAgentOpenAIKey:output_type -> Superplane.Organizations.SetAgentOpenAIKeyResponse
-	30, // 77: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:output_type -> Superplane.Organizations.DeleteAgentOpenAIKeyResponse
-	60, // 78: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
-	34, // 79: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
-	38, // 80: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
-	40, // 81: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
-	36, // 82: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
-	43, // 83: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
-	45, // 84: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
-	64, // [64:85] is the sub-list for method output_type
-	43, // [43:64] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	80, // 10: Superplane.Organizations.SSOConfig.oidc:type_name -> Superplane.Organizations.SSOConfig.OIDC
+	81, // 11: Superplane.Organizations.SSOConfig.saml:type_name -> Superplane.Organizations.SSOConfig.SAML
+	11, // 12: Superplane.Organizations.SSOConfig.group_mappings:type_name -> Superplane.Organizations.SSOGroupMapping
+	91, // 13: Superplane.Organizations.SSOConfig.updated_at:type_name -> google.protobuf.Timestamp
+	91, // 14: Superplane.Organizations.SCIMConfig.token_created_at:type_name -> google.protobuf.Timestamp
+	91, // 15: Superplane.Organizations.SCIMConfig.token_last_used_at:type_name -> google.protobuf.Timestamp
+	7,  // 16: Superplane.Organizations.CreateInvitationResponse.invitation:type_name -> Superplane.Organizations.Invitation
+	7,  // 17: Superplane.Organizations.ListInvitationsResponse.invitations:type_name -> Superplane.Organizations.Invitation
+	8,  // 18: Superplane.Organizations.GetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	8,  // 19: Superplane.Organizations.UpdateInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	8,  // 20: Superplane.Organizations.ResetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	10, // 21: Superplane.Organizations.GetAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	10, // 22: Superplane.Organizations.UpdateAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	10, // 23: Superplane.Organizations.SetAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	10, // 24: Superplane.Organizations.DeleteAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	12, // 25: Superplane.Organizations.GetSSOConfigResponse.sso_config:type_name -> Superplane.Organizations.SSOConfig
+	12, // 26: Superplane.Organizations.UpdateSSOConfigRequest.sso_config:type_name -> Superplane.Organizations.SSOConfig
+	12, // 27: Superplane.Organizations.UpdateSSOConfigResponse.sso_config:type_name -> Superplane.Organizations.SSOConfig
+	13, // 28: Superplane.Organizations.GetSCIMConfigResponse.scim_config:type_name -> Superplane.Organizations.SCIMConfig
+	13, // 29: Superplane.Organizations.RegenerateSCIMTokenResponse.scim_config:type_name -> Superplane.Organizations.SCIMConfig
+	46, // 30: Superplane.Organizations.GetUsageResponse.quotas:type_name -> Superplane.Organizations.QuotaUsage
+	64, // 31: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
+	92, // 32: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	64, // 33: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	64, // 34: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	82, // 35: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	59, // 36: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
+	92, // 37: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	64, // 38: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	83, // 39: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
+	84, // 40: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
+	85, // 41: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
+	87, // 42: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
+	91, // 43: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	91, // 44: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
+	91, // 45: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
+	91, // 46: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	88, // 47: Superplane.Organizations.Calendar.metadata:type_name -> Superplane.Organizations.Calendar.Metadata
+	90, // 48: Superplane.Organizations.Calendar.spec:type_name -> Superplane.Organizations.Calendar.Spec
+	70, // 49: Superplane.Organizations.ListCalendarsResponse.calendars:type_name -> Superplane.Organizations.Calendar
+	70, // 50: Superplane.Organizations.CreateCalendarRequest.calendar:type_name -> Superplane.Organizations.Calendar
+	70, // 51: Superplane.Organizations.CreateCalendarResponse.calendar:type_name -> Superplane.Organizations.Calendar
+	70, // 52: Superplane.Organizations.UpdateCalendarRequest.calendar:type_name -> Superplane.Organizations.Calendar
+	70, // 53: Superplane.Organizations.UpdateCalendarResponse.calendar:type_name -> Superplane.Organizations.Calendar
+	91, // 54: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	91, // 55: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	91, // 56: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	91, // 57: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	92, // 58: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
+	92, // 59: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
+	65, // 60: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
+	86, // 61: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
+	91, // 62: Superplane.Organizations.Calendar.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	91, // 63: Superplane.Organizations.Calendar.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	89, // 64: Superplane.Organizations.Calendar.Spec.exclusions:type_name -> Superplane.Organizations.Calendar.Exclusion
+	1,  // 65: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
+	3,  // 66: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
+	5,  // 67: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
+	49, // 68: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
+	14, // 69: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
+	16, // 70: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
+	18, // 71: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
+	20, // 72: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
+	22, // 73: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
+	24, // 74: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
+	26, // 75: Superplane.Organizations.Organizations.GetAgentSettings:input_type -> Superplane.Organizations.GetAgentSettingsRequest
+	28, // 76: Superplane.Organizations.Organizations.UpdateAgentSettings:input_type -> Superplane.Organizations.UpdateAgentSettingsRequest
+	30, // 77: Superplane.Organizations.Organizations.SetAgentOpenAIKey:input_type -> Superplane.Organizations.SetAgentOpenAIKeyRequest
+	32, // 78: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:input_type -> Superplane.Organizations.DeleteAgentOpenAIKeyRequest
+	34, // 79: Superplane.Organizations.Organizations.GetSSOConfig:input_type -> Superplane.Organizations.GetSSOConfigRequest
+	36, // 80: Superplane.Organizations.Organizations.UpdateSSOConfig:input_type -> Superplane.Organizations.UpdateSSOConfigRequest
+	38, // 81: Superplane.Organizations.Organizations.DeleteSSOConfig:input_type -> Superplane.Organizations.DeleteSSOConfigRequest
+	40, // 82: Superplane.Organizations.Organizations.GetSCIMConfig:input_type -> Superplane.Organizations.GetSCIMConfigRequest
+	42, // 83: Superplane.Organizations.Organizations.RegenerateSCIMToken:input_type -> Superplane.Organizations.RegenerateSCIMTokenRequest
+	44, // 84: Superplane.Organizations.Organizations.DeleteSCIMToken:input_type -> Superplane.Organizations.DeleteSCIMTokenRequest
+	47, // 85: Superplane.Organizations.Organizations.GetUsage:input_type -> Superplane.Organizations.GetUsageRequest
+	8,  // 86: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
+	51, // 87: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
+	55, // 88: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
+	57, // 89: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
+	53, // 90: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
+	60, // 91: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
+	62, // 92: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
+	71, // 93: Superplane.Organizations.Organizations.ListCalendars:input_type -> Superplane.Organizations.ListCalendarsRequest
+	73, // 94: Superplane.Organizations.Organizations.CreateCalendar:input_type -> Superplane.Organizations.CreateCalendarRequest
+	75, // 95: Superplane.Organizations.Organizations.UpdateCalendar:input_type -> Superplane.Organizations.UpdateCalendarRequest
+	77, // 96: Superplane.Organizations.Organizations.DeleteCalendar:input_type -> Superplane.Organizations.DeleteCalendarRequest
+	2,  // 97: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
+	4,  // 98: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
+	6,  // 99: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
+	50, // 100: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
+	15, // 101: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
+	17, // 102: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
+	19, // 103: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
+	21, // 104: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
+	23, // 105: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
+	25, // 106: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
+	27, // 107: Superplane.Organizations.Organizations.GetAgentSettings:output_type -> Superplane.Organizations.GetAgentSettingsResponse
+	29, // 108: Superplane.Organizations.Organizations.UpdateAgentSettings:output_type -> Superplane.Organizations.UpdateAgentSettingsResponse
+	31, // 109: Superplane.Organizations.Organizations.SetAgentOpenAIKey:output_type -> Superplane.Organizations.SetAgentOpenAIKeyResponse
+	33, // 110: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:output_type -> Superplane.Organizations.DeleteAgentOpenAIKeyResponse
+	35, // 111: Superplane.Organizations.Organizations.GetSSOConfig:output_type -> Superplane.Organizations.GetSSOConfigResponse
+	37, // 112: Superplane.Organizations.Organizations.UpdateSSOConfig:output_type -> Superplane.Organizations.UpdateSSOConfigResponse
+	39, // 113: Superplane.Organizations.Organizations.DeleteSSOConfig:output_type -> Superplane.Organizations.DeleteSSOConfigResponse
+	41, // 114: Superplane.Organizations.Organizations.GetSCIMConfig:output_type -> Superplane.Organizations.GetSCIMConfigResponse
+	43, // 115: Superplane.Organizations.Organizations.RegenerateSCIMToken:output_type -> Superplane.Organizations.RegenerateSCIMTokenResponse
+	45, // 116: Superplane.Organizations.Organizations.DeleteSCIMToken:output_type -> Superplane.Organizations.DeleteSCIMTokenResponse
+	48, // 117: Superplane.Organizations.Organizations.GetUsage:output_type -> Superplane.Organizations.GetUsageResponse
+	92, // 118: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
+	52, // 119: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
+	56, // 120: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
+	58, // 121: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
+	54, // 122: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
+	61, // 123: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
+	63, // 124: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
+	72, // 125: Superplane.Organizations.Organizations.ListCalendars:output_type -> Superplane.Organizations.ListCalendarsResponse
+	74, // 126: Superplane.Organizations.Organizations.CreateCalendar:output_type -> Superplane.Organizations.CreateCalendarResponse
+	76, // 127: Superplane.Organizations.Organizations.UpdateCalendar:output_type -> Superplane.Organizations.UpdateCalendarResponse
+	78, // 128: Superplane.Organizations.Organizations.DeleteCalendar:output_type -> Superplane.Organizations.DeleteCalendarResponse
+	97, // [97:129] is the sub-list for method output_type
+	65, // [65:97] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_organizations_proto_init() }
@@ -3435,14 +5343,14 @@ func file_organizations_proto_init() {
 	if File_organizations_proto != nil {
 		return
 	}
-	file_organizations_proto_msgTypes[52].OneofWrappers = []any{}
+	file_organizations_proto_msgTypes[79].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organizations_proto_rawDesc), len(file_organizations_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   59,
+			NumMessages:   91,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
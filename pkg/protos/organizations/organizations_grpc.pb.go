@@ -34,6 +34,13 @@ const (
 	Organizations_UpdateAgentSettings_FullMethodName      = "/Superplane.Organizations.Organizations/UpdateAgentSettings"
 	Organizations_SetAgentOpenAIKey_FullMethodName        = "/Superplane.Organizations.Organizations/SetAgentOpenAIKey"
 	Organizations_DeleteAgentOpenAIKey_FullMethodName     = "/Superplane.Organizations.Organizations/DeleteAgentOpenAIKey"
+	Organizations_GetSSOConfig_FullMethodName             = "/Superplane.Organizations.Organizations/GetSSOConfig"
+	Organizations_UpdateSSOConfig_FullMethodName          = "/Superplane.Organizations.Organizations/UpdateSSOConfig"
+	Organizations_DeleteSSOConfig_FullMethodName          = "/Superplane.Organizations.Organizations/DeleteSSOConfig"
+	Organizations_GetSCIMConfig_FullMethodName            = "/Superplane.Organizations.Organizations/GetSCIMConfig"
+	Organizations_RegenerateSCIMToken_FullMethodName      = "/Superplane.Organizations.Organizations/RegenerateSCIMToken"
+	Organizations_DeleteSCIMToken_FullMethodName          = "/Superplane.Organizations.Organizations/DeleteSCIMToken"
+	Organizations_GetUsage_FullMethodName                 = "/Superplane.Organizations.Organizations/GetUsage"
 	Organizations_AcceptInviteLink_FullMethodName         = "/Superplane.Organizations.Organizations/AcceptInviteLink"
 	Organizations_ListIntegrations_FullMethodName         = "/Superplane.Organizations.Organizations/ListIntegrations"
 	Organizations_DescribeIntegration_FullMethodName      = "/Superplane.Organizations.Organizations/DescribeIntegration"
@@ -41,6 +48,10 @@ const (
 	Organizations_CreateIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/CreateIntegration"
 	Organizations_UpdateIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/UpdateIntegration"
 	Organizations_DeleteIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/DeleteIntegration"
+	Organizations_ListCalendars_FullMethodName            = "/Superplane.Organizations.Organizations/ListCalendars"
+	Organizations_CreateCalendar_FullMethodName           = "/Superplane.Organizations.Organizations/CreateCalendar"
+	Organizations_UpdateCalendar_FullMethodName           = "/Superplane.Organizations.Organizations/UpdateCalendar"
+	Organizations_DeleteCalendar_FullMethodName           = "/Superplane.Organizations.Organizations/DeleteCalendar"
 )
 
 // OrganizationsClient is the client API for Organizations service.
@@ -61,6 +72,13 @@ type OrganizationsClient interface {
 	UpdateAgentSettings(ctx context.Context, in *UpdateAgentSettingsRequest, opts ...grpc.CallOption) (*UpdateAgentSettingsResponse, error)
 	SetAgentOpenAIKey(ctx context.Context, in *SetAgentOpenAIKeyRequest, opts ...grpc.CallOption) (*SetAgentOpenAIKeyResponse, error)
 	DeleteAgentOpenAIKey(ctx context.Context, in *DeleteAgentOpenAIKeyRequest, opts ...grpc.CallOption) (*DeleteAgentOpenAIKeyResponse, error)
+	GetSSOConfig(ctx context.Context, in *GetSSOConfigRequest, opts ...grpc.CallOption) (*GetSSOConfigResponse, error)
+	UpdateSSOConfig(ctx context.Context, in *UpdateSSOConfigRequest, opts ...grpc.CallOption) (*UpdateSSOConfigResponse, error)
+	DeleteSSOConfig(ctx context.Context, in *DeleteSSOConfigRequest, opts ...grpc.CallOption) (*DeleteSSOConfigResponse, error)
+	GetSCIMConfig(ctx context.Context, in *GetSCIMConfigRequest, opts ...grpc.CallOption) (*GetSCIMConfigResponse, error)
+	RegenerateSCIMToken(ctx context.Context, in *RegenerateSCIMTokenRequest, opts ...grpc.CallOption) (*RegenerateSCIMTokenResponse, error)
+	DeleteSCIMToken(ctx context.Context, in *DeleteSCIMTokenRequest, opts ...grpc.CallOption) (*DeleteSCIMTokenResponse, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
 	AcceptInviteLink(ctx context.Context, in *InviteLink, opts ...grpc.CallOption) (*_struct.Struct, error)
 	ListIntegrations(ctx context.Context, in *ListIntegrationsRequest, opts ...grpc.CallOption) (*ListIntegrationsResponse, error)
 	DescribeIntegration(ctx context.Context, in *DescribeIntegrationRequest, opts ...grpc.CallOption) (*DescribeIntegrationResponse, error)
@@ -68,6 +86,10 @@ type OrganizationsClient interface {
 	CreateIntegration(ctx context.Context, in *CreateIntegrationRequest, opts ...grpc.CallOption) (*CreateIntegrationResponse, error)
 	UpdateIntegration(ctx context.Context, in *UpdateIntegrationRequest, opts ...grpc.CallOption) (*UpdateIntegrationResponse, error)
 	DeleteIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*DeleteIntegrationResponse, error)
+	ListCalendars(ctx context.Context, in *ListCalendarsRequest, opts ...grpc.CallOption) (*ListCalendarsResponse, error)
+	CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...grpc.CallOption) (*CreateCalendarResponse, error)
+	UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...grpc.CallOption) (*UpdateCalendarResponse, error)
+	DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*DeleteCalendarResponse, error)
 }
 
 type organizationsClient struct {
@@ -218,6 +240,76 @@ func (c *organizationsClient) DeleteAgentOpenAIKey(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *organizationsClient) GetSSOConfig(ctx context.Context, in *GetSSOConfigRequest, opts ...grpc.CallOption) (*GetSSOConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSSOConfigResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetSSOConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateSSOConfig(ctx context.Context, in *UpdateSSOConfigRequest, opts ...grpc.CallOption) (*UpdateSSOConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSSOConfigResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateSSOConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) DeleteSSOConfig(ctx context.Context, in *DeleteSSOConfigRequest, opts ...grpc.CallOption) (*DeleteSSOConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSSOConfigResponse)
+	err := c.cc.Invoke(ctx, Organizations_DeleteSSOConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) GetSCIMConfig(ctx context.Context, in *GetSCIMConfigRequest, opts ...grpc.CallOption) (*GetSCIMConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSCIMConfigResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetSCIMConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) RegenerateSCIMToken(ctx context.Context, in *RegenerateSCIMTokenRequest, opts ...grpc.CallOption) (*RegenerateSCIMTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateSCIMTokenResponse)
+	err := c.cc.Invoke(ctx, Organizations_RegenerateSCIMToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) DeleteSCIMToken(ctx context.Context, in *DeleteSCIMTokenRequest, opts ...grpc.CallOption) (*DeleteSCIMTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSCIMTokenResponse)
+	err := c.cc.Invoke(ctx, Organizations_DeleteSCIMToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationsClient) AcceptInviteLink(ctx context.Context, in *InviteLink, opts ...grpc.CallOption) (*_struct.Struct, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(_struct.Struct)
@@ -288,6 +380,46 @@ func (c *organizationsClient) DeleteIntegration(ctx context.Context, in *DeleteI
 	return out, nil
 }
 
+func (c *organizationsClient) ListCalendars(ctx context.Context, in *ListCalendarsRequest, opts ...grpc.CallOption) (*ListCalendarsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCalendarsResponse)
+	err := c.cc.Invoke(ctx, Organizations_ListCalendars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...grpc.CallOption) (*CreateCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCalendarResponse)
+	err := c.cc.Invoke(ctx, Organizations_CreateCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...grpc.CallOption) (*UpdateCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCalendarResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*DeleteCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCalendarResponse)
+	err := c.cc.Invoke(ctx, Organizations_DeleteCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrganizationsServer is the server API for Organizations service.
 // All implementations should embed UnimplementedOrganizationsServer
 // for forward compatibility.
@@ -306,6 +438,13 @@ type OrganizationsServer interface {
 	UpdateAgentSettings(context.Context, *UpdateAgentSettingsRequest) (*UpdateAgentSettingsResponse, error)
 	SetAgentOpenAIKey(context.Context, *SetAgentOpenAIKeyRequest) (*SetAgentOpenAIKeyResponse, error)
 	DeleteAgentOpenAIKey(context.Context, *DeleteAgentOpenAIKeyRequest) (*DeleteAgentOpenAIKeyResponse, error)
+	GetSSOConfig(context.Context, *GetSSOConfigRequest) (*GetSSOConfigResponse, error)
+	UpdateSSOConfig(context.Context, *UpdateSSOConfigRequest) (*UpdateSSOConfigResponse, error)
+	DeleteSSOConfig(context.Context, *DeleteSSOConfigRequest) (*DeleteSSOConfigResponse, error)
+	GetSCIMConfig(context.Context, *GetSCIMConfigRequest) (*GetSCIMConfigResponse, error)
+	RegenerateSCIMToken(context.Context, *RegenerateSCIMTokenRequest) (*RegenerateSCIMTokenResponse, error)
+	DeleteSCIMToken(context.Context, *DeleteSCIMTokenRequest) (*DeleteSCIMTokenResponse, error)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
 	AcceptInviteLink(context.Context, *InviteLink) (*_struct.Struct, error)
 	ListIntegrations(context.Context, *ListIntegrationsRequest) (*ListIntegrationsResponse, error)
 	DescribeIntegration(context.Context, *DescribeIntegrationRequest) (*DescribeIntegrationResponse, error)
@@ -313,6 +452,10 @@ type OrganizationsServer interface {
 	CreateIntegration(context.Context, *CreateIntegrationRequest) (*CreateIntegrationResponse, error)
 	UpdateIntegration(context.Context, *UpdateIntegrationRequest) (*UpdateIntegrationResponse, error)
 	DeleteIntegration(context.Context, *DeleteIntegrationRequest) (*DeleteIntegrationResponse, error)
+	ListCalendars(context.Context, *ListCalendarsRequest) (*ListCalendarsResponse, error)
+	CreateCalendar(context.Context, *CreateCalendarRequest) (*CreateCalendarResponse, error)
+	UpdateCalendar(context.Context, *UpdateCalendarRequest) (*UpdateCalendarResponse, error)
+	DeleteCalendar(context.Context, *DeleteCalendarRequest) (*DeleteCalendarResponse, error)
 }
 
 // UnimplementedOrganizationsServer should be embedded to have
@@ -364,6 +507,27 @@ func (UnimplementedOrganizationsServer) SetAgentOpenAIKey(context.Context, *SetA
 func (UnimplementedOrganizationsServer) DeleteAgentOpenAIKey(context.Context, *DeleteAgentOpenAIKeyRequest) (*DeleteAgentOpenAIKeyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteAgentOpenAIKey not implemented")
 }
+func (UnimplementedOrganizationsServer) GetSSOConfig(context.Context, *GetSSOConfigRequest) (*GetSSOConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSSOConfig not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateSSOConfig(context.Context, *UpdateSSOConfigRequest) (*UpdateSSOConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSSOConfig not implemented")
+}
+func (UnimplementedOrganizationsServer) DeleteSSOConfig(context.Context, *DeleteSSOConfigRequest) (*DeleteSSOConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSSOConfig not implemented")
+}
+func (UnimplementedOrganizationsServer) GetSCIMConfig(context.Context, *GetSCIMConfigRequest) (*GetSCIMConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSCIMConfig not implemented")
+}
+func (UnimplementedOrganizationsServer) RegenerateSCIMToken(context.Context, *RegenerateSCIMTokenRequest) (*RegenerateSCIMTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegenerateSCIMToken not implemented")
+}
+func (UnimplementedOrganizationsServer) DeleteSCIMToken(context.Context, *DeleteSCIMTokenRequest) (*DeleteSCIMTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSCIMToken not implemented")
+}
+func (UnimplementedOrganizationsServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsage not implemented")
+}
 func (UnimplementedOrganizationsServer) AcceptInviteLink(context.Context, *InviteLink) (*_struct.Struct, error) {
 	return nil, status.Error(codes.Unimplemented, "method AcceptInviteLink not implemented")
 }
@@ -385,6 +549,18 @@ func (UnimplementedOrganizationsServer) UpdateIntegration(context.Context, *Upda
 func (UnimplementedOrganizationsServer) DeleteIntegration(context.Context, *DeleteIntegrationRequest) (*DeleteIntegrationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteIntegration not implemented")
 }
+func (UnimplementedOrganizationsServer) ListCalendars(context.Context, *ListCalendarsRequest) (*ListCalendarsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCalendars not implemented")
+}
+func (UnimplementedOrganizationsServer) CreateCalendar(context.Context, *CreateCalendarRequest) (*CreateCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCalendar not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateCalendar(context.Context, *UpdateCalendarRequest) (*UpdateCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCalendar not implemented")
+}
+func (UnimplementedOrganizationsServer) DeleteCalendar(context.Context, *DeleteCalendarRequest) (*DeleteCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCalendar not implemented")
+}
 func (UnimplementedOrganizationsServer) testEmbeddedByValue() {}
 
 // UnsafeOrganizationsServer may be embedded to opt out of forward compatibility for this service.
@@ -657,6 +833,132 @@ func _Organizations_DeleteAgentOpenAIKey_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organizations_GetSSOConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSSOConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetSSOConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetSSOConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetSSOConfig(ctx, req.(*GetSSOConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateSSOConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSSOConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateSSOConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateSSOConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateSSOConfig(ctx, req.(*UpdateSSOConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_DeleteSSOConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSSOConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).DeleteSSOConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_DeleteSSOConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).DeleteSSOConfig(ctx, req.(*DeleteSSOConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_GetSCIMConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSCIMConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetSCIMConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetSCIMConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetSCIMConfig(ctx, req.(*GetSCIMConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_RegenerateSCIMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateSCIMTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).RegenerateSCIMToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_RegenerateSCIMToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).RegenerateSCIMToken(ctx, req.(*RegenerateSCIMTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_DeleteSCIMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSCIMTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).DeleteSCIMToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_DeleteSCIMToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).DeleteSCIMToken(ctx, req.(*DeleteSCIMTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Organizations_AcceptInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InviteLink)
 	if err := dec(in); err != nil {
@@ -783,6 +1085,78 @@ func _Organizations_DeleteIntegration_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organizations_ListCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ListCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ListCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ListCalendars(ctx, req.(*ListCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_CreateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).CreateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_CreateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).CreateCalendar(ctx, req.(*CreateCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateCalendar(ctx, req.(*UpdateCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_DeleteCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).DeleteCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_DeleteCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).DeleteCalendar(ctx, req.(*DeleteCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Organizations_ServiceDesc is the grpc.ServiceDesc for Organizations service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -846,6 +1220,34 @@ var Organizations_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAgentOpenAIKey",
 			Handler:    _Organizations_DeleteAgentOpenAIKey_Handler,
 		},
+		{
+			MethodName: "GetSSOConfig",
+			Handler:    _Organizations_GetSSOConfig_Handler,
+		},
+		{
+			MethodName: "UpdateSSOConfig",
+			Handler:    _Organizations_UpdateSSOConfig_Handler,
+		},
+		{
+			MethodName: "DeleteSSOConfig",
+			Handler:    _Organizations_DeleteSSOConfig_Handler,
+		},
+		{
+			MethodName: "GetSCIMConfig",
+			Handler:    _Organizations_GetSCIMConfig_Handler,
+		},
+		{
+			MethodName: "RegenerateSCIMToken",
+			Handler:    _Organizations_RegenerateSCIMToken_Handler,
+		},
+		{
+			MethodName: "DeleteSCIMToken",
+			Handler:    _Organizations_DeleteSCIMToken_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _Organizations_GetUsage_Handler,
+		},
 		{
 			MethodName: "AcceptInviteLink",
 			Handler:    _Organizations_AcceptInviteLink_Handler,
@@ -874,6 +1276,22 @@ var Organizations_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteIntegration",
 			Handler:    _Organizations_DeleteIntegration_Handler,
 		},
+		{
+			MethodName: "ListCalendars",
+			Handler:    _Organizations_ListCalendars_Handler,
+		},
+		{
+			MethodName: "CreateCalendar",
+			Handler:    _Organizations_CreateCalendar_Handler,
+		},
+		{
+			MethodName: "UpdateCalendar",
+			Handler:    _Organizations_UpdateCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteCalendar",
+			Handler:    _Organizations_DeleteCalendar_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organizations.proto",
@@ -676,6 +676,404 @@ func (x *RegenerateServiceAccountTokenResponse) GetToken() string {
 	return ""
 }
 
+// Scopes are permissions, as resource:action, e.g. canvases:read.
+// A token without scopes has all the permissions of the service account.
+type ServiceAccountToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,4,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceAccountToken) Reset() {
+	*x = ServiceAccountToken{}
+	mi := &file_service_accounts_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccountToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccountToken) ProtoMessage() {}
+
+func (x *ServiceAccountToken) ProtoReflect() protoreflect.Message {
+	mi := &file_service_accounts_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccountToken.ProtoReflect.Descriptor instead.
+func (*ServiceAccountToken) Descriptor() ([]byte, []int) {
+	return file_service_accounts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ServiceAccountToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceAccountToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceAccountToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ServiceAccountToken) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ServiceAccountToken) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ServiceAccountToken) GetLastUsedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccountToken) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListServiceAccountTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountTokensRequest) Reset() {
+	*x = ListServiceAccountTokensRequest{}
+	mi := &file_service_accounts_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountTokensRequest) ProtoMessage() {}
+
+func (x *ListServiceAccountTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_accounts_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountTokensRequest) Descriptor() ([]byte, []int) {
+	return file_service_accounts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListServiceAccountTokensRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListServiceAccountTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*ServiceAccountToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountTokensResponse) Reset() {
+	*x = ListServiceAccountTokensResponse{}
+	mi := &file_service_accounts_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountTokensResponse) ProtoMessage() {}
+
+func (x *ListServiceAccountTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_accounts_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountTokensResponse) Descriptor() ([]byte, []int) {
+	return file_service_accounts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListServiceAccountTokensResponse) GetTokens() []*ServiceAccountToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type CreateServiceAccountTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,4,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountTokenRequest) Reset() {
+	*x = CreateServiceAccountTokenRequest{}
+	mi := &file_service_accounts_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountTokenRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_accounts_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountTokenRequest) Descriptor() ([]byte, []int) {
+	return file_service_accounts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateServiceAccountTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateServiceAccountTokenRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountTokenRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateServiceAccountTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiToken      *ServiceAccountToken   `protobuf:"bytes,1,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountTokenResponse) Reset() {
+	*x = CreateServiceAccountTokenResponse{}
+	mi := &file_service_accounts_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountTokenResponse) ProtoMessage() {}
+
+func (x *CreateServiceAccountTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_accounts_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountTokenResponse) Descriptor() ([]byte, []int) {
+	return file_service_accounts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateServiceAccountTokenResponse) GetApiToken() *ServiceAccountToken {
+	if x != nil {
+		return x.ApiToken
+	}
+	return nil
+}
+
+func (x *CreateServiceAccountTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeServiceAccountTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TokenId       string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeServiceAccountTokenRequest) Reset() {
+	*x = RevokeServiceAccountTokenRequest{}
+	mi := &file_service_accounts_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeServiceAccountTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeServiceAccountTokenRequest) ProtoMessage() {}
+
+func (x *RevokeServiceAccountTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_accounts_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeServiceAccountTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeServiceAccountTokenRequest) Descriptor() ([]byte, []int) {
+	return file_service_accounts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevokeServiceAccountTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevokeServiceAccountTokenRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+type RevokeServiceAccountTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeServiceAccountTokenResponse) Reset() {
+	*x = RevokeServiceAccountTokenResponse{}
+	mi := &file_service_accounts_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeServiceAccountTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeServiceAccountTokenResponse) ProtoMessage() {}
+
+func (x *RevokeServiceAccountTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_accounts_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeServiceAccountTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeServiceAccountTokenResponse) Descriptor() ([]byte, []int) {
+	return file_service_accounts_proto_rawDescGZIP(), []int{19}
+}
+
 var File_service_accounts_proto protoreflect.FileDescriptor
 
 const file_service_accounts_proto_rawDesc = "" +
@@ -719,7 +1117,36 @@ const file_service_accounts_proto_rawDesc = "" +
 	"$RegenerateServiceAccountTokenRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
 	"%RegenerateServiceAccountTokenResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token2\xba\r\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xa2\x02\n" +
+	"\x13ServiceAccountToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1b\n" +
+	"\tcanvas_id\x18\x04 \x01(\tR\bcanvasId\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"1\n" +
+	"\x1fListServiceAccountTokensRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"k\n" +
+	" ListServiceAccountTokensResponse\x12G\n" +
+	"\x06tokens\x18\x01 \x03(\v2/.Superplane.ServiceAccounts.ServiceAccountTokenR\x06tokens\"\xb6\x01\n" +
+	" CreateServiceAccountTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1b\n" +
+	"\tcanvas_id\x18\x04 \x01(\tR\bcanvasId\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x87\x01\n" +
+	"!CreateServiceAccountTokenResponse\x12L\n" +
+	"\tapi_token\x18\x01 \x01(\v2/.Superplane.ServiceAccounts.ServiceAccountTokenR\bapiToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"M\n" +
+	" RevokeServiceAccountTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\"#\n" +
+	"!RevokeServiceAccountTokenResponse2\xd8\x14\n" +
 	"\x0fServiceAccounts\x12\x90\x02\n" +
 	"\x14CreateServiceAccount\x127.Superplane.ServiceAccounts.CreateServiceAccountRequest\x1a8.Superplane.ServiceAccounts.CreateServiceAccountResponse\"\x84\x01\x92A^\n" +
 	"\x0fServiceAccounts\x12\x18Create a service account\x1a1Creates a new service account in the organization\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/service-accounts\x12\x85\x02\n" +
@@ -732,7 +1159,13 @@ const file_service_accounts_proto_rawDesc = "" +
 	"\x14DeleteServiceAccount\x127.Superplane.ServiceAccounts.DeleteServiceAccountRequest\x1a8.Superplane.ServiceAccounts.DeleteServiceAccountResponse\"\x8c\x01\x92Ad\n" +
 	"\x0fServiceAccounts\x12\x18Delete a service account\x1a7Deletes a service account and removes its RBAC policies\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/service-accounts/{id}\x12\xbc\x02\n" +
 	"\x1dRegenerateServiceAccountToken\x12@.Superplane.ServiceAccounts.RegenerateServiceAccountTokenRequest\x1aA.Superplane.ServiceAccounts.RegenerateServiceAccountTokenResponse\"\x95\x01\x92Ad\n" +
-	"\x0fServiceAccounts\x12 Regenerate service account token\x1a/Regenerates the API token for a service account\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/service-accounts/{id}/tokenB\xe0\x01\x92A\x9c\x01\x12r\n" +
+	"\x0fServiceAccounts\x12 Regenerate service account token\x1a/Regenerates the API token for a service account\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/service-accounts/{id}/token\x12\xac\x02\n" +
+	"\x18ListServiceAccountTokens\x12;.Superplane.ServiceAccounts.ListServiceAccountTokensRequest\x1a<.Superplane.ServiceAccounts.ListServiceAccountTokensResponse\"\x94\x01\x92Ae\n" +
+	"\x0fServiceAccounts\x12\x1fList service account API tokens\x1a1Returns the named API tokens of a service account\x82\xd3\xe4\x93\x02&\x12$/api/v1/service-accounts/{id}/tokens\x12\xb1\x02\n" +
+	"\x19CreateServiceAccountToken\x12<.Superplane.ServiceAccounts.CreateServiceAccountTokenRequest\x1a=.Superplane.ServiceAccounts.CreateServiceAccountTokenResponse\"\x96\x01\x92Ad\n" +
+	"\x0fServiceAccounts\x12 Create service account API token\x1a/Creates a named API token for a service account\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/service-accounts/{id}/tokens\x12\xb8\x02\n" +
+	"\x19RevokeServiceAccountToken\x12<.Superplane.ServiceAccounts.RevokeServiceAccountTokenRequest\x1a=.Superplane.ServiceAccounts.RevokeServiceAccountTokenResponse\"\x9d\x01\x92Ac\n" +
+	"\x0fServiceAccounts\x12 Revoke service account API token\x1a.Revokes a named API token of a service account\x82\xd3\xe4\x93\x021*//api/v1/service-accounts/{id}/tokens/{token_id}B\xe0\x01\x92A\x9c\x01\x12r\n" +
 	"\x1fSuperplane Service Accounts API\x12#API for Superplane Service Accounts\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ>github.com/superplanehq/superplane/pkg/protos/service_accountsb\x06proto3"
 
@@ -748,7 +1181,7 @@ func file_service_accounts_proto_rawDescGZIP() []byte {
 	return file_service_accounts_proto_rawDescData
 }
 
-var file_service_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_service_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_service_accounts_proto_goTypes = []any{
 	(*ServiceAccount)(nil),                        // 0: Superplane.ServiceAccounts.ServiceAccount
 	(*CreateServiceAccountRequest)(nil),           // 1: Superplane.ServiceAccounts.CreateServiceAccountRequest
@@ -763,32 +1196,51 @@ var file_service_accounts_proto_goTypes = []any{
 	(*DeleteServiceAccountResponse)(nil),          // 10: Superplane.ServiceAccounts.DeleteServiceAccountResponse
 	(*RegenerateServiceAccountTokenRequest)(nil),  // 11: Superplane.ServiceAccounts.RegenerateServiceAccountTokenRequest
 	(*RegenerateServiceAccountTokenResponse)(nil), // 12: Superplane.ServiceAccounts.RegenerateServiceAccountTokenResponse
-	(*timestamp.Timestamp)(nil),                   // 13: google.protobuf.Timestamp
+	(*ServiceAccountToken)(nil),                   // 13: Superplane.ServiceAccounts.ServiceAccountToken
+	(*ListServiceAccountTokensRequest)(nil),       // 14: Superplane.ServiceAccounts.ListServiceAccountTokensRequest
+	(*ListServiceAccountTokensResponse)(nil),      // 15: Superplane.ServiceAccounts.ListServiceAccountTokensResponse
+	(*CreateServiceAccountTokenRequest)(nil),      // 16: Superplane.ServiceAccounts.CreateServiceAccountTokenRequest
+	(*CreateServiceAccountTokenResponse)(nil),     // 17: Superplane.ServiceAccounts.CreateServiceAccountTokenResponse
+	(*RevokeServiceAccountTokenRequest)(nil),      // 18: Superplane.ServiceAccounts.RevokeServiceAccountTokenRequest
+	(*RevokeServiceAccountTokenResponse)(nil),     // 19: Superplane.ServiceAccounts.RevokeServiceAccountTokenResponse
+	(*timestamp.Timestamp)(nil),                   // 20: google.protobuf.Timestamp
 }
 var file_service_accounts_proto_depIdxs = []int32{
-	13, // 0: Superplane.ServiceAccounts.ServiceAccount.created_at:type_name -> google.protobuf.Timestamp
-	13, // 1: Superplane.ServiceAccounts.ServiceAccount.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 0: Superplane.ServiceAccounts.ServiceAccount.created_at:type_name -> google.protobuf.Timestamp
+	20, // 1: Superplane.ServiceAccounts.ServiceAccount.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: Superplane.ServiceAccounts.CreateServiceAccountResponse.service_account:type_name -> Superplane.ServiceAccounts.ServiceAccount
 	0,  // 3: Superplane.ServiceAccounts.ListServiceAccountsResponse.service_accounts:type_name -> Superplane.ServiceAccounts.ServiceAccount
 	0,  // 4: Superplane.ServiceAccounts.DescribeServiceAccountResponse.service_account:type_name -> Superplane.ServiceAccounts.ServiceAccount
 	0,  // 5: Superplane.ServiceAccounts.UpdateServiceAccountResponse.service_account:type_name -> Superplane.ServiceAccounts.ServiceAccount
-	1,  // 6: Superplane.ServiceAccounts.ServiceAccounts.CreateServiceAccount:input_type -> Superplane.ServiceAccounts.CreateServiceAccountRequest
-	3,  // 7: Superplane.ServiceAccounts.ServiceAccounts.ListServiceAccounts:input_type -> Superplane.ServiceAccounts.ListServiceAccountsRequest
-	5,  // 8: Superplane.ServiceAccounts.ServiceAccounts.DescribeServiceAccount:input_type -> Superplane.ServiceAccounts.DescribeServiceAccountRequest
-	7,  // 9: Superplane.ServiceAccounts.ServiceAccounts.UpdateServiceAccount:input_type -> Superplane.ServiceAccounts.UpdateServiceAccountRequest
-	9,  // 10: Superplane.ServiceAccounts.ServiceAccounts.DeleteServiceAccount:input_type -> Superplane.ServiceAccounts.DeleteServiceAccountRequest
-	11, // 11: Superplane.ServiceAccounts.ServiceAccounts.RegenerateServiceAccountToken:input_type -> Superplane.ServiceAccounts.RegenerateServiceAccountTokenRequest
-	2,  // 12: Superplane.ServiceAccounts.ServiceAccounts.CreateServiceAccount:output_type -> Superplane.ServiceAccounts.CreateServiceAccountResponse
-	4,  // 13: Superplane.ServiceAccounts.ServiceAccounts.ListServiceAccounts:output_type -> Superplane.ServiceAccounts.ListServiceAccountsResponse
-	6,  // 14: Superplane.ServiceAccounts.ServiceAccounts.DescribeServiceAccount:output_type -> Superplane.ServiceAccounts.DescribeServiceAccountResponse
-	8,  // 15: Superplane.ServiceAccounts.ServiceAccounts.UpdateServiceAccount:output_type -> Superplane.ServiceAccounts.UpdateServiceAccountResponse
-	10, // 16: Superplane.ServiceAccounts.ServiceAccounts.DeleteServiceAccount:output_type -> Superplane.ServiceAccounts.DeleteServiceAccountResponse
-	12, // 17: Superplane.ServiceAccounts.ServiceAccounts.RegenerateServiceAccountToken:output_type -> Superplane.ServiceAccounts.RegenerateServiceAccountTokenResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	20, // 6: Superplane.ServiceAccounts.ServiceAccountToken.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 7: Superplane.ServiceAccounts.ServiceAccountToken.last_used_at:type_name -> google.protobuf.Timestamp
+	20, // 8: Superplane.ServiceAccounts.ServiceAccountToken.created_at:type_name -> google.protobuf.Timestamp
+	13, // 9: Superplane.ServiceAccounts.ListServiceAccountTokensResponse.tokens:type_name -> Superplane.ServiceAccounts.ServiceAccountToken
+	20, // 10: Superplane.ServiceAccounts.CreateServiceAccountTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 11: Superplane.ServiceAccounts.CreateServiceAccountTokenResponse.api_token:type_name -> Superplane.ServiceAccounts.ServiceAccountToken
+	1,  // 12: Superplane.ServiceAccounts.ServiceAccounts.CreateServiceAccount:input_type -> Superplane.ServiceAccounts.CreateServiceAccountRequest
+	3,  // 13: Superplane.ServiceAccounts.ServiceAccounts.ListServiceAccounts:input_type -> Superplane.ServiceAccounts.ListServiceAccountsRequest
+	5,  // 14: Superplane.ServiceAccounts.ServiceAccounts.DescribeServiceAccount:input_type -> Superplane.ServiceAccounts.DescribeServiceAccountRequest
+	7,  // 15: Superplane.ServiceAccounts.ServiceAccounts.UpdateServiceAccount:input_type -> Superplane.ServiceAccounts.UpdateServiceAccountRequest
+	9,  // 16: Superplane.ServiceAccounts.ServiceAccounts.DeleteServiceAccount:input_type -> Superplane.ServiceAccounts.DeleteServiceAccountRequest
+	11, // 17: Superplane.ServiceAccounts.ServiceAccounts.RegenerateServiceAccountToken:input_type -> Superplane.ServiceAccounts.RegenerateServiceAccountTokenRequest
+	14, // 18: Superplane.ServiceAccounts.ServiceAccounts.ListServiceAccountTokens:input_type -> Superplane.ServiceAccounts.ListServiceAccountTokensRequest
+	16, // 19: Superplane.ServiceAccounts.ServiceAccounts.CreateServiceAccountToken:input_type -> Superplane.ServiceAccounts.CreateServiceAccountTokenRequest
+	18, // 20: Superplane.ServiceAccounts.ServiceAccounts.RevokeServiceAccountToken:input_type -> Superplane.ServiceAccounts.RevokeServiceAccountTokenRequest
+	2,  // 21: Superplane.ServiceAccounts.ServiceAccounts.CreateServiceAccount:output_type -> Superplane.ServiceAccounts.CreateServiceAccountResponse
+	4,  // 22: Superplane.ServiceAccounts.ServiceAccounts.ListServiceAccounts:output_type -> Superplane.ServiceAccounts.ListServiceAccountsResponse
+	6,  // 23: Superplane.ServiceAccounts.ServiceAccounts.DescribeServiceAccount:output_type -> Superplane.ServiceAccounts.DescribeServiceAccountResponse
+	8,  // 24: Superplane.ServiceAccounts.ServiceAccounts.UpdateServiceAccount:output_type -> Superplane.ServiceAccounts.UpdateServiceAccountResponse
+	10, // 25: Superplane.ServiceAccounts.ServiceAccounts.DeleteServiceAccount:output_type -> Superplane.ServiceAccounts.DeleteServiceAccountResponse
+	12, // 26: Superplane.ServiceAccounts.ServiceAccounts.RegenerateServiceAccountToken:output_type -> Superplane.ServiceAccounts.RegenerateServiceAccountTokenResponse
+	15, // 27: Superplane.ServiceAccounts.ServiceAccounts.ListServiceAccountTokens:output_type -> Superplane.ServiceAccounts.ListServiceAccountTokensResponse
+	17, // 28: Superplane.ServiceAccounts.ServiceAccounts.CreateServiceAccountToken:output_type -> Superplane.ServiceAccounts.CreateServiceAccountTokenResponse
+	19, // 29: Superplane.ServiceAccounts.ServiceAccounts.RevokeServiceAccountToken:output_type -> Superplane.ServiceAccounts.RevokeServiceAccountTokenResponse
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_service_accounts_proto_init() }
@@ -802,7 +1254,7 @@ func file_service_accounts_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_accounts_proto_rawDesc), len(file_service_accounts_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,9 @@ const (
 	ServiceAccounts_UpdateServiceAccount_FullMethodName          = "/Superplane.ServiceAccounts.ServiceAccounts/UpdateServiceAccount"
 	ServiceAccounts_DeleteServiceAccount_FullMethodName          = "/Superplane.ServiceAccounts.ServiceAccounts/DeleteServiceAccount"
 	ServiceAccounts_RegenerateServiceAccountToken_FullMethodName = "/Superplane.ServiceAccounts.ServiceAccounts/RegenerateServiceAccountToken"
+	ServiceAccounts_ListServiceAccountTokens_FullMethodName      = "/Superplane.ServiceAccounts.ServiceAccounts/ListServiceAccountTokens"
+	ServiceAccounts_CreateServiceAccountToken_FullMethodName     = "/Superplane.ServiceAccounts.ServiceAccounts/CreateServiceAccountToken"
+	ServiceAccounts_RevokeServiceAccountToken_FullMethodName     = "/Superplane.ServiceAccounts.ServiceAccounts/RevokeServiceAccountToken"
 )
 
 // ServiceAccountsClient is the client API for ServiceAccounts service.
@@ -37,6 +40,9 @@ type ServiceAccountsClient interface {
 	UpdateServiceAccount(ctx context.Context, in *UpdateServiceAccountRequest, opts ...grpc.CallOption) (*UpdateServiceAccountResponse, error)
 	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error)
 	RegenerateServiceAccountToken(ctx context.Context, in *RegenerateServiceAccountTokenRequest, opts ...grpc.CallOption) (*RegenerateServiceAccountTokenResponse, error)
+	ListServiceAccountTokens(ctx context.Context, in *ListServiceAccountTokensRequest, opts ...grpc.CallOption) (*ListServiceAccountTokensResponse, error)
+	CreateServiceAccountToken(ctx context.Context, in *CreateServiceAccountTokenRequest, opts ...grpc.CallOption) (*CreateServiceAccountTokenResponse, error)
+	RevokeServiceAccountToken(ctx context.Context, in *RevokeServiceAccountTokenRequest, opts ...grpc.CallOption) (*RevokeServiceAccountTokenResponse, error)
 }
 
 type serviceAccountsClient struct {
@@ -107,6 +113,36 @@ func (c *serviceAccountsClient) RegenerateServiceAccountToken(ctx context.Contex
 	return out, nil
 }
 
+func (c *serviceAccountsClient) ListServiceAccountTokens(ctx context.Context, in *ListServiceAccountTokensRequest, opts ...grpc.CallOption) (*ListServiceAccountTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceAccountTokensResponse)
+	err := c.cc.Invoke(ctx, ServiceAccounts_ListServiceAccountTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountsClient) CreateServiceAccountToken(ctx context.Context, in *CreateServiceAccountTokenRequest, opts ...grpc.CallOption) (*CreateServiceAccountTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateServiceAccountTokenResponse)
+	err := c.cc.Invoke(ctx, ServiceAccounts_CreateServiceAccountToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountsClient) RevokeServiceAccountToken(ctx context.Context, in *RevokeServiceAccountTokenRequest, opts ...grpc.CallOption) (*RevokeServiceAccountTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeServiceAccountTokenResponse)
+	err := c.cc.Invoke(ctx, ServiceAccounts_RevokeServiceAccountToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceAccountsServer is the server API for ServiceAccounts service.
 // All implementations should embed UnimplementedServiceAccountsServer
 // for forward compatibility.
@@ -117,6 +153,9 @@ type ServiceAccountsServer interface {
 	UpdateServiceAccount(context.Context, *UpdateServiceAccountRequest) (*UpdateServiceAccountResponse, error)
 	DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error)
 	RegenerateServiceAccountToken(context.Context, *RegenerateServiceAccountTokenRequest) (*RegenerateServiceAccountTokenResponse, error)
+	ListServiceAccountTokens(context.Context, *ListServiceAccountTokensRequest) (*ListServiceAccountTokensResponse, error)
+	CreateServiceAccountToken(context.Context, *CreateServiceAccountTokenRequest) (*CreateServiceAccountTokenResponse, error)
+	RevokeServiceAccountToken(context.Context, *RevokeServiceAccountTokenRequest) (*RevokeServiceAccountTokenResponse, error)
 }
 
 // UnimplementedServiceAccountsServer should be embedded to have
@@ -144,6 +183,15 @@ func (UnimplementedServiceAccountsServer) DeleteServiceAccount(context.Context,
 func (UnimplementedServiceAccountsServer) RegenerateServiceAccountToken(context.Context, *RegenerateServiceAccountTokenRequest) (*RegenerateServiceAccountTokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegenerateServiceAccountToken not implemented")
 }
+func (UnimplementedServiceAccountsServer) ListServiceAccountTokens(context.Context, *ListServiceAccountTokensRequest) (*ListServiceAccountTokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListServiceAccountTokens not implemented")
+}
+func (UnimplementedServiceAccountsServer) CreateServiceAccountToken(context.Context, *CreateServiceAccountTokenRequest) (*CreateServiceAccountTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateServiceAccountToken not implemented")
+}
+func (UnimplementedServiceAccountsServer) RevokeServiceAccountToken(context.Context, *RevokeServiceAccountTokenRequest) (*RevokeServiceAccountTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeServiceAccountToken not implemented")
+}
 func (UnimplementedServiceAccountsServer) testEmbeddedByValue() {}
 
 // UnsafeServiceAccountsServer may be embedded to opt out of forward compatibility for this service.
@@ -272,6 +320,60 @@ func _ServiceAccounts_RegenerateServiceAccountToken_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServiceAccounts_ListServiceAccountTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountsServer).ListServiceAccountTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccounts_ListServiceAccountTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountsServer).ListServiceAccountTokens(ctx, req.(*ListServiceAccountTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccounts_CreateServiceAccountToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountsServer).CreateServiceAccountToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccounts_CreateServiceAccountToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountsServer).CreateServiceAccountToken(ctx, req.(*CreateServiceAccountTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccounts_RevokeServiceAccountToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeServiceAccountTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountsServer).RevokeServiceAccountToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccounts_RevokeServiceAccountToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountsServer).RevokeServiceAccountToken(ctx, req.(*RevokeServiceAccountTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ServiceAccounts_ServiceDesc is the grpc.ServiceDesc for ServiceAccounts service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -303,6 +405,18 @@ var ServiceAccounts_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegenerateServiceAccountToken",
 			Handler:    _ServiceAccounts_RegenerateServiceAccountToken_Handler,
 		},
+		{
+			MethodName: "ListServiceAccountTokens",
+			Handler:    _ServiceAccounts_ListServiceAccountTokens_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccountToken",
+			Handler:    _ServiceAccounts_CreateServiceAccountToken_Handler,
+		},
+		{
+			MethodName: "RevokeServiceAccountToken",
+			Handler:    _ServiceAccounts_RevokeServiceAccountToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_accounts.proto",
@@ -9,21 +9,27 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
 )
 
 type contextKey string
 
 const AccountContextKey contextKey = "account"
 const UserContextKey contextKey = "user"
+const APITokenContextKey contextKey = "api_token"
+const SSOOrganizationContextKey contextKey = "sso_organization"
 const OrganizationNotFoundError string = "organization_not_found_error"
 const AccountNotFoundError string = "account_not_found_error"
+const SSORequiredError string = "sso_required_error"
 
 var ownerSetupEnabled = os.Getenv("OWNER_SETUP_ENABLED") == "yes"
 
@@ -113,7 +119,7 @@ func AccountAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 				return
 			}
 
-			accountID, err := getAccountFromCookie(r, jwtSigner)
+			session, err := getAccountSessionFromCookie(r, jwtSigner)
 			if err != nil {
 				if isAccountAPIPath(r.URL.Path) {
 					http.Error(w, "Unauthorized", http.StatusUnauthorized)
@@ -125,7 +131,7 @@ func AccountAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 				return
 			}
 
-			account, err := models.FindAccountByID(accountID)
+			account, err := models.FindAccountByID(session.AccountID)
 			if err != nil {
 				if isAccountAPIPath(r.URL.Path) {
 					http.Error(w, "Unauthorized", http.StatusUnauthorized)
@@ -137,7 +143,20 @@ func AccountAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 				return
 			}
 
+			//
+			// Sessions created through the single sign-on of an organization
+			// cannot be used to join or create other organizations.
+			//
+			if session.SSOOrganizationID != "" && !isAllowedForSSOSession(r) {
+				http.Error(w, "Not available for single sign-on sessions", http.StatusForbidden)
+				return
+			}
+
 			ctx := context.WithValue(r.Context(), AccountContextKey, account)
+			if session.SSOOrganizationID != "" {
+				ctx = context.WithValue(ctx, SSOOrganizationContextKey, session.SSOOrganizationID)
+			}
+
 			r = r.WithContext(ctx)
 			next.ServeHTTP(w, r)
 		})
@@ -153,13 +172,17 @@ func OrganizationAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 			// we expect a user API token.
 			//
 			if r.Header.Get("Authorization") != "" {
-				user, err := authenticateUserByToken(r)
+				user, token, err := authenticateUserByToken(r)
 				if err != nil {
 					http.Error(w, "Unauthorized", http.StatusUnauthorized)
 					return
 				}
 
 				ctx := context.WithValue(r.Context(), UserContextKey, user)
+				if token != nil {
+					ctx = context.WithValue(ctx, APITokenContextKey, token)
+				}
+
 				r = r.WithContext(ctx)
 				next.ServeHTTP(w, r)
 				return
@@ -176,6 +199,12 @@ func OrganizationAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 					return
 				}
 
+				if err.Error() == SSORequiredError {
+					w.Header().Set("X-SSO-Login-URL", "/auth/sso/"+findOrganizationID(r))
+					http.Error(w, "Single sign-on required", http.StatusForbidden)
+					return
+				}
+
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
 				return
 			}
@@ -187,23 +216,59 @@ func OrganizationAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 	}
 }
 
-func authenticateUserByToken(r *http.Request) (*models.User, error) {
+/*
+ * The token is either the single token of the user,
+ * or one of its named API tokens, which can expire and be scoped.
+ * For named tokens, the token is also returned,
+ * so its scopes can be enforced for the request.
+ */
+func authenticateUserByToken(r *http.Request) (*models.User, *models.APIToken, error) {
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
-		return nil, fmt.Errorf("authorization header not found")
+		return nil, nil, fmt.Errorf("authorization header not found")
 	}
 
 	headerParts := strings.Split(authHeader, "Bearer ")
 	if len(headerParts) != 2 {
-		return nil, fmt.Errorf("invalid authorization header")
+		return nil, nil, fmt.Errorf("invalid authorization header")
 	}
 
 	hashedToken := crypto.HashToken(headerParts[1])
-	return models.FindActiveUserByTokenHash(hashedToken)
+	user, err := models.FindActiveUserByTokenHash(hashedToken)
+	if err == nil {
+		return user, nil, nil
+	}
+
+	token, err := models.FindActiveAPITokenByHash(hashedToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	if token.IsExpired(now) {
+		return nil, nil, fmt.Errorf("token expired")
+	}
+
+	user, err = models.FindActiveUserByID(token.OrganizationID.String(), token.UserID.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = token.TouchLastUsed(now)
+	if err != nil {
+		log.Warnf("failed to record usage of API token %s: %v", token.ID, err)
+	}
+
+	return user, token, nil
 }
 
+/*
+ * Organizations that enforce single sign-on can only be used
+ * with sessions created through their identity provider,
+ * and those sessions cannot be used for other organizations.
+ */
 func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.User, error) {
-	accountID, err := getAccountFromCookie(r, jwtSigner)
+	session, err := getAccountSessionFromCookie(r, jwtSigner)
 	if err != nil {
 		return nil, errors.New(AccountNotFoundError)
 	}
@@ -213,7 +278,20 @@ func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.U
 		return nil, errors.New(OrganizationNotFoundError)
 	}
 
-	account, err := models.FindAccountByID(accountID)
+	if session.SSOOrganizationID != "" && session.SSOOrganizationID != organizationID {
+		return nil, errors.New(OrganizationNotFoundError)
+	}
+
+	ssoConfig, err := models.FindOrganizationSSOConfig(organizationID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if ssoConfig != nil && ssoConfig.IsEnforced() && session.SSOOrganizationID != organizationID {
+		return nil, errors.New(SSORequiredError)
+	}
+
+	account, err := models.FindAccountByID(session.AccountID)
 	if err != nil {
 		return nil, errors.New(AccountNotFoundError)
 	}
@@ -265,6 +343,14 @@ func isOwnerSetupAllowedPath(path string) bool {
 	return false
 }
 
+func isAllowedForSSOSession(r *http.Request) bool {
+	if strings.HasPrefix(r.URL.Path, "/api/v1/invite-links/") {
+		return false
+	}
+
+	return r.URL.Path != "/organizations" || r.Method == http.MethodGet
+}
+
 func isAccountAPIPath(path string) bool {
 	switch path {
 	case "/account", "/organizations":
@@ -274,28 +360,45 @@ func isAccountAPIPath(path string) bool {
 	}
 }
 
-func getAccountFromCookie(r *http.Request, jwtSigner *jwt.Signer) (string, error) {
+type accountSession struct {
+	AccountID string
+
+	// Set for sessions created through the single sign-on of an organization.
+	SSOOrganizationID string
+}
+
+func getAccountSessionFromCookie(r *http.Request, jwtSigner *jwt.Signer) (*accountSession, error) {
 	cookie, err := r.Cookie("account_token")
 	if err != nil {
-		return "", fmt.Errorf("account token cookie not found")
+		return nil, fmt.Errorf("account token cookie not found")
 	}
 
 	claims, err := jwtSigner.ValidateAndGetClaims(cookie.Value)
 	if err != nil {
-		return "", fmt.Errorf("invalid account token: %v", err)
+		return nil, fmt.Errorf("invalid account token: %v", err)
 	}
 
 	accountClaim, exists := claims["sub"]
 	if !exists {
-		return "", fmt.Errorf("account ID missing from token")
+		return nil, fmt.Errorf("account ID missing from token")
 	}
 
 	accountID, ok := accountClaim.(string)
 	if !ok {
-		return "", fmt.Errorf("invalid account ID in token")
+		return nil, fmt.Errorf("invalid account ID in token")
 	}
 
-	return accountID, nil
+	session := &accountSession{AccountID: accountID}
+	if ssoClaim, exists := claims[authentication.SSOOrganizationClaim]; exists {
+		ssoOrganizationID, ok := ssoClaim.(string)
+		if !ok || ssoOrganizationID == "" {
+			return nil, fmt.Errorf("invalid single sign-on organization in token")
+		}
+
+		session.SSOOrganizationID = ssoOrganizationID
+	}
+
+	return session, nil
 }
 
 func GetUserFromContext(ctx context.Context) (*models.User, bool) {
@@ -303,6 +406,20 @@ func GetUserFromContext(ctx context.Context) (*models.User, bool) {
 	return user, ok
 }
 
+// GetAPITokenFromContext returns the named API token used for the request,
+// if any. Requests authenticated with a cookie or the user token have none.
+func GetAPITokenFromContext(ctx context.Context) (*models.APIToken, bool) {
+	token, ok := ctx.Value(APITokenContextKey).(*models.APIToken)
+	return token, ok
+}
+
+// GetSSOOrganizationFromContext returns the organization
+// of sessions created through single sign-on.
+func GetSSOOrganizationFromContext(ctx context.Context) (string, bool) {
+	organizationID, ok := ctx.Value(SSOOrganizationContextKey).(string)
+	return organizationID, ok
+}
+
 func redirectToLoginWithOriginalURL(w http.ResponseWriter, r *http.Request) {
 	redirectURL := url.QueryEscape(r.URL.RequestURI())
 	loginURL := fmt.Sprintf("/login?redirect=%s", redirectURL)
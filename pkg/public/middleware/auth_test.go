@@ -9,7 +9,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
 )
 
@@ -66,3 +70,134 @@ func TestOrganizationAuthMiddleware_CookieAuthErrors(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, res.Code)
 	})
 }
+
+func TestOrganizationAuthMiddleware_APITokens(t *testing.T) {
+	r := support.Setup(t)
+	signer := jwt.NewSigner("test-secret")
+
+	var tokenFromContext *models.APIToken
+	handler := OrganizationAuthMiddleware(signer)(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		tokenFromContext, _ = GetAPITokenFromContext(req.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	createToken := func(t *testing.T, name string, expiresAt *time.Time) (*models.APIToken, string) {
+		plainToken, err := crypto.Base64String(64)
+		require.NoError(t, err)
+
+		token := &models.APIToken{
+			OrganizationID: r.Organization.ID,
+			UserID:         r.User,
+			Name:           name,
+			TokenHash:      crypto.HashToken(plainToken),
+			Scopes:         []string{"canvases:read"},
+			ExpiresAt:      expiresAt,
+		}
+
+		require.NoError(t, models.CreateAPIToken(token))
+		return token, plainToken
+	}
+
+	request := func(plainToken string) *httptest.ResponseRecorder {
+		tokenFromContext = nil
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/canvases", nil)
+		req.Header.Set("Authorization", "Bearer "+plainToken)
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res
+	}
+
+	t.Run("valid token reaches next handler and records usage", func(t *testing.T) {
+		token, plainToken := createToken(t, "ci", nil)
+
+		res := request(plainToken)
+		assert.Equal(t, http.StatusNoContent, res.Code)
+		require.NotNil(t, tokenFromContext)
+		assert.Equal(t, token.ID, tokenFromContext.ID)
+
+		token, err := models.FindAPITokenByID(token.ID.String())
+		require.NoError(t, err)
+		assert.NotNil(t, token.LastUsedAt)
+	})
+
+	t.Run("expired token returns unauthorized", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Minute)
+		_, plainToken := createToken(t, "expired", &expiresAt)
+
+		res := request(plainToken)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("revoked token returns unauthorized", func(t *testing.T) {
+		token, plainToken := createToken(t, "revoked", nil)
+		require.NoError(t, token.Delete())
+
+		res := request(plainToken)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+}
+
+func TestOrganizationAuthMiddleware_SSO(t *testing.T) {
+	r := support.Setup(t)
+	signer := jwt.NewSigner("test-secret")
+
+	handler := OrganizationAuthMiddleware(signer)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := func(token, organizationID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: token})
+		req.Header.Set("x-organization-id", organizationID)
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res
+	}
+
+	sessionToken, err := signer.Generate(r.Account.ID.String(), time.Hour)
+	require.NoError(t, err)
+
+	ssoToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+		authentication.SSOOrganizationClaim: r.Organization.ID.String(),
+	})
+	require.NoError(t, err)
+
+	otherSSOToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+		authentication.SSOOrganizationClaim: uuid.NewString(),
+	})
+	require.NoError(t, err)
+
+	t.Run("single sign-on session for another organization returns not found", func(t *testing.T) {
+		res := request(otherSSOToken, r.Organization.ID.String())
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("any session is accepted if single sign-on is not enforced", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, request(sessionToken, r.Organization.ID.String()).Code)
+		assert.Equal(t, http.StatusNoContent, request(ssoToken, r.Organization.ID.String()).Code)
+	})
+
+	t.Run("enforced single sign-on requires a single sign-on session", func(t *testing.T) {
+		now := time.Now()
+		metadata := "<EntityDescriptor/>"
+		config := &models.OrganizationSSOConfig{
+			OrganizationID:  r.Organization.ID,
+			Protocol:        models.SSOProtocolSAML,
+			Enabled:         true,
+			Enforced:        true,
+			SAMLIdPMetadata: &metadata,
+			GroupsClaim:     models.DefaultSSOGroupsClaim,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		}
+		require.NoError(t, models.UpsertOrganizationSSOConfigInTransaction(database.Conn(), config))
+
+		res := request(sessionToken, r.Organization.ID.String())
+		assert.Equal(t, http.StatusForbidden, res.Code)
+		assert.Equal(t, "/auth/sso/"+r.Organization.ID.String(), res.Header().Get("X-SSO-Login-URL"))
+
+		assert.Equal(t, http.StatusNoContent, request(ssoToken, r.Organization.ID.String()).Code)
+	})
+}
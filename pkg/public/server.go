@@ -10,6 +10,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/scim"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	nooptrace "go.opentelemetry.io/otel/trace/noop"
@@ -49,6 +51,7 @@ import (
 	pbWidgets "github.com/superplanehq/superplane/pkg/protos/widgets"
 	"github.com/superplanehq/superplane/pkg/public/middleware"
 	"github.com/superplanehq/superplane/pkg/public/ws"
+	"github.com/superplanehq/superplane/pkg/quotas"
 	"github.com/superplanehq/superplane/pkg/web"
 	"github.com/superplanehq/superplane/pkg/web/assets"
 	grpcLib "google.golang.org/grpc"
@@ -78,6 +81,7 @@ type Server struct {
 	WebhooksBaseURL       string
 	wsHub                 *ws.Hub
 	authHandler           *authentication.Handler
+	scimHandler           *scim.Handler
 	isDev                 bool
 }
 
@@ -106,6 +110,7 @@ func NewServer(
 	authHandler := authentication.NewHandler(jwtSigner, encryptor, authorizationService, appEnv, templateDir, blockSignup, passwordLoginEnabled)
 	providers := getOAuthProviders()
 	authHandler.InitializeProviders(providers)
+	authHandler.InitializeSSO(baseURL)
 
 	server := &Server{
 		BaseURL:               baseURL,
@@ -113,6 +118,7 @@ func NewServer(
 		BasePath:              basePath,
 		wsHub:                 ws.NewHub(),
 		authHandler:           authHandler,
+		scimHandler:           scim.NewHandler(authorizationService, baseURL),
 		isDev:                 appEnv == "development",
 		timeoutHandlerTimeout: 15 * time.Second,
 		encryptor:             encryptor,
@@ -272,7 +278,7 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 
 func headersMatcher(key string) (string, bool) {
 	switch key {
-	case "X-User-Id", "X-Organization-Id", "X-Account-Id":
+	case "X-User-Id", "X-Organization-Id", "X-Account-Id", "X-Api-Token-Id":
 		return key, true
 	default:
 		return runtime.DefaultHeaderMatcher(key)
@@ -293,6 +299,16 @@ func (s *Server) grpcGatewayHandler(grpcGatewayMux *runtime.ServeMux) http.Handl
 		*r2.URL = *r.URL
 		r2.Header.Set("x-User-id", user.ID.String())
 		r2.Header.Set("x-Organization-id", user.OrganizationID.String())
+
+		//
+		// The token ID is only set by us,
+		// so scopes of named API tokens are enforced by the authorization interceptor.
+		//
+		r2.Header.Del("x-Api-Token-id")
+		if token, ok := middleware.GetAPITokenFromContext(r.Context()); ok {
+			r2.Header.Set("x-Api-Token-id", token.ID.String())
+		}
+
 		grpcGatewayMux.ServeHTTP(w, r2.WithContext(r.Context()))
 	})
 }
@@ -385,6 +401,9 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 	// Register authentication routes (no auth required)
 	s.authHandler.RegisterRoutes(r)
 
+	// SCIM provisioning routes (authenticated with the SCIM token of the organization)
+	s.scimHandler.RegisterRoutes(r)
+
 	//
 	// Public routes (no authentication required)
 	//
@@ -667,6 +686,16 @@ func (s *Server) listAccountOrganizations(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	//
+	// Sessions created through single sign-on
+	// only have access to their organization.
+	//
+	if ssoOrganizationID, ok := middleware.GetSSOOrganizationFromContext(r.Context()); ok {
+		organizations = slices.DeleteFunc(organizations, func(organization models.Organization) bool {
+			return organization.ID.String() != ssoOrganizationID
+		})
+	}
+
 	orgIDs := make([]string, 0, len(organizations))
 	for _, organization := range organizations {
 		orgIDs = append(orgIDs, organization.ID.String())
@@ -781,12 +810,40 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) executeWebhookNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode) (int, error) {
 	if node.Type == models.NodeTypeTrigger {
+
+		//
+		// Webhooks over the events quota are rejected before the trigger
+		// handles them, so senders can back off and retry later.
+		//
+		code, err := s.checkEventsQuota(node)
+		if err != nil {
+			return code, err
+		}
+
 		return s.executeTriggerNode(ctx, body, headers, node)
 	}
 
 	return s.executeComponentNode(ctx, body, headers, node)
 }
 
+func (s *Server) checkEventsQuota(node models.CanvasNode) (int, error) {
+	canvas, err := models.FindCanvasWithoutOrgScope(node.WorkflowID)
+	if err != nil {
+		return http.StatusNotFound, fmt.Errorf("canvas not found")
+	}
+
+	err = quotas.CheckEvents(database.Conn(), canvas.OrganizationID)
+	if quotas.IsExceeded(err) {
+		return http.StatusTooManyRequests, err
+	}
+
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
+
 func (s *Server) executeTriggerNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode) (int, error) {
 	ref := node.Ref.Data()
 	trigger, err := s.registry.GetTrigger(ref.Trigger.Name)
@@ -903,6 +960,12 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	token, ok := middleware.GetAPITokenFromContext(r.Context())
+	if ok && !token.Allows("canvases", parsedWorkflowID.String(), "read") {
+		http.Error(w, "canvas not found", http.StatusNotFound)
+		return
+	}
+
 	ws, err := s.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		if _, ok := err.(websocket.HandshakeError); !ok {
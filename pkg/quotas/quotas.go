@@ -0,0 +1,243 @@
+package quotas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"gorm.io/gorm"
+)
+
+const (
+	QuotaCanvases         = "canvases"
+	QuotaNodesPerCanvas   = "nodes_per_canvas"
+	QuotaEventsPerMinute  = "events_per_minute"
+	QuotaExecutionsPerDay = "executions_per_day"
+	QuotaMemoryRows       = "memory_rows"
+)
+
+/*
+ * Installation-wide defaults are configured with environment variables,
+ * set through the quotas values of the Helm chart.
+ * Organizations can have their own limits in the organization_quotas table.
+ * There is no API for those, since organization owners must not be able
+ * to change their own limits: operators set them in the database,
+ * and models.UpsertOrganizationQuota is what tooling should use for it.
+ * A limit of zero, or no limit at all, means the quota is not enforced.
+ */
+var defaultLimitVariables = map[string]string{
+	QuotaCanvases:         "QUOTA_MAX_CANVASES",
+	QuotaNodesPerCanvas:   "QUOTA_MAX_NODES_PER_CANVAS",
+	QuotaEventsPerMinute:  "QUOTA_MAX_EVENTS_PER_MINUTE",
+	QuotaExecutionsPerDay: "QUOTA_MAX_EXECUTIONS_PER_DAY",
+	QuotaMemoryRows:       "QUOTA_MAX_MEMORY_ROWS",
+}
+
+var quotaDescriptions = map[string]string{
+	QuotaCanvases:         "canvases",
+	QuotaNodesPerCanvas:   "nodes per canvas",
+	QuotaEventsPerMinute:  "events per minute",
+	QuotaExecutionsPerDay: "executions per day",
+	QuotaMemoryRows:       "canvas memory rows",
+}
+
+/*
+ * The order in which quotas are reported.
+ */
+var Quotas = []string{
+	QuotaCanvases,
+	QuotaNodesPerCanvas,
+	QuotaEventsPerMinute,
+	QuotaExecutionsPerDay,
+	QuotaMemoryRows,
+}
+
+type Limits map[string]int
+
+type Usage struct {
+	Quota string
+	Used  int64
+	Limit int
+}
+
+type ExceededError struct {
+	Quota string
+	Limit int
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("organization quota exceeded: limit of %d %s reached", e.Limit, quotaDescriptions[e.Quota])
+}
+
+func IsExceeded(err error) bool {
+	var exceeded *ExceededError
+	return errors.As(err, &exceeded)
+}
+
+func DefaultLimits() Limits {
+	limits := Limits{}
+	for quota, variable := range defaultLimitVariables {
+		value, err := strconv.Atoi(os.Getenv(variable))
+		if err == nil && value > 0 {
+			limits[quota] = value
+		}
+	}
+
+	return limits
+}
+
+func LimitsForOrganization(tx *gorm.DB, organizationID uuid.UUID) (Limits, error) {
+	limits := DefaultLimits()
+
+	override, err := models.FindOrganizationQuotaInTransaction(tx, organizationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return limits, nil
+		}
+
+		return nil, err
+	}
+
+	overrides := map[string]*int{
+		QuotaCanvases:         override.MaxCanvases,
+		QuotaNodesPerCanvas:   override.MaxNodesPerCanvas,
+		QuotaEventsPerMinute:  override.MaxEventsPerMinute,
+		QuotaExecutionsPerDay: override.MaxExecutionsPerDay,
+		QuotaMemoryRows:       override.MaxMemoryRows,
+	}
+
+	for quota, value := range overrides {
+		if value == nil {
+			continue
+		}
+
+		if *value > 0 {
+			limits[quota] = *value
+		} else {
+			delete(limits, quota)
+		}
+	}
+
+	return limits, nil
+}
+
+/*
+ * CheckCanvases verifies a new canvas can be created in the organization.
+ */
+func CheckCanvases(tx *gorm.DB, organizationID uuid.UUID) error {
+	return check(tx, organizationID, QuotaCanvases, 1, func() (int64, error) {
+		return models.CountCanvasesInOrganizationInTransaction(tx, organizationID)
+	})
+}
+
+/*
+ * CheckNodesPerCanvas verifies a canvas with the given number of nodes is allowed.
+ */
+func CheckNodesPerCanvas(tx *gorm.DB, organizationID uuid.UUID, nodes int) error {
+	return check(tx, organizationID, QuotaNodesPerCanvas, 0, func() (int64, error) {
+		return int64(nodes), nil
+	})
+}
+
+/*
+ * CheckEvents verifies a new event can be emitted in the organization.
+ */
+func CheckEvents(tx *gorm.DB, organizationID uuid.UUID) error {
+	return check(tx, organizationID, QuotaEventsPerMinute, 1, func() (int64, error) {
+		return models.CountCanvasEventsInOrganizationSinceInTransaction(tx, organizationID, time.Now().Add(-time.Minute))
+	})
+}
+
+/*
+ * CheckExecutions verifies a new execution can be created in the organization.
+ */
+func CheckExecutions(tx *gorm.DB, organizationID uuid.UUID) error {
+	return check(tx, organizationID, QuotaExecutionsPerDay, 1, func() (int64, error) {
+		return models.CountNodeExecutionsInOrganizationSinceInTransaction(tx, organizationID, time.Now().Add(-24*time.Hour))
+	})
+}
+
+/*
+ * CheckMemoryRows verifies a new canvas memory row can be added in the organization.
+ */
+func CheckMemoryRows(tx *gorm.DB, organizationID uuid.UUID) error {
+	return check(tx, organizationID, QuotaMemoryRows, 1, func() (int64, error) {
+		return models.CountCanvasMemoriesInOrganizationInTransaction(tx, organizationID)
+	})
+}
+
+/*
+ * Usage is only counted when the quota is enforced,
+ * so organizations without limits don't pay for it.
+ */
+func check(tx *gorm.DB, organizationID uuid.UUID, quota string, increment int64, used func() (int64, error)) error {
+	limits, err := LimitsForOrganization(tx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	limit, ok := limits[quota]
+	if !ok {
+		return nil
+	}
+
+	count, err := used()
+	if err != nil {
+		return err
+	}
+
+	if count+increment > int64(limit) {
+		telemetry.RecordQuotaExceeded(context.Background(), quota)
+		return &ExceededError{Quota: quota, Limit: limit}
+	}
+
+	return nil
+}
+
+/*
+ * GetUsage returns the current usage of every quota of the organization.
+ * For nodes per canvas, the usage is the number of nodes in its largest canvas.
+ */
+func GetUsage(tx *gorm.DB, organizationID uuid.UUID) ([]Usage, error) {
+	limits, err := LimitsForOrganization(tx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	counters := map[string]func() (int64, error){
+		QuotaCanvases: func() (int64, error) {
+			return models.CountCanvasesInOrganizationInTransaction(tx, organizationID)
+		},
+		QuotaNodesPerCanvas: func() (int64, error) {
+			return models.MaxNodesPerCanvasInOrganizationInTransaction(tx, organizationID)
+		},
+		QuotaEventsPerMinute: func() (int64, error) {
+			return models.CountCanvasEventsInOrganizationSinceInTransaction(tx, organizationID, now.Add(-time.Minute))
+		},
+		QuotaExecutionsPerDay: func() (int64, error) {
+			return models.CountNodeExecutionsInOrganizationSinceInTransaction(tx, organizationID, now.Add(-24*time.Hour))
+		},
+		QuotaMemoryRows: func() (int64, error) {
+			return models.CountCanvasMemoriesInOrganizationInTransaction(tx, organizationID)
+		},
+	}
+
+	usage := make([]Usage, 0, len(Quotas))
+	for _, quota := range Quotas {
+		used, err := counters[quota]()
+		if err != nil {
+			return nil, err
+		}
+
+		usage = append(usage, Usage{Quota: quota, Used: used, Limit: limits[quota]})
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,94 @@
+package quotas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__DefaultLimits(t *testing.T) {
+	t.Setenv("QUOTA_MAX_CANVASES", "10")
+	t.Setenv("QUOTA_MAX_EVENTS_PER_MINUTE", "0")
+	t.Setenv("QUOTA_MAX_EXECUTIONS_PER_DAY", "not-a-number")
+
+	assert.Equal(t, Limits{QuotaCanvases: 10}, DefaultLimits())
+}
+
+func Test__ExceededError(t *testing.T) {
+	err := &ExceededError{Quota: QuotaEventsPerMinute, Limit: 100}
+	assert.Equal(t, "organization quota exceeded: limit of 100 events per minute reached", err.Error())
+	assert.True(t, IsExceeded(err))
+	assert.False(t, IsExceeded(nil))
+}
+
+func Test__Quotas(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID:        "trigger-1",
+				Name:          "trigger-1",
+				Type:          models.NodeTypeTrigger,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{}),
+			},
+		},
+		nil,
+	)
+
+	t.Run("quotas are not enforced without limits", func(t *testing.T) {
+		require.NoError(t, CheckCanvases(database.Conn(), r.Organization.ID))
+		require.NoError(t, CheckNodesPerCanvas(database.Conn(), r.Organization.ID, 1000))
+		require.NoError(t, CheckEvents(database.Conn(), r.Organization.ID))
+	})
+
+	t.Run("default limits are enforced", func(t *testing.T) {
+		t.Setenv("QUOTA_MAX_CANVASES", "1")
+		t.Setenv("QUOTA_MAX_NODES_PER_CANVAS", "2")
+
+		err := CheckCanvases(database.Conn(), r.Organization.ID)
+		require.ErrorContains(t, err, "limit of 1 canvases reached")
+		require.NoError(t, CheckNodesPerCanvas(database.Conn(), r.Organization.ID, 2))
+		require.ErrorContains(t, CheckNodesPerCanvas(database.Conn(), r.Organization.ID, 3), "limit of 2 nodes per canvas reached")
+	})
+
+	t.Run("organization limits override defaults", func(t *testing.T) {
+		t.Setenv("QUOTA_MAX_CANVASES", "1")
+		t.Setenv("QUOTA_MAX_EVENTS_PER_MINUTE", "100")
+
+		unlimited := 0
+		oneEvent := 1
+		require.NoError(t, models.UpsertOrganizationQuota(&models.OrganizationQuota{
+			OrganizationID:     r.Organization.ID,
+			MaxCanvases:        &unlimited,
+			MaxEventsPerMinute: &oneEvent,
+		}))
+
+		require.NoError(t, CheckCanvases(database.Conn(), r.Organization.ID))
+		require.NoError(t, CheckEvents(database.Conn(), r.Organization.ID))
+
+		support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		err := CheckEvents(database.Conn(), r.Organization.ID)
+		require.True(t, IsExceeded(err))
+
+		usage, err := GetUsage(database.Conn(), r.Organization.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []Usage{
+			{Quota: QuotaCanvases, Used: 1, Limit: 0},
+			{Quota: QuotaNodesPerCanvas, Used: 1, Limit: 0},
+			{Quota: QuotaEventsPerMinute, Used: 1, Limit: 1},
+			{Quota: QuotaExecutionsPerDay, Used: 0, Limit: 0},
+			{Quota: QuotaMemoryRows, Used: 0, Limit: 0},
+		}, usage)
+	})
+}
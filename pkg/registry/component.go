@@ -3,6 +3,7 @@ package registry
 import (
 	"fmt"
 	"runtime/debug"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/configuration"
@@ -65,6 +66,15 @@ func (s *PanicableComponent) OutputChannels(config any) []core.OutputChannel {
 	return s.underlying.OutputChannels(config)
 }
 
+func (s *PanicableComponent) MaxWaitingTime() time.Duration {
+	waitingComponent, ok := s.underlying.(core.WaitingComponent)
+	if !ok {
+		return 0
+	}
+
+	return waitingComponent.MaxWaitingTime()
+}
+
 /*
  * Panicking methods.
  * These are where the component logic is implemented,
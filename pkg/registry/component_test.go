@@ -2,6 +2,7 @@ package registry
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
@@ -135,3 +136,26 @@ func TestPanicableComponent_Cleanup_CatchesPanic(t *testing.T) {
 	assert.Contains(t, err.Error(), "panicking-comp panicked in Cleanup()")
 	assert.Contains(t, err.Error(), "cleanup panic")
 }
+
+// waitingComponent is a component with a MaxWaitingTime() hint
+type waitingComponent struct {
+	panickingComponent
+}
+
+func (w *waitingComponent) MaxWaitingTime() time.Duration { return time.Hour }
+
+func TestPanicableComponent_MaxWaitingTime(t *testing.T) {
+	t.Run("forwards the hint of waiting components", func(t *testing.T) {
+		panicable := NewPanicableComponent(&waitingComponent{panickingComponent{name: "waiting-comp"}})
+		waiting, ok := panicable.(core.WaitingComponent)
+		require.True(t, ok)
+		assert.Equal(t, time.Hour, waiting.MaxWaitingTime())
+	})
+
+	t.Run("returns zero for other components", func(t *testing.T) {
+		panicable := NewPanicableComponent(&panickingComponent{name: "panicking-comp"})
+		waiting, ok := panicable.(core.WaitingComponent)
+		require.True(t, ok)
+		assert.Zero(t, waiting.MaxWaitingTime())
+	})
+}
@@ -0,0 +1,335 @@
+package registry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	PollActionName     = "poll"
+	PollingMetadataKey = "polling"
+
+	//
+	// How many item keys we keep around for deduplication.
+	// Older keys are dropped first.
+	//
+	MaxPolledItemKeys = 1000
+
+	//
+	// The first poll only records the items that already exist,
+	// so we do it right away, to avoid missing new items
+	// created in between the setup and the first poll.
+	//
+	initialPollDelay = time.Second
+)
+
+/*
+ * PollingTrigger wraps a core.PollingTrigger implementation
+ * into a Trigger, periodically calling Poll() through the poll action.
+ *
+ * The polling state - cursor, keys of the items already seen,
+ * and the result of the last poll - is stored in the node metadata,
+ * under the PollingMetadataKey key. The first poll only records
+ * the items that already exist, and does not emit events for them.
+ */
+type PollingTrigger struct {
+	underlying core.PollingTrigger
+}
+
+type PollingState struct {
+	ConfigHash   string   `json:"configHash" mapstructure:"configHash"`
+	Cursor       string   `json:"cursor" mapstructure:"cursor"`
+	SeenKeys     []string `json:"seenKeys" mapstructure:"seenKeys"`
+	LastPolledAt *string  `json:"lastPolledAt,omitempty" mapstructure:"lastPolledAt"`
+	LastError    string   `json:"lastError,omitempty" mapstructure:"lastError"`
+}
+
+func NewPollingTrigger(t core.PollingTrigger) core.Trigger {
+	return &PollingTrigger{underlying: t}
+}
+
+func (t *PollingTrigger) Name() string {
+	return t.underlying.Name()
+}
+
+func (t *PollingTrigger) Label() string {
+	return t.underlying.Label()
+}
+
+func (t *PollingTrigger) Description() string {
+	return t.underlying.Description()
+}
+
+func (t *PollingTrigger) Documentation() string {
+	return t.underlying.Documentation()
+}
+
+func (t *PollingTrigger) Icon() string {
+	return t.underlying.Icon()
+}
+
+func (t *PollingTrigger) Color() string {
+	return t.underlying.Color()
+}
+
+func (t *PollingTrigger) ExampleData() map[string]any {
+	return t.underlying.ExampleData()
+}
+
+func (t *PollingTrigger) Configuration() []configuration.Field {
+	return t.underlying.Configuration()
+}
+
+func (t *PollingTrigger) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        PollActionName,
+			Description: "Poll for new items",
+		},
+	}
+}
+
+func (t *PollingTrigger) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (t *PollingTrigger) Setup(ctx core.TriggerContext) error {
+	metadata := &pollingMetadataContext{underlying: ctx.Metadata}
+	setupCtx := ctx
+	setupCtx.Metadata = metadata
+
+	err := t.underlying.Setup(setupCtx)
+	if err != nil {
+		return err
+	}
+
+	hash, err := configurationHash(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	state, err := loadPollingState(ctx.Metadata)
+	if err != nil {
+		return err
+	}
+
+	//
+	// If the configuration changed, the cursor and the items seen
+	// no longer apply, so we start from scratch, with a new baseline.
+	//
+	if state.ConfigHash != hash {
+		state = &PollingState{ConfigHash: hash}
+		err = savePollingState(ctx.Metadata, state)
+		if err != nil {
+			return err
+		}
+	}
+
+	if state.LastPolledAt == nil {
+		return ctx.Requests.ScheduleActionCall(PollActionName, map[string]any{}, initialPollDelay)
+	}
+
+	return ctx.Requests.ScheduleActionCall(PollActionName, map[string]any{}, t.pollInterval(ctx.Configuration))
+}
+
+func (t *PollingTrigger) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	switch ctx.Name {
+	case PollActionName:
+		return nil, t.poll(ctx)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+func (t *PollingTrigger) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
+
+func (t *PollingTrigger) poll(ctx core.TriggerActionContext) error {
+	state, err := loadPollingState(ctx.Metadata)
+	if err != nil {
+		return err
+	}
+
+	pollCtx := core.PollContext{
+		Logger:        ctx.Logger,
+		Configuration: ctx.Configuration,
+		HTTP:          ctx.HTTP,
+		Integration:   ctx.Integration,
+		Cursor:        state.Cursor,
+	}
+
+	if state.LastPolledAt != nil {
+		lastPolledAt, err := time.Parse(time.RFC3339, *state.LastPolledAt)
+		if err == nil {
+			pollCtx.LastPolledAt = &lastPolledAt
+		}
+	}
+
+	now := time.Now()
+	result, err := t.underlying.Poll(pollCtx)
+
+	//
+	// Errors from the provider are usually transient,
+	// so we record them and keep polling on the next interval.
+	//
+	if err != nil {
+		ctx.Logger.Warnf("Error polling %s: %v", t.underlying.Name(), err)
+		state.LastError = err.Error()
+		err = savePollingState(ctx.Metadata, state)
+		if err != nil {
+			return err
+		}
+
+		return ctx.Requests.ScheduleActionCall(PollActionName, map[string]any{}, t.pollInterval(ctx.Configuration))
+	}
+
+	initialized := state.LastPolledAt != nil
+	seen := make(map[string]bool, len(state.SeenKeys))
+	for _, key := range state.SeenKeys {
+		seen[key] = true
+	}
+
+	for _, item := range result.Items {
+		if seen[item.Key] {
+			continue
+		}
+
+		seen[item.Key] = true
+		state.SeenKeys = append(state.SeenKeys, item.Key)
+		if !initialized {
+			continue
+		}
+
+		err = ctx.Events.Emit(item.PayloadType, item.Payload)
+		if err != nil {
+			return fmt.Errorf("error emitting event for %s: %w", item.Key, err)
+		}
+	}
+
+	if len(state.SeenKeys) > MaxPolledItemKeys {
+		state.SeenKeys = state.SeenKeys[len(state.SeenKeys)-MaxPolledItemKeys:]
+	}
+
+	polledAt := now.Format(time.RFC3339)
+	state.Cursor = result.Cursor
+	state.LastPolledAt = &polledAt
+	state.LastError = ""
+
+	err = savePollingState(ctx.Metadata, state)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Requests.ScheduleActionCall(PollActionName, map[string]any{}, t.pollInterval(ctx.Configuration))
+}
+
+func (t *PollingTrigger) pollInterval(configuration any) time.Duration {
+	interval := t.underlying.PollInterval(configuration)
+	if interval == 0 {
+		return core.DefaultPollInterval
+	}
+
+	if interval < core.MinPollInterval {
+		return core.MinPollInterval
+	}
+
+	return interval
+}
+
+/*
+ * pollingMetadataContext is given to the underlying trigger Setup(),
+ * so the trigger can manage its own node metadata
+ * without overwriting the polling state.
+ */
+type pollingMetadataContext struct {
+	underlying core.MetadataContext
+}
+
+func (m *pollingMetadataContext) Get() any {
+	return m.underlying.Get()
+}
+
+func (m *pollingMetadataContext) Set(value any) error {
+	metadata, err := toMap(value)
+	if err != nil {
+		return err
+	}
+
+	current, err := toMap(m.underlying.Get())
+	if err != nil {
+		return err
+	}
+
+	if state, ok := current[PollingMetadataKey]; ok {
+		metadata[PollingMetadataKey] = state
+	}
+
+	return m.underlying.Set(metadata)
+}
+
+func loadPollingState(metadata core.MetadataContext) (*PollingState, error) {
+	current, err := toMap(metadata.Get())
+	if err != nil {
+		return nil, err
+	}
+
+	state := PollingState{}
+	err = mapstructure.Decode(current[PollingMetadataKey], &state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode polling state: %w", err)
+	}
+
+	return &state, nil
+}
+
+func savePollingState(metadata core.MetadataContext, state *PollingState) error {
+	current, err := toMap(metadata.Get())
+	if err != nil {
+		return err
+	}
+
+	current[PollingMetadataKey] = state
+	return metadata.Set(current)
+}
+
+func toMap(value any) (map[string]any, error) {
+	m := map[string]any{}
+	if value == nil {
+		return m, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	if m == nil {
+		return map[string]any{}, nil
+	}
+
+	return m, nil
+}
+
+func configurationHash(configuration any) (string, error) {
+	data, err := json.Marshal(configuration)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,251 @@
+package registry
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+type fakePollingTrigger struct {
+	results []*core.PollResult
+	errors  []error
+	cursors []string
+}
+
+func (f *fakePollingTrigger) Name() string                         { return "fake.onItem" }
+func (f *fakePollingTrigger) Label() string                        { return "On Item" }
+func (f *fakePollingTrigger) Description() string                  { return "description" }
+func (f *fakePollingTrigger) Documentation() string                { return "" }
+func (f *fakePollingTrigger) Icon() string                         { return "icon" }
+func (f *fakePollingTrigger) Color() string                        { return "gray" }
+func (f *fakePollingTrigger) ExampleData() map[string]any          { return nil }
+func (f *fakePollingTrigger) Configuration() []configuration.Field { return nil }
+func (f *fakePollingTrigger) PollInterval(any) time.Duration       { return 5 * time.Minute }
+
+func (f *fakePollingTrigger) Setup(ctx core.TriggerContext) error {
+	return ctx.Metadata.Set(map[string]any{"project": "TEST"})
+}
+
+func (f *fakePollingTrigger) Poll(ctx core.PollContext) (*core.PollResult, error) {
+	f.cursors = append(f.cursors, ctx.Cursor)
+
+	i := len(f.cursors) - 1
+	if i < len(f.errors) && f.errors[i] != nil {
+		return nil, f.errors[i]
+	}
+
+	return f.results[i], nil
+}
+
+func item(key string) core.PolledItem {
+	return core.PolledItem{Key: key, PayloadType: "fake.item", Payload: map[string]any{"key": key}}
+}
+
+func Test__PollingTrigger(t *testing.T) {
+	logger := log.NewEntry(log.StandardLogger())
+
+	t.Run("setup schedules first poll and keeps trigger metadata", func(t *testing.T) {
+		trigger := NewPollingTrigger(&fakePollingTrigger{})
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"project": "TEST"},
+			Metadata:      metadata,
+			Requests:      requests,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, PollActionName, requests.Action)
+		assert.Equal(t, initialPollDelay, requests.Duration)
+
+		m := metadata.Get().(map[string]any)
+		assert.Equal(t, "TEST", m["project"])
+		assert.Contains(t, m, PollingMetadataKey)
+	})
+
+	t.Run("first poll records existing items, next polls emit new ones", func(t *testing.T) {
+		fake := &fakePollingTrigger{
+			results: []*core.PollResult{
+				{Cursor: "c1", Items: []core.PolledItem{item("a"), item("b")}},
+				{Cursor: "c2", Items: []core.PolledItem{item("b"), item("c"), item("c")}},
+				{Cursor: "c3", Items: []core.PolledItem{item("c"), item("d")}},
+			},
+		}
+
+		trigger := NewPollingTrigger(fake)
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+		configuration := map[string]any{"project": "TEST"}
+
+		require.NoError(t, trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: configuration,
+			Metadata:      metadata,
+			Requests:      requests,
+		}))
+
+		events := &contexts.EventContext{}
+		actionCtx := core.TriggerActionContext{
+			Name:          PollActionName,
+			Logger:        logger,
+			Configuration: configuration,
+			Metadata:      metadata,
+			Requests:      requests,
+			Events:        events,
+		}
+
+		_, err := trigger.HandleAction(actionCtx)
+		require.NoError(t, err)
+		assert.Zero(t, events.Count())
+		assert.Equal(t, 5*time.Minute, requests.Duration)
+
+		_, err = trigger.HandleAction(actionCtx)
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, map[string]any{"key": "c"}, events.Payloads[0].Data)
+
+		_, err = trigger.HandleAction(actionCtx)
+		require.NoError(t, err)
+		require.Equal(t, 2, events.Count())
+		assert.Equal(t, "fake.item", events.Payloads[1].Type)
+		assert.Equal(t, map[string]any{"key": "d"}, events.Payloads[1].Data)
+
+		assert.Equal(t, []string{"", "c1", "c2"}, fake.cursors)
+	})
+
+	t.Run("poll errors are recorded and polling continues", func(t *testing.T) {
+		fake := &fakePollingTrigger{
+			results: []*core.PollResult{
+				{Cursor: "c1", Items: []core.PolledItem{item("a")}},
+				nil,
+				{Cursor: "c2", Items: []core.PolledItem{item("a"), item("b")}},
+			},
+			errors: []error{nil, fmt.Errorf("rate limited")},
+		}
+
+		trigger := NewPollingTrigger(fake)
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+		events := &contexts.EventContext{}
+		actionCtx := core.TriggerActionContext{
+			Name:     PollActionName,
+			Logger:   logger,
+			Metadata: metadata,
+			Requests: requests,
+			Events:   events,
+		}
+
+		_, err := trigger.HandleAction(actionCtx)
+		require.NoError(t, err)
+
+		requests.Action = ""
+		_, err = trigger.HandleAction(actionCtx)
+		require.NoError(t, err)
+		assert.Equal(t, PollActionName, requests.Action)
+
+		state, err := loadPollingState(metadata)
+		require.NoError(t, err)
+		assert.Equal(t, "rate limited", state.LastError)
+		assert.Equal(t, "c1", state.Cursor)
+
+		_, err = trigger.HandleAction(actionCtx)
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, map[string]any{"key": "b"}, events.Payloads[0].Data)
+		assert.Equal(t, []string{"", "c1", "c1"}, fake.cursors)
+
+		state, err = loadPollingState(metadata)
+		require.NoError(t, err)
+		assert.Empty(t, state.LastError)
+	})
+
+	t.Run("configuration change resets polling state", func(t *testing.T) {
+		fake := &fakePollingTrigger{
+			results: []*core.PollResult{
+				{Cursor: "c1", Items: []core.PolledItem{item("a")}},
+			},
+		}
+
+		trigger := NewPollingTrigger(fake)
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+
+		require.NoError(t, trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"project": "TEST"},
+			Metadata:      metadata,
+			Requests:      requests,
+		}))
+
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:     PollActionName,
+			Logger:   logger,
+			Metadata: metadata,
+			Requests: requests,
+			Events:   &contexts.EventContext{},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"project": "TEST"},
+			Metadata:      metadata,
+			Requests:      requests,
+		}))
+
+		state, err := loadPollingState(metadata)
+		require.NoError(t, err)
+		assert.Equal(t, "c1", state.Cursor)
+		assert.Equal(t, 5*time.Minute, requests.Duration)
+
+		require.NoError(t, trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"project": "OTHER"},
+			Metadata:      metadata,
+			Requests:      requests,
+		}))
+
+		state, err = loadPollingState(metadata)
+		require.NoError(t, err)
+		assert.Empty(t, state.Cursor)
+		assert.Empty(t, state.SeenKeys)
+		assert.Nil(t, state.LastPolledAt)
+		assert.Equal(t, initialPollDelay, requests.Duration)
+	})
+
+	t.Run("seen keys are capped", func(t *testing.T) {
+		items := make([]core.PolledItem, 0, MaxPolledItemKeys+10)
+		for i := range MaxPolledItemKeys + 10 {
+			items = append(items, item(fmt.Sprintf("item-%d", i)))
+		}
+
+		fake := &fakePollingTrigger{
+			results: []*core.PollResult{{Cursor: "c1", Items: items}},
+		}
+
+		trigger := NewPollingTrigger(fake)
+		metadata := &contexts.MetadataContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:     PollActionName,
+			Logger:   logger,
+			Metadata: metadata,
+			Requests: &contexts.RequestContext{},
+			Events:   &contexts.EventContext{},
+		})
+		require.NoError(t, err)
+
+		state, err := loadPollingState(metadata)
+		require.NoError(t, err)
+		require.Len(t, state.SeenKeys, MaxPolledItemKeys)
+		assert.Equal(t, "item-10", state.SeenKeys[0])
+	})
+}
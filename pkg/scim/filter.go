@@ -0,0 +1,64 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+/*
+ * Identity providers only use filters to look up
+ * existing resources before creating them, so only
+ * equality filters on a single attribute are supported,
+ * e.g. userName eq "jane@example.com".
+ */
+var filterRegex = regexp.MustCompile(`(?i)^\s*([a-z][a-z0-9._:]*)\s+eq\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+type filter struct {
+	// Attribute names are case insensitive,
+	// so they are always lower case here.
+	Attribute string
+	Value     string
+}
+
+func parseFilter(expression string) (*filter, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+
+	matches := filterRegex.FindStringSubmatch(expression)
+	if matches == nil {
+		return nil, newError(http.StatusBadRequest, "invalidFilter", "unsupported filter %q: only 'attribute eq \"value\"' is supported", expression)
+	}
+
+	var value string
+	if err := json.Unmarshal([]byte(matches[2]), &value); err != nil {
+		return nil, newError(http.StatusBadRequest, "invalidFilter", "invalid filter value in %q", expression)
+	}
+
+	return &filter{
+		Attribute: strings.ToLower(matches[1]),
+		Value:     value,
+	}, nil
+}
+
+/*
+ * Member removals can target a single member with a value filter,
+ * e.g. members[value eq "2819c223-7f76-453a-919d-413861904646"].
+ */
+var memberPathRegex = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+("(?:[^"\\]|\\.)*")\s*\]$`)
+
+func parseMemberPath(path string) (string, bool) {
+	matches := memberPathRegex.FindStringSubmatch(strings.TrimSpace(path))
+	if matches == nil {
+		return "", false
+	}
+
+	var value string
+	if err := json.Unmarshal([]byte(matches[1]), &value); err != nil {
+		return "", false
+	}
+
+	return value, true
+}
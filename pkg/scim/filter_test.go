@@ -0,0 +1,103 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	t.Run("empty filter", func(t *testing.T) {
+		f, err := parseFilter("  ")
+		require.NoError(t, err)
+		assert.Nil(t, f)
+	})
+
+	t.Run("equality filter", func(t *testing.T) {
+		f, err := parseFilter(`userName eq "jane@example.com"`)
+		require.NoError(t, err)
+		assert.Equal(t, "username", f.Attribute)
+		assert.Equal(t, "jane@example.com", f.Value)
+	})
+
+	t.Run("operator is case insensitive and value can have escaped quotes", func(t *testing.T) {
+		f, err := parseFilter(`displayName EQ "The \"A\" Team"`)
+		require.NoError(t, err)
+		assert.Equal(t, "displayname", f.Attribute)
+		assert.Equal(t, `The "A" Team`, f.Value)
+	})
+
+	t.Run("unsupported filters", func(t *testing.T) {
+		for _, expression := range []string{
+			`userName co "jane"`,
+			`userName eq "a" and active eq true`,
+			`active eq true`,
+			`userName eq jane`,
+		} {
+			_, err := parseFilter(expression)
+			require.Error(t, err, expression)
+		}
+	})
+}
+
+func TestParseMemberPath(t *testing.T) {
+	value, ok := parseMemberPath(`members[value eq "2819c223-7f76-453a-919d-413861904646"]`)
+	require.True(t, ok)
+	assert.Equal(t, "2819c223-7f76-453a-919d-413861904646", value)
+
+	_, ok = parseMemberPath("members")
+	assert.False(t, ok)
+}
+
+func TestGroupNameFromDisplayName(t *testing.T) {
+	assert.Equal(t, "platform-engineers", groupNameFromDisplayName("Platform Engineers"))
+	assert.Equal(t, "sre-on-call", groupNameFromDisplayName("  SRE / On-Call  "))
+	assert.Regexp(t, `^group-[0-9a-f]{8}$`, groupNameFromDisplayName("日本"))
+}
+
+func TestUserPatch(t *testing.T) {
+	t.Run("operations with paths", func(t *testing.T) {
+		email, name, active, err := userPatch([]patchOperation{
+			{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+			{Op: "replace", Path: "displayName", Value: json.RawMessage(`"Jane Doe"`)},
+			{Op: "replace", Path: "userName", Value: json.RawMessage(`"Jane@Example.com"`)},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "jane@example.com", email)
+		assert.Equal(t, "Jane Doe", name)
+		require.NotNil(t, active)
+		assert.False(t, *active)
+	})
+
+	t.Run("operation without path", func(t *testing.T) {
+		email, name, active, err := userPatch([]patchOperation{
+			{Op: "replace", Value: json.RawMessage(`{"active": true, "name": {"givenName": "Jane", "familyName": "Doe"}, "externalId": "123"}`)},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, email)
+		assert.Equal(t, "Jane Doe", name)
+		require.NotNil(t, active)
+		assert.True(t, *active)
+	})
+
+	t.Run("userName that is not an email is ignored", func(t *testing.T) {
+		email, _, _, err := userPatch([]patchOperation{
+			{Op: "replace", Path: "userName", Value: json.RawMessage(`"jdoe"`)},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, email)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		_, _, _, err := userPatch([]patchOperation{{Op: "replace", Path: "active", Value: json.RawMessage(`"maybe"`)}})
+		require.Error(t, err)
+
+		_, _, _, err = userPatch([]patchOperation{{Op: "move", Path: "active", Value: json.RawMessage(`true`)}})
+		require.Error(t, err)
+	})
+}
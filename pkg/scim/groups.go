@@ -0,0 +1,563 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+// Groups created through SCIM get the viewer role.
+// The role can be changed in SuperPlane, and is kept
+// when the identity provider updates the group.
+const DefaultGroupRole = models.RoleOrgViewer
+
+type groupResource struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []memberRef `json:"members,omitempty"`
+	Meta        *meta       `json:"meta,omitempty"`
+}
+
+type memberRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+	startIndex, count := pagination(r)
+	includeMembers := !excludesMembers(r)
+
+	f, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	if f != nil && f.Attribute != "displayname" {
+		writeError(w, http.StatusBadRequest, "invalidFilter", "only displayName can be used in filters")
+		return
+	}
+
+	groups, err := h.authService.GetGroups(organizationID, models.DomainTypeOrganization)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	sort.Strings(groups)
+	resources := []groupResource{}
+	for _, group := range groups {
+		resource, err := h.serializeGroup(organizationID, group, false)
+		if err != nil {
+			writeErr(w, err)
+			return
+		}
+
+		if f == nil || strings.EqualFold(resource.DisplayName, f.Value) || strings.EqualFold(group, f.Value) {
+			resources = append(resources, resource)
+		}
+	}
+
+	total := len(resources)
+	page := resources[min(startIndex-1, total):min(startIndex-1+count, total)]
+	if includeMembers {
+		for i := range page {
+			page[i].Members, err = h.groupMembers(organizationID, page[i].ID)
+			if err != nil {
+				writeErr(w, err)
+				return
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, newListResponse(page, total, startIndex, len(page)))
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+	group, err := h.findGroup(organizationID, mux.Vars(r)["id"])
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	h.writeGroup(w, http.StatusOK, organizationID, group, !excludesMembers(r))
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+
+	var resource groupResource
+	if err := readJSON(w, r, &resource); err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	displayName := strings.TrimSpace(resource.DisplayName)
+	if displayName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+
+	groups, err := h.authService.GetGroups(organizationID, models.DomainTypeOrganization)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	group := groupNameFromDisplayName(displayName)
+	if slices.Contains(groups, group) {
+		writeError(w, http.StatusConflict, "uniqueness", "group "+displayName+" already exists")
+		return
+	}
+
+	members, err := activeMemberIDs(organizationID, resource.Members)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	err = h.authService.CreateGroup(organizationID, models.DomainTypeOrganization, group, DefaultGroupRole, displayName, "")
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	if err := h.setGroupMembers(organizationID, group, members); err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	h.writeGroup(w, http.StatusCreated, organizationID, group, true)
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+	group, err := h.findGroup(organizationID, mux.Vars(r)["id"])
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	var resource groupResource
+	if err := readJSON(w, r, &resource); err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	members, err := activeMemberIDs(organizationID, resource.Members)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	if err := h.renameGroup(organizationID, group, resource.DisplayName); err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	if err := h.setGroupMembers(organizationID, group, members); err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	h.writeGroup(w, http.StatusOK, organizationID, group, true)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+	group, err := h.findGroup(organizationID, mux.Vars(r)["id"])
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	request, err := readPatchRequest(w, r)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	for _, operation := range request.Operations {
+		if err := h.applyGroupPatch(organizationID, group, operation); err != nil {
+			writeErr(w, err)
+			return
+		}
+	}
+
+	h.writeGroup(w, http.StatusOK, organizationID, group, !excludesMembers(r))
+}
+
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+	group, err := h.findGroup(organizationID, mux.Vars(r)["id"])
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	if err := h.authService.DeleteGroup(organizationID, models.DomainTypeOrganization, group); err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) applyGroupPatch(organizationID, group string, operation patchOperation) error {
+	op, err := operation.normalizedOp()
+	if err != nil {
+		return err
+	}
+
+	path := strings.TrimSpace(operation.Path)
+
+	//
+	// Removal of a single member, with the member in the path.
+	//
+	if memberID, ok := parseMemberPath(path); ok {
+		if op != PatchOpRemove {
+			return newError(http.StatusBadRequest, "invalidPath", "unsupported path %q for %s", path, op)
+		}
+
+		return h.removeGroupMembers(organizationID, group, []string{memberID})
+	}
+
+	switch strings.ToLower(path) {
+	case "members":
+		return h.patchGroupMembers(organizationID, group, op, operation.Value)
+
+	case "displayname":
+		if op == PatchOpRemove {
+			return newError(http.StatusBadRequest, "mutability", "displayName cannot be removed")
+		}
+
+		displayName, err := patchString(operation.Value)
+		if err != nil {
+			return err
+		}
+
+		return h.renameGroup(organizationID, group, displayName)
+
+	case "":
+		if op == PatchOpRemove {
+			return newError(http.StatusBadRequest, "noTarget", "remove operations require a path")
+		}
+
+		attributes, err := patchAttributes(operation.Value)
+		if err != nil {
+			return err
+		}
+
+		if value, ok := attributes["displayname"]; ok {
+			displayName, err := patchString(value)
+			if err != nil {
+				return err
+			}
+
+			if err := h.renameGroup(organizationID, group, displayName); err != nil {
+				return err
+			}
+		}
+
+		if value, ok := attributes["members"]; ok {
+			return h.patchGroupMembers(organizationID, group, op, value)
+		}
+
+		return nil
+
+	default:
+		//
+		// Attributes that SuperPlane does not store, e.g. externalId, are ignored.
+		//
+		return nil
+	}
+}
+
+func (h *Handler) patchGroupMembers(organizationID, group, op string, value json.RawMessage) error {
+	var refs []memberRef
+	if len(value) > 0 && string(value) != "null" {
+		if err := json.Unmarshal(value, &refs); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "members must be a list of members")
+		}
+	}
+
+	switch op {
+	case PatchOpRemove:
+		//
+		// Removing the members attribute without a value removes all members.
+		//
+		if len(refs) == 0 {
+			return h.setGroupMembers(organizationID, group, []string{})
+		}
+
+		ids := []string{}
+		for _, ref := range refs {
+			ids = append(ids, ref.Value)
+		}
+
+		return h.removeGroupMembers(organizationID, group, ids)
+
+	case PatchOpAdd:
+		members, err := activeMemberIDs(organizationID, refs)
+		if err != nil {
+			return err
+		}
+
+		return h.addGroupMembers(organizationID, group, members)
+
+	default:
+		members, err := activeMemberIDs(organizationID, refs)
+		if err != nil {
+			return err
+		}
+
+		return h.setGroupMembers(organizationID, group, members)
+	}
+}
+
+func (h *Handler) findGroup(organizationID, id string) (string, error) {
+	groups, err := h.authService.GetGroups(organizationID, models.DomainTypeOrganization)
+	if err != nil {
+		return "", err
+	}
+
+	if !slices.Contains(groups, id) {
+		return "", newError(http.StatusNotFound, "", "group %s not found", id)
+	}
+
+	return id, nil
+}
+
+func (h *Handler) writeGroup(w http.ResponseWriter, status int, organizationID, group string, includeMembers bool) {
+	resource, err := h.serializeGroup(organizationID, group, includeMembers)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	writeJSON(w, status, resource)
+}
+
+func (h *Handler) serializeGroup(organizationID, group string, includeMembers bool) (groupResource, error) {
+	resource := groupResource{
+		Schemas:     []string{GroupSchema},
+		ID:          group,
+		DisplayName: group,
+		Meta: &meta{
+			ResourceType: "Group",
+			Location:     h.location("Groups", group),
+		},
+	}
+
+	metadata, err := models.FindGroupMetadata(group, models.DomainTypeOrganization, organizationID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return resource, err
+	}
+
+	if metadata != nil {
+		if metadata.DisplayName != "" {
+			resource.DisplayName = metadata.DisplayName
+		}
+
+		resource.Meta.Created = &metadata.CreatedAt
+		resource.Meta.LastModified = &metadata.UpdatedAt
+	}
+
+	if includeMembers {
+		resource.Members, err = h.groupMembers(organizationID, group)
+		if err != nil {
+			return resource, err
+		}
+	}
+
+	return resource, nil
+}
+
+func (h *Handler) groupMembers(organizationID, group string) ([]memberRef, error) {
+	userIDs, err := h.authService.GetGroupUsers(organizationID, models.DomainTypeOrganization, group)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := models.ListActiveUsersByID(organizationID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []memberRef{}
+	for _, user := range users {
+		if user.IsServiceAccount() {
+			continue
+		}
+
+		members = append(members, memberRef{
+			Value:   user.ID.String(),
+			Display: user.GetEmail(),
+			Ref:     h.location("Users", user.ID.String()),
+		})
+	}
+
+	return members, nil
+}
+
+/*
+ * The role and description of the group are kept,
+ * since they are managed in SuperPlane.
+ */
+func (h *Handler) renameGroup(organizationID, group, displayName string) error {
+	displayName = strings.TrimSpace(displayName)
+	if displayName == "" {
+		return nil
+	}
+
+	metadata, err := models.FindGroupMetadata(group, models.DomainTypeOrganization, organizationID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	description := ""
+	if metadata != nil {
+		if metadata.DisplayName == displayName {
+			return nil
+		}
+
+		description = metadata.Description
+	}
+
+	role, err := h.authService.GetGroupRole(organizationID, models.DomainTypeOrganization, group)
+	if err != nil {
+		return err
+	}
+
+	return h.authService.UpdateGroup(organizationID, models.DomainTypeOrganization, group, role, displayName, description)
+}
+
+func (h *Handler) setGroupMembers(organizationID, group string, members []string) error {
+	current, err := h.authService.GetGroupUsers(organizationID, models.DomainTypeOrganization, group)
+	if err != nil {
+		return err
+	}
+
+	removed := []string{}
+	for _, userID := range current {
+		if !slices.Contains(members, userID) {
+			removed = append(removed, userID)
+		}
+	}
+
+	if err := h.removeGroupMembers(organizationID, group, removed); err != nil {
+		return err
+	}
+
+	return h.addGroupMembers(organizationID, group, members)
+}
+
+func (h *Handler) addGroupMembers(organizationID, group string, members []string) error {
+	for _, userID := range members {
+		err := h.authService.AddUserToGroup(organizationID, models.DomainTypeOrganization, userID, group)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) removeGroupMembers(organizationID, group string, members []string) error {
+	current, err := h.authService.GetGroupUsers(organizationID, models.DomainTypeOrganization, group)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range members {
+		if !slices.Contains(current, userID) {
+			continue
+		}
+
+		err := h.authService.RemoveUserFromGroup(organizationID, models.DomainTypeOrganization, userID, group)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+ * Members must be active human users of the organization.
+ * Identity providers only add users they provisioned before.
+ */
+func activeMemberIDs(organizationID string, refs []memberRef) ([]string, error) {
+	ids := []string{}
+	for _, ref := range refs {
+		id := strings.TrimSpace(ref.Value)
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "member %s not found", ref.Value)
+		}
+
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	users, err := models.ListActiveUsersByID(organizationID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		found := slices.ContainsFunc(users, func(user models.User) bool {
+			return user.ID.String() == id && !user.IsServiceAccount()
+		})
+
+		if !found {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "member %s not found", id)
+		}
+	}
+
+	return ids, nil
+}
+
+func excludesMembers(r *http.Request) bool {
+	for _, attribute := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attribute), "members") {
+			return true
+		}
+	}
+
+	return false
+}
+
+var groupNameInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+/*
+ * Group names are used in URLs, so groups created through
+ * SCIM get a name derived from their display name,
+ * e.g. "Platform Engineers" becomes platform-engineers.
+ */
+func groupNameFromDisplayName(displayName string) string {
+	name := strings.Trim(groupNameInvalidChars.ReplaceAllString(strings.ToLower(displayName), "-"), "-")
+	if name == "" {
+		return "group-" + uuid.NewString()[:8]
+	}
+
+	return name
+}
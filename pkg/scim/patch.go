@@ -0,0 +1,99 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	PatchOpAdd     = "add"
+	PatchOpRemove  = "remove"
+	PatchOpReplace = "replace"
+)
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+/*
+ * Some identity providers send operations capitalized,
+ * e.g. Replace, so operations are normalized before use.
+ */
+func (o *patchOperation) normalizedOp() (string, error) {
+	op := strings.ToLower(strings.TrimSpace(o.Op))
+	switch op {
+	case PatchOpAdd, PatchOpRemove, PatchOpReplace:
+		return op, nil
+	default:
+		return "", newError(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation %q", o.Op)
+	}
+}
+
+func readPatchRequest(w http.ResponseWriter, r *http.Request) (*patchRequest, error) {
+	var request patchRequest
+	if err := readJSON(w, r, &request); err != nil {
+		return nil, err
+	}
+
+	if len(request.Operations) == 0 {
+		return nil, newError(http.StatusBadRequest, "invalidSyntax", "no patch operations")
+	}
+
+	return &request, nil
+}
+
+/*
+ * Operations without a path carry an object with the
+ * attributes to change. Attribute names are case insensitive.
+ */
+func patchAttributes(value json.RawMessage) (map[string]json.RawMessage, error) {
+	attributes := map[string]json.RawMessage{}
+	if err := json.Unmarshal(value, &attributes); err != nil {
+		return nil, newError(http.StatusBadRequest, "invalidValue", "patch value must be an object")
+	}
+
+	result := make(map[string]json.RawMessage, len(attributes))
+	for name, v := range attributes {
+		result[strings.ToLower(name)] = v
+	}
+
+	return result, nil
+}
+
+func patchString(value json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return "", newError(http.StatusBadRequest, "invalidValue", "expected a string value")
+	}
+
+	return strings.TrimSpace(s), nil
+}
+
+/*
+ * Booleans are also accepted as strings,
+ * since some identity providers send "True" and "False".
+ */
+func patchBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if parsed, err := strconv.ParseBool(strings.TrimSpace(s)); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return false, newError(http.StatusBadRequest, "invalidValue", "expected a boolean value")
+}
@@ -0,0 +1,241 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	BasePath    = "/scim/v2"
+	ContentType = "application/scim+json"
+
+	UserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	ListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	DefaultPageSize = 100
+	MaxPageSize     = 500
+	MaxRequestSize  = 1024 * 1024
+)
+
+type contextKey string
+
+const organizationIDContextKey contextKey = "scim-organization-id"
+
+/*
+ * Handler implements the SCIM 2.0 provisioning API.
+ * Each organization has its own token, so the token
+ * of the request determines the organization provisioned.
+ */
+type Handler struct {
+	authService authorization.Authorization
+	baseURL     string
+}
+
+func NewHandler(authService authorization.Authorization, baseURL string) *Handler {
+	return &Handler{
+		authService: authService,
+		baseURL:     baseURL,
+	}
+}
+
+func BaseURL(baseURL string) string {
+	return strings.TrimRight(baseURL, "/") + BasePath
+}
+
+func (h *Handler) RegisterRoutes(router *mux.Router) {
+	r := router.PathPrefix(BasePath).Subrouter()
+	r.Use(h.authenticate)
+
+	r.HandleFunc("/ServiceProviderConfig", h.getServiceProviderConfig).Methods(http.MethodGet)
+
+	r.HandleFunc("/Users", h.listUsers).Methods(http.MethodGet)
+	r.HandleFunc("/Users", h.createUser).Methods(http.MethodPost)
+	r.HandleFunc("/Users/{id}", h.getUser).Methods(http.MethodGet)
+	r.HandleFunc("/Users/{id}", h.replaceUser).Methods(http.MethodPut)
+	r.HandleFunc("/Users/{id}", h.patchUser).Methods(http.MethodPatch)
+	r.HandleFunc("/Users/{id}", h.deleteUser).Methods(http.MethodDelete)
+
+	r.HandleFunc("/Groups", h.listGroups).Methods(http.MethodGet)
+	r.HandleFunc("/Groups", h.createGroup).Methods(http.MethodPost)
+	r.HandleFunc("/Groups/{id}", h.getGroup).Methods(http.MethodGet)
+	r.HandleFunc("/Groups/{id}", h.replaceGroup).Methods(http.MethodPut)
+	r.HandleFunc("/Groups/{id}", h.patchGroup).Methods(http.MethodPatch)
+	r.HandleFunc("/Groups/{id}", h.deleteGroup).Methods(http.MethodDelete)
+}
+
+func (h *Handler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+			writeError(w, http.StatusUnauthorized, "", "missing bearer token")
+			return
+		}
+
+		scimToken, err := models.FindOrganizationSCIMTokenByHash(crypto.HashToken(strings.TrimSpace(token)))
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, "", "invalid token")
+			return
+		}
+
+		if err := scimToken.TouchLastUsed(time.Now()); err != nil {
+			log.Warnf("Error recording usage of SCIM token for organization %s: %v", scimToken.OrganizationID, err)
+		}
+
+		ctx := context.WithValue(r.Context(), organizationIDContextKey, scimToken.OrganizationID.String())
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func organizationIDFromContext(ctx context.Context) string {
+	organizationID, _ := ctx.Value(organizationIDContextKey).(string)
+	return organizationID
+}
+
+func (h *Handler) location(resourceType, id string) string {
+	return fmt.Sprintf("%s/%s/%s", BaseURL(h.baseURL), resourceType, id)
+}
+
+type meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    any      `json:"Resources"`
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+/*
+ * SCIM errors are returned with a status and, for bad requests,
+ * a scimType describing the problem, e.g. invalidFilter or uniqueness.
+ */
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func newError(status int, scimType, format string, args ...any) error {
+	return &scimError{status: status, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload any) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		log.Errorf("Error encoding SCIM response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, scimType, detail string) {
+	writeJSON(w, status, errorResponse{
+		Schemas:  []string{ErrorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+/*
+ * Errors that are not SCIM errors are logged,
+ * and not exposed to the identity provider.
+ */
+func writeErr(w http.ResponseWriter, err error) {
+	var e *scimError
+	if errors.As(err, &e) {
+		writeError(w, e.status, e.scimType, e.detail)
+		return
+	}
+
+	log.Errorf("Error handling SCIM request: %v", err)
+	writeError(w, http.StatusInternalServerError, "", "internal server error")
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	r.Body = http.MaxBytesReader(w, r.Body, MaxRequestSize)
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "invalid request body: %v", err)
+	}
+
+	return nil
+}
+
+/*
+ * SCIM pagination is 1-based: startIndex is the position
+ * of the first result, and count the size of the page.
+ */
+func pagination(r *http.Request) (int, int) {
+	startIndex := 1
+	if value, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && value > 1 {
+		startIndex = value
+	}
+
+	count := DefaultPageSize
+	if value, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil {
+		count = min(max(value, 0), MaxPageSize)
+	}
+
+	return startIndex, count
+}
+
+func newListResponse(resources any, total, startIndex, itemsPerPage int) listResponse {
+	return listResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: itemsPerPage,
+		Resources:    resources,
+	}
+}
+
+func (h *Handler) getServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{ServiceProviderConfigSchema},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": MaxPageSize},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with the SCIM token of the organization",
+				"primary":     true,
+			},
+		},
+	})
+}
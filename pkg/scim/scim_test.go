@@ -0,0 +1,320 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+const testSCIMToken = "scim-test-token"
+
+func setupSCIM(t *testing.T) (*mux.Router, *support.ResourceRegistry) {
+	r := support.Setup(t)
+
+	require.NoError(t, models.UpsertOrganizationSCIMToken(&models.OrganizationSCIMToken{
+		OrganizationID: r.Organization.ID,
+		TokenHash:      crypto.HashToken(testSCIMToken),
+	}))
+
+	router := mux.NewRouter()
+	NewHandler(r.AuthService, "http://localhost:8000").RegisterRoutes(router)
+	return router, r
+}
+
+func scimRequest(t *testing.T, router *mux.Router, method, path string, body any) *httptest.ResponseRecorder {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Authorization", "Bearer "+testSCIMToken)
+	req.Header.Set("Content-Type", ContentType)
+
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, req)
+	return res
+}
+
+func decode[T any](t *testing.T, res *httptest.ResponseRecorder) T {
+	var v T
+	require.NoError(t, json.Unmarshal(res.Body.Bytes(), &v))
+	return v
+}
+
+func TestSCIM_Authentication(t *testing.T) {
+	router, _ := setupSCIM(t)
+
+	t.Run("missing token -> unauthorized", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil)
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("invalid token -> unauthorized", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil)
+		req.Header.Set("Authorization", "Bearer not-the-token")
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("valid token -> records usage", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodGet, "/scim/v2/ServiceProviderConfig", nil)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		token, err := models.FindOrganizationSCIMTokenByHash(crypto.HashToken(testSCIMToken))
+		require.NoError(t, err)
+		assert.NotNil(t, token.LastUsedAt)
+	})
+}
+
+func TestSCIM_Users(t *testing.T) {
+	router, r := setupSCIM(t)
+	orgID := r.Organization.ID.String()
+
+	var userID string
+
+	t.Run("create user -> account, user and viewer role are created", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodPost, "/scim/v2/Users", map[string]any{
+			"schemas":  []string{UserSchema},
+			"userName": "Jane@Example.com",
+			"name":     map[string]any{"givenName": "Jane", "familyName": "Doe"},
+			"active":   true,
+		})
+
+		require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+		resource := decode[userResource](t, res)
+		assert.Equal(t, "jane@example.com", resource.UserName)
+		assert.Equal(t, "Jane Doe", resource.DisplayName)
+		require.NotNil(t, resource.Active)
+		assert.True(t, *resource.Active)
+		userID = resource.ID
+
+		_, err := models.FindAccountByEmail("jane@example.com")
+		require.NoError(t, err)
+
+		roles, err := r.AuthService.GetUserRolesForOrg(userID, orgID)
+		require.NoError(t, err)
+		require.NotEmpty(t, roles)
+	})
+
+	t.Run("create existing user -> conflict", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodPost, "/scim/v2/Users", map[string]any{
+			"schemas":  []string{UserSchema},
+			"userName": "jane@example.com",
+		})
+
+		assert.Equal(t, http.StatusConflict, res.Code)
+		assert.Equal(t, "uniqueness", decode[errorResponse](t, res).ScimType)
+	})
+
+	t.Run("filter by userName", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodGet, `/scim/v2/Users?filter=userName%20eq%20%22jane%40example.com%22`, nil)
+		require.Equal(t, http.StatusOK, res.Code)
+
+		list := decode[struct {
+			TotalResults int            `json:"totalResults"`
+			Resources    []userResource `json:"Resources"`
+		}](t, res)
+
+		assert.Equal(t, 1, list.TotalResults)
+		require.Len(t, list.Resources, 1)
+		assert.Equal(t, userID, list.Resources[0].ID)
+	})
+
+	t.Run("changing the userName -> bad request", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodPatch, "/scim/v2/Users/"+userID, map[string]any{
+			"schemas":    []string{PatchOpSchema},
+			"Operations": []map[string]any{{"op": "replace", "path": "userName", "value": "john@example.com"}},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	canvasID := canvas.ID.String()
+
+	t.Run("deactivate user -> user is removed from organization, groups and canvases", func(t *testing.T) {
+		require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "engineering", models.RoleOrgViewer, "Engineering", ""))
+		require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, userID, "engineering"))
+		require.NoError(t, r.AuthService.AssignCanvasRole(canvasID, authorization.CanvasRoleBinding{UserID: userID, Role: models.RoleCanvasEditor}))
+
+		res := scimRequest(t, router, http.MethodPatch, "/scim/v2/Users/"+userID, map[string]any{
+			"schemas":    []string{PatchOpSchema},
+			"Operations": []map[string]any{{"op": "Replace", "path": "active", "value": "False"}},
+		})
+
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+		resource := decode[userResource](t, res)
+		assert.False(t, *resource.Active)
+
+		_, err := models.FindActiveUserByID(orgID, userID)
+		require.Error(t, err)
+
+		members, err := r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, "engineering")
+		require.NoError(t, err)
+		assert.NotContains(t, members, userID)
+
+		canvasRoles, err := r.AuthService.GetUserCanvasRoles(userID, orgID, canvasID)
+		require.NoError(t, err)
+		assert.Empty(t, canvasRoles)
+
+		res = scimRequest(t, router, http.MethodGet, "/scim/v2/Users/"+userID, nil)
+		require.Equal(t, http.StatusOK, res.Code)
+		assert.False(t, *decode[userResource](t, res).Active)
+	})
+
+	t.Run("reactivate user", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodPut, "/scim/v2/Users/"+userID, map[string]any{
+			"schemas":     []string{UserSchema},
+			"userName":    "jane@example.com",
+			"displayName": "Jane D.",
+			"active":      true,
+		})
+
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+		resource := decode[userResource](t, res)
+		assert.True(t, *resource.Active)
+		assert.Equal(t, "Jane D.", resource.DisplayName)
+
+		user, err := models.FindActiveUserByID(orgID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, "Jane D.", user.Name)
+
+		//
+		// Reactivated users start over, without the canvas roles they had before.
+		//
+		canvasRoles, err := r.AuthService.GetUserCanvasRoles(userID, orgID, canvasID)
+		require.NoError(t, err)
+		assert.Empty(t, canvasRoles)
+
+		allowed, err := r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, "canvases", "update")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("last owner cannot be deactivated", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodDelete, "/scim/v2/Users/"+r.User.String(), nil)
+		assert.Equal(t, http.StatusConflict, res.Code)
+
+		_, err := models.FindActiveUserByID(orgID, r.User.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("delete user", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodDelete, "/scim/v2/Users/"+userID, nil)
+		assert.Equal(t, http.StatusNoContent, res.Code)
+
+		_, err := models.FindActiveUserByID(orgID, userID)
+		require.Error(t, err)
+	})
+}
+
+func TestSCIM_Groups(t *testing.T) {
+	router, r := setupSCIM(t)
+	orgID := r.Organization.ID.String()
+	member := support.CreateUser(t, r, r.Organization.ID)
+	memberID := member.ID.String()
+
+	t.Run("create group with members", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodPost, "/scim/v2/Groups", map[string]any{
+			"schemas":     []string{GroupSchema},
+			"displayName": "Platform Engineers",
+			"members":     []map[string]any{{"value": memberID}},
+		})
+
+		require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+		resource := decode[groupResource](t, res)
+		assert.Equal(t, "platform-engineers", resource.ID)
+		assert.Equal(t, "Platform Engineers", resource.DisplayName)
+		require.Len(t, resource.Members, 1)
+		assert.Equal(t, memberID, resource.Members[0].Value)
+
+		role, err := r.AuthService.GetGroupRole(orgID, models.DomainTypeOrganization, "platform-engineers")
+		require.NoError(t, err)
+		assert.Equal(t, DefaultGroupRole, role)
+	})
+
+	t.Run("create group with unknown member -> bad request", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodPost, "/scim/v2/Groups", map[string]any{
+			"schemas":     []string{GroupSchema},
+			"displayName": "Other",
+			"members":     []map[string]any{{"value": "2819c223-7f76-453a-919d-413861904646"}},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("filter by displayName", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodGet, `/scim/v2/Groups?filter=displayName%20eq%20%22Platform%20Engineers%22&excludedAttributes=members`, nil)
+		require.Equal(t, http.StatusOK, res.Code)
+
+		list := decode[struct {
+			TotalResults int             `json:"totalResults"`
+			Resources    []groupResource `json:"Resources"`
+		}](t, res)
+
+		assert.Equal(t, 1, list.TotalResults)
+		require.Len(t, list.Resources, 1)
+		assert.Empty(t, list.Resources[0].Members)
+	})
+
+	t.Run("remove member with a path filter", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodPatch, "/scim/v2/Groups/platform-engineers", map[string]any{
+			"schemas":    []string{PatchOpSchema},
+			"Operations": []map[string]any{{"op": "remove", "path": `members[value eq "` + memberID + `"]`}},
+		})
+
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+		members, err := r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, "platform-engineers")
+		require.NoError(t, err)
+		assert.NotContains(t, members, memberID)
+	})
+
+	t.Run("add member and rename group -> role is kept", func(t *testing.T) {
+		require.NoError(t, r.AuthService.UpdateGroup(orgID, models.DomainTypeOrganization, "platform-engineers", models.RoleOrgAdmin, "Platform Engineers", "managed"))
+
+		res := scimRequest(t, router, http.MethodPatch, "/scim/v2/Groups/platform-engineers", map[string]any{
+			"schemas": []string{PatchOpSchema},
+			"Operations": []map[string]any{
+				{"op": "add", "path": "members", "value": []map[string]any{{"value": memberID}}},
+				{"op": "replace", "value": map[string]any{"displayName": "Platform Team"}},
+			},
+		})
+
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+		resource := decode[groupResource](t, res)
+		assert.Equal(t, "Platform Team", resource.DisplayName)
+		require.Len(t, resource.Members, 1)
+
+		role, err := r.AuthService.GetGroupRole(orgID, models.DomainTypeOrganization, "platform-engineers")
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleOrgAdmin, role)
+
+		metadata, err := models.FindGroupMetadata("platform-engineers", models.DomainTypeOrganization, orgID)
+		require.NoError(t, err)
+		assert.Equal(t, "managed", metadata.Description)
+	})
+
+	t.Run("delete group", func(t *testing.T) {
+		res := scimRequest(t, router, http.MethodDelete, "/scim/v2/Groups/platform-engineers", nil)
+		assert.Equal(t, http.StatusNoContent, res.Code)
+
+		res = scimRequest(t, router, http.MethodGet, "/scim/v2/Groups/platform-engineers", nil)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
@@ -0,0 +1,536 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type userResource struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *userName   `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []userEmail `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Meta        *meta       `json:"meta,omitempty"`
+}
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type userEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+/*
+ * SuperPlane users are identified by their email.
+ * Identity providers usually send it as the userName,
+ * but some use another identifier there, and only send
+ * the email in the emails attribute.
+ */
+func (u *userResource) email() string {
+	if strings.Contains(u.UserName, "@") {
+		return utils.NormalizeEmail(u.UserName)
+	}
+
+	for _, email := range u.Emails {
+		if email.Primary {
+			return utils.NormalizeEmail(email.Value)
+		}
+	}
+
+	if len(u.Emails) > 0 {
+		return utils.NormalizeEmail(u.Emails[0].Value)
+	}
+
+	return ""
+}
+
+func (u *userResource) fullName() string {
+	if name := strings.TrimSpace(u.DisplayName); name != "" {
+		return name
+	}
+
+	if u.Name == nil {
+		return ""
+	}
+
+	if name := strings.TrimSpace(u.Name.Formatted); name != "" {
+		return name
+	}
+
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+func (u *userResource) isActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+func (h *Handler) serializeUser(user *models.User) userResource {
+	active := !user.DeletedAt.Valid
+	email := user.GetEmail()
+	return userResource{
+		Schemas:     []string{UserSchema},
+		ID:          user.ID.String(),
+		UserName:    email,
+		Name:        &userName{Formatted: user.Name},
+		DisplayName: user.Name,
+		Emails:      []userEmail{{Value: email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &meta{
+			ResourceType: "User",
+			Created:      &user.CreatedAt,
+			LastModified: &user.UpdatedAt,
+			Location:     h.location("Users", user.ID.String()),
+		},
+	}
+}
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+	startIndex, count := pagination(r)
+
+	f, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	email := ""
+	if f != nil {
+		if f.Attribute != "username" {
+			writeError(w, http.StatusBadRequest, "invalidFilter", "only userName can be used in filters")
+			return
+		}
+
+		email = utils.NormalizeEmail(f.Value)
+		if email == "" {
+			writeJSON(w, http.StatusOK, newListResponse([]userResource{}, 0, startIndex, 0))
+			return
+		}
+	}
+
+	users, total, err := models.ListMaybeDeletedHumanUsers(organizationID, email, startIndex-1, count)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	resources := make([]userResource, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, h.serializeUser(&user))
+	}
+
+	writeJSON(w, http.StatusOK, newListResponse(resources, int(total), startIndex, len(resources)))
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
+	user, err := findUser(organizationIDFromContext(r.Context()), mux.Vars(r)["id"])
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.serializeUser(user))
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+
+	var resource userResource
+	if err := readJSON(w, r, &resource); err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	email := resource.email()
+	if email == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "an email is required, as userName or in emails")
+		return
+	}
+
+	existing, err := models.FindMaybeDeletedUserByEmail(organizationID, email)
+	if err == nil && !existing.DeletedAt.Valid {
+		writeError(w, http.StatusConflict, "uniqueness", "user "+email+" already exists")
+		return
+	}
+
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		writeErr(w, err)
+		return
+	}
+
+	name := resource.fullName()
+	if name == "" {
+		name = email
+	}
+
+	user, err := h.provisionUser(organizationID, email, name)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	if !resource.isActive() {
+		if err := h.deprovisionUser(organizationID, user); err != nil {
+			writeErr(w, err)
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusCreated, h.serializeUser(user))
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+	user, err := findUser(organizationID, mux.Vars(r)["id"])
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	var resource userResource
+	if err := readJSON(w, r, &resource); err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	active := resource.isActive()
+	err = h.updateUser(organizationID, user, resource.email(), resource.fullName(), &active)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.serializeUser(user))
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+	user, err := findUser(organizationID, mux.Vars(r)["id"])
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	request, err := readPatchRequest(w, r)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	email, name, active, err := userPatch(request.Operations)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	err = h.updateUser(organizationID, user, email, name, active)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.serializeUser(user))
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	organizationID := organizationIDFromContext(r.Context())
+	user, err := findUser(organizationID, mux.Vars(r)["id"])
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	if err := h.deprovisionUser(organizationID, user); err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*
+ * Only the name and the active state of a user can be changed.
+ * The email identifies the user in SuperPlane, so it can't be.
+ * Empty values and nil mean the attribute is not being changed.
+ */
+func (h *Handler) updateUser(organizationID string, user *models.User, email, name string, active *bool) error {
+	if email != "" && email != user.GetEmail() {
+		return newError(http.StatusBadRequest, "mutability", "userName cannot be changed")
+	}
+
+	if name != "" && name != user.Name {
+		if err := user.UpdateName(name); err != nil {
+			return err
+		}
+	}
+
+	if active == nil {
+		return nil
+	}
+
+	if *active {
+		restored, err := h.provisionUser(organizationID, user.GetEmail(), user.Name)
+		if err != nil {
+			return err
+		}
+
+		*user = *restored
+		return nil
+	}
+
+	return h.deprovisionUser(organizationID, user)
+}
+
+// Attributes of patch operations without a path are applied
+// in this order, so the display name wins over the name parts.
+var userPatchAttributes = []string{"username", "name", "name.formatted", "displayname", "active"}
+
+/*
+ * userPatch collects the changes of the patch operations.
+ * Attributes that SuperPlane does not store are ignored.
+ */
+func userPatch(operations []patchOperation) (string, string, *bool, error) {
+	var email, name string
+	var active *bool
+
+	apply := func(attribute string, value []byte) error {
+		var err error
+		switch attribute {
+		case "active":
+			var b bool
+			b, err = patchBool(value)
+			active = &b
+		case "displayname", "name.formatted":
+			name, err = patchString(value)
+		case "username":
+			var login string
+			login, err = patchString(value)
+			if strings.Contains(login, "@") {
+				email = utils.NormalizeEmail(login)
+			}
+		case "name":
+			var n userName
+			if jsonErr := json.Unmarshal(value, &n); jsonErr != nil {
+				return newError(http.StatusBadRequest, "invalidValue", "invalid name")
+			}
+			name = (&userResource{Name: &n}).fullName()
+		}
+
+		return err
+	}
+
+	for _, operation := range operations {
+		op, err := operation.normalizedOp()
+		if err != nil {
+			return "", "", nil, err
+		}
+
+		if op == PatchOpRemove {
+			continue
+		}
+
+		path := strings.ToLower(strings.TrimSpace(operation.Path))
+		if path != "" {
+			if err := apply(path, operation.Value); err != nil {
+				return "", "", nil, err
+			}
+			continue
+		}
+
+		attributes, err := patchAttributes(operation.Value)
+		if err != nil {
+			return "", "", nil, err
+		}
+
+		for _, attribute := range userPatchAttributes {
+			value, ok := attributes[attribute]
+			if !ok {
+				continue
+			}
+
+			if err := apply(attribute, value); err != nil {
+				return "", "", nil, err
+			}
+		}
+	}
+
+	return email, name, active, nil
+}
+
+func findUser(organizationID, id string) (*models.User, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, newError(http.StatusNotFound, "", "user %s not found", id)
+	}
+
+	user, err := models.FindMaybeDeletedUserByID(organizationID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, newError(http.StatusNotFound, "", "user %s not found", id)
+		}
+
+		return nil, err
+	}
+
+	if user.IsServiceAccount() {
+		return nil, newError(http.StatusNotFound, "", "user %s not found", id)
+	}
+
+	return user, nil
+}
+
+/*
+ * provisionUser adds a user to the organization, or restores
+ * a removed one. If the person has no SuperPlane account yet,
+ * one is created, which they use once they sign in with that email.
+ */
+func (h *Handler) provisionUser(organizationID, email, name string) (*models.User, error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *models.User
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		user, err = models.FindMaybeDeletedUserByEmailInTransaction(tx, organizationID, email)
+		if err == nil && !user.DeletedAt.Valid {
+			return nil
+		}
+
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err == nil {
+			err = user.RestoreInTransaction(tx)
+			user.DeletedAt = gorm.DeletedAt{}
+		} else {
+			user, err = createUserInTransaction(tx, orgID, email, name)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return h.authService.AssignRole(user.ID.String(), models.RoleOrgViewer, organizationID, models.DomainTypeOrganization)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func createUserInTransaction(tx *gorm.DB, organizationID uuid.UUID, email, name string) (*models.User, error) {
+	account, err := models.FindAccountByEmail(email)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		account, err = models.CreateAccountInTransaction(tx, name, email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return models.CreateUserInTransaction(tx, organizationID, account.ID, email, name)
+}
+
+/*
+ * deprovisionUser removes a user from the organization, like removing
+ * a member does, and also from all groups, so access is lost immediately.
+ * The user is kept, as removed, so it can be provisioned again later.
+ */
+func (h *Handler) deprovisionUser(organizationID string, user *models.User) error {
+	if user.DeletedAt.Valid {
+		return nil
+	}
+
+	userID := user.ID.String()
+	owners, err := h.authService.GetOrgUsersForRole(models.RoleOrgOwner, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if len(owners) <= 1 && slices.Contains(owners, userID) {
+		return newError(http.StatusConflict, "", "cannot deactivate the last organization owner")
+	}
+
+	groups, err := h.authService.GetGroups(organizationID, models.DomainTypeOrganization)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		members, err := h.authService.GetGroupUsers(organizationID, models.DomainTypeOrganization, group)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(members, userID) {
+			continue
+		}
+
+		err = h.authService.RemoveUserFromGroup(organizationID, models.DomainTypeOrganization, userID, group)
+		if err != nil {
+			return err
+		}
+	}
+
+	roles, err := h.authService.GetUserRolesForOrg(userID, organizationID)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		err = h.authService.RemoveRole(userID, role.Name, organizationID, models.DomainTypeOrganization)
+		if err != nil {
+			return err
+		}
+	}
+
+	canvases, err := models.ListCanvases(organizationID, false)
+	if err != nil {
+		return err
+	}
+
+	canvasIDs := make([]string, 0, len(canvases))
+	for _, canvas := range canvases {
+		canvasIDs = append(canvasIDs, canvas.ID.String())
+	}
+
+	err = h.authService.RemoveUserCanvasRoles(userID, canvasIDs)
+	if err != nil {
+		return err
+	}
+
+	err = user.Delete()
+	if err != nil {
+		return err
+	}
+
+	user.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	return nil
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -21,6 +24,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/templates"
 	"github.com/superplanehq/superplane/pkg/workers"
+	"github.com/superplanehq/superplane/pkg/workers/coordination"
 
 	// Import integrations, components and triggers to register them via init()
 	_ "github.com/superplanehq/superplane/pkg/components/addmemory"
@@ -31,6 +35,7 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/components/if"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
+	_ "github.com/superplanehq/superplane/pkg/components/publishevent"
 	_ "github.com/superplanehq/superplane/pkg/components/readmemory"
 	_ "github.com/superplanehq/superplane/pkg/components/ssh"
 	_ "github.com/superplanehq/superplane/pkg/components/timegate"
@@ -73,20 +78,31 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/integrations/smtp"
 	_ "github.com/superplanehq/superplane/pkg/integrations/statuspage"
 	_ "github.com/superplanehq/superplane/pkg/integrations/telegram"
+	_ "github.com/superplanehq/superplane/pkg/triggers/email"
+	_ "github.com/superplanehq/superplane/pkg/triggers/onevent"
 	_ "github.com/superplanehq/superplane/pkg/triggers/schedule"
 	_ "github.com/superplanehq/superplane/pkg/triggers/start"
 	_ "github.com/superplanehq/superplane/pkg/triggers/webhook"
 	_ "github.com/superplanehq/superplane/pkg/widgets/annotation"
 )
 
-func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, authService authorization.Authorization) {
+// startWorkers starts the workers, which stop when ctx is canceled.
+// The returned WaitGroup is done once the workers with in-flight work have drained it.
+func startWorkers(ctx context.Context, encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, authService authorization.Authorization) *sync.WaitGroup {
 	log.Println("Starting Workers")
 
+	draining := &sync.WaitGroup{}
+
 	rabbitMQURL, err := config.RabbitMQURL()
 	if err != nil {
 		panic(err)
 	}
 
+	if coordination.Enabled() {
+		log.Println("Starting Worker Coordinator")
+		coordination.Start(ctx)
+	}
+
 	if os.Getenv("START_CONSUMERS") == "yes" {
 		startEmailConsumers(rabbitMQURL, encryptor, baseURL, authService)
 	}
@@ -95,23 +111,30 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		log.Println("Starting Event Router")
 
 		w := workers.NewEventRouter()
-		go w.Start(context.Background())
+		go w.Start(ctx)
 	}
 
 	if os.Getenv("START_WORKFLOW_NODE_EXECUTOR") == "yes" || os.Getenv("START_NODE_EXECUTOR") == "yes" {
 		log.Println("Starting Node Executor")
 
 		webhookBaseURL := getWebhookBaseURL(baseURL)
-		w := workers.NewNodeExecutor(encryptor, registry, baseURL, webhookBaseURL)
-		go w.Start(context.Background())
+		w := workers.NewNodeExecutor(encryptor, registry, oidcProvider, baseURL, webhookBaseURL)
+		draining.Add(1)
+		go func() {
+			defer draining.Done()
+			w.Start(ctx)
+		}()
+
+		reaper := workers.NewNodeExecutionReaper()
+		go coordination.RunAsLeader(ctx, "node-execution-reaper", reaper.Start)
 	}
 
 	if os.Getenv("START_NODE_REQUEST_WORKER") == "yes" {
 		log.Println("Starting Node Request Worker")
 
 		webhookBaseURL := getWebhookBaseURL(baseURL)
-		w := workers.NewNodeRequestWorker(encryptor, registry, webhookBaseURL)
-		go w.Start(context.Background())
+		w := workers.NewNodeRequestWorker(encryptor, registry, oidcProvider, webhookBaseURL)
+		go w.Start(ctx)
 	}
 
 	if os.Getenv("START_APP_INSTALLATION_REQUEST_WORKER") == "yes" || os.Getenv("START_INTEGRATION_REQUEST_WORKER") == "yes" {
@@ -119,14 +142,14 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 
 		webhooksBaseURL := getWebhookBaseURL(baseURL)
 		w := workers.NewIntegrationRequestWorker(encryptor, registry, oidcProvider, baseURL, webhooksBaseURL)
-		go w.Start(context.Background())
+		go w.Start(ctx)
 	}
 
 	if os.Getenv("START_WORKFLOW_NODE_QUEUE_WORKER") == "yes" || os.Getenv("START_NODE_QUEUE_WORKER") == "yes" {
 		log.Println("Starting Node Queue Worker")
 
 		w := workers.NewNodeQueueWorker(registry)
-		go w.Start(context.Background())
+		go w.Start(ctx)
 	}
 
 	// Start Webhook Provisioner when internal API runs so integration webhooks (e.g. GCP On VM Created) get provisioned.
@@ -137,29 +160,61 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		}
 		webhookBaseURL := getWebhookBaseURL(baseURL)
 		w := workers.NewWebhookProvisioner(webhookBaseURL, encryptor, registry)
-		go w.Start(context.Background())
+		go coordination.RunAsLeader(ctx, "webhook-provisioner", w.Start)
 	}
 
 	if os.Getenv("START_WEBHOOK_CLEANUP_WORKER") == "yes" {
 		log.Println("Starting Webhook Cleanup Worker")
 
 		w := workers.NewWebhookCleanupWorker(encryptor, registry, baseURL)
-		go w.Start(context.Background())
+		go coordination.RunAsLeader(ctx, "webhook-cleanup-worker", w.Start)
 	}
 
 	if os.Getenv("START_INSTALLATION_CLEANUP_WORKER") == "yes" || os.Getenv("START_INTEGRATION_CLEANUP_WORKER") == "yes" {
 		log.Println("Starting Integration Cleanup Worker")
 
 		w := workers.NewIntegrationCleanupWorker(registry, encryptor, baseURL)
-		go w.Start(context.Background())
+		go coordination.RunAsLeader(ctx, "integration-cleanup-worker", w.Start)
 	}
 
 	if os.Getenv("START_WORKFLOW_CLEANUP_WORKER") == "yes" || os.Getenv("START_CANVAS_CLEANUP_WORKER") == "yes" {
 		log.Println("Starting Canvas Cleanup Worker")
 
 		w := workers.NewCanvasCleanupWorker()
-		go w.Start(context.Background())
+		go coordination.RunAsLeader(ctx, "canvas-cleanup-worker", w.Start)
+	}
+
+	if os.Getenv("START_STUCK_EXECUTION_WORKER") == "yes" {
+		log.Println("Starting Stuck Execution Worker")
+
+		w := workers.NewStuckExecutionWorker(os.Getenv("STUCK_EXECUTION_ACTION"))
+		go coordination.RunAsLeader(ctx, "stuck-execution-worker", w.Start)
 	}
+
+	if os.Getenv("START_INBOUND_EMAIL_RECEIVER") == "yes" {
+		startInboundEmailReceiver(ctx, encryptor, registry, baseURL)
+	}
+
+	return draining
+}
+
+func startInboundEmailReceiver(ctx context.Context, encryptor crypto.Encryptor, registry *registry.Registry, baseURL string) {
+	domain := os.Getenv("INBOUND_EMAIL_DOMAIN")
+	if domain == "" {
+		log.Warn("Inbound Email Receiver not started - missing required environment variable (INBOUND_EMAIL_DOMAIN)")
+		return
+	}
+
+	port := os.Getenv("INBOUND_EMAIL_PORT")
+	if port == "" {
+		port = "2525"
+	}
+
+	log.Println("Starting Inbound Email Receiver")
+
+	webhookBaseURL := getWebhookBaseURL(baseURL)
+	w := workers.NewInboundEmailReceiver(":"+port, domain, encryptor, registry, webhookBaseURL)
+	go w.Start(ctx)
 }
 
 func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, baseURL string, authService authorization.Authorization) {
@@ -390,11 +445,21 @@ func Start() {
 		go startInternalAPI(baseURL, webhooksBaseURL, basePath, encryptorInstance, authService, registry, oidcProvider)
 	}
 
-	startWorkers(encryptorInstance, registry, oidcProvider, baseURL, authService)
+	//
+	// On SIGTERM, workers stop picking up new work,
+	// and we wait for the in-flight work to be drained before exiting.
+	//
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	draining := startWorkers(ctx, encryptorInstance, registry, oidcProvider, baseURL, authService)
 
 	log.Println("SuperPlane is UP.")
 
-	select {}
+	<-ctx.Done()
+	log.Println("Shutting down - draining workers")
+	draining.Wait()
+	log.Println("SuperPlane is DOWN.")
 }
 
 // getWebhookBaseURL returns the webhook base URL, using the same pattern as SyncContext.
@@ -0,0 +1,433 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultInboundMessageSize = 10 * 1024 * 1024
+	MaxInboundRecipients      = 50
+
+	DefaultMaxInboundConnections      = 200
+	DefaultMaxInboundConnectionsPerIP = 10
+
+	smtpCommandTimeout = 5 * time.Minute
+	smtpMaxLineLength  = 4096
+)
+
+var ErrRecipientNotFound = errors.New("recipient not found")
+
+type InboundMessage struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+/*
+ * SMTPReceiver is a minimal SMTP server used to receive
+ * inbound emails for the email trigger. It only supports
+ * the commands needed to receive messages - no authentication,
+ * no TLS and no relaying. TLS is expected to be terminated in front of it.
+ */
+type SMTPReceiver struct {
+	Domain         string
+	MaxMessageSize int64
+
+	//
+	// Connections over these limits are turned away with a 421 reply,
+	// which tells senders to try again later.
+	//
+	MaxConnections      int
+	MaxConnectionsPerIP int
+
+	//
+	// Called for every RCPT TO command.
+	// Returning ErrRecipientNotFound rejects the recipient permanently,
+	// any other error rejects it temporarily.
+	//
+	AcceptRecipient func(address string) error
+
+	//
+	// Called once the message data is received.
+	// Returning an error tells the sender to try again later.
+	//
+	Handler func(message *InboundMessage) error
+
+	mu               sync.Mutex
+	listener         net.Listener
+	closed           bool
+	connections      int
+	connectionsPerIP map[string]int
+}
+
+func NewSMTPReceiver(domain string, acceptRecipient func(string) error, handler func(*InboundMessage) error) *SMTPReceiver {
+	return &SMTPReceiver{
+		Domain:              domain,
+		MaxMessageSize:      DefaultInboundMessageSize,
+		MaxConnections:      DefaultMaxInboundConnections,
+		MaxConnectionsPerIP: DefaultMaxInboundConnectionsPerIP,
+		AcceptRecipient:     acceptRecipient,
+		Handler:             handler,
+	}
+}
+
+func (s *SMTPReceiver) ListenAndServe(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", addr, err)
+	}
+
+	return s.Serve(listener)
+}
+
+func (s *SMTPReceiver) Serve(listener net.Listener) error {
+	s.mu.Lock()
+	s.listener = listener
+	s.mu.Unlock()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return nil
+			}
+
+			return err
+		}
+
+		if !s.acquireConnection(conn) {
+			go s.rejectConnection(conn)
+			continue
+		}
+
+		go s.handleConnection(conn)
+	}
+}
+
+func (s *SMTPReceiver) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	if s.listener == nil {
+		return nil
+	}
+
+	return s.listener.Close()
+}
+
+func (s *SMTPReceiver) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+func (s *SMTPReceiver) acquireConnection(conn net.Conn) bool {
+	ip := remoteIP(conn)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.MaxConnections > 0 && s.connections >= s.MaxConnections {
+		return false
+	}
+
+	if s.MaxConnectionsPerIP > 0 && s.connectionsPerIP[ip] >= s.MaxConnectionsPerIP {
+		return false
+	}
+
+	if s.connectionsPerIP == nil {
+		s.connectionsPerIP = map[string]int{}
+	}
+
+	s.connections++
+	s.connectionsPerIP[ip]++
+	return true
+}
+
+func (s *SMTPReceiver) releaseConnection(conn net.Conn) {
+	ip := remoteIP(conn)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.connections--
+	s.connectionsPerIP[ip]--
+	if s.connectionsPerIP[ip] <= 0 {
+		delete(s.connectionsPerIP, ip)
+	}
+}
+
+func (s *SMTPReceiver) rejectConnection(conn net.Conn) {
+	defer conn.Close()
+
+	log.Warnf("Rejecting SMTP connection from %s - too many connections", conn.RemoteAddr())
+	_ = conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	_, _ = fmt.Fprintf(conn, "421 %s Too many connections, try again later\r\n", s.Domain)
+}
+
+func remoteIP(conn net.Conn) string {
+	host, _, err := net.SplitHostPort(conn.RemoteAddr().String())
+	if err != nil {
+		return conn.RemoteAddr().String()
+	}
+
+	return host
+}
+
+type smtpSession struct {
+	receiver *SMTPReceiver
+	conn     net.Conn
+	text     *textproto.Conn
+	helo     string
+	from     *string
+	to       []string
+}
+
+func (s *SMTPReceiver) handleConnection(conn net.Conn) {
+	defer s.releaseConnection(conn)
+	defer conn.Close()
+
+	session := &smtpSession{
+		receiver: s,
+		conn:     conn,
+		text:     textproto.NewConn(conn),
+	}
+
+	session.serve()
+}
+
+func (s *smtpSession) serve() {
+	s.reply(220, "%s ESMTP SuperPlane", s.receiver.Domain)
+
+	for {
+		_ = s.conn.SetDeadline(time.Now().Add(smtpCommandTimeout))
+		line, err := s.text.ReadLine()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				log.Debugf("Error reading SMTP command from %s: %v", s.conn.RemoteAddr(), err)
+			}
+
+			return
+		}
+
+		if len(line) > smtpMaxLineLength {
+			s.reply(500, "Line too long")
+			continue
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "HELO":
+			s.handleHelo(arg, false)
+		case "EHLO":
+			s.handleHelo(arg, true)
+		case "MAIL":
+			s.handleMail(arg)
+		case "RCPT":
+			s.handleRcpt(arg)
+		case "DATA":
+			s.handleData()
+		case "RSET":
+			s.reset()
+			s.reply(250, "OK")
+		case "NOOP":
+			s.reply(250, "OK")
+		case "VRFY":
+			s.reply(252, "Cannot verify user")
+		case "QUIT":
+			s.reply(221, "Bye")
+			return
+		default:
+			s.reply(502, "Command not implemented")
+		}
+	}
+}
+
+func (s *smtpSession) handleHelo(arg string, extended bool) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		s.reply(501, "Domain required")
+		return
+	}
+
+	s.helo = arg
+	s.reset()
+
+	if !extended {
+		s.reply(250, "%s", s.receiver.Domain)
+		return
+	}
+
+	_ = s.text.PrintfLine("250-%s", s.receiver.Domain)
+	_ = s.text.PrintfLine("250-SIZE %d", s.receiver.MaxMessageSize)
+	_ = s.text.PrintfLine("250-8BITMIME")
+	s.reply(250, "SMTPUTF8")
+}
+
+func (s *smtpSession) handleMail(arg string) {
+	if s.helo == "" {
+		s.reply(503, "Send HELO/EHLO first")
+		return
+	}
+
+	if s.from != nil {
+		s.reply(503, "Sender already specified")
+		return
+	}
+
+	address, params, err := parsePathArgument(arg, "FROM:")
+	if err != nil {
+		s.reply(501, "%v", err)
+		return
+	}
+
+	for _, param := range params {
+		key, value, _ := strings.Cut(param, "=")
+		if !strings.EqualFold(key, "SIZE") {
+			continue
+		}
+
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err == nil && size > s.receiver.MaxMessageSize {
+			s.reply(552, "Message size exceeds maximum of %d bytes", s.receiver.MaxMessageSize)
+			return
+		}
+	}
+
+	s.from = &address
+	s.reply(250, "OK")
+}
+
+func (s *smtpSession) handleRcpt(arg string) {
+	if s.from == nil {
+		s.reply(503, "Send MAIL first")
+		return
+	}
+
+	if len(s.to) >= MaxInboundRecipients {
+		s.reply(452, "Too many recipients")
+		return
+	}
+
+	address, _, err := parsePathArgument(arg, "TO:")
+	if err != nil || address == "" {
+		s.reply(501, "Invalid recipient")
+		return
+	}
+
+	if s.receiver.AcceptRecipient != nil {
+		err = s.receiver.AcceptRecipient(address)
+		if errors.Is(err, ErrRecipientNotFound) {
+			s.reply(550, "No such recipient")
+			return
+		}
+
+		if err != nil {
+			log.Errorf("Error checking inbound email recipient %s: %v", address, err)
+			s.reply(451, "Temporary failure, try again later")
+			return
+		}
+	}
+
+	s.to = append(s.to, address)
+	s.reply(250, "OK")
+}
+
+func (s *smtpSession) handleData() {
+	if len(s.to) == 0 {
+		s.reply(503, "Send RCPT first")
+		return
+	}
+
+	s.reply(354, "End data with <CR><LF>.<CR><LF>")
+
+	//
+	// We always read the whole message, even if it is too large,
+	// so the connection stays in sync with the sender.
+	//
+	reader := s.text.DotReader()
+	data, err := io.ReadAll(io.LimitReader(reader, s.receiver.MaxMessageSize+1))
+	if err != nil {
+		s.reply(451, "Error reading message")
+		s.reset()
+		return
+	}
+
+	if int64(len(data)) > s.receiver.MaxMessageSize {
+		_, _ = io.Copy(io.Discard, reader)
+		s.reply(552, "Message size exceeds maximum of %d bytes", s.receiver.MaxMessageSize)
+		s.reset()
+		return
+	}
+
+	message := &InboundMessage{
+		From: *s.from,
+		To:   s.to,
+		Data: data,
+	}
+
+	s.reset()
+
+	if s.receiver.Handler != nil {
+		err = s.receiver.Handler(message)
+		if err != nil {
+			log.Errorf("Error handling inbound email from %s: %v", message.From, err)
+			s.reply(451, "Temporary failure, try again later")
+			return
+		}
+	}
+
+	s.reply(250, "OK: message accepted")
+}
+
+func (s *smtpSession) reset() {
+	s.from = nil
+	s.to = nil
+}
+
+func (s *smtpSession) reply(code int, format string, args ...any) {
+	_ = s.text.PrintfLine("%d %s", code, fmt.Sprintf(format, args...))
+}
+
+// parsePathArgument parses the argument of MAIL and RCPT commands,
+// e.g. "FROM:<user@example.com> SIZE=1000". An empty path (<>) is allowed.
+func parsePathArgument(arg, prefix string) (string, []string, error) {
+	arg = strings.TrimSpace(arg)
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", nil, fmt.Errorf("syntax error, expected %s<address>", prefix)
+	}
+
+	fields := strings.Fields(strings.TrimSpace(arg[len(prefix):]))
+	if len(fields) == 0 {
+		return "", nil, fmt.Errorf("syntax error, expected %s<address>", prefix)
+	}
+
+	path := fields[0]
+	if !strings.HasPrefix(path, "<") || !strings.HasSuffix(path, ">") {
+		return "", nil, fmt.Errorf("syntax error, address must be enclosed in <>")
+	}
+
+	address := strings.TrimSuffix(strings.TrimPrefix(path, "<"), ">")
+	if address == "" {
+		return "", fields[1:], nil
+	}
+
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid address %s", address)
+	}
+
+	return parsed.Address, fields[1:], nil
+}
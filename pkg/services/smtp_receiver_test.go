@@ -0,0 +1,186 @@
+package services
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startSMTPReceiver(t *testing.T, receiver *SMTPReceiver) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() { _ = receiver.Serve(listener) }()
+	t.Cleanup(func() { _ = receiver.Close() })
+
+	return listener.Addr().String()
+}
+
+func Test__SMTPReceiver(t *testing.T) {
+	t.Run("message is delivered to handler", func(t *testing.T) {
+		var mu sync.Mutex
+		messages := []*InboundMessage{}
+
+		receiver := NewSMTPReceiver("inbound.example.com",
+			func(address string) error {
+				if strings.HasPrefix(address, "known@") {
+					return nil
+				}
+
+				return ErrRecipientNotFound
+			},
+			func(message *InboundMessage) error {
+				mu.Lock()
+				defer mu.Unlock()
+				messages = append(messages, message)
+				return nil
+			},
+		)
+
+		addr := startSMTPReceiver(t, receiver)
+		body := "Subject: Hello\r\n\r\nHello world\r\n.leading dot\r\n"
+		err := smtp.SendMail(addr, nil, "alice@example.com", []string{"known@inbound.example.com"}, []byte(body))
+		require.NoError(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, messages, 1)
+		assert.Equal(t, "alice@example.com", messages[0].From)
+		assert.Equal(t, []string{"known@inbound.example.com"}, messages[0].To)
+		assert.Equal(t, "Subject: Hello\n\nHello world\n.leading dot\n", string(messages[0].Data))
+	})
+
+	t.Run("unknown recipient is rejected", func(t *testing.T) {
+		receiver := NewSMTPReceiver("inbound.example.com",
+			func(address string) error { return ErrRecipientNotFound },
+			func(message *InboundMessage) error { return nil },
+		)
+
+		addr := startSMTPReceiver(t, receiver)
+		err := smtp.SendMail(addr, nil, "alice@example.com", []string{"unknown@inbound.example.com"}, []byte("Subject: Hello\r\n\r\nHi\r\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "550")
+	})
+
+	t.Run("handler errors are temporary failures", func(t *testing.T) {
+		receiver := NewSMTPReceiver("inbound.example.com",
+			func(address string) error { return nil },
+			func(message *InboundMessage) error { return fmt.Errorf("database is down") },
+		)
+
+		addr := startSMTPReceiver(t, receiver)
+		err := smtp.SendMail(addr, nil, "alice@example.com", []string{"known@inbound.example.com"}, []byte("Subject: Hello\r\n\r\nHi\r\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "451")
+	})
+
+	t.Run("messages larger than the maximum size are rejected", func(t *testing.T) {
+		handled := false
+		receiver := NewSMTPReceiver("inbound.example.com",
+			func(address string) error { return nil },
+			func(message *InboundMessage) error {
+				handled = true
+				return nil
+			},
+		)
+
+		receiver.MaxMessageSize = 64
+		addr := startSMTPReceiver(t, receiver)
+
+		client, err := smtp.Dial(addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.Hello("localhost"))
+		require.NoError(t, client.Mail("alice@example.com"))
+		require.NoError(t, client.Rcpt("known@inbound.example.com"))
+
+		w, err := client.Data()
+		require.NoError(t, err)
+		_, err = w.Write([]byte("Subject: Hello\r\n\r\n" + strings.Repeat("a", 200) + "\r\n"))
+		require.NoError(t, err)
+
+		err = w.Close()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "552")
+		assert.False(t, handled)
+
+		// the session is still usable after the rejected message
+		require.NoError(t, client.Noop())
+	})
+
+	t.Run("connections over the limits are rejected", func(t *testing.T) {
+		receiver := NewSMTPReceiver("inbound.example.com", nil, nil)
+		receiver.MaxConnectionsPerIP = 1
+		addr := startSMTPReceiver(t, receiver)
+
+		first, err := smtp.Dial(addr)
+		require.NoError(t, err)
+
+		_, err = smtp.Dial(addr)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "421")
+
+		// the connection is released when the first client is done
+		require.NoError(t, first.Quit())
+		require.Eventually(t, func() bool {
+			client, err := smtp.Dial(addr)
+			if err != nil {
+				return false
+			}
+
+			_ = client.Quit()
+			return true
+		}, time.Second, 10*time.Millisecond)
+
+		receiver = NewSMTPReceiver("inbound.example.com", nil, nil)
+		receiver.MaxConnections = 1
+		addr = startSMTPReceiver(t, receiver)
+
+		first, err = smtp.Dial(addr)
+		require.NoError(t, err)
+		defer first.Close()
+
+		_, err = smtp.Dial(addr)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "421")
+	})
+
+	t.Run("commands out of order are rejected", func(t *testing.T) {
+		receiver := NewSMTPReceiver("inbound.example.com", nil, nil)
+		addr := startSMTPReceiver(t, receiver)
+
+		client, err := smtp.Dial(addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.Hello("localhost"))
+		err = client.Rcpt("known@inbound.example.com")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "503")
+	})
+}
+
+func Test__ParsePathArgument(t *testing.T) {
+	address, params, err := parsePathArgument("FROM:<alice@example.com> SIZE=100", "FROM:")
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", address)
+	assert.Equal(t, []string{"SIZE=100"}, params)
+
+	address, _, err = parsePathArgument("from: <>", "FROM:")
+	require.NoError(t, err)
+	assert.Empty(t, address)
+
+	_, _, err = parsePathArgument("TO:alice@example.com", "TO:")
+	require.Error(t, err)
+
+	_, _, err = parsePathArgument("FROM:<alice@example.com>", "TO:")
+	require.Error(t, err)
+}
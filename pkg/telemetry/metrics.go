@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/metric"
 
@@ -22,6 +23,7 @@ var (
 
 	executorWorkerTickHistogram       metric.Float64Histogram
 	executorWorkerNodesCountHistogram metric.Int64Histogram
+	executorStuckExecutions           metric.Int64Histogram
 
 	eventWorkerTickHistogram        metric.Float64Histogram
 	eventWorkerEventsCountHistogram metric.Int64Histogram
@@ -34,6 +36,8 @@ var (
 
 	dbLocksCountHistogram       metric.Int64Histogram
 	dbLongQueriesCountHistogram metric.Int64Histogram
+
+	quotaExceededCounter metric.Int64Counter
 )
 
 func InitMetrics(ctx context.Context) error {
@@ -168,6 +172,24 @@ func InitMetrics(ctx context.Context) error {
 		return err
 	}
 
+	executorStuckExecutions, err = meter.Int64Histogram(
+		"executions.stuck.count",
+		metric.WithDescription("Number of workflow node executions waiting longer than their maximum waiting time"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
+	quotaExceededCounter, err = meter.Int64Counter(
+		"quotas.exceeded.count",
+		metric.WithDescription("Number of operations rejected because an organization quota was exceeded"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
 	StartPeriodicMetricsReporter()
 
 	metricsReady.Store(true)
@@ -276,6 +298,14 @@ func RecordStuckQueueItemsCount(ctx context.Context, count int) {
 	queueWorkerStuckItems.Record(ctx, int64(count))
 }
 
+func RecordStuckExecutionsCount(ctx context.Context, count int64) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	executorStuckExecutions.Record(ctx, count)
+}
+
 func RecordDBLongQueriesCount(ctx context.Context, count int64) {
 	if !metricsReady.Load() {
 		return
@@ -283,3 +313,11 @@ func RecordDBLongQueriesCount(ctx context.Context, count int64) {
 
 	dbLongQueriesCountHistogram.Record(ctx, count)
 }
+
+func RecordQuotaExceeded(ctx context.Context, quota string) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	quotaExceededCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("quota", quota)))
+}
@@ -0,0 +1,325 @@
+package email
+
+import (
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	MaxEmailSize = 10 * 1024 * 1024
+	PayloadType  = "email.received"
+
+	//
+	// Headers set by the inbound email receiver when delivering messages.
+	// The signature is the HMAC-SHA256 of the raw message,
+	// using the webhook secret of the node as the key.
+	//
+	SignatureHeader    = "X-Signature-256"
+	EnvelopeFromHeader = "X-Envelope-From"
+	EnvelopeToHeader   = "X-Envelope-To"
+
+	InboundDomainEnvVar = "INBOUND_EMAIL_DOMAIN"
+)
+
+func init() {
+	registry.RegisterTrigger("email", &Email{})
+}
+
+type Email struct{}
+
+type Metadata struct {
+	Address string `json:"address" mapstructure:"address"`
+}
+
+type Configuration struct {
+	SenderFilter []string `json:"senderFilter" mapstructure:"senderFilter"`
+}
+
+type Envelope struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type Event struct {
+	Message
+	Envelope Envelope `json:"envelope"`
+}
+
+func (e *Email) Name() string {
+	return "email"
+}
+
+func (e *Email) Label() string {
+	return "Email"
+}
+
+func (e *Email) Description() string {
+	return "Start a new execution chain when an email is received"
+}
+
+func (e *Email) Documentation() string {
+	return `The Email trigger starts a new workflow execution when an email is sent to the unique address generated for the node.
+
+## Use Cases
+
+- **Alert ingestion**: Receive alerts from tools that can only send emails
+- **Support workflows**: Start workflows from emails sent to a shared inbox, by forwarding them
+- **Approvals by email**: React to replies from people outside SuperPlane
+
+## How It Works
+
+1. When you add an Email trigger to a workflow, SuperPlane generates a unique email address for it
+2. Emails sent to this address are received by the SuperPlane SMTP receiver
+3. Each email starts a new workflow execution with the parsed message
+
+## Configuration
+
+- **Sender Filter**: Only start executions for emails from these senders. Use full addresses (` + "`alerts@example.com`" + `) or domains (` + "`example.com`" + `). If empty, emails from any sender start executions.
+
+The sender is taken from the ` + "`From`" + ` header of the message, which is not authenticated and can be set to anything by whoever sends the email.
+The filter is meant to ignore unwanted emails, such as notifications from other tools, not to restrict who can start executions.
+Anyone who knows the node address can start executions, so treat it as a secret.
+
+## Event Data
+
+Each event includes:
+- **subject**: The message subject
+- **from**, **to**, **cc**, **replyTo**: Addresses, with name and address
+- **date**: When the message was sent
+- **messageId**: The Message-ID header
+- **text** and **html**: The message bodies, truncated to 64KB
+- **attachments**: Filename, content type and size of each attachment. The content of the attachments is not included.
+- **envelope**: The SMTP envelope sender and recipient
+
+## Limits
+
+- Maximum message size: 10MB`
+}
+
+func (e *Email) Icon() string {
+	return "mail"
+}
+
+func (e *Email) Color() string {
+	return "black"
+}
+
+func (e *Email) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "senderFilter",
+			Label:       "Sender Filter",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only start executions for emails whose From header matches these addresses or domains",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Sender",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *Email) Setup(ctx core.TriggerContext) error {
+	config := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	err = validateSenderFilter(config.SenderFilter)
+	if err != nil {
+		return err
+	}
+
+	var metadata Metadata
+	err = mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	if metadata.Address != "" {
+		return nil
+	}
+
+	domain, err := inboundDomain(ctx.Webhook.GetBaseURL())
+	if err != nil {
+		return err
+	}
+
+	webhookURL, err := ctx.Webhook.Setup()
+	if err != nil {
+		return fmt.Errorf("failed to setup webhook: %w", err)
+	}
+
+	metadata.Address = fmt.Sprintf("%s@%s", path.Base(webhookURL), domain)
+	err = ctx.Metadata.Set(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to set metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (e *Email) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (e *Email) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+func (e *Email) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	if len(ctx.Body) > MaxEmailSize {
+		return http.StatusRequestEntityTooLarge, fmt.Errorf("message too large")
+	}
+
+	config := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	secret, err := ctx.Webhook.GetSecret()
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("error authenticating request")
+	}
+
+	//
+	// Messages are only accepted through the inbound email receiver,
+	// which signs them with the webhook secret.
+	//
+	signature := strings.TrimPrefix(ctx.Headers.Get(SignatureHeader), "sha256=")
+	if signature == "" {
+		return http.StatusForbidden, fmt.Errorf("missing signature header")
+	}
+
+	if err := crypto.VerifySignature(secret, ctx.Body, signature); err != nil {
+		return http.StatusForbidden, fmt.Errorf("invalid signature")
+	}
+
+	message, err := ParseMessage(ctx.Body)
+	if err != nil {
+		return http.StatusBadRequest, fmt.Errorf("error parsing message: %v", err)
+	}
+
+	sender := ""
+	if message.From != nil {
+		sender = message.From.Address
+	}
+
+	if !senderMatchesFilter(config.SenderFilter, sender) {
+		ctx.Logger.Infof("Ignoring email from %q - sender does not match the filter", sender)
+		return http.StatusOK, nil
+	}
+
+	event := Event{
+		Message: *message,
+		Envelope: Envelope{
+			From: ctx.Headers.Get(EnvelopeFromHeader),
+			To:   ctx.Headers.Get(EnvelopeToHeader),
+		},
+	}
+
+	err = ctx.Events.Emit(PayloadType, event)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
+	}
+
+	return http.StatusOK, nil
+}
+
+func (e *Email) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
+
+// inboundDomain returns the domain used for the node addresses.
+// If not configured, the host of the webhook base URL is used.
+func inboundDomain(baseURL string) (string, error) {
+	domain := strings.TrimSpace(os.Getenv(InboundDomainEnvVar))
+	if domain != "" {
+		return strings.ToLower(domain), nil
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Hostname() == "" {
+		return "", fmt.Errorf("inbound email is not configured: %s is not set", InboundDomainEnvVar)
+	}
+
+	return strings.ToLower(u.Hostname()), nil
+}
+
+func validateSenderFilter(senders []string) error {
+	for _, sender := range senders {
+		sender = strings.TrimSpace(sender)
+		if sender == "" {
+			return fmt.Errorf("sender filter entries cannot be empty")
+		}
+
+		if strings.Contains(strings.TrimPrefix(sender, "@"), "@") {
+			_, err := mail.ParseAddress(sender)
+			if err != nil {
+				return fmt.Errorf("invalid sender address %s", sender)
+			}
+
+			continue
+		}
+
+		domain := strings.TrimPrefix(sender, "@")
+		if domain == "" || strings.ContainsAny(domain, " <>") {
+			return fmt.Errorf("invalid sender domain %s", sender)
+		}
+	}
+
+	return nil
+}
+
+// senderMatchesFilter checks the sender against the filter.
+// Entries are either full addresses or domains,
+// with or without a leading @. An empty filter matches everything.
+// The sender comes from the unauthenticated From header,
+// so this is not a security boundary.
+func senderMatchesFilter(filter []string, sender string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+
+	sender = strings.ToLower(strings.TrimSpace(sender))
+	if sender == "" {
+		return false
+	}
+
+	_, senderDomain, _ := strings.Cut(sender, "@")
+	for _, entry := range filter {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if strings.Contains(strings.TrimPrefix(entry, "@"), "@") {
+			if entry == sender {
+				return true
+			}
+
+			continue
+		}
+
+		if strings.TrimPrefix(entry, "@") == senderDomain {
+			return true
+		}
+	}
+
+	return false
+}
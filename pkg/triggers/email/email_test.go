@@ -0,0 +1,225 @@
+package email
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+const multipartMessage = "From: \"Monitoring\" <Alerts@Example.com>\r\n" +
+	"To: node@inbound.example.com\r\n" +
+	"Cc: =?UTF-8?Q?Jos=C3=A9?= <jose@example.com>\r\n" +
+	"Subject: =?UTF-8?B?RGlzayB1c2FnZSDinIU=?=\r\n" +
+	"Date: Mon, 19 Jan 2026 12:00:00 +0100\r\n" +
+	"Message-ID: <abc123@mail.example.com>\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/mixed; boundary=\"outer\"\r\n" +
+	"\r\n" +
+	"--outer\r\n" +
+	"Content-Type: multipart/alternative; boundary=\"inner\"\r\n" +
+	"\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"Disk usage is at 92=25.\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/html; charset=iso-8859-1\r\n" +
+	"\r\n" +
+	"<p>Caf\xe9</p>\r\n" +
+	"--inner--\r\n" +
+	"--outer\r\n" +
+	"Content-Type: image/png\r\n" +
+	"Content-Disposition: attachment; filename=\"chart.png\"\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"\r\n" +
+	"iVBORw0KGgo=\r\n" +
+	"--outer\r\n" +
+	"Content-Type: text/plain; name=\"notes.txt\"\r\n" +
+	"\r\n" +
+	"some notes\r\n" +
+	"--outer--\r\n"
+
+func Test__ParseMessage(t *testing.T) {
+	t.Run("multipart message", func(t *testing.T) {
+		message, err := ParseMessage([]byte(multipartMessage))
+		require.NoError(t, err)
+
+		assert.Equal(t, "abc123@mail.example.com", message.MessageID)
+		assert.Equal(t, "Disk usage ✅", message.Subject)
+		assert.Equal(t, &Address{Name: "Monitoring", Address: "alerts@example.com"}, message.From)
+		assert.Equal(t, []Address{{Address: "node@inbound.example.com"}}, message.To)
+		assert.Equal(t, []Address{{Name: "José", Address: "jose@example.com"}}, message.Cc)
+		assert.Empty(t, message.ReplyTo)
+		assert.Equal(t, "2026-01-19T11:00:00Z", message.Date)
+		assert.Equal(t, "Disk usage is at 92%.", strings.TrimSpace(message.Text))
+		assert.Equal(t, "<p>Café</p>", strings.TrimSpace(message.HTML))
+		assert.Equal(t, []Attachment{
+			{Filename: "chart.png", ContentType: "image/png", Size: 8},
+			{Filename: "notes.txt", ContentType: "text/plain", Size: 10},
+		}, message.Attachments)
+	})
+
+	t.Run("plain text message", func(t *testing.T) {
+		message, err := ParseMessage([]byte("From: alice@example.com\nSubject: Hi\n\nHello there\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "Hi", message.Subject)
+		assert.Equal(t, "Hello there\n", message.Text)
+		assert.Empty(t, message.HTML)
+		assert.Empty(t, message.Attachments)
+	})
+
+	t.Run("long bodies are truncated", func(t *testing.T) {
+		message, err := ParseMessage([]byte("Subject: Hi\n\n" + strings.Repeat("a", MaxBodySize+100)))
+		require.NoError(t, err)
+		assert.Len(t, message.Text, MaxBodySize)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		_, err := ParseMessage([]byte("not an email"))
+		require.Error(t, err)
+	})
+}
+
+func Test__Email__Setup(t *testing.T) {
+	t.Run("generates address from webhook", func(t *testing.T) {
+		t.Setenv(InboundDomainEnvVar, "Inbound.Example.com")
+
+		metadata := &contexts.MetadataContext{}
+		err := (&Email{}).Setup(core.TriggerContext{
+			Configuration: map[string]any{},
+			Metadata:      metadata,
+			Webhook:       &contexts.NodeWebhookContext{},
+		})
+
+		require.NoError(t, err)
+		m := metadata.Get().(Metadata)
+		assert.True(t, strings.HasSuffix(m.Address, "@inbound.example.com"))
+	})
+
+	t.Run("falls back to the webhook base URL host", func(t *testing.T) {
+		t.Setenv(InboundDomainEnvVar, "")
+
+		metadata := &contexts.MetadataContext{}
+		err := (&Email{}).Setup(core.TriggerContext{
+			Configuration: map[string]any{},
+			Metadata:      metadata,
+			Webhook:       &contexts.NodeWebhookContext{},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(metadata.Get().(Metadata).Address, "@localhost"))
+	})
+
+	t.Run("keeps existing address", func(t *testing.T) {
+		metadata := &contexts.MetadataContext{Metadata: Metadata{Address: "existing@inbound.example.com"}}
+		err := (&Email{}).Setup(core.TriggerContext{
+			Configuration: map[string]any{"senderFilter": []string{"example.com"}},
+			Metadata:      metadata,
+			Webhook:       &contexts.NodeWebhookContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "existing@inbound.example.com", metadata.Get().(Metadata).Address)
+	})
+
+	t.Run("invalid sender filter", func(t *testing.T) {
+		for _, sender := range []string{"", "not an address@", "a@b@c"} {
+			err := (&Email{}).Setup(core.TriggerContext{
+				Configuration: map[string]any{"senderFilter": []string{sender}},
+				Metadata:      &contexts.MetadataContext{},
+				Webhook:       &contexts.NodeWebhookContext{},
+			})
+
+			require.Error(t, err, sender)
+		}
+	})
+}
+
+func Test__Email__HandleWebhook(t *testing.T) {
+	secret := "secret"
+	body := []byte(multipartMessage)
+
+	newContext := func(configuration map[string]any, signature string) (core.WebhookRequestContext, *contexts.EventContext) {
+		headers := http.Header{}
+		if signature != "" {
+			headers.Set(SignatureHeader, signature)
+		}
+
+		headers.Set(EnvelopeFromHeader, "bounces@example.com")
+		headers.Set(EnvelopeToHeader, "node@inbound.example.com")
+
+		events := &contexts.EventContext{}
+		return core.WebhookRequestContext{
+			Body:          body,
+			Headers:       headers,
+			Configuration: configuration,
+			Logger:        log.NewEntry(log.StandardLogger()),
+			Webhook:       &contexts.NodeWebhookContext{Secret: secret},
+			Events:        events,
+		}, events
+	}
+
+	t.Run("missing signature is rejected", func(t *testing.T) {
+		ctx, events := newContext(map[string]any{}, "")
+		code, err := (&Email{}).HandleWebhook(ctx)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, code)
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("invalid signature is rejected", func(t *testing.T) {
+		ctx, events := newContext(map[string]any{}, crypto.Sign([]byte("other"), body))
+		code, err := (&Email{}).HandleWebhook(ctx)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, code)
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("message is emitted", func(t *testing.T) {
+		ctx, events := newContext(map[string]any{}, "sha256="+crypto.Sign([]byte(secret), body))
+		code, err := (&Email{}).HandleWebhook(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, PayloadType, events.Payloads[0].Type)
+
+		event := events.Payloads[0].Data.(Event)
+		assert.Equal(t, "Disk usage ✅", event.Subject)
+		assert.Equal(t, "bounces@example.com", event.Envelope.From)
+		assert.Equal(t, "node@inbound.example.com", event.Envelope.To)
+	})
+
+	t.Run("sender not matching the filter is ignored", func(t *testing.T) {
+		configuration := map[string]any{"senderFilter": []string{"ops@example.com", "other.com"}}
+		ctx, events := newContext(configuration, crypto.Sign([]byte(secret), body))
+		code, err := (&Email{}).HandleWebhook(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("sender matching the filter by domain", func(t *testing.T) {
+		configuration := map[string]any{"senderFilter": []string{"@EXAMPLE.com"}}
+		ctx, events := newContext(configuration, crypto.Sign([]byte(secret), body))
+		_, err := (&Email{}).HandleWebhook(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, events.Count())
+	})
+}
+
+func Test__SenderMatchesFilter(t *testing.T) {
+	assert.True(t, senderMatchesFilter(nil, "alice@example.com"))
+	assert.True(t, senderMatchesFilter([]string{"alice@example.com"}, "Alice@Example.com"))
+	assert.True(t, senderMatchesFilter([]string{"example.com"}, "alice@example.com"))
+	assert.False(t, senderMatchesFilter([]string{"example.com"}, "alice@sub.example.com"))
+	assert.False(t, senderMatchesFilter([]string{"bob@example.com"}, "alice@example.com"))
+	assert.False(t, senderMatchesFilter([]string{"example.com"}, ""))
+}
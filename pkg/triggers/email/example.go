@@ -0,0 +1,18 @@
+package email
+
+import (
+	_ "embed"
+	"sync"
+
+	"github.com/superplanehq/superplane/pkg/utils"
+)
+
+//go:embed example_data.json
+var exampleDataBytes []byte
+
+var exampleDataOnce sync.Once
+var exampleData map[string]any
+
+func (e *Email) ExampleData() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleDataOnce, exampleDataBytes, &exampleData)
+}
@@ -0,0 +1,265 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+const (
+	//
+	// Text and HTML bodies are truncated to this size,
+	// to keep the event payloads small.
+	//
+	MaxBodySize = 64 * 1024
+
+	//
+	// Limits how deep we go into nested multipart messages.
+	//
+	maxMultipartDepth = 5
+)
+
+type Address struct {
+	Name    string `json:"name,omitempty"`
+	Address string `json:"address"`
+}
+
+type Attachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	Size        int    `json:"size"`
+}
+
+type Message struct {
+	MessageID   string       `json:"messageId,omitempty"`
+	Subject     string       `json:"subject"`
+	From        *Address     `json:"from,omitempty"`
+	To          []Address    `json:"to"`
+	Cc          []Address    `json:"cc"`
+	ReplyTo     []Address    `json:"replyTo"`
+	Date        string       `json:"date,omitempty"`
+	Text        string       `json:"text"`
+	HTML        string       `json:"html"`
+	Attachments []Attachment `json:"attachments"`
+}
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: charsetReader}
+
+// ParseMessage parses a raw RFC 5322 message, extracting the headers,
+// the text and HTML bodies, and metadata about the attachments.
+// The content of the attachments is not kept.
+func ParseMessage(data []byte) (*Message, error) {
+	raw, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error reading message: %w", err)
+	}
+
+	message := &Message{
+		MessageID:   strings.Trim(raw.Header.Get("Message-Id"), "<> "),
+		Subject:     decodeHeader(raw.Header.Get("Subject")),
+		To:          parseAddressList(raw.Header, "To"),
+		Cc:          parseAddressList(raw.Header, "Cc"),
+		ReplyTo:     parseAddressList(raw.Header, "Reply-To"),
+		Attachments: []Attachment{},
+	}
+
+	from := parseAddressList(raw.Header, "From")
+	if len(from) > 0 {
+		message.From = &from[0]
+	}
+
+	date, err := raw.Header.Date()
+	if err == nil {
+		message.Date = date.UTC().Format(time.RFC3339)
+	}
+
+	err = message.readPart(
+		raw.Header.Get("Content-Type"),
+		raw.Header.Get("Content-Disposition"),
+		raw.Header.Get("Content-Transfer-Encoding"),
+		raw.Body,
+		0,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	message.Text = truncate(message.Text)
+	message.HTML = truncate(message.HTML)
+	return message, nil
+}
+
+func (m *Message) readPart(contentType, disposition, encoding string, body io.Reader, depth int) error {
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "application/octet-stream"
+		params = map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxMultipartDepth {
+			return nil
+		}
+
+		return m.readMultipart(body, params["boundary"], depth)
+	}
+
+	content, err := io.ReadAll(decodeTransferEncoding(encoding, body))
+	if err != nil {
+		return fmt.Errorf("error reading %s part: %w", mediaType, err)
+	}
+
+	dispositionType, dispositionParams, _ := mime.ParseMediaType(disposition)
+	filename := decodeHeader(dispositionParams["filename"])
+	if filename == "" {
+		filename = decodeHeader(params["name"])
+	}
+
+	isBody := (mediaType == "text/plain" || mediaType == "text/html") &&
+		dispositionType != "attachment" &&
+		filename == ""
+
+	if !isBody {
+		m.Attachments = append(m.Attachments, Attachment{
+			Filename:    filename,
+			ContentType: mediaType,
+			Size:        len(content),
+		})
+
+		return nil
+	}
+
+	text := decodeCharset(params["charset"], content)
+	if mediaType == "text/html" {
+		if m.HTML == "" {
+			m.HTML = text
+		}
+
+		return nil
+	}
+
+	if m.Text == "" {
+		m.Text = text
+	}
+
+	return nil
+}
+
+func (m *Message) readMultipart(body io.Reader, boundary string, depth int) error {
+	if boundary == "" {
+		return fmt.Errorf("multipart message without boundary")
+	}
+
+	reader := multipart.NewReader(body, boundary)
+	for {
+		part, err := reader.NextRawPart()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading multipart message: %w", err)
+		}
+
+		err = m.readPart(
+			part.Header.Get("Content-Type"),
+			part.Header.Get("Content-Disposition"),
+			part.Header.Get("Content-Transfer-Encoding"),
+			part,
+			depth+1,
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func decodeTransferEncoding(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func decodeCharset(charset string, content []byte) string {
+	charset = strings.ToLower(strings.TrimSpace(charset))
+	if charset == "" || charset == "utf-8" || charset == "us-ascii" {
+		return string(content)
+	}
+
+	reader, err := charsetReader(charset, bytes.NewReader(content))
+	if err != nil {
+		return string(content)
+	}
+
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return string(content)
+	}
+
+	return string(decoded)
+}
+
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	encoding, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported charset %s", charset)
+	}
+
+	return encoding.NewDecoder().Reader(input), nil
+}
+
+func decodeHeader(value string) string {
+	decoded, err := wordDecoder.DecodeHeader(value)
+	if err != nil {
+		return value
+	}
+
+	return decoded
+}
+
+func parseAddressList(header mail.Header, key string) []Address {
+	addresses := []Address{}
+	if header.Get(key) == "" {
+		return addresses
+	}
+
+	parser := mail.AddressParser{WordDecoder: wordDecoder}
+	list, err := parser.ParseList(header.Get(key))
+	if err != nil {
+		return addresses
+	}
+
+	for _, a := range list {
+		addresses = append(addresses, Address{Name: a.Name, Address: strings.ToLower(a.Address)})
+	}
+
+	return addresses
+}
+
+func truncate(s string) string {
+	if len(s) <= MaxBodySize {
+		return s
+	}
+
+	return strings.ToValidUTF8(s[:MaxBodySize], "")
+}
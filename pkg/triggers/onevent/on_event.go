@@ -0,0 +1,279 @@
+package onevent
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	TriggerName       = "onEvent"
+	PayloadType       = "event.received"
+	ReceiveActionName = "receive"
+)
+
+var topicRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._/-]{0,127}$`)
+
+func init() {
+	registry.RegisterTrigger(TriggerName, &OnEvent{})
+}
+
+type OnEvent struct{}
+
+type Configuration struct {
+	Topic  string `json:"topic" mapstructure:"topic"`
+	Filter string `json:"filter" mapstructure:"filter"`
+}
+
+type Metadata struct {
+	Topic string `json:"topic" mapstructure:"topic"`
+}
+
+/*
+ * Event is what the event bus delivers to the subscribers,
+ * through the receive action, and what the trigger emits.
+ */
+type Event struct {
+	Topic       string         `json:"topic" mapstructure:"topic"`
+	Type        string         `json:"type" mapstructure:"type"`
+	Data        any            `json:"data" mapstructure:"data"`
+	Source      map[string]any `json:"source" mapstructure:"source"`
+	PublishedAt string         `json:"publishedAt" mapstructure:"publishedAt"`
+}
+
+func (t *OnEvent) Name() string {
+	return TriggerName
+}
+
+func (t *OnEvent) Label() string {
+	return "On Event"
+}
+
+func (t *OnEvent) Description() string {
+	return "Start a new execution chain when an event is published to a topic"
+}
+
+func (t *OnEvent) Documentation() string {
+	return `The On Event trigger starts a new workflow execution when an event is published to an organization-level topic by the Publish Event component.
+
+## Use Cases
+
+- **Release fan-out**: A release canvas publishes a ` + "`release.created`" + ` event, and each service deploy canvas reacts to it
+- **Cross-team workflows**: Let canvases owned by different teams react to each other without webhooks
+- **Decoupled pipelines**: Split large canvases into smaller ones connected by topics
+
+## Configuration
+
+- **Topic**: The topic to subscribe to, e.g. ` + "`releases`" + `
+- **Filter**: Optional boolean expression, evaluated against the event. Only events for which it returns true start executions.
+
+## Filter Expressions
+
+The expression has access to the event as ` + "`$`" + `:
+- ` + "`$.type == \"release.created\"`" + `
+- ` + "`$.data.service == \"api\" && $.data.environment == \"production\"`" + `
+
+## Event Data
+
+Each event includes:
+- **topic**: The topic the event was published to
+- **type**: The event type set by the publisher
+- **data**: The event data
+- **source**: The canvas, node and execution that published the event
+- **publishedAt**: When the event was published
+
+## Notes
+
+- Events are delivered to every subscribed canvas in the organization, including the one publishing them.
+  Avoid publishing to a topic the same canvas subscribes to, unless the filter prevents loops.`
+}
+
+func (t *OnEvent) Icon() string {
+	return "radio-tower"
+}
+
+func (t *OnEvent) Color() string {
+	return "purple"
+}
+
+func (t *OnEvent) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "topic",
+			Label:       "Topic",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Topic to subscribe to",
+			Placeholder: "releases",
+		},
+		{
+			Name:        "filter",
+			Label:       "Filter",
+			Type:        configuration.FieldTypeExpression,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only start executions for events matching this expression",
+			Placeholder: `$.type == "release.created"`,
+		},
+	}
+}
+
+func (t *OnEvent) Setup(ctx core.TriggerContext) error {
+	config, err := decodeConfiguration(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateTopic(config.Topic)
+	if err != nil {
+		return err
+	}
+
+	if config.Filter != "" {
+		_, err = compileFilter(config.Filter)
+		if err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
+	return ctx.Metadata.Set(Metadata{Topic: config.Topic})
+}
+
+func (t *OnEvent) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        ReceiveActionName,
+			Description: "Receive an event published to the topic",
+		},
+	}
+}
+
+func (t *OnEvent) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	switch ctx.Name {
+	case ReceiveActionName:
+		return nil, t.receive(ctx)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+func (t *OnEvent) receive(ctx core.TriggerActionContext) error {
+	config, err := decodeConfiguration(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	event := Event{}
+	err = mapstructure.Decode(ctx.Parameters, &event)
+	if err != nil {
+		return fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	//
+	// The subscription may have changed since the event was published.
+	//
+	if event.Topic != config.Topic {
+		ctx.Logger.Infof("Ignoring event for topic %s - subscribed to %s", event.Topic, config.Topic)
+		return nil
+	}
+
+	//
+	// Filter errors will not go away by retrying,
+	// so we log them and drop the event.
+	//
+	matches, err := matchesFilter(config.Filter, event)
+	if err != nil {
+		ctx.Logger.Warnf("Error evaluating filter for event on topic %s: %v", event.Topic, err)
+		return nil
+	}
+
+	if !matches {
+		return nil
+	}
+
+	return ctx.Events.Emit(PayloadType, event)
+}
+
+func (t *OnEvent) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (t *OnEvent) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
+
+func ValidateTopic(topic string) error {
+	if topic == "" {
+		return fmt.Errorf("topic is required")
+	}
+
+	if !topicRegex.MatchString(topic) {
+		return fmt.Errorf("invalid topic %q: only letters, numbers, '.', '_', '-' and '/' are allowed, up to 128 characters", topic)
+	}
+
+	return nil
+}
+
+func decodeConfiguration(c any) (*Configuration, error) {
+	config := Configuration{}
+	err := mapstructure.Decode(c, &config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	config.Topic = strings.TrimSpace(config.Topic)
+	config.Filter = strings.TrimSpace(config.Filter)
+	return &config, nil
+}
+
+func filterEnv(event Event) map[string]any {
+	return map[string]any{
+		"$": map[string]any{
+			"topic":       event.Topic,
+			"type":        event.Type,
+			"data":        event.Data,
+			"source":      event.Source,
+			"publishedAt": event.PublishedAt,
+		},
+	}
+}
+
+func compileFilter(filter string) (*vm.Program, error) {
+	return expr.Compile(filter,
+		expr.Env(filterEnv(Event{})),
+		expr.AsBool(),
+		expr.Timezone(time.UTC.String()),
+	)
+}
+
+func matchesFilter(filter string, event Event) (bool, error) {
+	if filter == "" {
+		return true, nil
+	}
+
+	program, err := compileFilter(filter)
+	if err != nil {
+		return false, err
+	}
+
+	output, err := expr.Run(program, filterEnv(event))
+	if err != nil {
+		return false, err
+	}
+
+	matches, ok := output.(bool)
+	if !ok {
+		return false, fmt.Errorf("filter must evaluate to boolean, got %T", output)
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,123 @@
+package onevent
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__OnEvent__Setup(t *testing.T) {
+	trigger := &OnEvent{}
+
+	t.Run("valid configuration", func(t *testing.T) {
+		metadata := &contexts.MetadataContext{}
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"topic": "releases", "filter": `$.type == "release.created"`},
+			Metadata:      metadata,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, Metadata{Topic: "releases"}, metadata.Get())
+	})
+
+	t.Run("missing topic", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{},
+			Metadata:      &contexts.MetadataContext{},
+		})
+
+		require.ErrorContains(t, err, "topic is required")
+	})
+
+	t.Run("invalid topic", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"topic": "releases and more"},
+			Metadata:      &contexts.MetadataContext{},
+		})
+
+		require.ErrorContains(t, err, "invalid topic")
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"topic": "releases", "filter": "$.type =="},
+			Metadata:      &contexts.MetadataContext{},
+		})
+
+		require.ErrorContains(t, err, "invalid filter")
+	})
+}
+
+func Test__OnEvent__Receive(t *testing.T) {
+	trigger := &OnEvent{}
+	logger := log.NewEntry(log.StandardLogger())
+	parameters := map[string]any{
+		"topic": "releases",
+		"type":  "release.created",
+		"data": map[string]any{
+			"service": "api",
+		},
+		"source": map[string]any{
+			"canvasId": "canvas-1",
+			"nodeId":   "publish-1",
+		},
+		"publishedAt": "2026-01-19T12:00:00Z",
+	}
+
+	receive := func(configuration map[string]any) (*contexts.EventContext, error) {
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          ReceiveActionName,
+			Parameters:    parameters,
+			Configuration: configuration,
+			Logger:        logger,
+			Events:        events,
+		})
+
+		return events, err
+	}
+
+	t.Run("emits event without filter", func(t *testing.T) {
+		events, err := receive(map[string]any{"topic": "releases"})
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, PayloadType, events.Payloads[0].Type)
+
+		event := events.Payloads[0].Data.(Event)
+		assert.Equal(t, "release.created", event.Type)
+		assert.Equal(t, map[string]any{"service": "api"}, event.Data)
+		assert.Equal(t, "canvas-1", event.Source["canvasId"])
+	})
+
+	t.Run("emits event matching filter", func(t *testing.T) {
+		events, err := receive(map[string]any{"topic": "releases", "filter": `$.data.service == "api"`})
+		require.NoError(t, err)
+		assert.Equal(t, 1, events.Count())
+	})
+
+	t.Run("ignores event not matching filter", func(t *testing.T) {
+		events, err := receive(map[string]any{"topic": "releases", "filter": `$.data.service == "worker"`})
+		require.NoError(t, err)
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("ignores event for another topic", func(t *testing.T) {
+		events, err := receive(map[string]any{"topic": "deployments"})
+		require.NoError(t, err)
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("filter errors drop the event", func(t *testing.T) {
+		events, err := receive(map[string]any{"topic": "releases", "filter": `$.data.service.name == "api"`})
+		require.NoError(t, err)
+		assert.Zero(t, events.Count())
+
+		events, err = receive(map[string]any{"topic": "releases", "filter": `$.type`})
+		require.NoError(t, err)
+		assert.Zero(t, events.Count())
+	})
+}
@@ -0,0 +1,288 @@
+package schedule
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	MaxJitterSeconds    = 3600
+	DefaultPreviewCount = 10
+	MaxPreviewCount     = 50
+	MaxCatchUpRuns      = 100
+
+	//
+	// Fire times handled later than this (plus the configured jitter)
+	// are considered missed, and go through the catch-up policy.
+	//
+	CatchUpGracePeriod = 2 * time.Minute
+
+	//
+	// Upper bound on how many consecutive fire times we walk through
+	// when skipping excluded dates.
+	//
+	maxFireTimeIterations = 10000
+
+	//
+	// Upper bound on how many fire times we walk through when collecting
+	// missed fire times, about two years of a schedule firing every minute.
+	//
+	maxCatchUpIterations = 1000000
+
+	dateFormat = "2006-01-02"
+)
+
+type firedAt struct {
+	Time   time.Time
+	Missed bool
+}
+
+// fireTimesToEmit decides which events the emitEvent action should emit.
+// On-time fire times emit a single event, unless the date is excluded.
+// Late fire times go through the catch-up policy.
+func fireTimesToEmit(spec Configuration, calendars core.CalendarContext, metadata Metadata, now time.Time) ([]firedAt, error) {
+	scheduled := now
+	if metadata.NextTrigger != nil {
+		t, err := time.Parse(time.RFC3339, *metadata.NextTrigger)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing next trigger: %v", err)
+		}
+
+		scheduled = t
+	}
+
+	if now.Sub(scheduled) <= CatchUpGracePeriod+maxJitter(spec) {
+		excluded, err := isExcluded(spec, calendars, scheduled)
+		if err != nil {
+			return nil, err
+		}
+
+		if excluded {
+			return []firedAt{}, nil
+		}
+
+		return []firedAt{{Time: now}}, nil
+	}
+
+	switch catchUpPolicy(spec) {
+	case CatchUpSkip:
+		return []firedAt{}, nil
+
+	case CatchUpAll:
+		return missedFireTimes(spec, calendars, scheduled, now, metadata.ReferenceTime, MaxCatchUpRuns)
+
+	default:
+		return missedFireTimes(spec, calendars, scheduled, now, metadata.ReferenceTime, 1)
+	}
+}
+
+// missedFireTimes returns the newest limit non-excluded fire times
+// between the scheduled fire time and now, oldest first.
+//
+// Interval schedules are relative to the previous fire time,
+// so fire times are always walked forward from the scheduled one,
+// keeping only the newest ones found.
+func missedFireTimes(spec Configuration, calendars core.CalendarContext, scheduled, now time.Time, referenceTime *string, limit int) ([]firedAt, error) {
+	missed := []firedAt{}
+	t := scheduled
+
+	for range maxCatchUpIterations {
+		if t.After(now) {
+			break
+		}
+
+		excluded, err := isExcluded(spec, calendars, t)
+		if err != nil {
+			return nil, err
+		}
+
+		if !excluded {
+			missed = append(missed, firedAt{Time: t, Missed: true})
+			if len(missed) > limit {
+				missed = missed[1:]
+			}
+		}
+
+		next, err := getNextTrigger(spec, t, referenceTime)
+		if err != nil {
+			return nil, err
+		}
+
+		if !next.After(t) {
+			break
+		}
+
+		t = *next
+	}
+
+	return missed, nil
+}
+
+// nextFireTime returns the first fire time after now
+// that does not fall on an excluded date.
+func nextFireTime(spec Configuration, calendars core.CalendarContext, now time.Time, referenceTime *string) (*time.Time, error) {
+	t := now
+
+	for range maxFireTimeIterations {
+		next, err := getNextTrigger(spec, t, referenceTime)
+		if err != nil {
+			return nil, err
+		}
+
+		excluded, err := isExcluded(spec, calendars, *next)
+		if err != nil {
+			return nil, err
+		}
+
+		if !excluded {
+			return next, nil
+		}
+
+		t = *next
+	}
+
+	return nil, fmt.Errorf("no fire time found outside of the excluded dates")
+}
+
+func upcomingFireTimes(spec Configuration, calendars core.CalendarContext, now time.Time, referenceTime *string, count int) ([]time.Time, error) {
+	fireTimes := make([]time.Time, 0, count)
+	t := now
+
+	for len(fireTimes) < count {
+		next, err := nextFireTime(spec, calendars, t, referenceTime)
+		if err != nil {
+			return nil, err
+		}
+
+		fireTimes = append(fireTimes, *next)
+		t = *next
+	}
+
+	return fireTimes, nil
+}
+
+// isExcluded checks the date of t, in the schedule timezone,
+// against the trigger exclusions and the configured calendars.
+func isExcluded(spec Configuration, calendars core.CalendarContext, t time.Time) (bool, error) {
+	local := t.In(scheduleLocation(spec))
+	day := local.Format(dateFormat)
+
+	for _, exclusion := range spec.ExcludeDates {
+		end := exclusion.End
+		if end == "" {
+			end = exclusion.Start
+		}
+
+		if day >= exclusion.Start && day <= end {
+			return true, nil
+		}
+	}
+
+	if len(spec.Calendars) == 0 {
+		return false, nil
+	}
+
+	if calendars == nil {
+		return false, fmt.Errorf("calendars are not available")
+	}
+
+	for _, name := range spec.Calendars {
+		excluded, err := calendars.IsExcluded(name, local)
+		if err != nil {
+			return false, err
+		}
+
+		if excluded {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func validateExclusions(spec Configuration, calendars core.CalendarContext) error {
+	for _, exclusion := range spec.ExcludeDates {
+		start, err := time.Parse(dateFormat, exclusion.Start)
+		if err != nil {
+			return fmt.Errorf("invalid excluded date %q: expected format YYYY-MM-DD", exclusion.Start)
+		}
+
+		if exclusion.End == "" {
+			continue
+		}
+
+		end, err := time.Parse(dateFormat, exclusion.End)
+		if err != nil {
+			return fmt.Errorf("invalid excluded date %q: expected format YYYY-MM-DD", exclusion.End)
+		}
+
+		if end.Before(start) {
+			return fmt.Errorf("excluded date range %s - %s ends before it starts", exclusion.Start, exclusion.End)
+		}
+	}
+
+	if spec.Jitter != nil && (*spec.Jitter < 0 || *spec.Jitter > MaxJitterSeconds) {
+		return fmt.Errorf("jitter must be between 0 and %d seconds, got: %d", MaxJitterSeconds, *spec.Jitter)
+	}
+
+	switch spec.CatchUp {
+	case "", CatchUpSkip, CatchUpOnce, CatchUpAll:
+	default:
+		return fmt.Errorf("unsupported catch-up policy: %s", spec.CatchUp)
+	}
+
+	//
+	// Make sure the calendars exist, so problems show up
+	// when the canvas is saved, and not when the schedule fires.
+	//
+	if len(spec.Calendars) > 0 {
+		if calendars == nil {
+			return fmt.Errorf("calendars are not available")
+		}
+
+		for _, name := range spec.Calendars {
+			_, err := calendars.IsExcluded(name, time.Now())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func catchUpPolicy(spec Configuration) string {
+	if spec.CatchUp == "" {
+		return CatchUpOnce
+	}
+
+	return spec.CatchUp
+}
+
+func maxJitter(spec Configuration) time.Duration {
+	if spec.Jitter == nil || *spec.Jitter <= 0 {
+		return 0
+	}
+
+	return time.Duration(*spec.Jitter) * time.Second
+}
+
+// delayUntil returns how long to wait before firing,
+// including a random jitter, if one is configured.
+func delayUntil(spec Configuration, fireTime time.Time) time.Duration {
+	delay := time.Until(fireTime)
+
+	jitter := maxJitter(spec)
+	if jitter > 0 {
+		delay += rand.N(jitter + time.Second)
+	}
+
+	if delay < time.Second {
+		return time.Second
+	}
+
+	return delay
+}
@@ -33,6 +33,10 @@ const (
 	WeekDayFriday    = "friday"
 	WeekDaySaturday  = "saturday"
 	WeekDaySunday    = "sunday"
+
+	CatchUpSkip = "skip"
+	CatchUpOnce = "once"
+	CatchUpAll  = "all"
 )
 
 type Schedule struct{}
@@ -55,6 +59,16 @@ type Configuration struct {
 	DayOfMonth      *int     `json:"dayOfMonth"`      // 1-31 for months scheduling
 	CronExpression  *string  `json:"cronExpression"`  // For cron scheduling
 	Timezone        *string  `json:"timezone"`        // Timezone offset (e.g., "0", "-5", "5.5")
+
+	Calendars    []string        `json:"calendars"`    // Names of organization calendars with excluded dates
+	ExcludeDates []DateExclusion `json:"excludeDates"` // Dates or date ranges excluded for this trigger only
+	Jitter       *int            `json:"jitter"`       // 0-3600 seconds of random delay added to each fire time
+	CatchUp      string          `json:"catchUp"`      // What to do with fire times missed while the server was down
+}
+
+type DateExclusion struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
 }
 
 func (s *Schedule) Name() string {
@@ -98,11 +112,37 @@ Supports both 5-field and 6-field cron expressions:
 - **5-field**: ` + "`minute hour day month dayofweek`" + ` (e.g., ` + "`30 14 * * MON-FRI`" + `)
 - **6-field**: ` + "`second minute hour day month dayofweek`" + ` (e.g., ` + "`0 30 14 * * MON-FRI`" + `)
 
+## Exclusions
+
+Fire times that fall on excluded dates are skipped, and the trigger moves on to the next fire time:
+- **Calendars**: names of organization calendars, shared across canvases (e.g. public holidays or release freezes)
+- **Excluded dates**: dates or date ranges that only apply to this trigger
+
+Dates are evaluated in the configured timezone.
+
+## Jitter
+
+Jitter adds a random delay of up to the configured number of seconds to each fire time. Use it to avoid many schedules hitting the same system at exactly the same moment.
+
+## Catch-up Policy
+
+If SuperPlane was not running when a fire time was due, the missed fire times are evaluated when it starts again:
+- **Skip**: missed fire times are dropped, and the next regular fire time is scheduled
+- **Run once**: a single event is emitted for the most recent missed fire time
+- **Run all missed**: one event is emitted for every missed fire time, up to the 100 most recent ones
+
+Events emitted during catch-up include ` + "`missed: true`" + ` and the original ` + "`scheduled_at`" + ` time.
+
+## Previewing Fire Times
+
+The ` + "`previewFireTimes`" + ` action returns the next fire times (10 by default), with exclusions applied and without jitter.
+
 ## Event Data
 
 Each scheduled execution includes calendar information:
 - **calendar**: Year, month, day, hour, minute, second, week_day
 - **timezone**: Timezone information (for applicable schedule types)
+- **missed** and **scheduled_at**: Only present for events emitted by the catch-up policy
 
 ## Examples
 
@@ -349,6 +389,79 @@ func (s *Schedule) Configuration() []configuration.Field {
 				Cron: &configuration.CronTypeOptions{},
 			},
 		},
+		{
+			Name:        "calendars",
+			Label:       "Exclusion calendars",
+			Type:        configuration.FieldTypeList,
+			Togglable:   true,
+			Description: "Names of organization calendars. Fire times on dates excluded by any of them are skipped.",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Calendar",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		{
+			Name:        "excludeDates",
+			Label:       "Excluded dates",
+			Type:        configuration.FieldTypeList,
+			Togglable:   true,
+			Description: "Dates or date ranges on which this trigger does not fire",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Date range",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "start",
+								Label:    "Start",
+								Type:     configuration.FieldTypeDate,
+								Required: true,
+							},
+							{
+								Name:        "end",
+								Label:       "End",
+								Type:        configuration.FieldTypeDate,
+								Description: "Leave empty to exclude a single day",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "jitter",
+			Label:       "Jitter (seconds)",
+			Type:        configuration.FieldTypeNumber,
+			Default:     intPtr(0),
+			Description: "Random delay of up to this many seconds added to each fire time (0-3600)",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: intPtr(0),
+					Max: intPtr(MaxJitterSeconds),
+				},
+			},
+		},
+		{
+			Name:        "catchUp",
+			Label:       "Missed runs",
+			Type:        configuration.FieldTypeSelect,
+			Default:     CatchUpOnce,
+			Description: "What to do with fire times missed while SuperPlane was not running",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Skip", Value: CatchUpSkip},
+						{Label: "Run once", Value: CatchUpOnce},
+						{Label: "Run all missed", Value: CatchUpAll},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -359,6 +472,11 @@ func (s *Schedule) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	err = validateExclusions(config, ctx.Calendars)
+	if err != nil {
+		return err
+	}
+
 	var metadata Metadata
 	err = mapstructure.Decode(ctx.Metadata.Get(), &metadata)
 	if err != nil {
@@ -372,7 +490,7 @@ func (s *Schedule) Setup(ctx core.TriggerContext) error {
 		metadata.ReferenceTime = &referenceTime
 	}
 
-	nextTrigger, err := getNextTrigger(config, now, metadata.ReferenceTime)
+	nextTrigger, err := nextFireTime(config, ctx.Calendars, now, metadata.ReferenceTime)
 	if err != nil {
 		return err
 	}
@@ -394,7 +512,7 @@ func (s *Schedule) Setup(ctx core.TriggerContext) error {
 	//
 	// Always schedule the next and save the next trigger in the metadata.
 	//
-	err = ctx.Requests.ScheduleActionCall("emitEvent", map[string]any{}, time.Until(*nextTrigger))
+	err = ctx.Requests.ScheduleActionCall("emitEvent", map[string]any{}, delayUntil(config, *nextTrigger))
 	if err != nil {
 		return err
 	}
@@ -412,6 +530,26 @@ func (s *Schedule) Actions() []core.Action {
 			Name:           "emitEvent",
 			UserAccessible: false,
 		},
+		{
+			Name:           "previewFireTimes",
+			Description:    "List the next fire times, with exclusions applied",
+			UserAccessible: true,
+			Parameters: []configuration.Field{
+				{
+					Name:        "count",
+					Label:       "Count",
+					Type:        configuration.FieldTypeNumber,
+					Default:     intPtr(DefaultPreviewCount),
+					Description: "Number of fire times to return (1-50)",
+					TypeOptions: &configuration.TypeOptions{
+						Number: &configuration.NumberTypeOptions{
+							Min: intPtr(1),
+							Max: intPtr(MaxPreviewCount),
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -419,6 +557,8 @@ func (s *Schedule) HandleAction(ctx core.TriggerActionContext) (map[string]any,
 	switch ctx.Name {
 	case "emitEvent":
 		return nil, s.emitEvent(ctx)
+	case "previewFireTimes":
+		return s.previewFireTimes(ctx)
 	}
 
 	return nil, fmt.Errorf("action %s not supported", ctx.Name)
@@ -431,15 +571,102 @@ func (s *Schedule) emitEvent(ctx core.TriggerActionContext) error {
 		return err
 	}
 
+	var existingMetadata Metadata
+	err = mapstructure.Decode(ctx.Metadata.Get(), &existingMetadata)
+	if err != nil {
+		return fmt.Errorf("failed to parse existing metadata: %w", err)
+	}
+
+	nowUTC := time.Now()
+
+	//
+	// If this fire time is late - usually because SuperPlane was not running -
+	// the catch-up policy decides which of the missed fire times emit events.
+	//
+	fireTimes, err := fireTimesToEmit(spec, ctx.Calendars, existingMetadata, nowUTC)
+	if err != nil {
+		return err
+	}
+
+	for _, fireTime := range fireTimes {
+		err = ctx.Events.Emit("scheduler.tick", buildPayload(spec, fireTime))
+		if err != nil {
+			return err
+		}
+	}
+
+	nextTrigger, err := nextFireTime(spec, ctx.Calendars, nowUTC, existingMetadata.ReferenceTime)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Requests.ScheduleActionCall("emitEvent", map[string]any{}, delayUntil(spec, *nextTrigger))
+	if err != nil {
+		return err
+	}
+
+	formatted := nextTrigger.Format(time.RFC3339)
+	ctx.Logger.Infof("Next trigger at: %v", formatted)
+
+	return ctx.Metadata.Set(Metadata{
+		NextTrigger:   &formatted,
+		ReferenceTime: existingMetadata.ReferenceTime,
+	})
+}
+
+func (s *Schedule) previewFireTimes(ctx core.TriggerActionContext) (map[string]any, error) {
+	spec := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata Metadata
+	err = mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	count := DefaultPreviewCount
+	if v, ok := ctx.Parameters["count"]; ok && v != nil {
+		c, err := strconv.Atoi(fmt.Sprintf("%v", v))
+		if err != nil {
+			return nil, fmt.Errorf("invalid count: %v", v)
+		}
+
+		count = c
+	}
+
+	if count < 1 || count > MaxPreviewCount {
+		return nil, fmt.Errorf("count must be between 1 and %d, got: %d", MaxPreviewCount, count)
+	}
+
+	fireTimes, err := upcomingFireTimes(spec, ctx.Calendars, time.Now(), metadata.ReferenceTime, count)
+	if err != nil {
+		return nil, err
+	}
+
+	location := scheduleLocation(spec)
+	formatted := make([]any, 0, len(fireTimes))
+	for _, fireTime := range fireTimes {
+		formatted = append(formatted, fireTime.In(location).Format(time.RFC3339))
+	}
+
+	return map[string]any{
+		"fireTimes": formatted,
+	}, nil
+}
+
+func buildPayload(spec Configuration, fireTime firedAt) map[string]any {
 	var timezone *time.Location
 	var now time.Time
 
 	// Only use timezone for schedule types that support it
-	if spec.Type == TypeDays || spec.Type == TypeWeeks || spec.Type == TypeMonths || spec.Type == TypeCron {
+	if supportsTimezone(spec) {
 		timezone = parseTimezone(spec.Timezone)
-		now = time.Now().In(timezone)
+		now = fireTime.Time.In(timezone)
 	} else {
-		now = time.Now()
+		now = fireTime.Time
 	}
 
 	payload := map[string]any{
@@ -459,36 +686,24 @@ func (s *Schedule) emitEvent(ctx core.TriggerActionContext) error {
 		payload["timezone"] = formatTimezone(timezone)
 	}
 
-	err = ctx.Events.Emit("scheduler.tick", payload)
-
-	if err != nil {
-		return err
+	if fireTime.Missed {
+		payload["missed"] = true
+		payload["scheduled_at"] = now.Format(time.RFC3339)
 	}
 
-	var existingMetadata Metadata
-	err = mapstructure.Decode(ctx.Metadata.Get(), &existingMetadata)
-	if err != nil {
-		return fmt.Errorf("failed to parse existing metadata: %w", err)
-	}
+	return payload
+}
 
-	nowUTC := time.Now()
-	nextTrigger, err := getNextTrigger(spec, nowUTC, existingMetadata.ReferenceTime)
-	if err != nil {
-		return err
-	}
+func supportsTimezone(spec Configuration) bool {
+	return spec.Type == TypeDays || spec.Type == TypeWeeks || spec.Type == TypeMonths || spec.Type == TypeCron
+}
 
-	err = ctx.Requests.ScheduleActionCall("emitEvent", map[string]any{}, time.Until(*nextTrigger))
-	if err != nil {
-		return err
+func scheduleLocation(spec Configuration) *time.Location {
+	if supportsTimezone(spec) {
+		return parseTimezone(spec.Timezone)
 	}
 
-	formatted := nextTrigger.Format(time.RFC3339)
-	ctx.Logger.Infof("Next trigger at: %v", formatted)
-
-	return ctx.Metadata.Set(Metadata{
-		NextTrigger:   &formatted,
-		ReferenceTime: existingMetadata.ReferenceTime,
-	})
+	return time.UTC
 }
 
 func getNextTrigger(config Configuration, now time.Time, referenceTime *string) (*time.Time, error) {
@@ -553,3 +553,456 @@ func TestEmitEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestNextFireTimeWithExclusions(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Configuration
+		calendars   *contexts.CalendarContext
+		now         time.Time
+		expectNext  time.Time
+		expectError bool
+	}{
+		{
+			name: "no exclusions",
+			config: Configuration{
+				Type:         TypeDays,
+				DaysInterval: intPtr(1),
+				Hour:         intPtr(2),
+				Minute:       intPtr(0),
+			},
+			now:        mustParseTime("2025-12-23T10:00:00Z"),
+			expectNext: mustParseTime("2025-12-24T02:00:00Z"),
+		},
+		{
+			name: "excluded date range is skipped",
+			config: Configuration{
+				Type:         TypeDays,
+				DaysInterval: intPtr(1),
+				Hour:         intPtr(2),
+				Minute:       intPtr(0),
+				ExcludeDates: []DateExclusion{{Start: "2025-12-24", End: "2025-12-26"}},
+			},
+			now:        mustParseTime("2025-12-23T10:00:00Z"),
+			expectNext: mustParseTime("2025-12-27T02:00:00Z"),
+		},
+		{
+			name: "single excluded date is skipped",
+			config: Configuration{
+				Type:         TypeDays,
+				DaysInterval: intPtr(1),
+				Hour:         intPtr(2),
+				Minute:       intPtr(0),
+				ExcludeDates: []DateExclusion{{Start: "2025-12-24"}},
+			},
+			now:        mustParseTime("2025-12-23T10:00:00Z"),
+			expectNext: mustParseTime("2025-12-25T02:00:00Z"),
+		},
+		{
+			name: "dates are evaluated in the schedule timezone",
+			config: Configuration{
+				Type:         TypeDays,
+				DaysInterval: intPtr(1),
+				Hour:         intPtr(23),
+				Minute:       intPtr(0),
+				Timezone:     stringPtr("-5"),
+				ExcludeDates: []DateExclusion{{Start: "2025-12-24"}},
+			},
+			now:        mustParseTime("2025-12-23T12:00:00Z"),
+			expectNext: mustParseTime("2025-12-26T04:00:00Z"),
+		},
+		{
+			name: "calendar exclusions are skipped",
+			config: Configuration{
+				Type:         TypeDays,
+				DaysInterval: intPtr(1),
+				Hour:         intPtr(2),
+				Minute:       intPtr(0),
+				Calendars:    []string{"holidays"},
+			},
+			calendars: &contexts.CalendarContext{
+				ExcludedDays: map[string][]string{"holidays": {"2025-12-24", "2025-12-25"}},
+			},
+			now:        mustParseTime("2025-12-23T10:00:00Z"),
+			expectNext: mustParseTime("2025-12-26T02:00:00Z"),
+		},
+		{
+			name: "unknown calendar",
+			config: Configuration{
+				Type:         TypeDays,
+				DaysInterval: intPtr(1),
+				Calendars:    []string{"unknown"},
+			},
+			calendars:   &contexts.CalendarContext{ExcludedDays: map[string][]string{}},
+			now:         mustParseTime("2025-12-23T10:00:00Z"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calendars core.CalendarContext
+			if tt.calendars != nil {
+				calendars = tt.calendars
+			}
+
+			result, err := nextFireTime(tt.config, calendars, tt.now, nil)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !result.Equal(tt.expectNext) {
+				t.Errorf("expected next trigger at %v, got %v", tt.expectNext, *result)
+			}
+		})
+	}
+}
+
+func TestFireTimesToEmit(t *testing.T) {
+	hourly := Configuration{
+		Type:          TypeHours,
+		HoursInterval: intPtr(1),
+		Minute:        intPtr(0),
+	}
+
+	withPolicy := func(policy string) Configuration {
+		config := hourly
+		config.CatchUp = policy
+		return config
+	}
+
+	scheduled := "2025-01-01T02:00:00Z"
+	now := mustParseTime("2025-01-01T05:30:00Z")
+
+	tests := []struct {
+		name          string
+		config        Configuration
+		nextTrigger   string
+		now           time.Time
+		expectTimes   []time.Time
+		expectMissed  bool
+		expectNoEvent bool
+	}{
+		{
+			name:        "on time",
+			config:      hourly,
+			nextTrigger: scheduled,
+			now:         mustParseTime("2025-01-01T02:00:01Z"),
+			expectTimes: []time.Time{mustParseTime("2025-01-01T02:00:01Z")},
+		},
+		{
+			name: "on time but excluded",
+			config: Configuration{
+				Type:          TypeHours,
+				HoursInterval: intPtr(1),
+				Minute:        intPtr(0),
+				ExcludeDates:  []DateExclusion{{Start: "2025-01-01"}},
+			},
+			nextTrigger:   scheduled,
+			now:           mustParseTime("2025-01-01T02:00:01Z"),
+			expectNoEvent: true,
+		},
+		{
+			name:          "missed with skip policy",
+			config:        withPolicy(CatchUpSkip),
+			nextTrigger:   scheduled,
+			now:           now,
+			expectNoEvent: true,
+		},
+		{
+			name:         "missed with run once policy",
+			config:       withPolicy(CatchUpOnce),
+			nextTrigger:  scheduled,
+			now:          now,
+			expectTimes:  []time.Time{mustParseTime("2025-01-01T05:00:00Z")},
+			expectMissed: true,
+		},
+		{
+			name:         "missed without policy defaults to run once",
+			config:       hourly,
+			nextTrigger:  scheduled,
+			now:          now,
+			expectTimes:  []time.Time{mustParseTime("2025-01-01T05:00:00Z")},
+			expectMissed: true,
+		},
+		{
+			name:        "missed with run all policy",
+			config:      withPolicy(CatchUpAll),
+			nextTrigger: scheduled,
+			now:         now,
+			expectTimes: []time.Time{
+				mustParseTime("2025-01-01T02:00:00Z"),
+				mustParseTime("2025-01-01T03:00:00Z"),
+				mustParseTime("2025-01-01T04:00:00Z"),
+				mustParseTime("2025-01-01T05:00:00Z"),
+			},
+			expectMissed: true,
+		},
+		{
+			name: "jitter extends the grace period",
+			config: Configuration{
+				Type:          TypeHours,
+				HoursInterval: intPtr(1),
+				Minute:        intPtr(0),
+				Jitter:        intPtr(600),
+				CatchUp:       CatchUpSkip,
+			},
+			nextTrigger: scheduled,
+			now:         mustParseTime("2025-01-01T02:10:00Z"),
+			expectTimes: []time.Time{mustParseTime("2025-01-01T02:10:00Z")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata := Metadata{NextTrigger: &tt.nextTrigger}
+			result, err := fireTimesToEmit(tt.config, nil, metadata, tt.now)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if tt.expectNoEvent {
+				if len(result) != 0 {
+					t.Errorf("expected no events, got %v", result)
+				}
+				return
+			}
+
+			if len(result) != len(tt.expectTimes) {
+				t.Errorf("expected %d events, got %d", len(tt.expectTimes), len(result))
+				return
+			}
+
+			for i, fireTime := range result {
+				if !fireTime.Time.Equal(tt.expectTimes[i]) {
+					t.Errorf("expected event %d at %v, got %v", i, tt.expectTimes[i], fireTime.Time)
+				}
+
+				if fireTime.Missed != tt.expectMissed {
+					t.Errorf("expected event %d missed=%v, got %v", i, tt.expectMissed, fireTime.Missed)
+				}
+			}
+		})
+	}
+}
+
+func TestFireTimesToEmitAfterLongOutage(t *testing.T) {
+	//
+	// Two weeks of a schedule firing every minute
+	// is more than 20000 missed fire times.
+	//
+	scheduled := "2025-01-01T00:00:00Z"
+	now := mustParseTime("2025-01-15T00:00:30Z")
+	newest := mustParseTime("2025-01-15T00:00:00Z")
+	metadata := Metadata{NextTrigger: &scheduled}
+
+	everyMinute := func(policy string) Configuration {
+		return Configuration{Type: TypeMinutes, MinutesInterval: intPtr(1), CatchUp: policy}
+	}
+
+	t.Run("run all policy emits the newest missed fire times", func(t *testing.T) {
+		result, err := fireTimesToEmit(everyMinute(CatchUpAll), nil, metadata, now)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(result) != MaxCatchUpRuns {
+			t.Fatalf("expected %d events, got %d", MaxCatchUpRuns, len(result))
+		}
+
+		for i, fireTime := range result {
+			expected := newest.Add(-time.Duration(MaxCatchUpRuns-1-i) * time.Minute)
+			if !fireTime.Time.Equal(expected) {
+				t.Errorf("expected event %d at %v, got %v", i, expected, fireTime.Time)
+			}
+		}
+	})
+
+	t.Run("run once policy emits the newest missed fire time", func(t *testing.T) {
+		result, err := fireTimesToEmit(everyMinute(CatchUpOnce), nil, metadata, now)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(result) != 1 || !result[0].Time.Equal(newest) {
+			t.Errorf("expected a single event at %v, got %v", newest, result)
+		}
+	})
+}
+
+func TestEmitEventCatchUp(t *testing.T) {
+	schedule := &Schedule{}
+	eventCtx := &contexts.EventContext{}
+	nextTrigger := time.Now().Truncate(time.Hour).Add(-3 * time.Hour).Format(time.RFC3339)
+
+	ctx := core.TriggerActionContext{
+		Name: "emitEvent",
+		Configuration: Configuration{
+			Type:          TypeHours,
+			HoursInterval: intPtr(1),
+			Minute:        intPtr(0),
+			CatchUp:       CatchUpAll,
+		},
+		Logger:   log.NewEntry(log.StandardLogger()),
+		Events:   eventCtx,
+		Metadata: &contexts.MetadataContext{Metadata: Metadata{NextTrigger: &nextTrigger}},
+		Requests: &contexts.RequestContext{},
+	}
+
+	err := schedule.emitEvent(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	//
+	// Three missed hours, plus the current one.
+	//
+	if eventCtx.Count() != 4 {
+		t.Fatalf("expected 4 catch-up events, got %d", eventCtx.Count())
+	}
+
+	for _, p := range eventCtx.Payloads {
+		payload := p.Data.(map[string]any)
+		if payload["missed"] != true {
+			t.Errorf("expected catch-up event to be marked as missed")
+		}
+
+		if _, ok := payload["scheduled_at"].(string); !ok {
+			t.Errorf("expected catch-up event to include scheduled_at")
+		}
+	}
+}
+
+func TestPreviewFireTimes(t *testing.T) {
+	schedule := &Schedule{}
+
+	ctx := core.TriggerActionContext{
+		Name: "previewFireTimes",
+		Configuration: Configuration{
+			Type:         TypeDays,
+			DaysInterval: intPtr(1),
+			Hour:         intPtr(9),
+			Minute:       intPtr(0),
+			ExcludeDates: []DateExclusion{{Start: time.Now().AddDate(0, 0, 2).Format("2006-01-02")}},
+		},
+		Parameters: map[string]any{"count": 5},
+		Logger:     log.NewEntry(log.StandardLogger()),
+		Metadata:   &contexts.MetadataContext{},
+	}
+
+	result, err := schedule.HandleAction(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fireTimes, ok := result["fireTimes"].([]any)
+	if !ok {
+		t.Fatalf("expected fireTimes to be a list, got %T", result["fireTimes"])
+	}
+
+	if len(fireTimes) != 5 {
+		t.Fatalf("expected 5 fire times, got %d", len(fireTimes))
+	}
+
+	excluded := time.Now().AddDate(0, 0, 2).Format("2006-01-02")
+	previous := time.Time{}
+	for _, v := range fireTimes {
+		fireTime, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			t.Fatalf("invalid fire time %v: %v", v, err)
+		}
+
+		if fireTime.Format("2006-01-02") == excluded {
+			t.Errorf("expected %s to be excluded, got fire time %v", excluded, fireTime)
+		}
+
+		if !fireTime.After(previous) {
+			t.Errorf("expected fire times in ascending order, got %v after %v", fireTime, previous)
+		}
+
+		previous = fireTime
+	}
+
+	ctx.Parameters = map[string]any{"count": 100}
+	_, err = schedule.HandleAction(ctx)
+	if err == nil {
+		t.Errorf("expected error for count above the limit")
+	}
+}
+
+func TestValidateExclusions(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Configuration
+		expectError bool
+	}{
+		{
+			name:   "valid exclusions",
+			config: Configuration{ExcludeDates: []DateExclusion{{Start: "2025-12-24", End: "2025-12-26"}}, Jitter: intPtr(30), CatchUp: CatchUpAll},
+		},
+		{
+			name:        "invalid date",
+			config:      Configuration{ExcludeDates: []DateExclusion{{Start: "24/12/2025"}}},
+			expectError: true,
+		},
+		{
+			name:        "range ends before it starts",
+			config:      Configuration{ExcludeDates: []DateExclusion{{Start: "2025-12-26", End: "2025-12-24"}}},
+			expectError: true,
+		},
+		{
+			name:        "jitter too large",
+			config:      Configuration{Jitter: intPtr(MaxJitterSeconds + 1)},
+			expectError: true,
+		},
+		{
+			name:        "unsupported catch-up policy",
+			config:      Configuration{CatchUp: "sometimes"},
+			expectError: true,
+		},
+		{
+			name:        "calendars without calendar context",
+			config:      Configuration{Calendars: []string{"holidays"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateExclusions(tt.config, nil)
+			if tt.expectError && err == nil {
+				t.Errorf("expected error but got none")
+			}
+
+			if !tt.expectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDelayUntilWithJitter(t *testing.T) {
+	fireTime := time.Now().Add(time.Hour)
+	config := Configuration{Jitter: intPtr(60)}
+
+	for range 20 {
+		delay := delayUntil(config, fireTime)
+		if delay < 59*time.Minute || delay > 61*time.Minute+time.Second {
+			t.Errorf("expected delay between 1h and 1h1m, got %v", delay)
+		}
+	}
+
+	delay := delayUntil(Configuration{}, time.Now().Add(-time.Minute))
+	if delay != time.Second {
+		t.Errorf("expected past fire times to be delayed by 1s, got %v", delay)
+	}
+}
@@ -0,0 +1,233 @@
+package manual
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+// inputTypes are the configuration field types inputs can have.
+var inputTypes = []string{
+	configuration.FieldTypeString,
+	configuration.FieldTypeNumber,
+	configuration.FieldTypeBool,
+	configuration.FieldTypeSelect,
+	configuration.FieldTypeGitRef,
+	configuration.FieldTypeIntegrationResource,
+	configuration.FieldTypeUser,
+}
+
+type Configuration struct {
+	Templates []Template `json:"templates" mapstructure:"templates"`
+	Inputs    []Input    `json:"inputs" mapstructure:"inputs"`
+}
+
+type Template struct {
+	Name    string         `json:"name" mapstructure:"name"`
+	Payload map[string]any `json:"payload" mapstructure:"payload"`
+}
+
+// Input is a typed parameter declared on the trigger,
+// which must be provided when the canvas is run manually.
+type Input struct {
+	Name         string   `json:"name" mapstructure:"name"`
+	Label        string   `json:"label" mapstructure:"label"`
+	Description  string   `json:"description" mapstructure:"description"`
+	Type         string   `json:"type" mapstructure:"type"`
+	Required     bool     `json:"required" mapstructure:"required"`
+	Default      string   `json:"default" mapstructure:"default"`
+	Options      []string `json:"options" mapstructure:"options"`
+	ResourceType string   `json:"resourceType" mapstructure:"resourceType"`
+}
+
+// Field returns the configuration field used
+// to render and validate the input value.
+func (i Input) Field() configuration.Field {
+	label := i.Label
+	if label == "" {
+		label = i.Name
+	}
+
+	field := configuration.Field{
+		Name:        i.Name,
+		Label:       label,
+		Description: i.Description,
+		Type:        i.Type,
+		Required:    i.Required,
+	}
+
+	switch i.Type {
+	case configuration.FieldTypeSelect:
+		options := make([]configuration.FieldOption, 0, len(i.Options))
+		for _, option := range i.Options {
+			options = append(options, configuration.FieldOption{Label: option, Value: option})
+		}
+
+		field.TypeOptions = &configuration.TypeOptions{
+			Select: &configuration.SelectTypeOptions{Options: options},
+		}
+
+	case configuration.FieldTypeIntegrationResource:
+		field.TypeOptions = &configuration.TypeOptions{
+			Resource: &configuration.ResourceTypeOptions{Type: i.ResourceType},
+		}
+	}
+
+	return field
+}
+
+func validateInputs(inputs []Input) error {
+	names := map[string]bool{}
+
+	for _, input := range inputs {
+		if input.Name == "" {
+			return fmt.Errorf("input name is required")
+		}
+
+		if names[input.Name] {
+			return fmt.Errorf("duplicate input name: %s", input.Name)
+		}
+
+		names[input.Name] = true
+
+		if !slices.Contains(inputTypes, input.Type) {
+			return fmt.Errorf("input %s: unsupported type %q", input.Name, input.Type)
+		}
+
+		if input.Type == configuration.FieldTypeSelect && len(input.Options) == 0 {
+			return fmt.Errorf("input %s: select inputs require at least one option", input.Name)
+		}
+
+		if input.Type == configuration.FieldTypeIntegrationResource && input.ResourceType == "" {
+			return fmt.Errorf("input %s: resource type is required", input.Name)
+		}
+
+		if input.Default == "" {
+			continue
+		}
+
+		value, err := coerceInputValue(input, input.Default)
+		if err != nil {
+			return fmt.Errorf("input %s: invalid default: %v", input.Name, err)
+		}
+
+		err = configuration.ValidateConfiguration([]configuration.Field{input.Field()}, map[string]any{input.Name: value})
+		if err != nil {
+			return fmt.Errorf("input %s: invalid default: %v", input.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ResolveEventInputs validates the inputs of a run started by emitting
+// an event for the trigger directly, like the UI and API do, instead of
+// through the run action. The values under "inputs" in the event data
+// are replaced by the resolved ones.
+func ResolveEventInputs(nodeConfiguration map[string]any, data map[string]any) (map[string]any, error) {
+	config := Configuration{}
+	err := mapstructure.Decode(nodeConfiguration, &config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if len(config.Inputs) == 0 {
+		return data, nil
+	}
+
+	parameters := map[string]any{}
+	if raw, ok := data["inputs"]; ok && raw != nil {
+		parameters, ok = raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("inputs must be an object")
+		}
+	}
+
+	inputs, err := resolveInputs(config.Inputs, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := maps.Clone(data)
+	if resolved == nil {
+		resolved = map[string]any{}
+	}
+
+	resolved["inputs"] = inputs
+	return resolved, nil
+}
+
+// resolveInputs applies defaults to the given parameters,
+// converts string values to the declared input types,
+// and validates the result against the declared inputs.
+func resolveInputs(inputs []Input, parameters map[string]any) (map[string]any, error) {
+	values := map[string]any{}
+	fields := make([]configuration.Field, 0, len(inputs))
+
+	for name := range parameters {
+		if !slices.ContainsFunc(inputs, func(input Input) bool { return input.Name == name }) {
+			return nil, fmt.Errorf("unknown input: %s", name)
+		}
+	}
+
+	for _, input := range inputs {
+		fields = append(fields, input.Field())
+
+		value, ok := parameters[input.Name]
+		if !ok || value == nil || value == "" {
+			if input.Default == "" {
+				continue
+			}
+
+			value = input.Default
+		}
+
+		v, err := coerceInputValue(input, value)
+		if err != nil {
+			return nil, fmt.Errorf("input '%s': %v", input.Name, err)
+		}
+
+		values[input.Name] = v
+	}
+
+	err := configuration.ValidateConfiguration(fields, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// coerceInputValue converts string values into the type declared for the input.
+// Values coming from the CLI or from the input defaults are always strings.
+func coerceInputValue(input Input, value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+
+	switch input.Type {
+	case configuration.FieldTypeNumber:
+		n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number")
+		}
+
+		return n, nil
+
+	case configuration.FieldTypeBool:
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("must be a boolean")
+		}
+
+		return b, nil
+	}
+
+	return s, nil
+}
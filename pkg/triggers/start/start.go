@@ -1,8 +1,10 @@
 package manual
 
 import (
+	"fmt"
 	"net/http"
 
+	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/registry"
@@ -12,6 +14,11 @@ func init() {
 	registry.RegisterTrigger("start", &Start{})
 }
 
+const (
+	RunActionName  = "run"
+	RunPayloadType = "manual.run"
+)
+
 type Start struct{}
 
 func (s *Start) Name() string {
@@ -44,11 +51,26 @@ func (s *Start) Documentation() string {
 
 ## Configuration
 
-The Manual Run trigger requires no configuration. It's ready to use immediately after being added to a workflow.
+- **Templates**: Named payloads you can pick from when starting a run from the UI
+- **Inputs**: Typed parameters that must be provided when the workflow is run, similar to GitHub's ` + "`workflow_dispatch`" + ` inputs
+
+## Inputs
+
+Each input has a name, a type and, optionally, a default value. Supported types are:
+
+- **string**, **number** and **boolean**
+- **select**: one of a fixed list of options
+- **git-ref**: a branch or tag reference, e.g. ` + "`refs/heads/main`" + `
+- **integration-resource**: a resource from an integration, e.g. a repository
+- **user**: a user from the organization
+
+Input values are validated before the run starts, whether it is started from the UI, the API or the CLI, so runs with missing or invalid inputs are rejected.
+From the CLI, use ` + "`superplane canvases run <canvas> --node <node-id> --param key=value`" + `, or ` + "`--template <name>`" + ` to start a run from a template.
 
 ## Event Data
 
-Manual runs start with an empty event payload. You can use this as a starting point and add data through subsequent components.`
+Runs started from a template emit the template payload.
+Runs started with inputs emit the validated input values under ` + "`inputs`" + `, e.g. ` + "`{{ $['Manual Run'].data.inputs.environment }}`" + `.`
 }
 
 func (s *Start) Icon() string {
@@ -94,6 +116,93 @@ func (s *Start) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "inputs",
+			Label:       "Inputs",
+			Type:        configuration.FieldTypeList,
+			Description: "Typed parameters provided when the workflow is run",
+			Togglable:   true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Input",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "name",
+								Label:    "Name",
+								Type:     configuration.FieldTypeString,
+								Required: true,
+							},
+							{
+								Name:  "label",
+								Label: "Label",
+								Type:  configuration.FieldTypeString,
+							},
+							{
+								Name:  "description",
+								Label: "Description",
+								Type:  configuration.FieldTypeString,
+							},
+							{
+								Name:     "type",
+								Label:    "Type",
+								Type:     configuration.FieldTypeSelect,
+								Required: true,
+								Default:  configuration.FieldTypeString,
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: []configuration.FieldOption{
+											{Label: "String", Value: configuration.FieldTypeString},
+											{Label: "Number", Value: configuration.FieldTypeNumber},
+											{Label: "Boolean", Value: configuration.FieldTypeBool},
+											{Label: "Select", Value: configuration.FieldTypeSelect},
+											{Label: "Git Reference", Value: configuration.FieldTypeGitRef},
+											{Label: "Integration Resource", Value: configuration.FieldTypeIntegrationResource},
+											{Label: "User", Value: configuration.FieldTypeUser},
+										},
+									},
+								},
+							},
+							{
+								Name:  "required",
+								Label: "Required",
+								Type:  configuration.FieldTypeBool,
+							},
+							{
+								Name:  "default",
+								Label: "Default Value",
+								Type:  configuration.FieldTypeString,
+							},
+							{
+								Name:  "options",
+								Label: "Options",
+								Type:  configuration.FieldTypeList,
+								TypeOptions: &configuration.TypeOptions{
+									List: &configuration.ListTypeOptions{
+										ItemLabel: "Option",
+										ItemDefinition: &configuration.ListItemDefinition{
+											Type: configuration.FieldTypeString,
+										},
+									},
+								},
+								VisibilityConditions: []configuration.VisibilityCondition{
+									{Field: "type", Values: []string{configuration.FieldTypeSelect}},
+								},
+							},
+							{
+								Name:  "resourceType",
+								Label: "Resource Type",
+								Type:  configuration.FieldTypeString,
+								VisibilityConditions: []configuration.VisibilityCondition{
+									{Field: "type", Values: []string{configuration.FieldTypeIntegrationResource}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -102,15 +211,58 @@ func (s *Start) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 }
 
 func (s *Start) Setup(ctx core.TriggerContext) error {
-	return nil
+	config := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return validateInputs(config.Inputs)
 }
 
 func (s *Start) Actions() []core.Action {
-	return []core.Action{}
+	return []core.Action{
+		{
+			Name:           RunActionName,
+			Description:    "Start a run with the given input values",
+			UserAccessible: true,
+
+			//
+			// Parameters are the inputs declared in the node configuration,
+			// so they are validated by the action itself.
+			//
+			Parameters: []configuration.Field{},
+		},
+	}
 }
 
 func (s *Start) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
-	return nil, nil
+	switch ctx.Name {
+	case RunActionName:
+		return s.run(ctx)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+func (s *Start) run(ctx core.TriggerActionContext) (map[string]any, error) {
+	config := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	inputs, err := resolveInputs(config.Inputs, ctx.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.Events.Emit(RunPayloadType, map[string]any{"inputs": inputs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to emit event: %w", err)
+	}
+
+	return map[string]any{"inputs": inputs}, nil
 }
 
 func (s *Start) Cleanup(ctx core.TriggerContext) error {
@@ -0,0 +1,184 @@
+package manual
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__Start__Setup(t *testing.T) {
+	s := &Start{}
+
+	t.Run("no inputs -> ok", func(t *testing.T) {
+		require.NoError(t, s.Setup(core.TriggerContext{Configuration: map[string]any{}}))
+	})
+
+	t.Run("valid inputs -> ok", func(t *testing.T) {
+		err := s.Setup(core.TriggerContext{
+			Configuration: map[string]any{
+				"inputs": []any{
+					map[string]any{"name": "environment", "type": "select", "options": []any{"staging", "production"}, "default": "staging"},
+					map[string]any{"name": "replicas", "type": "number", "default": "2"},
+					map[string]any{"name": "ref", "type": "git-ref", "required": true},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("duplicate input names -> error", func(t *testing.T) {
+		err := s.Setup(core.TriggerContext{
+			Configuration: map[string]any{
+				"inputs": []any{
+					map[string]any{"name": "ref", "type": "string"},
+					map[string]any{"name": "ref", "type": "git-ref"},
+				},
+			},
+		})
+
+		require.ErrorContains(t, err, "duplicate input name")
+	})
+
+	t.Run("unsupported type -> error", func(t *testing.T) {
+		err := s.Setup(core.TriggerContext{
+			Configuration: map[string]any{
+				"inputs": []any{map[string]any{"name": "when", "type": "datetime"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "unsupported type")
+	})
+
+	t.Run("select without options -> error", func(t *testing.T) {
+		err := s.Setup(core.TriggerContext{
+			Configuration: map[string]any{
+				"inputs": []any{map[string]any{"name": "environment", "type": "select"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "at least one option")
+	})
+
+	t.Run("invalid default -> error", func(t *testing.T) {
+		err := s.Setup(core.TriggerContext{
+			Configuration: map[string]any{
+				"inputs": []any{map[string]any{"name": "environment", "type": "select", "options": []any{"staging"}, "default": "production"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "invalid default")
+	})
+}
+
+func Test__Start__Run(t *testing.T) {
+	s := &Start{}
+	configuration := map[string]any{
+		"inputs": []any{
+			map[string]any{"name": "environment", "type": "select", "options": []any{"staging", "production"}, "required": true},
+			map[string]any{"name": "replicas", "type": "number", "default": "2"},
+			map[string]any{"name": "dryRun", "type": "boolean"},
+			map[string]any{"name": "ref", "type": "git-ref"},
+		},
+	}
+
+	run := func(parameters map[string]any) (*contexts.EventContext, map[string]any, error) {
+		events := &contexts.EventContext{}
+		result, err := s.HandleAction(core.TriggerActionContext{
+			Name:          RunActionName,
+			Configuration: configuration,
+			Parameters:    parameters,
+			Events:        events,
+		})
+
+		return events, result, err
+	}
+
+	t.Run("valid inputs -> emits event with typed values", func(t *testing.T) {
+		events, result, err := run(map[string]any{
+			"environment": "production",
+			"dryRun":      "true",
+			"ref":         "refs/heads/main",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, RunPayloadType, events.Payloads[0].Type)
+
+		expected := map[string]any{
+			"environment": "production",
+			"replicas":    float64(2),
+			"dryRun":      true,
+			"ref":         "refs/heads/main",
+		}
+
+		assert.Equal(t, map[string]any{"inputs": expected}, events.Payloads[0].Data)
+		assert.Equal(t, expected, result["inputs"])
+	})
+
+	t.Run("missing required input -> error", func(t *testing.T) {
+		events, _, err := run(map[string]any{"replicas": "3"})
+		require.ErrorContains(t, err, "field 'environment' is required")
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("value not in select options -> error", func(t *testing.T) {
+		events, _, err := run(map[string]any{"environment": "qa"})
+		require.ErrorContains(t, err, "must be one of")
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("invalid number -> error", func(t *testing.T) {
+		events, _, err := run(map[string]any{"environment": "staging", "replicas": "many"})
+		require.ErrorContains(t, err, "must be a number")
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("unknown input -> error", func(t *testing.T) {
+		events, _, err := run(map[string]any{"environment": "staging", "region": "eu"})
+		require.ErrorContains(t, err, "unknown input")
+		assert.Zero(t, events.Count())
+	})
+}
+
+func Test__Start__ResolveEventInputs(t *testing.T) {
+	configuration := map[string]any{
+		"inputs": []any{
+			map[string]any{"name": "environment", "type": "select", "options": []any{"staging", "production"}, "required": true},
+			map[string]any{"name": "replicas", "type": "number", "default": "2"},
+		},
+	}
+
+	t.Run("no declared inputs -> data is unchanged", func(t *testing.T) {
+		data := map[string]any{"message": "hello"}
+		resolved, err := ResolveEventInputs(map[string]any{}, data)
+		require.NoError(t, err)
+		assert.Equal(t, data, resolved)
+	})
+
+	t.Run("valid inputs -> resolved values replace the given ones", func(t *testing.T) {
+		resolved, err := ResolveEventInputs(configuration, map[string]any{
+			"message": "hello",
+			"inputs":  map[string]any{"environment": "staging"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"message": "hello",
+			"inputs":  map[string]any{"environment": "staging", "replicas": float64(2)},
+		}, resolved)
+	})
+
+	t.Run("missing inputs -> error", func(t *testing.T) {
+		_, err := ResolveEventInputs(configuration, nil)
+		require.ErrorContains(t, err, "field 'environment' is required")
+	})
+
+	t.Run("inputs that are not an object -> error", func(t *testing.T) {
+		_, err := ResolveEventInputs(configuration, map[string]any{"inputs": "staging"})
+		require.ErrorContains(t, err, "inputs must be an object")
+	})
+}
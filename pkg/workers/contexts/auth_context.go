@@ -14,14 +14,16 @@ import (
 type AuthContext struct {
 	tx                *gorm.DB
 	orgID             uuid.UUID
+	canvasID          uuid.UUID
 	authService       authorization.Authorization
 	authenticatedUser *models.User
 }
 
-func NewAuthContext(tx *gorm.DB, orgID uuid.UUID, authService authorization.Authorization, authenticatedUser *models.User) *AuthContext {
+func NewAuthContext(tx *gorm.DB, orgID, canvasID uuid.UUID, authService authorization.Authorization, authenticatedUser *models.User) *AuthContext {
 	return &AuthContext{
 		tx:                tx,
 		orgID:             orgID,
+		canvasID:          canvasID,
 		authService:       authService,
 		authenticatedUser: authenticatedUser,
 	}
@@ -68,7 +70,20 @@ func (c *AuthContext) HasRole(role string) (bool, error) {
 		}
 	}
 
-	return false, nil
+	//
+	// Canvas roles are only checked in the canvas
+	// where the action is happening.
+	//
+	if c.canvasID == uuid.Nil || !c.authService.IsDefaultRole(role, models.DomainTypeCanvas) {
+		return false, nil
+	}
+
+	canvasRoles, err := c.authService.GetUserCanvasRoles(c.authenticatedUser.ID.String(), c.orgID.String(), c.canvasID.String())
+	if err != nil {
+		return false, fmt.Errorf("error finding canvas roles: %v", err)
+	}
+
+	return slices.Contains(canvasRoles, role), nil
 }
 
 func (c *AuthContext) InGroup(group string) (bool, error) {
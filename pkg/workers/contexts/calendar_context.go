@@ -0,0 +1,57 @@
+package contexts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+// CalendarContext resolves organization calendars for trigger nodes.
+// Triggers check many dates against the same calendars, so the organization
+// and the calendars are only loaded once for each context.
+type CalendarContext struct {
+	tx        *gorm.DB
+	node      *models.CanvasNode
+	orgID     *uuid.UUID
+	calendars map[string]*models.Calendar
+}
+
+func NewCalendarContext(tx *gorm.DB, node *models.CanvasNode) *CalendarContext {
+	return &CalendarContext{tx: tx, node: node, calendars: map[string]*models.Calendar{}}
+}
+
+// IsExcluded implements core.CalendarContext.
+func (c *CalendarContext) IsExcluded(calendarName string, t time.Time) (bool, error) {
+	calendar, err := c.findCalendar(calendarName)
+	if err != nil {
+		return false, err
+	}
+
+	return calendar.Excludes(t), nil
+}
+
+func (c *CalendarContext) findCalendar(name string) (*models.Calendar, error) {
+	if calendar, ok := c.calendars[name]; ok {
+		return calendar, nil
+	}
+
+	if c.orgID == nil {
+		canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(c.tx, c.node.WorkflowID)
+		if err != nil {
+			return nil, fmt.Errorf("error finding canvas: %v", err)
+		}
+
+		c.orgID = &canvas.OrganizationID
+	}
+
+	calendar, err := models.FindCalendarByNameInTransaction(c.tx, *c.orgID, name)
+	if err != nil {
+		return nil, fmt.Errorf("calendar %s not found: %v", name, err)
+	}
+
+	c.calendars[name] = calendar
+	return calendar, nil
+}
@@ -0,0 +1,70 @@
+package contexts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__CalendarContext__IsExcluded(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Name:   "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	calendar := models.Calendar{
+		ID:             uuid.New(),
+		OrganizationID: r.Organization.ID,
+		Name:           "holidays",
+		Exclusions:     datatypes.NewJSONSlice([]models.CalendarExclusion{{Start: "2025-12-24", End: "2025-12-26"}}),
+	}
+	require.NoError(t, models.CreateCalendar(&calendar))
+
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "trigger-1")
+	require.NoError(t, err)
+
+	ctx := NewCalendarContext(database.Conn(), node)
+
+	t.Run("unknown calendar -> error", func(t *testing.T) {
+		_, err := ctx.IsExcluded("freezes", time.Now())
+		require.ErrorContains(t, err, "calendar freezes not found")
+	})
+
+	t.Run("dates are checked against the calendar exclusions", func(t *testing.T) {
+		excluded, err := ctx.IsExcluded("holidays", time.Date(2025, 12, 25, 10, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, excluded)
+
+		excluded, err = ctx.IsExcluded("holidays", time.Date(2025, 12, 27, 10, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.False(t, excluded)
+	})
+
+	t.Run("calendars are only loaded once", func(t *testing.T) {
+		require.NoError(t, database.Conn().Delete(&calendar).Error)
+
+		excluded, err := ctx.IsExcluded("holidays", time.Date(2025, 12, 24, 10, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, excluded)
+	})
+}
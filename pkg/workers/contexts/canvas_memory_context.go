@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/quotas"
 	"gorm.io/gorm"
 )
 
@@ -24,6 +25,15 @@ func (c *CanvasMemoryContext) Add(namespace string, values any) error {
 		return fmt.Errorf("namespace is required")
 	}
 
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(c.tx, c.canvasID)
+	if err != nil {
+		return fmt.Errorf("failed to find canvas: %w", err)
+	}
+
+	if err := quotas.CheckMemoryRows(c.tx, canvas.OrganizationID); err != nil {
+		return err
+	}
+
 	return models.AddCanvasMemoryInTransaction(c.tx, c.canvasID, namespace, values)
 }
 
@@ -0,0 +1,73 @@
+package contexts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/triggers/onevent"
+	"gorm.io/gorm"
+)
+
+/*
+ * EventBusContext delivers published events to the subscribed onEvent triggers
+ * by creating a request to invoke their receive action. The requests are processed
+ * by the NodeRequestWorker, so the delivery to each subscriber is retried independently,
+ * and the publishing execution does not depend on the subscribers.
+ */
+type EventBusContext struct {
+	tx             *gorm.DB
+	organizationID uuid.UUID
+	execution      *models.CanvasNodeExecution
+}
+
+func NewEventBusContext(tx *gorm.DB, organizationID uuid.UUID, execution *models.CanvasNodeExecution) *EventBusContext {
+	return &EventBusContext{
+		tx:             tx,
+		organizationID: organizationID,
+		execution:      execution,
+	}
+}
+
+func (c *EventBusContext) Publish(topic, eventType string, data any) (int, error) {
+	topic = strings.TrimSpace(topic)
+	err := onevent.ValidateTopic(topic)
+	if err != nil {
+		return 0, err
+	}
+
+	subscribers, err := models.ListTopicSubscribersInTransaction(c.tx, c.organizationID, onevent.TriggerName, topic)
+	if err != nil {
+		return 0, fmt.Errorf("error finding subscribers for topic %s: %w", topic, err)
+	}
+
+	now := time.Now()
+	parameters := map[string]any{
+		"topic": topic,
+		"type":  eventType,
+		"data":  data,
+		"source": map[string]any{
+			"canvasId":    c.execution.WorkflowID.String(),
+			"nodeId":      c.execution.NodeID,
+			"executionId": c.execution.ID.String(),
+		},
+		"publishedAt": now.UTC().Format(time.RFC3339),
+	}
+
+	for _, subscriber := range subscribers {
+		err = subscriber.CreateRequest(c.tx, models.NodeRequestTypeInvokeAction, models.NodeExecutionRequestSpec{
+			InvokeAction: &models.InvokeAction{
+				ActionName: onevent.ReceiveActionName,
+				Parameters: parameters,
+			},
+		}, &now)
+
+		if err != nil {
+			return 0, fmt.Errorf("error delivering event to node %s: %w", subscriber.NodeID, err)
+		}
+	}
+
+	return len(subscribers), nil
+}
@@ -0,0 +1,80 @@
+package contexts
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/triggers/onevent"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__EventBusContext__Publish(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	subscriber := func(nodeID, topic string) models.CanvasNode {
+		return models.CanvasNode{
+			NodeID:        nodeID,
+			Name:          nodeID,
+			Type:          models.NodeTypeTrigger,
+			Ref:           datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: onevent.TriggerName}}),
+			Configuration: datatypes.NewJSONType(map[string]any{"topic": topic}),
+		}
+	}
+
+	publisher, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{
+		{
+			NodeID:        "publish",
+			Name:          "publish",
+			Type:          models.NodeTypeComponent,
+			Ref:           datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "publishEvent"}}),
+			Configuration: datatypes.NewJSONType(map[string]any{}),
+		},
+	}, nil)
+
+	deployAPI, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{subscriber("on-release", "releases")}, nil)
+	deployWorker, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{subscriber("on-release", "releases")}, nil)
+	other, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{subscriber("on-incident", "incidents")}, nil)
+
+	rootEvent := support.EmitCanvasEventForNode(t, publisher.ID, "publish", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, publisher.ID, "publish", rootEvent.ID, rootEvent.ID, nil)
+
+	t.Run("invalid topic", func(t *testing.T) {
+		ctx := NewEventBusContext(database.Conn(), r.Organization.ID, execution)
+		_, err := ctx.Publish("not a topic", "release.created", map[string]any{})
+		require.ErrorContains(t, err, "invalid topic")
+	})
+
+	t.Run("no subscribers", func(t *testing.T) {
+		ctx := NewEventBusContext(database.Conn(), r.Organization.ID, execution)
+		count, err := ctx.Publish("deployments", "deployment.created", map[string]any{})
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("event is delivered to all subscribers", func(t *testing.T) {
+		ctx := NewEventBusContext(database.Conn(), r.Organization.ID, execution)
+		count, err := ctx.Publish("releases", "release.created", map[string]any{"version": "v1.0.0"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		for _, canvasID := range []uuid.UUID{deployAPI.ID, deployWorker.ID} {
+			request, err := models.FindPendingRequestForNode(database.Conn(), canvasID, "on-release")
+			require.NoError(t, err)
+
+			spec := request.Spec.Data()
+			require.NotNil(t, spec.InvokeAction)
+			assert.Equal(t, onevent.ReceiveActionName, spec.InvokeAction.ActionName)
+			assert.Equal(t, "releases", spec.InvokeAction.Parameters["topic"])
+			assert.Equal(t, "release.created", spec.InvokeAction.Parameters["type"])
+		}
+
+		_, err = models.FindPendingRequestForNode(database.Conn(), other.ID, "on-incident")
+		require.Error(t, err)
+	})
+}
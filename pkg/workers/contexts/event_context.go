@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/quotas"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
@@ -37,6 +38,10 @@ func (s *EventContext) Emit(payloadType string, payload any) error {
 		return fmt.Errorf("event payload too large: %d bytes (max %d)", len(data), s.maxPayloadSize)
 	}
 
+	if err := s.checkQuota(); err != nil {
+		return err
+	}
+
 	now := time.Now()
 
 	//
@@ -60,6 +65,15 @@ func (s *EventContext) Emit(payloadType string, payload any) error {
 	return s.tx.Create(&event).Error
 }
 
+func (s *EventContext) checkQuota() error {
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(s.tx, s.node.WorkflowID)
+	if err != nil {
+		return fmt.Errorf("failed to find canvas: %w", err)
+	}
+
+	return quotas.CheckEvents(s.tx, canvas.OrganizationID)
+}
+
 func (s *EventContext) resolveCustomName(payload any) (*string, error) {
 	config := s.node.Configuration.Data()
 	if config == nil {
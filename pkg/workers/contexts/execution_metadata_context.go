@@ -2,6 +2,7 @@ package contexts
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/datatypes"
@@ -33,8 +34,17 @@ func (m *ExecutionMetadataContext) Set(value any) error {
 		return err
 	}
 
+	//
+	// Metadata changes are the activity of an execution,
+	// e.g. a webhook or an action updating it.
+	//
+	now := time.Now()
 	m.execution.Metadata = datatypes.NewJSONType(v)
+	m.execution.LastActivityAt = &now
 	return m.tx.Model(m.execution).
-		Update("metadata", v).
+		Updates(map[string]any{
+			"metadata":         v,
+			"last_activity_at": now,
+		}).
 		Error
 }
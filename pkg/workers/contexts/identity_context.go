@@ -0,0 +1,91 @@
+package contexts
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/oidc"
+)
+
+const (
+	DefaultIdentityTokenDuration = 5 * time.Minute
+	MaxIdentityTokenDuration     = time.Hour
+)
+
+var (
+	ErrIdentityNotAvailable = errors.New("workload identity is not available")
+	ErrReservedAudience     = errors.New("audience is reserved for integration tokens")
+)
+
+// IdentityContext mints OIDC tokens identifying a node execution.
+// Tokens are signed with the same keys published in the OIDC discovery
+// endpoints, so any service trusting SuperPlane as an issuer can verify them.
+// Audiences SuperPlane uses for its own integration tokens are refused, and
+// tokens carry token_use=workload, so they can't stand in for integration tokens.
+type IdentityContext struct {
+	provider       oidc.Provider
+	organizationID string
+	canvasID       string
+	nodeID         string
+	executionID    string
+}
+
+// NewIdentityContext returns an IdentityContext for the given execution.
+func NewIdentityContext(provider oidc.Provider, organizationID uuid.UUID, execution *models.CanvasNodeExecution) *IdentityContext {
+	return &IdentityContext{
+		provider:       provider,
+		organizationID: organizationID.String(),
+		canvasID:       execution.WorkflowID.String(),
+		nodeID:         execution.NodeID,
+		executionID:    execution.ID.String(),
+	}
+}
+
+// Token implements core.IdentityContext.
+func (c *IdentityContext) Token(audience string, duration time.Duration) (string, error) {
+	if c.provider == nil {
+		return "", ErrIdentityNotAvailable
+	}
+
+	audience = strings.TrimSpace(audience)
+	if audience == "" {
+		return "", errors.New("audience is required")
+	}
+
+	if isReservedAudience(audience) {
+		return "", ErrReservedAudience
+	}
+
+	if duration <= 0 {
+		duration = DefaultIdentityTokenDuration
+	}
+
+	if duration > MaxIdentityTokenDuration {
+		return "", fmt.Errorf("token duration cannot be longer than %s", MaxIdentityTokenDuration)
+	}
+
+	subject := fmt.Sprintf("canvas:%s:node:%s", c.canvasID, c.nodeID)
+	return c.provider.Sign(subject, duration, audience, map[string]any{
+		oidc.TokenUseClaim: oidc.TokenUseWorkload,
+		"organization_id":  c.organizationID,
+		"canvas_id":        c.canvasID,
+		"node_id":          c.nodeID,
+		"execution_id":     c.executionID,
+	})
+}
+
+// isReservedAudience reports whether the audience could be mistaken for
+// one of the audiences used by integration tokens: AWS integrations use
+// the integration ID, and GCP integrations use the workload identity
+// pool provider resource name.
+func isReservedAudience(audience string) bool {
+	if _, err := uuid.Parse(audience); err == nil {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(audience), "workloadidentitypools/")
+}
@@ -0,0 +1,91 @@
+package contexts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/oidc"
+)
+
+type signRequest struct {
+	subject  string
+	duration time.Duration
+	audience string
+	claims   map[string]any
+}
+
+type recordingOIDCProvider struct {
+	requests []signRequest
+}
+
+func (p *recordingOIDCProvider) PublicJWKs() []oidc.PublicJWK {
+	return nil
+}
+
+func (p *recordingOIDCProvider) Sign(subject string, duration time.Duration, audience string, additionalClaims map[string]any) (string, error) {
+	p.requests = append(p.requests, signRequest{subject: subject, duration: duration, audience: audience, claims: additionalClaims})
+	return "token", nil
+}
+
+func Test__IdentityContext(t *testing.T) {
+	organizationID := uuid.New()
+	execution := &models.CanvasNodeExecution{
+		ID:         uuid.New(),
+		WorkflowID: uuid.New(),
+		NodeID:     "deploy",
+	}
+
+	t.Run("token includes execution claims", func(t *testing.T) {
+		provider := &recordingOIDCProvider{}
+		token, err := NewIdentityContext(provider, organizationID, execution).Token(" https://vault.example.com ", 0)
+		require.NoError(t, err)
+		assert.Equal(t, "token", token)
+
+		require.Len(t, provider.requests, 1)
+		request := provider.requests[0]
+		assert.Equal(t, "canvas:"+execution.WorkflowID.String()+":node:deploy", request.subject)
+		assert.Equal(t, "https://vault.example.com", request.audience)
+		assert.Equal(t, DefaultIdentityTokenDuration, request.duration)
+		assert.Equal(t, map[string]any{
+			"token_use":       "workload",
+			"organization_id": organizationID.String(),
+			"canvas_id":       execution.WorkflowID.String(),
+			"node_id":         "deploy",
+			"execution_id":    execution.ID.String(),
+		}, request.claims)
+	})
+
+	t.Run("audience is required", func(t *testing.T) {
+		_, err := NewIdentityContext(&recordingOIDCProvider{}, organizationID, execution).Token("", 0)
+		require.ErrorContains(t, err, "audience is required")
+	})
+
+	t.Run("integration ID audience is refused", func(t *testing.T) {
+		provider := &recordingOIDCProvider{}
+		_, err := NewIdentityContext(provider, organizationID, execution).Token(uuid.NewString(), 0)
+		require.ErrorIs(t, err, ErrReservedAudience)
+		assert.Empty(t, provider.requests)
+	})
+
+	t.Run("workload identity provider audience is refused", func(t *testing.T) {
+		provider := &recordingOIDCProvider{}
+		audience := "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/superplane"
+		_, err := NewIdentityContext(provider, organizationID, execution).Token(audience, 0)
+		require.ErrorIs(t, err, ErrReservedAudience)
+		assert.Empty(t, provider.requests)
+	})
+
+	t.Run("duration is limited", func(t *testing.T) {
+		_, err := NewIdentityContext(&recordingOIDCProvider{}, organizationID, execution).Token("vault", 2*time.Hour)
+		require.ErrorContains(t, err, "cannot be longer than")
+	})
+
+	t.Run("provider is required", func(t *testing.T) {
+		_, err := NewIdentityContext(nil, organizationID, execution).Token("vault", 0)
+		require.ErrorIs(t, err, ErrIdentityNotAvailable)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/quotas"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
@@ -85,6 +86,19 @@ func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *model
 	}
 
 	ctx.CreateExecution = func() (*core.ExecutionContext, error) {
+		canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, node.WorkflowID)
+		if err != nil {
+			return nil, err
+		}
+
+		//
+		// If the organization has reached its executions quota,
+		// the queue item is kept, and processed once usage goes down.
+		//
+		if err := quotas.CheckExecutions(tx, canvas.OrganizationID); err != nil {
+			return nil, err
+		}
+
 		now := time.Now()
 
 		execution := models.CanvasNodeExecution{
@@ -110,7 +124,7 @@ func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *model
 			}
 		}
 
-		err := tx.Create(&execution).Error
+		err = tx.Create(&execution).Error
 		if err != nil {
 			return nil, err
 		}
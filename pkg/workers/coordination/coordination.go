@@ -0,0 +1,243 @@
+package coordination
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+/*
+ * Every replica runs the same workers, and the coordinator keeps them out of each other's way:
+ *
+ *   - Singleton workers only run on the replica holding their leader lock.
+ *   - Canvases are split into shards, and each replica only processes
+ *     the pending work of the canvases in the shards it owns.
+ *   - Workers are woken up by Postgres notifications when new work is created,
+ *     so they don't need to poll the database every second.
+ *
+ * Leadership and shard ownership are Postgres session-level advisory locks,
+ * held by a connection dedicated to the coordinator, so they are released
+ * as soon as a replica goes away.
+ *
+ * Sharding only distributes the load. Pending work is still locked with SKIP LOCKED
+ * when processed, so a shard moving between replicas never processes anything twice.
+ */
+
+const (
+	NotificationChannel = "superplane_workers"
+	DefaultShardCount   = 32
+
+	//
+	// With notifications, polling is only a fallback for the work
+	// that becomes ready without one, or for notifications lost while reconnecting.
+	//
+	IdlePollInterval = 5 * time.Second
+
+	syncInterval = 5 * time.Second
+)
+
+// Two-key advisory locks are used, so the first key
+// keeps the coordinator locks apart from any other advisory lock.
+const (
+	leaderLockSpace = 73501
+	memberLockSpace = 73502
+	shardLockSpace  = 73503
+)
+
+var current *Coordinator
+
+type Coordinator struct {
+	dsn        string
+	shardCount int
+	logger     *log.Entry
+
+	mu      sync.Mutex
+	conn    *pgx.Conn
+	shards  []int
+	leaders map[string]context.CancelFunc
+
+	subscribersMu sync.Mutex
+	subscribers   map[string][]chan struct{}
+}
+
+func Enabled() bool {
+	return os.Getenv("WORKER_COORDINATION") == "yes"
+}
+
+func ShardCount() int {
+	count, err := strconv.Atoi(os.Getenv("WORKER_SHARD_COUNT"))
+	if err != nil || count <= 0 {
+		return DefaultShardCount
+	}
+
+	return count
+}
+
+func NewCoordinator(dsn string, shardCount int) *Coordinator {
+	return &Coordinator{
+		dsn:         dsn,
+		shardCount:  shardCount,
+		leaders:     map[string]context.CancelFunc{},
+		subscribers: map[string][]chan struct{}{},
+		logger:      log.WithFields(log.Fields{"worker": "Coordinator"}),
+	}
+}
+
+// Start coordinates the workers of this replica with the other replicas.
+// It must be called before the workers are started.
+func Start(ctx context.Context) {
+	current = NewCoordinator(database.DSN(), ShardCount())
+	go current.run(ctx)
+	go current.listen(ctx)
+}
+
+// CanvasShards returns the shards owned by this replica,
+// or nil if the workers are not coordinated and should process every canvas.
+func CanvasShards() *models.CanvasShards {
+	if current == nil {
+		return nil
+	}
+
+	return current.CanvasShards()
+}
+
+// PollInterval returns how often workers woken up by notifications should still poll.
+func PollInterval() time.Duration {
+	if current == nil {
+		return time.Second
+	}
+
+	return IdlePollInterval
+}
+
+func (c *Coordinator) CanvasShards() *models.CanvasShards {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &models.CanvasShards{
+		Count: c.shardCount,
+		Owned: slices.Clone(c.shards),
+	}
+}
+
+func (c *Coordinator) run(ctx context.Context) {
+	ticker := time.NewTicker(syncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.sync(ctx); err != nil {
+			c.logger.Errorf("Error coordinating with other replicas: %v", err)
+			c.disconnect()
+		}
+
+		select {
+		case <-ctx.Done():
+			c.disconnect()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+/*
+ * sync connects to the database if needed, registering this replica as a member,
+ * and rebalances the shards, so every member owns about the same number of them.
+ * Shards above the target are released first, and claimed by other members on their next sync.
+ */
+func (c *Coordinator) sync(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		conn, err := pgx.Connect(ctx, c.dsn)
+		if err != nil {
+			return err
+		}
+
+		c.conn = conn
+		_, err = conn.Exec(ctx, "SELECT pg_advisory_lock($1, pg_backend_pid())", memberLockSpace)
+		if err != nil {
+			return err
+		}
+	}
+
+	var members int
+	err := c.conn.QueryRow(ctx, `
+		SELECT count(*) FROM pg_locks
+		WHERE locktype = 'advisory'
+		  AND granted
+		  AND objsubid = 2
+		  AND classid::bigint = $1
+		  AND database = (SELECT oid FROM pg_database WHERE datname = current_database())
+	`, memberLockSpace).Scan(&members)
+
+	if err != nil {
+		return err
+	}
+
+	target := ShardTarget(c.shardCount, members)
+	for len(c.shards) > target {
+		shard := c.shards[len(c.shards)-1]
+		_, err := c.conn.Exec(ctx, "SELECT pg_advisory_unlock($1, $2)", shardLockSpace, shard)
+		if err != nil {
+			return err
+		}
+
+		c.shards = c.shards[:len(c.shards)-1]
+	}
+
+	for shard := 0; shard < c.shardCount && len(c.shards) < target; shard++ {
+		if slices.Contains(c.shards, shard) {
+			continue
+		}
+
+		var locked bool
+		err := c.conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1, $2)", shardLockSpace, shard).Scan(&locked)
+		if err != nil {
+			return err
+		}
+
+		if locked {
+			c.shards = append(c.shards, shard)
+		}
+	}
+
+	return nil
+}
+
+// ShardTarget is the number of shards each member should own.
+func ShardTarget(shardCount, members int) int {
+	if members <= 0 {
+		return shardCount
+	}
+
+	return (shardCount + members - 1) / members
+}
+
+/*
+ * Closing the connection releases all the locks held by it,
+ * so this replica is no longer the leader of anything, and owns no shards.
+ */
+func (c *Coordinator) disconnect() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, cancel := range c.leaders {
+		cancel()
+		delete(c.leaders, name)
+	}
+
+	c.shards = nil
+	if c.conn != nil {
+		_ = c.conn.Close(context.Background())
+		c.conn = nil
+	}
+}
@@ -0,0 +1,93 @@
+package coordination
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+func Test__ShardTarget(t *testing.T) {
+	assert.Equal(t, 32, ShardTarget(32, 0))
+	assert.Equal(t, 32, ShardTarget(32, 1))
+	assert.Equal(t, 16, ShardTarget(32, 2))
+	assert.Equal(t, 11, ShardTarget(32, 3))
+	assert.Equal(t, 1, ShardTarget(4, 8))
+}
+
+func Test__Subscribe(t *testing.T) {
+	c := NewCoordinator("", DefaultShardCount)
+	executions := c.Subscribe("workflow_node_executions")
+	queue := c.Subscribe("workflow_node_queue_items", "workflow_nodes")
+
+	//
+	// Notifications received while the subscriber is busy are coalesced.
+	//
+	c.notify("workflow_node_executions")
+	c.notify("workflow_node_executions")
+	c.notify("workflow_nodes")
+
+	assert.Len(t, executions, 1)
+	assert.Len(t, queue, 1)
+
+	<-executions
+	<-queue
+	c.notify("workflow_events")
+	assert.Len(t, executions, 0)
+	assert.Len(t, queue, 0)
+}
+
+func Test__Wakeups(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wakeups := Wakeups(ctx, 10*time.Millisecond, "workflow_events")
+	select {
+	case <-wakeups:
+	case <-time.After(time.Second):
+		t.Fatal("expected a wakeup")
+	}
+}
+
+func Test__Coordinator(t *testing.T) {
+	ctx := context.Background()
+	first := NewCoordinator(database.DSN(), 8)
+	second := NewCoordinator(database.DSN(), 8)
+	defer first.disconnect()
+	defer second.disconnect()
+
+	t.Run("shards are split between members", func(t *testing.T) {
+		require.NoError(t, first.sync(ctx))
+		assert.Len(t, first.CanvasShards().Owned, 8)
+
+		require.NoError(t, second.sync(ctx))
+		require.NoError(t, first.sync(ctx))
+		require.NoError(t, second.sync(ctx))
+
+		assert.Len(t, first.CanvasShards().Owned, 4)
+		assert.Len(t, second.CanvasShards().Owned, 4)
+		assert.NotContains(t, first.CanvasShards().Owned, second.CanvasShards().Owned[0])
+	})
+
+	t.Run("only one member leads", func(t *testing.T) {
+		leaderCtx, ok := first.lead(ctx, "test-worker")
+		require.True(t, ok)
+
+		_, ok = second.lead(ctx, "test-worker")
+		require.False(t, ok)
+
+		//
+		// Losing the connection cancels the leadership.
+		//
+		first.disconnect()
+		assert.Error(t, leaderCtx.Err())
+		assert.Empty(t, first.CanvasShards().Owned)
+
+		_, ok = second.lead(ctx, "test-worker")
+		require.True(t, ok)
+		second.resign("test-worker")
+	})
+}
@@ -0,0 +1,86 @@
+package coordination
+
+import (
+	"context"
+	"time"
+)
+
+// RunAsLeader runs a singleton worker only while this replica is its leader.
+// If leadership is lost, the context given to run is canceled,
+// and this replica tries to become the leader again.
+// If the workers are not coordinated, run is called right away.
+func RunAsLeader(ctx context.Context, name string, run func(context.Context)) {
+	if current == nil {
+		run(ctx)
+		return
+	}
+
+	current.RunAsLeader(ctx, name, run)
+}
+
+func (c *Coordinator) RunAsLeader(ctx context.Context, name string, run func(context.Context)) {
+	ticker := time.NewTicker(syncInterval)
+	defer ticker.Stop()
+
+	for {
+		leaderCtx, ok := c.lead(ctx, name)
+		if ok {
+			c.logger.Infof("Leading %s", name)
+			run(leaderCtx)
+			c.resign(name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Coordinator) lead(ctx context.Context, name string) (context.Context, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return nil, false
+	}
+
+	var locked bool
+	err := c.conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1, hashtext($2))", leaderLockSpace, name).Scan(&locked)
+	if err != nil {
+		c.logger.Errorf("Error trying to lead %s: %v", name, err)
+		return nil, false
+	}
+
+	if !locked {
+		return nil, false
+	}
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	c.leaders[name] = cancel
+	return leaderCtx, true
+}
+
+func (c *Coordinator) resign(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	//
+	// If the connection was lost, the lock is already gone.
+	//
+	cancel, ok := c.leaders[name]
+	if !ok {
+		return
+	}
+
+	cancel()
+	delete(c.leaders, name)
+
+	if c.conn != nil {
+		_, err := c.conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1, hashtext($2))", leaderLockSpace, name)
+		if err != nil {
+			c.logger.Errorf("Error resigning as leader of %s: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package coordination
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+/*
+ * Database triggers notify NotificationChannel when new work is created,
+ * with the name of the table where it was created as the payload.
+ * Notifications are only delivered when the transaction creating the work commits.
+ */
+
+// Wakeups returns a channel that receives whenever a worker should look for pending work:
+// every interval, and whenever new work is created in one of the given tables.
+func Wakeups(ctx context.Context, interval time.Duration, tables ...string) <-chan struct{} {
+	var notifications <-chan struct{}
+	if current != nil {
+		notifications = current.Subscribe(tables...)
+	}
+
+	wakeups := make(chan struct{}, 1)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-notifications:
+			}
+
+			select {
+			case wakeups <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	return wakeups
+}
+
+// Subscribe returns a channel that receives when new work is created in one of the given tables.
+// Notifications received while the subscriber is busy are coalesced into one.
+func (c *Coordinator) Subscribe(tables ...string) <-chan struct{} {
+	c.subscribersMu.Lock()
+	defer c.subscribersMu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	for _, table := range tables {
+		c.subscribers[table] = append(c.subscribers[table], ch)
+	}
+
+	return ch
+}
+
+func (c *Coordinator) notify(table string) {
+	c.subscribersMu.Lock()
+	defer c.subscribersMu.Unlock()
+
+	for _, ch := range c.subscribers[table] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (c *Coordinator) listen(ctx context.Context) {
+	for {
+		err := c.waitForNotifications(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.logger.Errorf("Error listening for notifications: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(syncInterval):
+		}
+	}
+}
+
+func (c *Coordinator) waitForNotifications(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, c.dsn)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+NotificationChannel)
+	if err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.notify(notification.Payload)
+	}
+}
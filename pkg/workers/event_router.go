@@ -14,6 +14,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/telemetry"
+	"github.com/superplanehq/superplane/pkg/workers/coordination"
 )
 
 type EventRouter struct {
@@ -29,17 +30,16 @@ func NewEventRouter() *EventRouter {
 }
 
 func (w *EventRouter) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
+	wakeups := coordination.Wakeups(ctx, coordination.PollInterval(), "workflow_events")
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
+		case <-wakeups:
 			tickStart := time.Now()
 
-			events, err := models.ListPendingCanvasEvents()
+			events, err := models.ListPendingCanvasEvents(coordination.CanvasShards(), schedulingBatchSize)
 			if err != nil {
 				w.logger.Errorf("Error finding canvas nodes ready to be processed: %v", err)
 			}
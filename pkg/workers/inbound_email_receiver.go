@@ -0,0 +1,174 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/services"
+	"github.com/superplanehq/superplane/pkg/triggers/email"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+/*
+ * InboundEmailReceiver runs the SMTP receiver for the email trigger.
+ * Each email trigger node has its own address, <webhook-id>@<domain>,
+ * and received messages are delivered to the nodes using that webhook,
+ * signed with the webhook secret, the same way HTTP webhooks are.
+ */
+type InboundEmailReceiver struct {
+	addr           string
+	domain         string
+	encryptor      crypto.Encryptor
+	registry       *registry.Registry
+	webhookBaseURL string
+}
+
+func NewInboundEmailReceiver(addr, domain string, encryptor crypto.Encryptor, registry *registry.Registry, webhookBaseURL string) *InboundEmailReceiver {
+	return &InboundEmailReceiver{
+		addr:           addr,
+		domain:         strings.ToLower(domain),
+		encryptor:      encryptor,
+		registry:       registry,
+		webhookBaseURL: webhookBaseURL,
+	}
+}
+
+func (w *InboundEmailReceiver) Start(ctx context.Context) {
+	receiver := services.NewSMTPReceiver(w.domain, w.acceptRecipient, w.handleMessage)
+
+	go func() {
+		<-ctx.Done()
+		_ = receiver.Close()
+	}()
+
+	w.log("Listening on %s for %s", w.addr, w.domain)
+	err := receiver.ListenAndServe(w.addr)
+	if err != nil {
+		w.log("Error running SMTP receiver: %v", err)
+	}
+}
+
+func (w *InboundEmailReceiver) acceptRecipient(address string) error {
+	nodes, err := w.findNodes(address)
+	if err != nil {
+		return err
+	}
+
+	if len(nodes) == 0 {
+		return services.ErrRecipientNotFound
+	}
+
+	return nil
+}
+
+func (w *InboundEmailReceiver) handleMessage(message *services.InboundMessage) error {
+	for _, recipient := range message.To {
+		nodes, err := w.findNodes(recipient)
+		if err != nil {
+			return err
+		}
+
+		for _, node := range nodes {
+			code, err := w.deliver(message, recipient, node)
+			if err == nil {
+				continue
+			}
+
+			//
+			// Client errors mean the message will never be accepted,
+			// so there is no point in asking the sender to retry.
+			//
+			if code < http.StatusInternalServerError {
+				w.log("Message for node %s rejected: %v", node.NodeID, err)
+				continue
+			}
+
+			return fmt.Errorf("error delivering message to node %s: %w", node.NodeID, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *InboundEmailReceiver) findNodes(address string) ([]models.CanvasNode, error) {
+	webhookID, ok := w.webhookID(address)
+	if !ok {
+		return nil, services.ErrRecipientNotFound
+	}
+
+	nodes, err := models.FindWebhookNodes(webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	emailNodes := []models.CanvasNode{}
+	for _, node := range nodes {
+		ref := node.Ref.Data()
+		if node.Type == models.NodeTypeTrigger && ref.Trigger != nil && ref.Trigger.Name == "email" {
+			emailNodes = append(emailNodes, node)
+		}
+	}
+
+	return emailNodes, nil
+}
+
+func (w *InboundEmailReceiver) webhookID(address string) (uuid.UUID, bool) {
+	local, domain, ok := strings.Cut(strings.ToLower(address), "@")
+	if !ok || domain != w.domain {
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(local)
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+func (w *InboundEmailReceiver) deliver(message *services.InboundMessage, recipient string, node models.CanvasNode) (int, error) {
+	trigger, err := w.registry.GetTrigger(node.Ref.Data().Trigger.Name)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("trigger not found: %w", err)
+	}
+
+	tx := database.Conn()
+	webhookCtx := contexts.NewNodeWebhookContext(context.Background(), tx, w.encryptor, &node, w.webhookBaseURL)
+	secret, err := webhookCtx.GetSecret()
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("error getting webhook secret: %w", err)
+	}
+
+	headers := http.Header{}
+	headers.Set(email.SignatureHeader, crypto.Sign(secret, message.Data))
+	headers.Set(email.EnvelopeFromHeader, message.From)
+	headers.Set(email.EnvelopeToHeader, recipient)
+
+	return trigger.HandleWebhook(core.WebhookRequestContext{
+		Body:          message.Data,
+		Headers:       headers,
+		WorkflowID:    node.WorkflowID.String(),
+		NodeID:        node.NodeID,
+		Configuration: node.Configuration.Data(),
+		Metadata:      contexts.NewNodeMetadataContext(tx, &node),
+		Logger:        logging.ForNode(node),
+		HTTP:          w.registry.HTTPContext(),
+		Webhook:       webhookCtx,
+		Events:        contexts.NewEventContext(tx, &node),
+	})
+}
+
+func (w *InboundEmailReceiver) log(format string, v ...any) {
+	log.Printf("[InboundEmailReceiver] "+format, v...)
+}
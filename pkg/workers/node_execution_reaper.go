@@ -0,0 +1,126 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	// A heartbeat not refreshed for this long belongs to a worker that went away.
+	nodeExecutionHeartbeatStaleAfter = 6 * nodeExecutionHeartbeatInterval
+
+	nodeExecutionReaperInterval = 30 * time.Second
+	nodeExecutionReaperBatch    = 100
+)
+
+/*
+ * NodeExecutionReaper looks for executions whose processing was orphaned,
+ * because the worker processing them went away without draining them.
+ *
+ * Since executions are started in the same transaction that runs their component,
+ * an orphaned execution is rolled back to pending, and is already queued to be processed again.
+ * If it was interrupted too many times, it is failed instead, since it is likely
+ * the execution itself is taking its workers down.
+ */
+type NodeExecutionReaper struct {
+	logger *log.Entry
+}
+
+func NewNodeExecutionReaper() *NodeExecutionReaper {
+	return &NodeExecutionReaper{
+		logger: log.WithFields(log.Fields{"worker": "NodeExecutionReaper"}),
+	}
+}
+
+func (w *NodeExecutionReaper) Start(ctx context.Context) {
+	ticker := time.NewTicker(nodeExecutionReaperInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Tick(); err != nil {
+				w.logger.Errorf("Error reaping node executions: %v", err)
+			}
+		}
+	}
+}
+
+func (w *NodeExecutionReaper) Tick() error {
+	heartbeats, err := models.ListStaleNodeExecutionHeartbeats(time.Now().Add(-nodeExecutionHeartbeatStaleAfter), nodeExecutionReaperBatch)
+	if err != nil {
+		return fmt.Errorf("error listing stale heartbeats: %w", err)
+	}
+
+	for _, heartbeat := range heartbeats {
+		if err := w.reap(heartbeat); err != nil {
+			w.logger.Errorf("Error reaping execution %s: %v", heartbeat.ExecutionID, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *NodeExecutionReaper) reap(heartbeat models.CanvasNodeExecutionHeartbeat) error {
+	var failed *models.CanvasNodeExecution
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var execution models.CanvasNodeExecution
+		err := tx.Where("id = ?", heartbeat.ExecutionID).First(&execution).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.DeleteNodeExecutionHeartbeatInTransaction(tx, heartbeat.ExecutionID)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		//
+		// If we can't lock the execution, a worker is processing it again,
+		// and will take care of the heartbeat.
+		//
+		locked, err := models.LockCanvasNodeExecution(tx, execution.ID)
+		if err != nil {
+			return nil
+		}
+
+		if locked.State != models.CanvasNodeExecutionStatePending {
+			return models.DeleteNodeExecutionHeartbeatInTransaction(tx, heartbeat.ExecutionID)
+		}
+
+		interrupted, err := locked.FailIfInterruptedTooOften(tx, heartbeat.Attempts)
+		if err != nil {
+			return err
+		}
+
+		if !interrupted {
+			w.logger.Infof("Execution %s was interrupted on %s - it will be processed again", locked.ID, heartbeat.Worker)
+			return nil
+		}
+
+		w.logger.Warnf("Execution %s was interrupted %d times - failed it", locked.ID, heartbeat.Attempts)
+		failed = locked
+		return models.DeleteNodeExecutionHeartbeatInTransaction(tx, heartbeat.ExecutionID)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if failed != nil {
+		messages.NewCanvasExecutionMessage(failed.WorkflowID.String(), failed.ID.String(), failed.NodeID).Publish()
+	}
+
+	return nil
+}
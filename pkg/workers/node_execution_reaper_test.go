@@ -0,0 +1,106 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__NodeExecutionReaper(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: "component-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "component-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	reaper := NewNodeExecutionReaper()
+
+	//
+	// Simulates a worker going away while processing the execution, attempts times.
+	//
+	interrupt := func(executionID uuid.UUID, attempts int) {
+		for range attempts {
+			_, err := models.StartNodeExecutionHeartbeat(executionID, "gone")
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, database.Conn().
+			Model(&models.CanvasNodeExecutionHeartbeat{}).
+			Where("execution_id = ?", executionID).
+			Update("heartbeat_at", time.Now().Add(-time.Hour)).
+			Error)
+	}
+
+	countHeartbeats := func(executionID uuid.UUID) int64 {
+		var count int64
+		require.NoError(t, database.Conn().Model(&models.CanvasNodeExecutionHeartbeat{}).Where("execution_id = ?", executionID).Count(&count).Error)
+		return count
+	}
+
+	t.Run("interrupted execution is processed again", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+		interrupt(execution.ID, 1)
+		require.NoError(t, reaper.Tick())
+
+		updatedExecution, err := models.FindNodeExecution(canvas.ID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStatePending, updatedExecution.State)
+		assert.Equal(t, int64(1), countHeartbeats(execution.ID))
+	})
+
+	t.Run("execution interrupted too many times is failed", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+		interrupt(execution.ID, models.MaxNodeExecutionAttempts)
+		require.NoError(t, reaper.Tick())
+
+		updatedExecution, err := models.FindNodeExecution(canvas.ID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, updatedExecution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultFailed, updatedExecution.Result)
+		assert.Zero(t, countHeartbeats(execution.ID))
+	})
+
+	t.Run("heartbeat of processed execution is deleted", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+		require.NoError(t, execution.Start())
+		interrupt(execution.ID, 1)
+		require.NoError(t, reaper.Tick())
+
+		updatedExecution, err := models.FindNodeExecution(canvas.ID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateStarted, updatedExecution.State)
+		assert.Zero(t, countHeartbeats(execution.ID))
+	})
+
+	t.Run("heartbeat of deleted execution is deleted", func(t *testing.T) {
+		executionID := uuid.New()
+		interrupt(executionID, 1)
+		require.NoError(t, reaper.Tick())
+		assert.Zero(t, countHeartbeats(executionID))
+	})
+}
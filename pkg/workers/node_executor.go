@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
 	"time"
 
 	"golang.org/x/sync/semaphore"
@@ -19,45 +21,102 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"github.com/superplanehq/superplane/pkg/workers/coordination"
 )
 
 var ErrRecordLocked = errors.New("record locked")
 
+// Pending work is listed in a fair order across organizations and canvases.
+// Workers only pick up a bounded batch on every tick, so work arriving
+// in the meantime competes with the work that is already pending.
+const schedulingBatchSize = 250
+
+const (
+	maxConcurrentExecutions = 25
+
+	//
+	// On shutdown, in-flight executions are given some time to finish,
+	// before they are interrupted. It should be shorter than the time
+	// the process is given to exit, so interrupted executions are rolled back cleanly.
+	//
+	DefaultDrainTimeout = 20 * time.Second
+
+	// Heartbeats are refreshed while an execution is being processed.
+	nodeExecutionHeartbeatInterval = 10 * time.Second
+)
+
 type NodeExecutor struct {
 	encryptor      crypto.Encryptor
 	registry       *registry.Registry
+	oidcProvider   oidc.Provider
 	baseURL        string
 	webhookBaseURL string
 	semaphore      *semaphore.Weighted
+	drainTimeout   time.Duration
+	worker         string
 	logger         *logrus.Entry
 }
 
-func NewNodeExecutor(encryptor crypto.Encryptor, registry *registry.Registry, baseURL string, webhookBaseURL string) *NodeExecutor {
+func NewNodeExecutor(encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, webhookBaseURL string) *NodeExecutor {
 	return &NodeExecutor{
 		encryptor:      encryptor,
 		registry:       registry,
+		oidcProvider:   oidcProvider,
 		baseURL:        baseURL,
 		webhookBaseURL: webhookBaseURL,
-		semaphore:      semaphore.NewWeighted(25),
+		semaphore:      semaphore.NewWeighted(maxConcurrentExecutions),
+		drainTimeout:   DrainTimeout(),
+		worker:         workerName(),
 		logger:         logrus.WithFields(logrus.Fields{"worker": "NodeExecutor"}),
 	}
 }
 
+// DrainTimeout is how long in-flight executions are given to finish on shutdown.
+func DrainTimeout() time.Duration {
+	seconds, err := strconv.Atoi(os.Getenv("WORKER_DRAIN_TIMEOUT_SECONDS"))
+	if err != nil || seconds < 0 {
+		return DefaultDrainTimeout
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+func workerName() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return fmt.Sprintf("%s/%d", hostname, os.Getpid())
+}
+
+/*
+ * Start processes pending executions until ctx is canceled.
+ * Then, it stops picking up new executions, and drains the in-flight ones before returning.
+ *
+ * In-flight executions run with their own context, which is only canceled
+ * if they don't finish before the drain timeout. Interrupted executions are rolled back
+ * to pending, so they are processed again by another worker.
+ */
 func (w *NodeExecutor) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
+	executionCtx, cancelExecutions := context.WithCancel(context.Background())
+	defer cancelExecutions()
+
+	wakeups := coordination.Wakeups(ctx, coordination.PollInterval(), "workflow_node_executions")
 
 	for {
 		select {
 		case <-ctx.Done():
+			w.drain(cancelExecutions)
 			return
-		case <-ticker.C:
+		case <-wakeups:
 			tickStart := time.Now()
 
-			executions, err := models.ListPendingNodeExecutions()
+			executions, err := models.ListPendingNodeExecutions(coordination.CanvasShards(), schedulingBatchSize)
 			if err != nil {
 				w.logger.Errorf("Error finding workflow nodes ready to be processed: %v", err)
 			}
@@ -65,9 +124,12 @@ func (w *NodeExecutor) Start(ctx context.Context) {
 			telemetry.RecordExecutorWorkerNodesCount(context.Background(), len(executions))
 
 			for _, execution := range executions {
-				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
-					w.logger.Errorf("Error acquiring semaphore: %v", err)
-					continue
+				if err := w.semaphore.Acquire(ctx, 1); err != nil {
+					if ctx.Err() == nil {
+						w.logger.Errorf("Error acquiring semaphore: %v", err)
+					}
+
+					break
 				}
 
 				messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).Publish()
@@ -75,7 +137,7 @@ func (w *NodeExecutor) Start(ctx context.Context) {
 				go func(execution models.CanvasNodeExecution) {
 					defer w.semaphore.Release(1)
 
-					err := w.LockAndProcessNodeExecution(execution.ID)
+					err := w.LockAndProcessNodeExecution(executionCtx, execution.ID)
 					if err == nil {
 						messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).Publish()
 						return
@@ -94,8 +156,44 @@ func (w *NodeExecutor) Start(ctx context.Context) {
 	}
 }
 
-func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
-	return database.Conn().Transaction(func(tx *gorm.DB) error {
+// drain waits for the in-flight executions to finish,
+// interrupting them if they don't finish before the drain timeout.
+func (w *NodeExecutor) drain(cancelExecutions context.CancelFunc) {
+	w.logger.Infof("Draining in-flight executions")
+
+	drainCtx, cancel := context.WithTimeout(context.Background(), w.drainTimeout)
+	defer cancel()
+
+	if err := w.semaphore.Acquire(drainCtx, maxConcurrentExecutions); err == nil {
+		w.logger.Infof("In-flight executions drained")
+		return
+	}
+
+	w.logger.Warnf("In-flight executions did not finish in %v - interrupting them", w.drainTimeout)
+	cancelExecutions()
+
+	if err := w.semaphore.Acquire(context.Background(), maxConcurrentExecutions); err != nil {
+		w.logger.Errorf("Error waiting for interrupted executions: %v", err)
+	}
+}
+
+func (w *NodeExecutor) LockAndProcessNodeExecution(ctx context.Context, id uuid.UUID) error {
+	//
+	// The heartbeat is only deleted once processing is done,
+	// whatever the outcome, so it only outlives processing if this worker goes away.
+	//
+	heartbeating := false
+	defer func() {
+		if !heartbeating {
+			return
+		}
+
+		if err := models.DeleteNodeExecutionHeartbeat(id); err != nil {
+			w.logger.Errorf("Error deleting heartbeat for execution %s: %v", id, err)
+		}
+	}()
+
+	return database.Conn().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var execution models.CanvasNodeExecution
 
 		//
@@ -129,11 +227,55 @@ func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
 			return ErrRecordLocked
 		}
 
-		return w.processNodeExecution(tx, &execution)
+		//
+		// Heartbeats are written outside of the transaction,
+		// so they are kept even if the transaction is rolled back.
+		//
+		attempts, err := models.StartNodeExecutionHeartbeat(id, w.worker)
+		if err != nil {
+			return fmt.Errorf("error starting heartbeat: %w", err)
+		}
+
+		heartbeating = true
+		failed, err := execution.FailIfInterruptedTooOften(tx, attempts-1)
+		if err != nil {
+			return err
+		}
+
+		if failed {
+			w.logger.Warnf("Execution %s was interrupted %d times - failed it", id, attempts-1)
+			return nil
+		}
+
+		stopHeartbeat := w.keepHeartbeat(id)
+		defer stopHeartbeat()
+
+		return w.processNodeExecution(ctx, tx, &execution)
 	})
 }
 
-func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.CanvasNodeExecution) error {
+func (w *NodeExecutor) keepHeartbeat(id uuid.UUID) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(nodeExecutionHeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := models.RefreshNodeExecutionHeartbeat(id); err != nil {
+					w.logger.Errorf("Error refreshing heartbeat for execution %s: %v", id, err)
+				}
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+func (w *NodeExecutor) processNodeExecution(ctx context.Context, tx *gorm.DB, execution *models.CanvasNodeExecution) error {
 	node, err := models.FindCanvasNode(tx, execution.WorkflowID, execution.NodeID)
 	if err != nil {
 		return err
@@ -143,7 +285,7 @@ func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.Canva
 		return w.executeBlueprintNode(tx, execution, node)
 	}
 
-	return w.executeComponentNode(tx, execution, node)
+	return w.executeComponentNode(ctx, tx, execution, node)
 }
 
 func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
@@ -241,19 +383,13 @@ func (w *NodeExecutor) configurationFieldsForBlueprintNode(tx *gorm.DB, node mod
 	}
 }
 
-func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+func (w *NodeExecutor) executeComponentNode(executionCtx context.Context, tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
 	logger := logging.WithExecution(
 		logging.WithNode(w.logger, *node),
 		execution,
 		nil,
 	)
 
-	err := execution.StartInTransaction(tx)
-	if err != nil {
-		logger.Errorf("failed to start execution: %v", err)
-		return fmt.Errorf("failed to start execution: %w", err)
-	}
-
 	ref := node.Ref.Data()
 	component, err := w.registry.GetComponent(ref.Component.Name)
 	if err != nil {
@@ -261,6 +397,16 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		return fmt.Errorf("component %s not found: %w", ref.Component.Name, err)
 	}
 
+	if waitingComponent, ok := component.(core.WaitingComponent); ok {
+		execution.SetMaxWaitingTime(waitingComponent.MaxWaitingTime())
+	}
+
+	err = execution.StartInTransaction(tx)
+	if err != nil {
+		logger.Errorf("failed to start execution: %v", err)
+		return fmt.Errorf("failed to start execution: %w", err)
+	}
+
 	inputEvent, err := models.FindCanvasEventInTransaction(tx, execution.EventID)
 	if err != nil {
 		logger.Errorf("failed to find input event: %v", err)
@@ -276,6 +422,7 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 	}
 
 	ctx := core.ExecutionContext{
+		Context:        executionCtx,
 		ID:             execution.ID,
 		WorkflowID:     execution.WorkflowID.String(),
 		OrganizationID: workflow.OrganizationID.String(),
@@ -289,10 +436,12 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		NodeMetadata:   contexts.NewNodeMetadataContext(tx, node),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
-		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
+		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, workflow.ID, nil, nil),
 		Notifications:  contexts.NewNotificationContext(tx, workflow.OrganizationID, execution.WorkflowID),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Identity:       contexts.NewIdentityContext(w.oidcProvider, workflow.OrganizationID, execution),
 		CanvasMemory:   contexts.NewCanvasMemoryContext(tx, execution.WorkflowID),
+		EventBus:       contexts.NewEventBusContext(tx, workflow.OrganizationID, execution),
 		Webhook:        contexts.NewNodeWebhookContext(context.Background(), tx, w.encryptor, node, w.webhookBaseURL),
 	}
 	ctx.ExpressionEnv = func(expression string) (map[string]any, error) {
@@ -324,6 +473,15 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 
 	ctx.Logger = logger
 	if err := component.Execute(ctx); err != nil {
+		//
+		// Interrupted executions are rolled back,
+		// so they are processed again later, instead of failing.
+		//
+		if executionCtx.Err() != nil {
+			logger.Warnf("execution interrupted: %v", err)
+			return err
+		}
+
 		logger.Errorf("failed to execute component: %v", err)
 		err = execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, err.Error())
 		return err
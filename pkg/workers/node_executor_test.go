@@ -1,13 +1,19 @@
 package workers
 
 import (
+	"context"
 	"log"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
+	"golang.org/x/sync/semaphore"
 	"gorm.io/datatypes"
 )
 
@@ -56,13 +62,13 @@ func Test__NodeExecutor_PreventsConcurrentProcessing(t *testing.T) {
 	// Create two workers and have them try to process the execution concurrently.
 	//
 	go func() {
-		executor1 := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
-		results <- executor1.LockAndProcessNodeExecution(execution.ID)
+		executor1 := NewNodeExecutor(r.Encryptor, r.Registry, nil, "http://localhost", "http://localhost")
+		results <- executor1.LockAndProcessNodeExecution(context.Background(), execution.ID)
 	}()
 
 	go func() {
-		executor2 := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
-		results <- executor2.LockAndProcessNodeExecution(execution.ID)
+		executor2 := NewNodeExecutor(r.Encryptor, r.Registry, nil, "http://localhost", "http://localhost")
+		results <- executor2.LockAndProcessNodeExecution(context.Background(), execution.ID)
 	}()
 
 	// Collect results - one should succeed (return nil) and one should get ErrRecordLocked
@@ -146,8 +152,8 @@ func Test__NodeExecutor_BlueprintNodeExecution(t *testing.T) {
 	// Process the execution and verify the blueprint node creates a child execution
 	// and moves the parent execution to started state.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
-	err := executor.LockAndProcessNodeExecution(execution.ID)
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, nil, "http://localhost", "http://localhost")
+	err := executor.LockAndProcessNodeExecution(context.Background(), execution.ID)
 	require.NoError(t, err)
 
 	// Verify parent execution moved to started state
@@ -224,8 +230,8 @@ func Test__NodeExecutor_ComponentNodeWithoutStateChange(t *testing.T) {
 	// Process the execution and verify the execution is started but NOT finished.
 	// The approval component doesn't call Pass() in Execute(), so it should remain in started state.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
-	err = executor.LockAndProcessNodeExecution(execution.ID)
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, nil, "http://localhost", "http://localhost")
+	err = executor.LockAndProcessNodeExecution(context.Background(), execution.ID)
 	require.NoError(t, err)
 
 	// Verify execution moved to started state but not finished,
@@ -291,8 +297,8 @@ func Test__NodeExecutor_ComponentNodeWithStateChange(t *testing.T) {
 	// Process the execution and verify the execution is both started AND finished.
 	// The noop component calls Pass() in Execute(), which should finish the execution.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
-	err := executor.LockAndProcessNodeExecution(execution.ID)
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, nil, "http://localhost", "http://localhost")
+	err := executor.LockAndProcessNodeExecution(context.Background(), execution.ID)
 	require.NoError(t, err)
 
 	// Verify execution moved to finished state with passed result
@@ -372,8 +378,8 @@ func Test__NodeExecutor_BlueprintNodeExecutionFailsWhenConfigurationCannotBeBuil
 	// LockAndProcessNodeExecution should not return an error,
 	// since this isn't a runtime error, but a configuration error.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
-	err := executor.LockAndProcessNodeExecution(execution.ID)
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, nil, "http://localhost", "http://localhost")
+	err := executor.LockAndProcessNodeExecution(context.Background(), execution.ID)
 	require.NoError(t, err)
 
 	//
@@ -386,6 +392,192 @@ func Test__NodeExecutor_BlueprintNodeExecutionFailsWhenConfigurationCannotBeBuil
 	assert.Contains(t, failedExecution.ResultMessage, "error building configuration for execution of node")
 }
 
+func Test__NodeExecutor_FairScheduling(t *testing.T) {
+	r := support.Setup(t)
+
+	createCanvas := func(organizationID uuid.UUID) *models.Canvas {
+		canvas, _ := support.CreateCanvas(
+			t,
+			organizationID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "trigger-1",
+					Type:   models.NodeTypeTrigger,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+				},
+				{
+					NodeID: "component-1",
+					Type:   models.NodeTypeComponent,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				},
+			},
+			[]models.Edge{
+				{SourceID: "trigger-1", TargetID: "component-1", Channel: "default"},
+			},
+		)
+
+		return canvas
+	}
+
+	createExecutions := func(canvas *models.Canvas, count int) []uuid.UUID {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		ids := []uuid.UUID{}
+		for range count {
+			execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+			ids = append(ids, execution.ID)
+		}
+
+		return ids
+	}
+
+	listPending := func(limit int) []uuid.UUID {
+		executions, err := models.ListPendingNodeExecutions(nil, limit)
+		require.NoError(t, err)
+
+		ids := []uuid.UUID{}
+		for _, execution := range executions {
+			ids = append(ids, execution.ID)
+		}
+
+		return ids
+	}
+
+	//
+	// A busy canvas with a large fan-out does not delay
+	// the executions of another canvas created after it.
+	//
+	busyCanvas := createCanvas(r.Organization.ID)
+	quietCanvas := createCanvas(r.Organization.ID)
+	busy := createExecutions(busyCanvas, 3)
+	quiet := createExecutions(quietCanvas, 1)
+	assert.Equal(t, []uuid.UUID{busy[0], quiet[0]}, listPending(2))
+
+	//
+	// A canvas with a higher priority gets more turns.
+	//
+	require.NoError(t, database.Conn().Model(busyCanvas).Update("priority", models.CanvasPriorityHigh).Error)
+	assert.Equal(t, []uuid.UUID{busy[0], busy[1], quiet[0], busy[2]}, listPending(10))
+
+	//
+	// Organizations get the same number of turns, regardless of priorities.
+	//
+	otherOrganization := support.CreateOrganization(t, r, r.User)
+	other := createExecutions(createCanvas(otherOrganization.ID), 1)
+	assert.Equal(t, []uuid.UUID{busy[0], other[0]}, listPending(2))
+}
+
+func Test__NodeExecutor_Heartbeats(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: "component-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "component-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, nil, "http://localhost", "http://localhost")
+
+	countHeartbeats := func(executionID uuid.UUID) int64 {
+		var count int64
+		require.NoError(t, database.Conn().Model(&models.CanvasNodeExecutionHeartbeat{}).Where("execution_id = ?", executionID).Count(&count).Error)
+		return count
+	}
+
+	t.Run("heartbeat is deleted after processing", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+		require.NoError(t, executor.LockAndProcessNodeExecution(context.Background(), execution.ID))
+
+		updatedExecution, err := models.FindNodeExecution(canvas.ID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionResultPassed, updatedExecution.Result)
+		assert.Zero(t, countHeartbeats(execution.ID))
+	})
+
+	t.Run("execution interrupted too many times is failed", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+
+		//
+		// Simulate workers going away while processing the execution.
+		//
+		for range models.MaxNodeExecutionAttempts {
+			_, err := models.StartNodeExecutionHeartbeat(execution.ID, "gone")
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, executor.LockAndProcessNodeExecution(context.Background(), execution.ID))
+
+		updatedExecution, err := models.FindNodeExecution(canvas.ID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, updatedExecution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultFailed, updatedExecution.Result)
+		assert.Equal(t, "execution was interrupted 3 times", updatedExecution.ResultMessage)
+		assert.Zero(t, countHeartbeats(execution.ID))
+	})
+}
+
+func Test__NodeExecutor_Drain(t *testing.T) {
+	t.Run("waits for in-flight executions", func(t *testing.T) {
+		executor := &NodeExecutor{
+			semaphore:    semaphore.NewWeighted(maxConcurrentExecutions),
+			drainTimeout: time.Second,
+			logger:       logrus.NewEntry(logrus.New()),
+		}
+
+		require.NoError(t, executor.semaphore.Acquire(context.Background(), 1))
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			executor.semaphore.Release(1)
+		}()
+
+		interrupted := false
+		executor.drain(func() { interrupted = true })
+		assert.False(t, interrupted)
+	})
+
+	t.Run("interrupts in-flight executions after the drain timeout", func(t *testing.T) {
+		executor := &NodeExecutor{
+			semaphore:    semaphore.NewWeighted(maxConcurrentExecutions),
+			drainTimeout: 10 * time.Millisecond,
+			logger:       logrus.NewEntry(logrus.New()),
+		}
+
+		executionCtx, cancelExecutions := context.WithCancel(context.Background())
+		require.NoError(t, executor.semaphore.Acquire(context.Background(), 1))
+		go func() {
+			<-executionCtx.Done()
+			executor.semaphore.Release(1)
+		}()
+
+		executor.drain(cancelExecutions)
+		assert.Error(t, executionCtx.Err())
+	})
+}
+
+func Test__DrainTimeout(t *testing.T) {
+	t.Setenv("WORKER_DRAIN_TIMEOUT_SECONDS", "")
+	assert.Equal(t, DefaultDrainTimeout, DrainTimeout())
+
+	t.Setenv("WORKER_DRAIN_TIMEOUT_SECONDS", "5")
+	assert.Equal(t, 5*time.Second, DrainTimeout())
+}
+
 func countConcurrentExecutionResults(t *testing.T, results []error) (successCount int, lockedCount int) {
 	for i, result := range results {
 		switch result {
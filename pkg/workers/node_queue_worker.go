@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"golang.org/x/sync/semaphore"
@@ -17,15 +18,21 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/quotas"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"github.com/superplanehq/superplane/pkg/workers/coordination"
 )
 
 type NodeQueueWorker struct {
 	registry  *registry.Registry
 	semaphore *semaphore.Weighted
 	logger    *log.Entry
+
+	// Nodes whose queue is held by an exceeded organization quota,
+	// so it is only logged when that starts and stops, not on every tick.
+	overQuota sync.Map
 }
 
 func NewNodeQueueWorker(registry *registry.Registry) *NodeQueueWorker {
@@ -37,16 +44,15 @@ func NewNodeQueueWorker(registry *registry.Registry) *NodeQueueWorker {
 }
 
 func (w *NodeQueueWorker) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
+	wakeups := coordination.Wakeups(ctx, coordination.PollInterval(), "workflow_node_queue_items", "workflow_nodes")
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
+		case <-wakeups:
 			tickStart := time.Now()
-			nodes, err := models.ListCanvasNodesReady()
+			nodes, err := models.ListCanvasNodesReady(coordination.CanvasShards(), schedulingBatchSize)
 			if err != nil {
 				w.logger.Errorf("Error finding canvas nodes ready to be processed: %v", err)
 			}
@@ -63,7 +69,9 @@ func (w *NodeQueueWorker) Start(ctx context.Context) {
 				go func(node models.CanvasNode) {
 					defer w.semaphore.Release(1)
 
-					if err := w.LockAndProcessNode(logger, node); err != nil {
+					err := w.LockAndProcessNode(logger, node)
+					w.logQuotaState(logger, node, err)
+					if err != nil && !quotas.IsExceeded(err) {
 						logger.Errorf("Error processing: %v", err)
 					}
 				}(node)
@@ -74,6 +82,25 @@ func (w *NodeQueueWorker) Start(ctx context.Context) {
 	}
 }
 
+func (w *NodeQueueWorker) logQuotaState(logger *log.Entry, node models.CanvasNode, err error) {
+	key := node.WorkflowID.String() + "/" + node.NodeID
+	if quotas.IsExceeded(err) {
+		if _, held := w.overQuota.LoadOrStore(key, true); !held {
+			logger.Warnf("Queue items kept until the quota allows them: %v", err)
+		}
+
+		return
+	}
+
+	if err != nil {
+		return
+	}
+
+	if _, held := w.overQuota.LoadAndDelete(key); held {
+		logger.Infof("Quota no longer exceeded - processing queue items")
+	}
+}
+
 func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.CanvasNode) error {
 	var executionIDs []*uuid.UUID
 	var queueItem *models.CanvasNodeQueueItem
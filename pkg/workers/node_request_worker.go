@@ -18,39 +18,42 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"github.com/superplanehq/superplane/pkg/workers/coordination"
 )
 
 type NodeRequestWorker struct {
 	semaphore      *semaphore.Weighted
 	registry       *registry.Registry
 	encryptor      crypto.Encryptor
+	oidcProvider   oidc.Provider
 	webhookBaseURL string
 }
 
-func NewNodeRequestWorker(encryptor crypto.Encryptor, registry *registry.Registry, webhookBaseURL string) *NodeRequestWorker {
+func NewNodeRequestWorker(encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, webhookBaseURL string) *NodeRequestWorker {
 	return &NodeRequestWorker{
 		encryptor:      encryptor,
 		registry:       registry,
+		oidcProvider:   oidcProvider,
 		webhookBaseURL: webhookBaseURL,
 		semaphore:      semaphore.NewWeighted(25),
 	}
 }
 
 func (w *NodeRequestWorker) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
+	wakeups := coordination.Wakeups(ctx, time.Second, "workflow_node_requests")
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
+		case <-wakeups:
 			tickStart := time.Now()
 
-			requests, err := models.ListNodeRequests()
+			requests, err := models.ListNodeRequests(coordination.CanvasShards())
 			if err != nil {
 				w.log("Error finding workflow nodes ready to be processed: %v", err)
 			}
@@ -158,6 +161,7 @@ func (w *NodeRequestWorker) invokeTriggerAction(tx *gorm.DB, request *models.Can
 		Metadata:      contexts.NewNodeMetadataContext(tx, node),
 		Events:        contexts.NewEventContext(tx, node),
 		Requests:      contexts.NewNodeRequestContext(tx, node),
+		Calendars:     contexts.NewCalendarContext(tx, node),
 	}
 
 	if node.WebhookID != nil {
@@ -303,8 +307,9 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, node.WorkflowID),
-		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
+		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, workflow.ID, nil, nil),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Identity:       contexts.NewIdentityContext(w.oidcProvider, workflow.OrganizationID, execution),
 	}
 
 	if node.AppInstallationID != nil {
@@ -384,8 +389,9 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
-		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
+		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, workflow.ID, nil, nil),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Identity:       contexts.NewIdentityContext(w.oidcProvider, workflow.OrganizationID, execution),
 	}
 
 	err = component.HandleAction(actionCtx)
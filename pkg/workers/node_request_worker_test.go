@@ -18,7 +18,7 @@ import (
 func Test__NodeRequestWorker_InvokeTriggerAction(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, nil, "")
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -87,7 +87,7 @@ func Test__NodeRequestWorker_InvokeTriggerAction(t *testing.T) {
 func Test__NodeRequestWorker_InvokeNodeComponentActionWithoutExecution(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, nil, "")
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -192,12 +192,12 @@ func Test__NodeRequestWorker_PreventsConcurrentProcessing(t *testing.T) {
 	// Create two workers and have them try to process the request concurrently.
 	//
 	go func() {
-		worker1 := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+		worker1 := NewNodeRequestWorker(r.Encryptor, r.Registry, nil, "")
 		results <- worker1.LockAndProcessRequest(request)
 	}()
 
 	go func() {
-		worker2 := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+		worker2 := NewNodeRequestWorker(r.Encryptor, r.Registry, nil, "")
 		results <- worker2.LockAndProcessRequest(request)
 	}()
 
@@ -229,7 +229,7 @@ func Test__NodeRequestWorker_PreventsConcurrentProcessing(t *testing.T) {
 func Test__NodeRequestWorker_UnsupportedRequestType(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, nil, "")
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -286,7 +286,7 @@ func Test__NodeRequestWorker_UnsupportedRequestType(t *testing.T) {
 func Test__NodeRequestWorker_MissingInvokeActionSpec(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, nil, "")
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -343,7 +343,7 @@ func Test__NodeRequestWorker_MissingInvokeActionSpec(t *testing.T) {
 func Test__NodeRequestWorker_NonExistentTrigger(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, nil, "")
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -399,7 +399,7 @@ func Test__NodeRequestWorker_NonExistentTrigger(t *testing.T) {
 func Test__NodeRequestWorker_NonExistentAction(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, nil, "")
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -517,7 +517,7 @@ func Test__NodeRequestWorker_DoesNotProcessDeletedNodeRequests(t *testing.T) {
 	//
 	// Verify that ListNodeRequests does not return the request for the deleted node.
 	//
-	requests, err := models.ListNodeRequests()
+	requests, err := models.ListNodeRequests(nil)
 	require.NoError(t, err)
 
 	// Check that our request is not in the list
@@ -592,7 +592,7 @@ func Test__NodeRequestWorker_DoesNotProcessDeletedWorkflowRequests(t *testing.T)
 	//
 	// Verify that ListNodeRequests does not return the request for the deleted workflow.
 	//
-	requests, err := models.ListNodeRequests()
+	requests, err := models.ListNodeRequests(nil)
 	require.NoError(t, err)
 
 	// Check that our request is not in the list
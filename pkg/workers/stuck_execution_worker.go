@@ -0,0 +1,127 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+const (
+	StuckExecutionActionFail  = "fail"
+	StuckExecutionActionAlert = "alert"
+
+	stuckExecutionWorkerInterval = time.Minute
+	stuckExecutionWorkerBatch    = 100
+)
+
+/*
+ * StuckExecutionWorker looks for started executions without any activity
+ * for longer than the MaxWaitingTime() hint of their component,
+ * e.g. executions waiting for a webhook that was lost.
+ *
+ * Stuck executions are reported with a metric, and either failed,
+ * so the nodes they block can process their queues again, or only logged.
+ */
+type StuckExecutionWorker struct {
+	action string
+	logger *log.Entry
+}
+
+func NewStuckExecutionWorker(action string) *StuckExecutionWorker {
+	if action != StuckExecutionActionAlert {
+		action = StuckExecutionActionFail
+	}
+
+	return &StuckExecutionWorker{
+		action: action,
+		logger: log.WithFields(log.Fields{"worker": "StuckExecutionWorker"}),
+	}
+}
+
+func (w *StuckExecutionWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(stuckExecutionWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Tick(); err != nil {
+				w.logger.Errorf("Error handling stuck executions: %v", err)
+			}
+		}
+	}
+}
+
+func (w *StuckExecutionWorker) Tick() error {
+	count, err := models.CountStuckNodeExecutions()
+	if err != nil {
+		return fmt.Errorf("error counting stuck executions: %w", err)
+	}
+
+	telemetry.RecordStuckExecutionsCount(context.Background(), count)
+	if count == 0 {
+		return nil
+	}
+
+	executions, err := models.ListStuckNodeExecutions(stuckExecutionWorkerBatch)
+	if err != nil {
+		return fmt.Errorf("error listing stuck executions: %w", err)
+	}
+
+	for _, execution := range executions {
+		if w.action == StuckExecutionActionAlert {
+			w.logger.Warnf(
+				"Execution %s of node %s in canvas %s has no activity since %v",
+				execution.ID, execution.NodeID, execution.WorkflowID, execution.LastActivityAt,
+			)
+
+			continue
+		}
+
+		if err := w.fail(execution); err != nil {
+			w.logger.Errorf("Error failing stuck execution %s: %v", execution.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *StuckExecutionWorker) fail(execution models.CanvasNodeExecution) error {
+	failed := false
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		//
+		// If the execution can't be locked, or had some activity
+		// since it was listed, it is no longer stuck.
+		//
+		locked, err := models.LockStuckNodeExecution(tx, execution.ID)
+		if err != nil {
+			return nil
+		}
+
+		w.logger.Warnf("Failing execution %s of node %s in canvas %s - no activity since %v", locked.ID, locked.NodeID, locked.WorkflowID, locked.LastActivityAt)
+
+		failed = true
+		return locked.FailInTransaction(
+			tx,
+			models.CanvasNodeExecutionResultReasonError,
+			fmt.Sprintf("execution had no activity for more than %v", time.Duration(*locked.MaxWaitingSeconds)*time.Second),
+		)
+	})
+
+	if err != nil || !failed {
+		return err
+	}
+
+	messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).Publish()
+	return nil
+}
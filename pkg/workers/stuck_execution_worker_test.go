@@ -0,0 +1,137 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__StuckExecutionWorker(t *testing.T) {
+	r := support.Setup(t)
+	support.RegisterWaitingComponent(t, r, "waiting-approval", "approval", time.Hour)
+
+	approvalConfiguration := map[string]any{
+		"items": []any{
+			map[string]any{
+				"type": "user",
+				"user": r.User.String(),
+			},
+		},
+	}
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:        "waiting-1",
+				Type:          models.NodeTypeComponent,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "waiting-approval"}}),
+				Configuration: datatypes.NewJSONType(approvalConfiguration),
+			},
+			{
+				NodeID:        "approval-1",
+				Type:          models.NodeTypeComponent,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "approval"}}),
+				Configuration: datatypes.NewJSONType(approvalConfiguration),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "waiting-1", Channel: "default"},
+			{SourceID: "trigger-1", TargetID: "approval-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, nil, "http://localhost", "http://localhost")
+
+	findExecution := func(execution *models.CanvasNodeExecution) *models.CanvasNodeExecution {
+		updated, err := models.FindNodeExecution(canvas.ID, execution.ID)
+		require.NoError(t, err)
+		return updated
+	}
+
+	//
+	// Runs an execution through the executor, and moves its
+	// last activity back in time, as if it was waiting since then.
+	//
+	runExecution := func(nodeID string, idleFor time.Duration) *models.CanvasNodeExecution {
+		execution := support.CreateNodeExecutionWithConfiguration(t, canvas.ID, nodeID, rootEvent.ID, rootEvent.ID, nil, approvalConfiguration)
+		require.NoError(t, executor.LockAndProcessNodeExecution(context.Background(), execution.ID))
+
+		execution = findExecution(execution)
+		require.Equal(t, models.CanvasNodeExecutionStateStarted, execution.State)
+		require.NoError(t, database.Conn().Model(execution).Update("last_activity_at", time.Now().Add(-idleFor)).Error)
+
+		return findExecution(execution)
+	}
+
+	t.Run("maximum waiting time is recorded when the execution starts", func(t *testing.T) {
+		execution := runExecution("waiting-1", 0)
+		require.NotNil(t, execution.MaxWaitingSeconds)
+		assert.Equal(t, 3600, *execution.MaxWaitingSeconds)
+
+		execution = runExecution("approval-1", 0)
+		assert.Nil(t, execution.MaxWaitingSeconds)
+	})
+
+	t.Run("executions with recent activity are not stuck", func(t *testing.T) {
+		execution := runExecution("waiting-1", time.Minute)
+		require.NoError(t, NewStuckExecutionWorker(StuckExecutionActionFail).Tick())
+		assert.Equal(t, models.CanvasNodeExecutionStateStarted, findExecution(execution).State)
+	})
+
+	t.Run("executions without a maximum waiting time are never stuck", func(t *testing.T) {
+		execution := runExecution("approval-1", 2*time.Hour)
+		require.NoError(t, NewStuckExecutionWorker(StuckExecutionActionFail).Tick())
+		assert.Equal(t, models.CanvasNodeExecutionStateStarted, findExecution(execution).State)
+	})
+
+	t.Run("stuck executions are only reported when alerting", func(t *testing.T) {
+		execution := runExecution("waiting-1", 2*time.Hour)
+
+		count, err := models.CountStuckNodeExecutions()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		require.NoError(t, NewStuckExecutionWorker(StuckExecutionActionAlert).Tick())
+		assert.Equal(t, models.CanvasNodeExecutionStateStarted, findExecution(execution).State)
+	})
+
+	t.Run("stuck executions are failed", func(t *testing.T) {
+		execution := runExecution("waiting-1", 2*time.Hour)
+		require.NoError(t, NewStuckExecutionWorker(StuckExecutionActionFail).Tick())
+
+		updated := findExecution(execution)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, updated.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultFailed, updated.Result)
+		assert.Equal(t, "execution had no activity for more than 1h0m0s", updated.ResultMessage)
+
+		count, err := models.CountStuckNodeExecutions()
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("metadata updates are activity", func(t *testing.T) {
+		execution := runExecution("waiting-1", 2*time.Hour)
+
+		metadata := contexts.NewExecutionMetadataContext(database.Conn(), execution)
+		require.NoError(t, metadata.Set(map[string]any{"status": "running"}))
+		require.NoError(t, NewStuckExecutionWorker(StuckExecutionActionFail).Tick())
+		assert.Equal(t, models.CanvasNodeExecutionStateStarted, findExecution(execution).State)
+	})
+}
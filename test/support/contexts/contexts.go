@@ -3,6 +3,7 @@ package contexts
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -367,3 +368,30 @@ func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 
 	return value, nil
 }
+
+type CalendarContext struct {
+	ExcludedDays map[string][]string
+}
+
+func (c *CalendarContext) IsExcluded(calendarName string, t time.Time) (bool, error) {
+	days, ok := c.ExcludedDays[calendarName]
+	if !ok {
+		return false, fmt.Errorf("calendar not found: %s", calendarName)
+	}
+
+	return slices.Contains(days, t.Format("2006-01-02")), nil
+}
+
+type IdentityContext struct {
+	Tokens []IdentityToken
+}
+
+type IdentityToken struct {
+	Audience string
+	Duration time.Duration
+}
+
+func (c *IdentityContext) Token(audience string, duration time.Duration) (string, error) {
+	c.Tokens = append(c.Tokens, IdentityToken{Audience: audience, Duration: duration})
+	return "identity-token-for-" + audience, nil
+}
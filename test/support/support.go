@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
@@ -131,6 +132,28 @@ func CreateSecret(t *testing.T, r *ResourceRegistry, secretData map[string]strin
 	return secret, nil
 }
 
+// waitingComponent gives a registered component a maximum waiting time.
+type waitingComponent struct {
+	core.Component
+	maxWaitingTime time.Duration
+}
+
+func (c *waitingComponent) MaxWaitingTime() time.Duration {
+	return c.maxWaitingTime
+}
+
+// RegisterWaitingComponent registers the component named from under a new name,
+// with a maximum waiting time, so its executions can be reported as stuck.
+func RegisterWaitingComponent(t *testing.T, r *ResourceRegistry, name, from string, maxWaitingTime time.Duration) {
+	component, err := r.Registry.GetComponent(from)
+	require.NoError(t, err)
+
+	r.Registry.Components[name] = &waitingComponent{
+		Component:      component,
+		maxWaitingTime: maxWaitingTime,
+	}
+}
+
 func RandomName(prefix string) string {
 	return prefix + "-" + uuid.New().String()
 }